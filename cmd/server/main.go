@@ -4,18 +4,31 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 	"transactor-server/pkg/account"
+	"transactor-server/pkg/accrual"
 	"transactor-server/pkg/api"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/fx"
+	"transactor-server/pkg/hold"
+	"transactor-server/pkg/idempotency"
 	"transactor-server/pkg/infra/config"
 	"transactor-server/pkg/infra/log"
+	"transactor-server/pkg/journal"
 	"transactor-server/pkg/metric"
+	"transactor-server/pkg/money"
 	"transactor-server/pkg/operationtype"
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/statement"
+	"transactor-server/pkg/stream"
 	"transactor-server/pkg/tracer"
 	"transactor-server/pkg/transaction"
+	"transactor-server/pkg/transfer"
+	"transactor-server/pkg/webhook"
 
 	appconfig "transactor-server/pkg/config"
 	"transactor-server/pkg/db"
@@ -43,6 +56,11 @@ func main() {
 		log.L.Fatal("", zap.Error(err))
 	}
 
+	err = cfg.Validate()
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
 	var otelConn *grpc.ClientConn
 
 	if cfg.Server.EnableTelemetry {
@@ -98,14 +116,46 @@ func main() {
 
 	operationTypeDAO := operationtype.NewDAO(entClient)
 
+	allocationStrategy, err := transaction.NewAllocationStrategy(cfg.Transaction.AllocationStrategy, cfg.Transaction.OperationTypePriority)
+	if err != nil {
+		logger.Fatal("", zap.Error(err))
+	}
+
 	transactionDAO := transaction.NewDAO(entClient)
 	transactionService := transaction.NewService(
 		operationTypeDAO,
 		transactionDAO,
 		logger.With(zap.String("layer", "application"), zap.String("service", "transaction")),
+		transaction.WithMaxBackdate(cfg.Transaction.MaxBackdate),
+		transaction.WithAllocationStrategy(allocationStrategy),
 	)
 	transactionAPI := transaction.NewAPI(transactionService)
 
+	transferDAO := transfer.NewDAO(entClient)
+	transferService := transfer.NewService(
+		transferDAO,
+		logger.With(zap.String("layer", "application"), zap.String("service", "transfer")),
+		transfer.WithAllocationStrategy(allocationStrategy),
+	)
+	transferService = transfer.NewTracedService(transferService, logger.With(zap.String("layer", "application"), zap.String("service", "transfer")))
+	transferService = transfer.NewMeteredService(transferService)
+	transferAPI := transfer.NewAPI(transferService)
+
+	holdDAO := hold.NewDAO(entClient, hold.WithTTL(cfg.Transaction.HoldTTL))
+	holdService := hold.NewService(
+		operationTypeDAO,
+		holdDAO,
+		logger.With(zap.String("layer", "application"), zap.String("service", "hold")),
+	)
+	holdService = hold.NewTracedService(holdService, logger.With(zap.String("layer", "application"), zap.String("service", "hold")))
+	holdService = hold.NewMeteredService(holdService)
+	holdAPI := hold.NewAPI(holdService)
+	holdSweeper := hold.NewSweeper(
+		holdDAO,
+		cfg.Transaction.HoldSweepInterval,
+		logger.With(zap.String("layer", "application"), zap.String("job", "hold_sweeper")),
+	)
+
 	accountDAO := account.NewDAO(entClient)
 	accountService := account.NewService(
 		accountDAO,
@@ -115,7 +165,131 @@ func main() {
 	accountService = account.NewMeteredService(accountService)
 	accountAPI := account.NewAPI(accountService)
 
-	app := api.NewRouter(cfg.Server.APIKey, transactionAPI, accountAPI, logger)
+	statementDAO := statement.NewDAO(entClient)
+	statementService := statement.NewService(
+		statementDAO,
+		logger.With(zap.String("layer", "application"), zap.String("service", "statement")),
+	)
+	statementService = statement.NewTracedService(statementService, logger.With(zap.String("layer", "application"), zap.String("service", "statement")))
+	statementService = statement.NewMeteredService(statementService)
+	statementAPI := statement.NewAPI(statementService)
+
+	minimumPaymentRule, err := billing.NewMinimumPaymentRule(cfg.Billing.MinimumPaymentRule, cfg.Billing.MinimumPaymentPercent, cfg.Billing.MinimumPaymentFloor)
+	if err != nil {
+		logger.Fatal("", zap.Error(err))
+	}
+
+	billingDAO := billing.NewDAO(entClient, billing.WithMinimumPaymentRule(minimumPaymentRule))
+	billingService := billing.NewService(
+		billingDAO,
+		logger.With(zap.String("layer", "application"), zap.String("service", "billing")),
+	)
+	billingService = billing.NewTracedService(billingService, logger.With(zap.String("layer", "application"), zap.String("service", "billing")))
+	billingService = billing.NewMeteredService(billingService)
+	billingAPI := billing.NewAPI(billingService)
+	cycleCloser := billing.NewCycleCloser(
+		billingDAO,
+		cfg.Billing.CloseInterval,
+		logger.With(zap.String("layer", "application"), zap.String("job", "cycle_closer")),
+	)
+
+	fxDAO := fx.NewDAO(entClient)
+	fxService := fx.NewService(
+		fxDAO,
+		logger.With(zap.String("layer", "application"), zap.String("service", "fx")),
+	)
+	fxService = fx.NewTracedService(fxService, logger.With(zap.String("layer", "application"), zap.String("service", "fx")))
+	fxService = fx.NewMeteredService(fxService)
+	fxAPI := fx.NewAPI(fxService)
+
+	journalDAO := journal.NewDAO(entClient)
+	journalService := journal.NewService(
+		journalDAO,
+		logger.With(zap.String("layer", "application"), zap.String("service", "journal")),
+	)
+	journalService = journal.NewTracedService(journalService, logger.With(zap.String("layer", "application"), zap.String("service", "journal")))
+	journalService = journal.NewMeteredService(journalService)
+	journalAPI := journal.NewAPI(journalService)
+
+	interestRates, err := accrual.NewInterestRates(cfg.Accrual.InterestRates)
+	if err != nil {
+		logger.Fatal("", zap.Error(err))
+	}
+	lateFee, err := money.Parse(cfg.Accrual.LateFee)
+	if err != nil {
+		logger.Fatal("", zap.Error(err))
+	}
+
+	accrualDAO := accrual.NewDAO(entClient, accrual.WithInterestRates(interestRates), accrual.WithLateFee(lateFee))
+	accruer := accrual.NewAccruer(
+		accrualDAO,
+		cfg.Accrual.Interval,
+		logger.With(zap.String("layer", "application"), zap.String("job", "accruer")),
+	)
+
+	streamService := stream.NewService(
+		stream.NewDAO(entClient),
+		logger.With(zap.String("layer", "application"), zap.String("service", "stream")),
+		stream.WithPollInterval(cfg.Stream.PollInterval),
+	)
+	streamService = stream.NewTracedService(streamService, logger.With(zap.String("layer", "application"), zap.String("service", "stream")))
+	streamService = stream.NewMeteredService(streamService)
+	streamAPI := stream.NewAPI(streamService)
+
+	webhookDAO := webhook.NewDAO(entClient)
+	webhookService := webhook.NewService(
+		webhookDAO,
+		logger.With(zap.String("layer", "application"), zap.String("service", "webhook")),
+	)
+	webhookService = webhook.NewTracedService(webhookService, logger.With(zap.String("layer", "application"), zap.String("service", "webhook")))
+	webhookService = webhook.NewMeteredService(webhookService)
+	webhookAPI := webhook.NewAPI(webhookService)
+	webhookDeliverer := webhook.NewDeliverer(
+		webhookDAO,
+		&http.Client{Timeout: cfg.Webhook.Timeout},
+		cfg.Webhook.DeliverInterval,
+		logger.With(zap.String("layer", "application"), zap.String("job", "webhook_deliverer")),
+		webhook.WithBatchSize(cfg.Webhook.BatchSize),
+		webhook.WithMaxAttempts(cfg.Webhook.MaxAttempts),
+		webhook.WithBackoff(cfg.Webhook.Backoff, cfg.Webhook.MaxBackoff),
+	)
+
+	outboxPublisher, err := outbox.NewPublisher(cfg.Outbox.Publisher, cfg.Outbox.FilePath, cfg.Outbox.HTTPURL, cfg.Outbox.HTTPTimeout)
+	if err != nil {
+		logger.Fatal("", zap.Error(err))
+	}
+	outboxDAO := outbox.NewDAO(entClient)
+	outboxRelay := outbox.NewRelay(
+		outboxDAO,
+		// every event also becomes the deliveries of the webhooks which subscribed to it
+		// the enqueuer goes first so an outage of the external publisher does not hold back the webhooks,
+		// enqueueing an event again when the external publisher is retried adds no deliveries
+		outbox.NewFanoutPublisher(webhook.NewEnqueuer(webhookDAO), outboxPublisher),
+		cfg.Outbox.RelayInterval,
+		cfg.Outbox.BatchSize,
+		logger.With(zap.String("layer", "application"), zap.String("job", "outbox_relay")),
+	)
+	outboxSweeper := outbox.NewSweeper(
+		outboxDAO,
+		cfg.Outbox.Retention,
+		cfg.Outbox.SweepInterval,
+		logger.With(zap.String("layer", "application"), zap.String("job", "outbox_sweeper")),
+	)
+
+	idempotencyDAO := idempotency.NewDAO(entClient)
+	idempotencyMiddleware := idempotency.New(
+		idempotencyDAO,
+		cfg.Idempotency.KeyTTL,
+		logger.With(zap.String("layer", "transport"), zap.String("middleware", "idempotency")),
+		idempotency.WithLockTimeout(cfg.Idempotency.LockTimeout),
+	)
+	idempotencySweeper := idempotency.NewSweeper(
+		idempotencyDAO,
+		cfg.Idempotency.SweepInterval,
+		logger.With(zap.String("layer", "application"), zap.String("job", "idempotency_sweeper")),
+	)
+
+	app := api.NewRouter(cfg.Server.APIKey, idempotencyMiddleware, transactionAPI, transferAPI, holdAPI, accountAPI, statementAPI, billingAPI, fxAPI, journalAPI, webhookAPI, streamAPI, logger)
 
 	var g run.Group
 	{
@@ -124,6 +298,8 @@ func main() {
 			return app.Listen(addr)
 		}, func(error) {
 			logger.Info("server", zap.String("msg", "stopping http server"))
+			// open event streams would keep the server from shutting down, their clients reconnect elsewhere
+			streamAPI.Close()
 			if err := app.Shutdown(); err != nil {
 				logger.Fatal("", zap.Error(err))
 			}
@@ -134,6 +310,69 @@ func main() {
 			}
 		})
 	}
+	{
+		// removes expired idempotency keys in the background
+		sweeperCtx, sweeperCancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return idempotencySweeper.Run(sweeperCtx)
+		}, func(error) {
+			sweeperCancel()
+		})
+	}
+	{
+		// releases holds which expired before they were captured in the background
+		sweeperCtx, sweeperCancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return holdSweeper.Run(sweeperCtx)
+		}, func(error) {
+			sweeperCancel()
+		})
+	}
+	{
+		// closes the billing cycles which ended into invoices in the background
+		closerCtx, closerCancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return cycleCloser.Run(closerCtx)
+		}, func(error) {
+			closerCancel()
+		})
+	}
+	{
+		// charges interest and late fees in the background
+		accruerCtx, accruerCancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return accruer.Run(accruerCtx)
+		}, func(error) {
+			accruerCancel()
+		})
+	}
+	{
+		// publishes the events of the outbox in the background
+		relayCtx, relayCancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return outboxRelay.Run(relayCtx)
+		}, func(error) {
+			relayCancel()
+		})
+	}
+	{
+		// removes the events which were published longer than the retention ago in the background
+		sweeperCtx, sweeperCancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return outboxSweeper.Run(sweeperCtx)
+		}, func(error) {
+			sweeperCancel()
+		})
+	}
+	{
+		// sends the webhook deliveries which are due in the background
+		delivererCtx, delivererCancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return webhookDeliverer.Run(delivererCtx)
+		}, func(error) {
+			delivererCancel()
+		})
+	}
 	{
 		// set-up our signal handler
 		var (
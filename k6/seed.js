@@ -45,8 +45,9 @@ export default function () {
 
   for (let i = 1; i <= 10; i++) {
     const operationType = operationTypes[faker.random.number(3)];
+    // amounts can have at most 2 decimal places
     const amount =
-      (faker.finance.amount(1000, 9999) / 100) *
+      (Math.round(faker.finance.amount(1000, 9999)) / 100) *
       (operationType.is_debit ? -1 : 1);
     const operationTypeId = operationType.id;
 
@@ -0,0 +1,6 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "idempotency_keys" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "key" character varying(255) NOT NULL, "request_hash" character varying NOT NULL, "response_status" bigint NULL, "response_body" bytea NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "idempotency_keys_key_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotency_keys_key_key" ON "idempotency_keys" ("key");
+-- Create index "idempotencykey_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idempotencykey_expires_at" ON "idempotency_keys" ("expires_at");
@@ -0,0 +1,3 @@
+-- Modify "transactions" table
+-- amount & balance are now stored in minor units (cents) so existing values are scaled by 100
+ALTER TABLE "transactions" ALTER COLUMN "amount" TYPE bigint USING round("amount" * 100)::bigint, ALTER COLUMN "balance" TYPE bigint USING round("balance" * 100)::bigint;
@@ -0,0 +1,6 @@
+-- Create "settlements" table
+CREATE TABLE "settlements" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "amount" bigint NOT NULL, "timestamp" timestamptz NOT NULL, "credit_txn_id" bigint NOT NULL, "debit_txn_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "settlements_transactions_credit_settlements" FOREIGN KEY ("credit_txn_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "settlements_transactions_debit_settlements" FOREIGN KEY ("debit_txn_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "settlement_credit_txn_id" to table: "settlements"
+CREATE INDEX "settlement_credit_txn_id" ON "settlements" ("credit_txn_id");
+-- Create index "settlement_debit_txn_id" to table: "settlements"
+CREATE INDEX "settlement_debit_txn_id" ON "settlements" ("debit_txn_id");
@@ -0,0 +1,2 @@
+-- Modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "credit_limit" bigint NULL;
@@ -0,0 +1,4 @@
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "installment_number" bigint NULL, ADD COLUMN "due_date" timestamptz NULL, ADD COLUMN "parent_id" bigint NULL, ADD CONSTRAINT "transactions_transactions_installments" FOREIGN KEY ("parent_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "transaction_parent_id" to table: "transactions"
+CREATE INDEX "transaction_parent_id" ON "transactions" ("parent_id");
@@ -0,0 +1,18 @@
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "status" character varying NOT NULL DEFAULT 'posted', ADD COLUMN "reversal_of_id" bigint NULL, ADD CONSTRAINT "transactions_transactions_reversal" FOREIGN KEY ("reversal_of_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "transactions_reversal_of_id_key" to table: "transactions"
+CREATE UNIQUE INDEX "transactions_reversal_of_id_key" ON "transactions" ("reversal_of_id");
+-- Modify "settlements" table
+ALTER TABLE "settlements" ADD COLUMN "reversed_at" timestamptz NULL;
+-- Add the operation types of the compensating transactions of reversals.
+INSERT INTO
+    operation_types (
+        id,
+        description,
+        is_debit,
+        create_time,
+        update_time
+    )
+VALUES
+    (5, 'Debit Reversal', false, now(), now()),
+    (6, 'Credit Reversal', true, now(), now());
@@ -0,0 +1,22 @@
+-- Create "transfers" table
+CREATE TABLE "transfers" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "amount" bigint NOT NULL, "timestamp" timestamptz NOT NULL, "source_account_id" bigint NOT NULL, "destination_account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "transfers_accounts_outgoing_transfers" FOREIGN KEY ("source_account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "transfers_accounts_incoming_transfers" FOREIGN KEY ("destination_account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "transfer_source_account_id" to table: "transfers"
+CREATE INDEX "transfer_source_account_id" ON "transfers" ("source_account_id");
+-- Create index "transfer_destination_account_id" to table: "transfers"
+CREATE INDEX "transfer_destination_account_id" ON "transfers" ("destination_account_id");
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "transfer_id" bigint NULL, ADD CONSTRAINT "transactions_transfers_transactions" FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "transaction_transfer_id" to table: "transactions"
+CREATE INDEX "transaction_transfer_id" ON "transactions" ("transfer_id");
+-- Add the operation types of the two legs of a transfer.
+INSERT INTO
+    operation_types (
+        id,
+        description,
+        is_debit,
+        create_time,
+        update_time
+    )
+VALUES
+    (7, 'Transfer Out', true, now(), now()),
+    (8, 'Transfer In', false, now(), now());
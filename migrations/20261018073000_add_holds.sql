@@ -0,0 +1,8 @@
+-- Create "holds" table
+CREATE TABLE "holds" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "amount" bigint NOT NULL, "status" character varying NOT NULL DEFAULT 'active', "expires_at" timestamptz NOT NULL, "captured_amount" bigint NULL, "account_id" bigint NOT NULL, "operation_type_id" bigint NOT NULL, "transaction_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "holds_accounts_holds" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "holds_operation_types_holds" FOREIGN KEY ("operation_type_id") REFERENCES "operation_types" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "holds_transactions_hold" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "hold_account_id_status" to table: "holds"
+CREATE INDEX "hold_account_id_status" ON "holds" ("account_id", "status");
+-- Create index "hold_status_expires_at" to table: "holds"
+CREATE INDEX "hold_status_expires_at" ON "holds" ("status", "expires_at");
+-- Create index "holds_transaction_id_key" to table: "holds"
+CREATE UNIQUE INDEX "holds_transaction_id_key" ON "holds" ("transaction_id");
@@ -0,0 +1,4 @@
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "description" character varying(255) NULL, ADD COLUMN "merchant_name" character varying(255) NULL, ADD COLUMN "mcc" character varying(4) NULL, ADD COLUMN "external_reference" character varying(128) NULL, ADD COLUMN "metadata" jsonb NULL;
+-- Create index "transaction_account_id_external_reference" to table: "transactions"
+CREATE INDEX "transaction_account_id_external_reference" ON "transactions" ("account_id", "external_reference");
@@ -0,0 +1,8 @@
+-- Modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "cycle_closing_day" bigint NULL, ADD COLUMN "due_date_offset" bigint NOT NULL DEFAULT 10;
+-- Create "invoices" table
+CREATE TABLE "invoices" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "period_start" timestamptz NOT NULL, "period_end" timestamptz NOT NULL, "total_due" bigint NOT NULL, "minimum_payment" bigint NOT NULL, "due_date" timestamptz NOT NULL, "paid_amount" bigint NOT NULL DEFAULT 0, "status" character varying NOT NULL DEFAULT 'open', "account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "invoices_accounts_invoices" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "invoice_account_id_period_end" to table: "invoices"
+CREATE UNIQUE INDEX "invoice_account_id_period_end" ON "invoices" ("account_id", "period_end");
+-- Create index "invoice_account_id_status" to table: "invoices"
+CREATE INDEX "invoice_account_id_status" ON "invoices" ("account_id", "status");
@@ -0,0 +1,20 @@
+-- Create "accruals" table
+CREATE TABLE "accruals" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "date" timestamptz NOT NULL, "kind" character varying NOT NULL, "amount" bigint NOT NULL, "account_id" bigint NOT NULL, "invoice_id" bigint NULL, "transaction_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "accruals_accounts_accruals" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "accruals_invoices_late_fee" FOREIGN KEY ("invoice_id") REFERENCES "invoices" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "accruals_transactions_accrual" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "accrual_account_id_date_kind" to table: "accruals"
+CREATE UNIQUE INDEX "accrual_account_id_date_kind" ON "accruals" ("account_id", "date", "kind");
+-- Create index "accruals_invoice_id_key" to table: "accruals"
+CREATE UNIQUE INDEX "accruals_invoice_id_key" ON "accruals" ("invoice_id");
+-- Create index "accruals_transaction_id_key" to table: "accruals"
+CREATE UNIQUE INDEX "accruals_transaction_id_key" ON "accruals" ("transaction_id");
+-- Add the operation types of the debits booked by the accrual job.
+INSERT INTO
+    operation_types (
+        id,
+        description,
+        is_debit,
+        create_time,
+        update_time
+    )
+VALUES
+    (9, 'Interest', true, now(), now()),
+    (10, 'Late Fee', true, now(), now());
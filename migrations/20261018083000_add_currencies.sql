@@ -0,0 +1,8 @@
+-- Modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "currency" character varying(3) NOT NULL DEFAULT 'USD';
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "currency" character varying(3) NOT NULL DEFAULT 'USD', ADD COLUMN "original_amount" bigint NULL, ADD COLUMN "original_currency" character varying(3) NULL, ADD COLUMN "fx_rate" character varying(32) NULL;
+-- Create "fx_rates" table
+CREATE TABLE "fx_rates" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "base_currency" character varying(3) NOT NULL, "quote_currency" character varying(3) NOT NULL, "rate" character varying(32) NOT NULL, PRIMARY KEY ("id"));
+-- Create index "fxrate_base_currency_quote_currency" to table: "fx_rates"
+CREATE UNIQUE INDEX "fxrate_base_currency_quote_currency" ON "fx_rates" ("base_currency", "quote_currency");
@@ -0,0 +1,74 @@
+-- Create "journal_entries" table
+CREATE TABLE "journal_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "ledger" character varying NOT NULL, "side" character varying NOT NULL, "amount" bigint NOT NULL, "currency" character varying(3) NOT NULL, "timestamp" timestamptz NOT NULL, "account_id" bigint NULL, "transaction_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "journal_entries_accounts_journal_entries" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "journal_entries_transactions_journal_entries" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "journalentry_account_id" to table: "journal_entries"
+CREATE INDEX "journalentry_account_id" ON "journal_entries" ("account_id");
+-- Create index "journalentry_timestamp" to table: "journal_entries"
+CREATE INDEX "journalentry_timestamp" ON "journal_entries" ("timestamp");
+-- Create index "journalentry_transaction_id" to table: "journal_entries"
+CREATE INDEX "journalentry_transaction_id" ON "journal_entries" ("transaction_id");
+-- Post the existing transactions the same way new ones are posted, installments are posted by their purchase
+-- and a reversal is posted against the ledger of what it reverses.
+INSERT INTO
+    journal_entries (
+        create_time,
+        update_time,
+        transaction_id,
+        ledger,
+        account_id,
+        side,
+        amount,
+        currency,
+        timestamp
+    )
+SELECT
+    now(),
+    now(),
+    t.id,
+    'customer_receivable',
+    t.account_id,
+    CASE WHEN t.amount < 0 THEN 'debit' ELSE 'credit' END,
+    ABS(t.amount),
+    t.currency,
+    t.timestamp
+FROM
+    transactions t
+WHERE
+    t.parent_id IS NULL;
+
+INSERT INTO
+    journal_entries (
+        create_time,
+        update_time,
+        transaction_id,
+        ledger,
+        account_id,
+        side,
+        amount,
+        currency,
+        timestamp
+    )
+SELECT
+    now(),
+    now(),
+    t.id,
+    CASE COALESCE(o.operation_type_id, t.operation_type_id)
+        WHEN 1 THEN 'merchant_payable'
+        WHEN 2 THEN 'merchant_payable'
+        WHEN 3 THEN 'cash'
+        WHEN 4 THEN 'cash'
+        WHEN 7 THEN 'transfer_clearing'
+        WHEN 8 THEN 'transfer_clearing'
+        WHEN 9 THEN 'interest_income'
+        WHEN 10 THEN 'fee_income'
+        ELSE 'suspense'
+    END,
+    NULL,
+    CASE WHEN t.amount < 0 THEN 'credit' ELSE 'debit' END,
+    ABS(t.amount),
+    t.currency,
+    t.timestamp
+FROM
+    transactions t
+    LEFT JOIN transactions o ON o.id = t.reversal_of_id
+WHERE
+    t.parent_id IS NULL;
@@ -0,0 +1,6 @@
+-- Create "outbox_events" table
+CREATE TABLE "outbox_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "type" character varying(64) NOT NULL, "aggregate_id" bigint NOT NULL, "payload" jsonb NOT NULL, "published_at" timestamptz NULL, "attempts" bigint NOT NULL DEFAULT 0, "last_error" character varying(1024) NULL, "account_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "outbox_events_accounts_outbox_events" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "outboxevent_account_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_account_id" ON "outbox_events" ("account_id");
+-- Create index "outboxevent_published_at" to table: "outbox_events"
+CREATE INDEX "outboxevent_published_at" ON "outbox_events" ("published_at");
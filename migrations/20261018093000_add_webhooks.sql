@@ -0,0 +1,8 @@
+-- Create "webhook_subscriptions" table
+CREATE TABLE "webhook_subscriptions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "url" character varying(2048) NOT NULL, "event_types" jsonb NOT NULL, "secret" character varying(128) NOT NULL, PRIMARY KEY ("id"));
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "next_attempt_at" timestamptz NOT NULL, "response_status" bigint NULL, "last_error" character varying(1024) NULL, "delivered_at" timestamptz NULL, "event_id" bigint NOT NULL, "subscription_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_outbox_events_webhook_deliveries" FOREIGN KEY ("event_id") REFERENCES "outbox_events" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "webhook_deliveries_webhook_subscriptions_deliveries" FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+-- Create index "webhookdelivery_subscription_id_event_id" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhookdelivery_subscription_id_event_id" ON "webhook_deliveries" ("subscription_id", "event_id");
@@ -0,0 +1,6 @@
+-- Create "invoice_payments" table
+CREATE TABLE "invoice_payments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "amount" bigint NOT NULL, "reversed_at" timestamptz NULL, "invoice_id" bigint NOT NULL, "transaction_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "invoice_payments_invoices_payments" FOREIGN KEY ("invoice_id") REFERENCES "invoices" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "invoice_payments_transactions_invoice_payment" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "invoicepayment_invoice_id" to table: "invoice_payments"
+CREATE INDEX "invoicepayment_invoice_id" ON "invoice_payments" ("invoice_id");
+-- Create index "invoicepayment_transaction_id" to table: "invoice_payments"
+CREATE UNIQUE INDEX "invoicepayment_transaction_id" ON "invoice_payments" ("transaction_id");
@@ -0,0 +1,8 @@
+-- Keys stored before they were scoped can not be matched to a caller or route, they are short lived so they are dropped
+DELETE FROM "idempotency_keys";
+-- Drop index "idempotency_keys_key_key" from table: "idempotency_keys"
+DROP INDEX "idempotency_keys_key_key";
+-- Modify "idempotency_keys" table
+ALTER TABLE "idempotency_keys" ADD COLUMN "scope" character varying(64) NOT NULL;
+-- Create index "idempotencykey_scope_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotencykey_scope_key" ON "idempotency_keys" ("scope", "key");
@@ -0,0 +1,2 @@
+-- Modify "accruals" table
+ALTER TABLE "accruals" ALTER COLUMN "transaction_id" DROP NOT NULL;
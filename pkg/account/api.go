@@ -26,6 +26,8 @@ func NewAPI(service Service) *API {
 func (a *API) Handle(router fiber.Router) {
 	router.Post("/", a.createAccount)
 	router.Get("/:id", a.getAccount)
+	router.Patch("/:id", a.updateAccount)
+	router.Get("/:id/balance", a.getAccountBalance)
 }
 
 // createAccount creates a new account in DB
@@ -92,3 +94,71 @@ func (a *API) getAccount(c *fiber.Ctx) error {
 	// incase of no error return response with 200 status
 	return c.Status(http.StatusOK).JSON(resp)
 }
+
+// updateAccount changes the credit limit or billing cycle of an existing account
+// @Summary      update an account
+// @Description  send a new credit_limit or unlimited as true to remove the limit, and/or a new cycle_closing_day & due_date_offset
+// @Produce      json
+// @Tags		 account
+// @Param        id     path     int            true  "account id"
+// @Param        req    body     UpdateRequest  true  "account details to update"
+// @Success      200  {object}  Account
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/accounts/{id} [patch]
+func (a *API) updateAccount(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	req := &UpdateRequest{}
+
+	// try to parse the body
+	err = c.BodyParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("account", "body_parse_failure", http.StatusBadRequest, err.Error())
+	}
+	req.ID = id
+
+	// call the service to update the account
+	resp, err := a.sevice.Update(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// getAccountBalance returns what an account owes and has available
+// @Summary      get the balance of an account
+// @Description  outstanding debit & unapplied credit are summed from the open balance of the transactions, in total and per operation type
+// @Produce      json
+// @Tags		 account
+// @Param        id    path     int  true  "account id"
+// @Success      200  {object}  Balance
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/accounts/{id}/balance [get]
+func (a *API) getAccountBalance(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to get the account balance
+	resp, err := a.sevice.Balance(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
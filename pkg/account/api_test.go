@@ -12,6 +12,7 @@ import (
 	"transactor-server/pkg/account"
 	"transactor-server/pkg/api"
 	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/mock"
@@ -190,3 +191,128 @@ func TestAPIGet(t *testing.T) {
 		require.Equal(t, acc.UpdatedAt.UTC(), returnedUpdatedAt.UTC())
 	})
 }
+
+func TestAPIUpdate(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPatch, "/test/accounts/abc", bytes.NewBufferString(`{"unlimited":true}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("body parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPatch, "/test/accounts/373", bytes.NewBufferString("something"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPatch, "/test/accounts/373", bytes.NewBufferString(`{"credit_limit":"1000.50"}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		limit := money.MustParse("1000.5")
+		service.On("Update", mock.Anything, &account.UpdateRequest{ID: 373, CreditLimit: &limit}).Return(&account.Account{
+			ID:          373,
+			CreditLimit: &limit,
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(373), gjson.Get(string(b), "id").Int())
+		require.Equal(t, 1000.5, gjson.Get(string(b), "credit_limit").Float())
+	})
+}
+
+func TestAPIBalance(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/abc/balance", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/balance", nil)
+
+		service.On("Balance", mock.Anything, 373).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/balance", nil)
+
+		service.On("Balance", mock.Anything, 373).Return(&account.Balance{
+			AccountID:        373,
+			OutstandingDebit: money.MustParse("13.5"),
+			UnappliedCredit:  money.MustParse("25.25"),
+			NetBalance:       money.MustParse("11.75"),
+			OperationTypes: []*account.OperationTypeBalance{
+				{OperationTypeID: 1, OutstandingDebit: money.MustParse("13.5"), NetBalance: money.MustParse("-13.5")},
+				{OperationTypeID: 4, UnappliedCredit: money.MustParse("25.25"), NetBalance: money.MustParse("25.25")},
+			},
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(373), gjson.Get(string(b), "account_id").Int())
+		require.Equal(t, 13.5, gjson.Get(string(b), "outstanding_debit").Float())
+		require.Equal(t, 25.25, gjson.Get(string(b), "unapplied_credit").Float())
+		require.Equal(t, 11.75, gjson.Get(string(b), "net_balance").Float())
+		require.Equal(t, int64(2), gjson.Get(string(b), "operation_types.#").Int())
+		require.Equal(t, -13.5, gjson.Get(string(b), "operation_types.0.net_balance").Float())
+	})
+}
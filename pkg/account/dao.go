@@ -2,7 +2,15 @@ package account
 
 import (
 	"context"
+	stdsql "database/sql"
+	"time"
+	"transactor-server/pkg/db"
 	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/outbox"
+
+	"entgo.io/ent/dialect/sql"
 )
 
 // DAO defines the data access object interface for account model
@@ -13,6 +21,20 @@ type DAO interface {
 	Create(ctx context.Context, req *CreateRequest) (*ent.Account, error)
 	// Get tries to find an existing account record in DB by id
 	Get(ctx context.Context, id int) (*ent.Account, error)
+	// Update changes the credit limit or billing cycle of an existing account record in DB
+	Update(ctx context.Context, req *UpdateRequest) (*ent.Account, error)
+	// Balance reads an account with the open balances of its transactions per operation type
+	// and the amount reserved by its holds, all of them from the same snapshot of the DB
+	Balance(ctx context.Context, id int) (*AccountBalance, error)
+}
+
+// AccountBalance is what the DAO reads to work out the Balance of an account
+type AccountBalance struct {
+	Account *ent.Account
+	// operation types without any transaction of the account are left out
+	OperationTypes []*OperationTypeBalance
+	// the amount reserved by the active holds of the account which have not expired
+	Held money.Amount
 }
 
 type dao struct {
@@ -28,14 +50,122 @@ func NewDAO(entClient *ent.Client) DAO {
 	}
 }
 
-func (d *dao) Create(ctx context.Context, req *CreateRequest) (*ent.Account, error) {
-	return d.entClient.Account.
-		Create().
-		SetDocumentNumber(req.DocumentNumber).
-		SetName(req.Name).
-		Save(ctx)
+func (d *dao) Create(ctx context.Context, req *CreateRequest) (dbAccount *ent.Account, err error) {
+	// the account and its event are written together, so the event is published only when the account exists
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		create := tx.Account.
+			Create().
+			SetDocumentNumber(req.DocumentNumber).
+			SetName(req.Name).
+			SetNillableCreditLimit(req.CreditLimit).
+			SetNillableCycleClosingDay(req.CycleClosingDay).
+			SetNillableDueDateOffset(req.DueDateOffset)
+		if req.Currency != "" {
+			create.SetCurrency(req.Currency)
+		}
+
+		dbAccount, err = create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		return outbox.Write(ctx, tx, outbox.EventAccountCreated, dbAccount.ID, dbAccount.ID, MapEntAccountToAccount(dbAccount))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbAccount, nil
 }
 
 func (d *dao) Get(ctx context.Context, id int) (*ent.Account, error) {
 	return d.entClient.Account.Get(ctx, id)
 }
+
+func (d *dao) Update(ctx context.Context, req *UpdateRequest) (*ent.Account, error) {
+	update := d.entClient.Account.UpdateOneID(req.ID)
+	if req.Unlimited {
+		update.ClearCreditLimit()
+	} else {
+		update.SetNillableCreditLimit(req.CreditLimit)
+	}
+	update.SetNillableCycleClosingDay(req.CycleClosingDay)
+	update.SetNillableDueDateOffset(req.DueDateOffset)
+	return update.Save(ctx)
+}
+
+func (d *dao) Balance(ctx context.Context, id int) (*AccountBalance, error) {
+	// the reads share one repeatable read transaction, so a transaction or hold which commits
+	// in between is either counted by all of them or by none
+	tx, err := d.entClient.BeginTx(ctx, &stdsql.TxOptions{Isolation: stdsql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	dbAccount, err := tx.Account.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	operationTypes, err := operationTypeBalances(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	held, err := db.HeldAmount(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountBalance{Account: dbAccount, OperationTypes: operationTypes, Held: held}, nil
+}
+
+// operationTypeBalances sums the open balances of the transactions of an account per operation type inside tx
+func operationTypeBalances(ctx context.Context, tx *ent.Tx, id int) ([]*OperationTypeBalance, error) {
+	balances := []*OperationTypeBalance{}
+
+	// installments are only outstanding once they are due, till then they are scheduled
+	now := time.Now()
+	isDue := func(b *sql.Builder) {
+		b.WriteString("balance < 0 AND (due_date IS NULL OR due_date <= ").Arg(now).WriteString(")")
+	}
+	isScheduled := func(b *sql.Builder) {
+		b.WriteString("balance < 0 AND due_date > ").Arg(now)
+	}
+	isCredit := func(b *sql.Builder) {
+		b.WriteString("balance > 0")
+	}
+
+	err := tx.Transaction.
+		Query().
+		Where(transaction.AccountID(id)).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(transaction.FieldOperationTypeID)).
+				AppendSelectExprAs(sumBalance(isDue), "outstanding_debit").
+				AppendSelectExprAs(sumBalance(isScheduled), "scheduled_debit").
+				AppendSelectExprAs(sumBalance(isCredit), "unapplied_credit").
+				GroupBy(s.C(transaction.FieldOperationTypeID)).
+				OrderBy(s.C(transaction.FieldOperationTypeID))
+		}).
+		Scan(ctx, &balances)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, balance := range balances {
+		balance.NetBalance = balance.UnappliedCredit - balance.OutstandingDebit - balance.ScheduledDebit
+	}
+
+	return balances, nil
+}
+
+// sumBalance sums the absolute balance of the rows matching cond
+// postgres sums bigints as numeric so it is cast back to bigint
+func sumBalance(cond func(b *sql.Builder)) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("CAST(COALESCE(SUM(CASE WHEN ")
+		cond(b)
+		b.WriteString(" THEN ABS(balance) ELSE 0 END), 0) AS BIGINT)")
+	})
+}
@@ -3,11 +3,18 @@ package account_test
 import (
 	"context"
 	"testing"
+	"time"
 	"transactor-server/pkg/account"
+	"transactor-server/pkg/db/ent"
 	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/money"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 )
 
 func TestDAOCreate(t *testing.T) {
@@ -28,11 +35,30 @@ func TestDAOCreate(t *testing.T) {
 	require.Equal(t, "12345", resp.DocumentNumber)
 	require.Equal(t, "John Doe", resp.Name)
 	require.Equal(t, 1, resp.ID)
+	// accounts are not billed unless they have a cycle closing day
+	require.Nil(t, resp.CycleClosingDay)
+	require.Equal(t, 10, resp.DueDateOffset)
+	require.Equal(t, "USD", resp.Currency)
 
 	dbResp := client.Account.Query().OnlyX(context.Background())
 	require.Equal(t, "12345", dbResp.DocumentNumber)
 	require.Equal(t, "John Doe", dbResp.Name)
 	require.Equal(t, 1, dbResp.ID)
+
+	// the account is announced with the same snapshot as the response
+	dbEvent := client.OutboxEvent.Query().Where(outboxevent.AccountID(1)).OnlyX(context.Background())
+	require.Equal(t, "AccountCreated", dbEvent.Type)
+	require.Equal(t, 1, dbEvent.AggregateID)
+	require.Nil(t, dbEvent.PublishedAt)
+	require.JSONEq(t, `"12345"`, gjson.GetBytes(dbEvent.Payload, "document_number").Raw)
+
+	resp, err = dao.Create(context.Background(), &account.CreateRequest{
+		DocumentNumber: "78901",
+		Name:           "Jane Doe",
+		Currency:       "EUR",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "EUR", resp.Currency)
 }
 
 func TestDAOGet(t *testing.T) {
@@ -76,3 +102,153 @@ func TestDAOGet(t *testing.T) {
 	require.Equal(t, "12345", resp.DocumentNumber)
 	require.Equal(t, "John Doe", resp.Name)
 }
+
+func TestDAOUpdate(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	dao := account.NewDAO(client)
+
+	limit := money.MustParse("1000")
+	created, err := dao.Create(ctx, &account.CreateRequest{
+		DocumentNumber: "12345",
+		Name:           "John Doe",
+		CreditLimit:    &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, limit, *created.CreditLimit)
+
+	newLimit := money.MustParse("250.5")
+	resp, err := dao.Update(ctx, &account.UpdateRequest{ID: created.ID, CreditLimit: &newLimit})
+	require.NoError(t, err)
+	require.Equal(t, newLimit, *resp.CreditLimit)
+	require.Equal(t, newLimit, *client.Account.GetX(ctx, created.ID).CreditLimit)
+
+	resp, err = dao.Update(ctx, &account.UpdateRequest{ID: created.ID, Unlimited: true})
+	require.NoError(t, err)
+	require.Nil(t, resp.CreditLimit)
+	require.Nil(t, client.Account.GetX(ctx, created.ID).CreditLimit)
+
+	// the billing cycle is changed without touching the credit limit
+	resp, err = dao.Update(ctx, &account.UpdateRequest{ID: created.ID, CycleClosingDay: lo.ToPtr(5), DueDateOffset: lo.ToPtr(15)})
+	require.NoError(t, err)
+	require.Nil(t, resp.CreditLimit)
+	require.Equal(t, 5, *resp.CycleClosingDay)
+	require.Equal(t, 15, resp.DueDateOffset)
+
+	_, err = dao.Update(ctx, &account.UpdateRequest{ID: 999, Unlimited: true})
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestDAOBalance(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	for _, txn := range []struct {
+		accountID       int
+		operationTypeID int
+		amount          string
+		balance         string
+		dueIn           time.Duration
+	}{
+		{1, 1, "-50", "0", 0},
+		{1, 1, "-23.5", "-13.5", 0},
+		{1, 2, "-18.7", "-18.7", 0},
+		// an installment which is not due yet
+		{1, 2, "-10", "-10", time.Hour},
+		{1, 4, "60", "0", 0},
+		{1, 4, "25.25", "25.25", 0},
+		{2, 1, "-10", "-10", 0},
+	} {
+		client.Transaction.Create().
+			SetAccountID(txn.accountID).
+			SetOperationTypeID(txn.operationTypeID).
+			SetAmount(money.MustParse(txn.amount)).
+			SetBalance(money.MustParse(txn.balance)).
+			SetTimestamp(time.Now()).
+			SetDueDate(time.Now().Add(txn.dueIn)).
+			ExecX(ctx)
+	}
+
+	dao := account.NewDAO(client)
+
+	balance, err := dao.Balance(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, balance.Account.ID)
+	require.Equal(t, []*account.OperationTypeBalance{
+		{OperationTypeID: 1, OutstandingDebit: money.MustParse("13.5"), NetBalance: money.MustParse("-13.5")},
+		{OperationTypeID: 2, OutstandingDebit: money.MustParse("18.7"), ScheduledDebit: money.MustParse("10"), NetBalance: money.MustParse("-28.7")},
+		{OperationTypeID: 4, UnappliedCredit: money.MustParse("25.25"), NetBalance: money.MustParse("25.25")},
+	}, balance.OperationTypes)
+
+	// no transactions, no operation types
+	client.Account.Create().SetDocumentNumber("55555").SetID(3).SetName("Jimmy Doe").ExecX(ctx)
+
+	balance, err = dao.Balance(ctx, 3)
+	require.NoError(t, err)
+	require.Empty(t, balance.OperationTypes)
+
+	_, err = dao.Balance(ctx, 999)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestDAOHeld(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	for _, h := range []struct {
+		accountID int
+		amount    string
+		status    hold.Status
+		expiresIn time.Duration
+	}{
+		{1, "10", hold.StatusActive, time.Hour},
+		{1, "20.5", hold.StatusActive, time.Hour},
+		// only active holds which have not expired reserve their amount
+		{1, "30", hold.StatusActive, -time.Hour},
+		{1, "40", hold.StatusCaptured, time.Hour},
+		{1, "50", hold.StatusReleased, time.Hour},
+		{2, "60", hold.StatusActive, time.Hour},
+	} {
+		client.Hold.Create().
+			SetAccountID(h.accountID).
+			SetOperationTypeID(1).
+			SetAmount(money.MustParse(h.amount)).
+			SetStatus(h.status).
+			SetExpiresAt(time.Now().Add(h.expiresIn)).
+			ExecX(ctx)
+	}
+
+	dao := account.NewDAO(client)
+
+	balance, err := dao.Balance(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, money.MustParse("30.5"), balance.Held)
+
+	client.Account.Create().SetDocumentNumber("55555").SetID(3).SetName("Jimmy Doe").ExecX(ctx)
+
+	balance, err = dao.Balance(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, money.Amount(0), balance.Held)
+}
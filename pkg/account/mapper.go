@@ -9,10 +9,14 @@ func MapEntAccountToAccount(a *ent.Account) *Account {
 	}
 
 	return &Account{
-		ID:             a.ID,
-		DocumentNumber: a.DocumentNumber,
-		Name:           a.Name,
-		CreatedAt:      a.CreateTime,
-		UpdatedAt:      a.UpdateTime,
+		ID:              a.ID,
+		DocumentNumber:  a.DocumentNumber,
+		Name:            a.Name,
+		CreditLimit:     a.CreditLimit,
+		CycleClosingDay: a.CycleClosingDay,
+		DueDateOffset:   a.DueDateOffset,
+		Currency:        a.Currency,
+		CreatedAt:       a.CreateTime,
+		UpdatedAt:       a.UpdateTime,
 	}
 }
@@ -22,6 +22,12 @@ type meteredSevice struct {
 
 	createCounterSuccess metric.Int64Counter
 	createCounterFailure metric.Int64Counter
+
+	updateCounterSuccess metric.Int64Counter
+	updateCounterFailure metric.Int64Counter
+
+	balanceCounterSuccess metric.Int64Counter
+	balanceCounterFailure metric.Int64Counter
 }
 
 var _ Service = (*meteredSevice)(nil)
@@ -47,13 +53,35 @@ func NewMeteredService(service Service) Service {
 		log.L.Fatal("", zap.Error(err))
 	}
 
+	updateCounterSuccess, err := meter.Int64Counter("account_service_update_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	updateCounterFailure, err := meter.Int64Counter("account_service_update_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	balanceCounterSuccess, err := meter.Int64Counter("account_service_balance_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	balanceCounterFailure, err := meter.Int64Counter("account_service_balance_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
 	return &meteredSevice{
-		service:              service,
-		meter:                meter,
-		getCounterSuccess:    getCounterSuccess,
-		getCounterFailure:    getCounterFailure,
-		createCounterSuccess: createCounterSuccess,
-		createCounterFailure: createCounterFailure,
+		service:               service,
+		meter:                 meter,
+		getCounterSuccess:     getCounterSuccess,
+		getCounterFailure:     getCounterFailure,
+		createCounterSuccess:  createCounterSuccess,
+		createCounterFailure:  createCounterFailure,
+		updateCounterSuccess:  updateCounterSuccess,
+		updateCounterFailure:  updateCounterFailure,
+		balanceCounterSuccess: balanceCounterSuccess,
+		balanceCounterFailure: balanceCounterFailure,
 	}
 }
 
@@ -80,3 +108,27 @@ func (m *meteredSevice) Get(ctx context.Context, id int) (resp *Account, err err
 	resp, err = m.service.Get(ctx, id)
 	return
 }
+
+func (m *meteredSevice) Update(ctx context.Context, req *UpdateRequest) (resp *Account, err error) {
+	defer func() {
+		if err == nil {
+			m.updateCounterSuccess.Add(ctx, 1)
+		} else {
+			m.updateCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Update(ctx, req)
+	return
+}
+
+func (m *meteredSevice) Balance(ctx context.Context, id int) (resp *Balance, err error) {
+	defer func() {
+		if err == nil {
+			m.balanceCounterSuccess.Add(ctx, 1)
+		} else {
+			m.balanceCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Balance(ctx, id)
+	return
+}
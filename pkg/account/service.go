@@ -16,6 +16,10 @@ type Service interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	// Get tries to find an existing account in dtabase layer
 	Get(context.Context, int) (*Account, error)
+	// Update changes the credit limit or billing cycle of an existing account in database layer
+	Update(context.Context, *UpdateRequest) (*Account, error)
+	// Balance returns what an account owes and has available, in total and per operation type
+	Balance(context.Context, int) (*Balance, error)
 }
 
 type service struct {
@@ -75,3 +79,54 @@ func (s *service) Get(ctx context.Context, id int) (*Account, error) {
 
 	return account, nil
 }
+
+func (s *service) Update(ctx context.Context, req *UpdateRequest) (*Account, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	// calls dao to update the record in database
+	dbAccount, err := s.accountDAO.Update(ctx, req)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	// map the ent model to return format
+	return MapEntAccountToAccount(dbAccount), nil
+}
+
+func (s *service) Balance(ctx context.Context, id int) (*Balance, error) {
+	// validates the id to be +ve
+	err := validation.Validate(id, validation.Min(1))
+	if err != nil {
+		return nil, pkgerr.WrapValidationError(err, "id")
+	}
+
+	// calls dao to read the account and sum its balances in database, an unknown account is not found
+	accountBalance, err := s.accountDAO.Balance(ctx, id)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	balance := &Balance{
+		AccountID:      id,
+		OperationTypes: accountBalance.OperationTypes,
+		// holds reserve part of the limit till they are captured, released or expire
+		Held: accountBalance.Held,
+	}
+	for _, operationTypeBalance := range accountBalance.OperationTypes {
+		balance.OutstandingDebit += operationTypeBalance.OutstandingDebit
+		balance.ScheduledDebit += operationTypeBalance.ScheduledDebit
+		balance.UnappliedCredit += operationTypeBalance.UnappliedCredit
+	}
+	balance.NetBalance = balance.UnappliedCredit - balance.OutstandingDebit - balance.ScheduledDebit
+
+	if accountBalance.Account.CreditLimit != nil {
+		available := *accountBalance.Account.CreditLimit + balance.NetBalance - balance.Held
+		balance.AvailableCreditLimit = &available
+	}
+
+	return balance, nil
+}
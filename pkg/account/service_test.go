@@ -9,8 +9,10 @@ import (
 	"transactor-server/pkg/account"
 	"transactor-server/pkg/db/ent"
 	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
 	"transactor-server/pkg/pkgerr"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -31,6 +33,19 @@ func TestServiceCreate(t *testing.T) {
 		require.NotNil(t, validationErr.ResponseBody())
 	})
 
+	t.Run("currency validation error", func(t *testing.T) {
+		t.Parallel()
+		service := account.NewService(mocks.NewMockAccountDAO(t), zap.NewNop())
+
+		resp, err := service.Create(context.Background(), &account.CreateRequest{DocumentNumber: "12345", Name: "John Doe", Currency: "EUX"})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
 	t.Run("create db error", func(t *testing.T) {
 		t.Parallel()
 		accountDAO := mocks.NewMockAccountDAO(t)
@@ -160,3 +175,165 @@ func TestServiceGet(t *testing.T) {
 		require.Equal(t, resp.UpdatedAt, dbAccount.UpdateTime)
 	})
 }
+
+func TestServiceUpdate(t *testing.T) {
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := account.NewService(mocks.NewMockAccountDAO(t), zap.NewNop())
+
+		limit := money.MustParse("-1")
+		for _, req := range []*account.UpdateRequest{
+			{},
+			{ID: 373},
+			{ID: 373, CreditLimit: &limit},
+			{ID: 373, CreditLimit: new(money.Amount), Unlimited: true},
+			{ID: 373, CycleClosingDay: lo.ToPtr(0)},
+			{ID: 373, CycleClosingDay: lo.ToPtr(29)},
+			{ID: 373, DueDateOffset: lo.ToPtr(-1)},
+			{ID: 373, DueDateOffset: lo.ToPtr(61)},
+		} {
+			resp, err := service.Update(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("db not found error", func(t *testing.T) {
+		t.Parallel()
+		accountDAO := mocks.NewMockAccountDAO(t)
+
+		service := account.NewService(accountDAO, zap.NewNop())
+
+		req := &account.UpdateRequest{ID: 373, Unlimited: true}
+		accountDAO.On("Update", mock.Anything, req).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Update(context.Background(), req)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		accountDAO := mocks.NewMockAccountDAO(t)
+
+		service := account.NewService(accountDAO, zap.NewNop())
+
+		limit := money.MustParse("1000")
+		req := &account.UpdateRequest{ID: 373, CreditLimit: &limit}
+		accountDAO.On("Update", mock.Anything, req).Return(&ent.Account{ID: 373, CreditLimit: &limit}, nil)
+
+		resp, err := service.Update(context.Background(), req)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, 373, resp.ID)
+		require.Equal(t, limit, *resp.CreditLimit)
+	})
+
+	t.Run("billing cycle only", func(t *testing.T) {
+		t.Parallel()
+		accountDAO := mocks.NewMockAccountDAO(t)
+
+		service := account.NewService(accountDAO, zap.NewNop())
+
+		req := &account.UpdateRequest{ID: 373, CycleClosingDay: lo.ToPtr(5), DueDateOffset: lo.ToPtr(0)}
+		accountDAO.On("Update", mock.Anything, req).Return(&ent.Account{ID: 373, CycleClosingDay: lo.ToPtr(5)}, nil)
+
+		resp, err := service.Update(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, 5, *resp.CycleClosingDay)
+		require.Equal(t, 0, resp.DueDateOffset)
+	})
+}
+
+func TestServiceBalance(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := account.NewService(mocks.NewMockAccountDAO(t), zap.NewNop())
+
+		resp, err := service.Balance(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		t.Parallel()
+		accountDAO := mocks.NewMockAccountDAO(t)
+
+		service := account.NewService(accountDAO, zap.NewNop())
+
+		accountDAO.On("Balance", mock.Anything, 373).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Balance(context.Background(), 373)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		t.Parallel()
+		accountDAO := mocks.NewMockAccountDAO(t)
+
+		service := account.NewService(accountDAO, zap.NewNop())
+
+		accountDAO.On("Balance", mock.Anything, 373).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := service.Balance(context.Background(), 373)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		accountDAO := mocks.NewMockAccountDAO(t)
+
+		service := account.NewService(accountDAO, zap.NewNop())
+
+		operationTypeBalances := []*account.OperationTypeBalance{
+			{OperationTypeID: 1, OutstandingDebit: money.MustParse("13.5"), NetBalance: money.MustParse("-13.5")},
+			{OperationTypeID: 2, OutstandingDebit: money.MustParse("18.7"), ScheduledDebit: money.MustParse("10"), NetBalance: money.MustParse("-28.7")},
+			{OperationTypeID: 4, UnappliedCredit: money.MustParse("25.25"), NetBalance: money.MustParse("25.25")},
+		}
+
+		limit := money.MustParse("1000")
+		accountDAO.On("Balance", mock.Anything, 373).Return(&account.AccountBalance{
+			Account:        &ent.Account{ID: 373, CreditLimit: &limit},
+			OperationTypes: operationTypeBalances,
+			Held:           money.MustParse("50"),
+		}, nil)
+
+		resp, err := service.Balance(context.Background(), 373)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, 373, resp.AccountID)
+		require.Equal(t, money.MustParse("32.2"), resp.OutstandingDebit)
+		require.Equal(t, money.MustParse("25.25"), resp.UnappliedCredit)
+		require.Equal(t, money.MustParse("10"), resp.ScheduledDebit)
+		require.Equal(t, money.MustParse("-16.95"), resp.NetBalance)
+		require.Equal(t, money.MustParse("50"), resp.Held)
+		require.Equal(t, money.MustParse("933.05"), *resp.AvailableCreditLimit)
+		require.Equal(t, operationTypeBalances, resp.OperationTypes)
+	})
+}
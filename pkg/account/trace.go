@@ -79,3 +79,55 @@ func (t *tracedService) Get(ctx context.Context, id int) (resp *Account, err err
 
 	return
 }
+
+func (t *tracedService) Update(ctx context.Context, req *UpdateRequest) (resp *Account, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "AccountService.Update")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling AccountService.Update", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	resp, err = t.service.Update(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end AccountService.Update with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end AccountService.Update", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) Balance(ctx context.Context, id int) (resp *Balance, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "AccountService.Balance")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling AccountService.Balance", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	resp, err = t.service.Balance(ctx, id)
+
+	if err != nil {
+		t.logger.Error("end AccountService.Balance with error", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+	} else {
+		t.logger.Info("end AccountService.Balance", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+	}
+
+	return
+}
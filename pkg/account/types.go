@@ -2,22 +2,74 @@ package account
 
 import (
 	"time"
+	"transactor-server/pkg/money"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
+// limits of the billing cycle of an account
+const (
+	// MaxCycleClosingDay keeps the closing day in every month
+	MaxCycleClosingDay = 28
+	MaxDueDateOffset   = 60
+)
+
 type CreateRequest struct {
 	DocumentNumber string `json:"document_number"`
 	Name           string `json:"name"`
+	// CreditLimit is how far the account can go into debit, the account has no limit when it is not sent
+	CreditLimit *money.Amount `json:"credit_limit,omitempty" swaggertype:"number" example:"1000"`
+	// CycleClosingDay is the day of the month the billing cycle closes on, the account is not billed when it is not sent
+	CycleClosingDay *int `json:"cycle_closing_day,omitempty" example:"5"`
+	// DueDateOffset is how many days after the cycle closed its invoice is due, it defaults to 10
+	DueDateOffset *int `json:"due_date_offset,omitempty" example:"10"`
+	// Currency is the ISO 4217 code of the currency of the account, it defaults to USD and can not be changed
+	Currency string `json:"currency,omitempty" example:"EUR"`
 }
 
 // Validate validates the CreateRequest to
-// have non empty document_number and
-// have name to be >= 8 & <= 100 characters in length
+// have non empty document_number,
+// have name to be >= 8 & <= 100 characters in length,
+// have an optional credit limit which is not -ve
+// have an optional cycle closing day between 1 & 28 and due date offset between 0 & MaxDueDateOffset
+// and have an optional ISO 4217 currency
 func (req CreateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(&req.DocumentNumber, validation.Required),
 		validation.Field(&req.Name, validation.Required, validation.Length(8, 100)),
+		validation.Field(&req.CreditLimit, validation.Min(money.Amount(0))),
+		validation.Field(&req.CycleClosingDay, validation.NilOrNotEmpty, validation.Min(1), validation.Max(MaxCycleClosingDay)),
+		validation.Field(&req.DueDateOffset, validation.Min(0), validation.Max(MaxDueDateOffset)),
+		validation.Field(&req.Currency, money.CurrencyRule),
+	)
+}
+
+// UpdateRequest changes the credit limit or the billing cycle of an account
+// a new credit_limit can be sent or unlimited can be true to remove the limit
+type UpdateRequest struct {
+	ID              int           `json:"-"`
+	CreditLimit     *money.Amount `json:"credit_limit,omitempty" swaggertype:"number" example:"1000"`
+	Unlimited       bool          `json:"unlimited,omitempty"`
+	CycleClosingDay *int          `json:"cycle_closing_day,omitempty" example:"5"`
+	DueDateOffset   *int          `json:"due_date_offset,omitempty" example:"10"`
+}
+
+// Validate validates the UpdateRequest to
+// have +ve id,
+// have either a credit limit which is not -ve or unlimited set, but not both, unless only the billing cycle changes
+// and have an optional cycle closing day between 1 & 28 and due date offset between 0 & MaxDueDateOffset
+func (req UpdateRequest) Validate() error {
+	// the credit limit can be left out when only the billing cycle is changed
+	billingCycle := req.CycleClosingDay != nil || req.DueDateOffset != nil
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ID, validation.Min(1)),
+		validation.Field(&req.CreditLimit,
+			validation.When(!req.Unlimited && !billingCycle, validation.NotNil.Error("is required unless unlimited is true")),
+			validation.When(req.Unlimited, validation.Nil.Error("must be empty when unlimited is true")),
+			validation.Min(money.Amount(0)),
+		),
+		validation.Field(&req.CycleClosingDay, validation.NilOrNotEmpty, validation.Min(1), validation.Max(MaxCycleClosingDay)),
+		validation.Field(&req.DueDateOffset, validation.Min(0), validation.Max(MaxDueDateOffset)),
 	)
 }
 
@@ -26,9 +78,40 @@ type CreateResponse struct {
 }
 
 type Account struct {
-	ID             int       `json:"id"`
-	DocumentNumber string    `json:"document_number"`
-	Name           string    `json:"name"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID             int    `json:"id"`
+	DocumentNumber string `json:"document_number"`
+	Name           string `json:"name"`
+	// CreditLimit is null when the account has no limit
+	CreditLimit *money.Amount `json:"credit_limit" swaggertype:"number" example:"1000"`
+	// CycleClosingDay is null when the account is not billed
+	CycleClosingDay *int      `json:"cycle_closing_day" example:"5"`
+	DueDateOffset   int       `json:"due_date_offset" example:"10"`
+	Currency        string    `json:"currency" example:"USD"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Balance tells what an account owes and what it has available
+// outstanding debit is what is due now and scheduled debit is the installments which are not due yet
+// debits and unapplied credit are always +ve and net balance is unapplied credit - outstanding debit - scheduled debit
+type Balance struct {
+	AccountID        int          `json:"account_id"`
+	OutstandingDebit money.Amount `json:"outstanding_debit" swaggertype:"number" example:"18.75"`
+	ScheduledDebit   money.Amount `json:"scheduled_debit" swaggertype:"number" example:"0"`
+	UnappliedCredit  money.Amount `json:"unapplied_credit" swaggertype:"number" example:"0"`
+	NetBalance       money.Amount `json:"net_balance" swaggertype:"number" example:"-18.75"`
+	// Held is reserved by active holds, it is not part of the net balance as holds are not posted transactions
+	Held money.Amount `json:"held" swaggertype:"number" example:"0"`
+	// AvailableCreditLimit is credit limit + net balance - held, it is left out when the account has no limit
+	AvailableCreditLimit *money.Amount           `json:"available_credit_limit,omitempty" swaggertype:"number" example:"981.25"`
+	OperationTypes       []*OperationTypeBalance `json:"operation_types"`
+}
+
+// OperationTypeBalance is the part of the Balance which comes from transactions of one operation type
+type OperationTypeBalance struct {
+	OperationTypeID  int          `json:"operation_type_id"`
+	OutstandingDebit money.Amount `json:"outstanding_debit" swaggertype:"number" example:"18.75"`
+	ScheduledDebit   money.Amount `json:"scheduled_debit" swaggertype:"number" example:"0"`
+	UnappliedCredit  money.Amount `json:"unapplied_credit" swaggertype:"number" example:"0"`
+	NetBalance       money.Amount `json:"net_balance" swaggertype:"number" example:"-18.75"`
 }
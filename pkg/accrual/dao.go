@@ -0,0 +1,304 @@
+package accrual
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/predicate"
+	enttransaction "transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/journal"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// operation types of the debits booked for accruals
+const (
+	interestOperationTypeID = 9
+	lateFeeOperationTypeID  = 10
+)
+
+// DAO defines the data access object interface for accrual model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockAccrualDAO --filename accrual_dao.go
+type DAO interface {
+	// InterestAccounts returns the ids of the accounts which have outstanding debit accruing interest
+	// and did not accrue interest on the day of now yet
+	InterestAccounts(ctx context.Context, now time.Time) ([]int, error)
+	// AccrueInterest books the interest of every day from the last interest accrual of an account up to the day of now
+	// as one debit a day, an account which never accrued interest starts on the day of now
+	// a day whose interest rounds to 0 is recorded without a debit, so it is not accrued again either
+	// it returns the accruals of the days it accrued, none when the day of now was accrued already
+	AccrueInterest(ctx context.Context, accountID int, now time.Time) ([]*ent.Accrual, error)
+	// OverdueInvoices returns the open invoices which are past their due date without their minimum payment
+	// and were not charged a late fee yet
+	OverdueInvoices(ctx context.Context, now time.Time) ([]*ent.Invoice, error)
+	// ChargeLateFee books the late fee of an overdue invoice as a debit of its account
+	// it returns nil when the invoice is not overdue anymore and a constraint error if it was charged a late fee already
+	ChargeLateFee(ctx context.Context, invoiceID int, now time.Time) (*ent.Accrual, error)
+}
+
+type dao struct {
+	entClient     *ent.Client
+	interestRates InterestRates
+	lateFee       money.Amount
+}
+
+var _ DAO = (*dao)(nil)
+
+// DAOOption configures the DAO returned by NewDAO
+type DAOOption func(*dao)
+
+// WithInterestRates sets the yearly interest rates per operation type, no operation type accrues interest by default
+func WithInterestRates(rates InterestRates) DAOOption {
+	return func(d *dao) {
+		d.interestRates = rates
+	}
+}
+
+// WithLateFee sets the fee charged for an overdue invoice, no late fee is charged by default
+func WithLateFee(fee money.Amount) DAOOption {
+	return func(d *dao) {
+		d.lateFee = fee
+	}
+}
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client, opts ...DAOOption) DAO {
+	d := &dao{
+		entClient:     entClient,
+		interestRates: InterestRates{},
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// accrualDate returns the UTC day of now at midnight, which is what accruals are unique by
+func accrualDate(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// accruingDebit matches the debits which accrue interest at now, installments only accrue once they are due
+func (d *dao) accruingDebit(now time.Time) predicate.Transaction {
+	return enttransaction.And(
+		enttransaction.BalanceLT(0),
+		enttransaction.OperationTypeIDIn(d.interestRates.OperationTypeIDs()...),
+		enttransaction.TimestampLTE(now),
+		enttransaction.Or(
+			enttransaction.DueDateIsNil(),
+			enttransaction.DueDateLTE(now),
+		),
+	)
+}
+
+func (d *dao) InterestAccounts(ctx context.Context, now time.Time) ([]int, error) {
+	if len(d.interestRates) == 0 {
+		return []int{}, nil
+	}
+
+	return d.entClient.Account.
+		Query().
+		Where(
+			account.HasTransactionsWith(d.accruingDebit(now)),
+			account.Not(account.HasAccrualsWith(
+				accrual.Date(accrualDate(now)),
+				accrual.KindEQ(accrual.KindInterest),
+			)),
+		).
+		Order(account.ByID()).
+		IDs(ctx)
+}
+
+// outstandingDebit is the outstanding debit of an account for one operation type
+type outstandingDebit struct {
+	OperationTypeID  int          `json:"operation_type_id"`
+	OutstandingDebit money.Amount `json:"outstanding_debit"`
+}
+
+func (d *dao) AccrueInterest(ctx context.Context, accountID int, now time.Time) (dbAccruals []*ent.Accrual, err error) {
+	today := accrualDate(now)
+
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		// the account lock keeps credits from paying debits while the interest is computed
+		// and makes another run wait until the days accrued here are committed
+		dbAccount, err := db.LockAccount(ctx, tx, accountID)
+		if err != nil {
+			return err
+		}
+
+		day := today
+		last, err := tx.Accrual.
+			Query().
+			Where(
+				accrual.AccountID(accountID),
+				accrual.KindEQ(accrual.KindInterest),
+			).
+			Order(accrual.ByDate(sql.OrderDesc())).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if last != nil {
+			day = accrualDate(last.Date).AddDate(0, 0, 1)
+		}
+
+		dbAccruals = []*ent.Accrual{}
+		for ; !day.After(today); day = day.AddDate(0, 0, 1) {
+			// a day accrues on the debits booked & due by its end, today only on those booked & due by now
+			end := day.AddDate(0, 0, 1)
+			if end.After(now) {
+				end = now
+			}
+
+			dbAccrual, err := d.accrueDay(ctx, tx, dbAccount, day, end, now)
+			if err != nil {
+				return err
+			}
+			dbAccruals = append(dbAccruals, dbAccrual)
+		}
+		return nil
+	})
+	return dbAccruals, err
+}
+
+// accrueDay books the interest of the day ending at end of the locked account inside tx and records it as an accrual
+// past days accrue on what is outstanding at now, as the balance of a debit on a past day is not kept
+func (d *dao) accrueDay(ctx context.Context, tx *ent.Tx, dbAccount *ent.Account, day time.Time, end time.Time, now time.Time) (*ent.Accrual, error) {
+	debits := []*outstandingDebit{}
+	err := tx.Transaction.
+		Query().
+		Where(
+			enttransaction.AccountID(dbAccount.ID),
+			d.accruingDebit(end),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(enttransaction.FieldOperationTypeID)).
+				AppendSelectExprAs(sql.Raw("CAST(COALESCE(SUM(-balance), 0) AS BIGINT)"), "outstanding_debit").
+				GroupBy(s.C(enttransaction.FieldOperationTypeID))
+		}).
+		Scan(ctx, &debits)
+	if err != nil {
+		return nil, err
+	}
+
+	// every operation type is rounded on its own, like it would be on a separate account
+	var interest money.Amount
+	for _, debit := range debits {
+		interest += d.interestRates.Daily(debit.OperationTypeID, debit.OutstandingDebit)
+	}
+
+	create := tx.Accrual.
+		Create().
+		SetAccountID(dbAccount.ID).
+		SetDate(day).
+		SetKind(accrual.KindInterest).
+		SetAmount(interest)
+
+	// the day is recorded even when its interest rounds to 0, so it is not accrued again
+	if interest > 0 {
+		dbTxn, err := book(ctx, tx, dbAccount, interestOperationTypeID, interest, now, "Interest for "+day.Format(time.DateOnly))
+		if err != nil {
+			return nil, err
+		}
+		create.SetTransactionID(dbTxn.ID)
+	}
+
+	return create.Save(ctx)
+}
+
+func (d *dao) OverdueInvoices(ctx context.Context, now time.Time) ([]*ent.Invoice, error) {
+	if d.lateFee == 0 {
+		return []*ent.Invoice{}, nil
+	}
+
+	return d.entClient.Invoice.
+		Query().
+		Where(
+			invoice.StatusEQ(invoice.StatusOpen),
+			invoice.DueDateLTE(now),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invoice.FieldPaidAmount), s.C(invoice.FieldMinimumPayment)))
+			},
+			invoice.Not(invoice.HasLateFee()),
+		).
+		Order(invoice.ByID()).
+		All(ctx)
+}
+
+func (d *dao) ChargeLateFee(ctx context.Context, invoiceID int, now time.Time) (dbAccrual *ent.Accrual, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		dbInvoice, err := tx.Invoice.Get(ctx, invoiceID)
+		if err != nil {
+			return err
+		}
+
+		dbAccount, err := db.LockAccount(ctx, tx, dbInvoice.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// a credit could have paid the minimum payment before the account was locked
+		dbInvoice, err = tx.Invoice.Get(ctx, invoiceID)
+		if err != nil {
+			return err
+		}
+		if dbInvoice.Status != invoice.StatusOpen || dbInvoice.DueDate.After(now) || dbInvoice.PaidAmount >= dbInvoice.MinimumPayment {
+			return nil
+		}
+
+		dbTxn, err := book(ctx, tx, dbAccount, lateFeeOperationTypeID, d.lateFee, now, fmt.Sprintf("Late fee for invoice %d", dbInvoice.ID))
+		if err != nil {
+			return err
+		}
+
+		dbAccrual, err = tx.Accrual.
+			Create().
+			SetAccountID(dbInvoice.AccountID).
+			SetDate(accrualDate(now)).
+			SetKind(accrual.KindLateFee).
+			SetAmount(d.lateFee).
+			SetTransactionID(dbTxn.ID).
+			SetInvoiceID(dbInvoice.ID).
+			Save(ctx)
+		return err
+	})
+	return dbAccrual, err
+}
+
+// book creates the debit of an accrual in the currency of the locked account inside tx and posts it to the journal
+// accruals are charged whatever the credit limit of the account is, as they are owed anyway
+func book(ctx context.Context, tx *ent.Tx, dbAccount *ent.Account, operationTypeID int, amount money.Amount, now time.Time, description string) (*ent.Transaction, error) {
+	dbTxn, err := tx.Transaction.
+		Create().
+		SetAccountID(dbAccount.ID).
+		SetCurrency(dbAccount.Currency).
+		SetOperationTypeID(operationTypeID).
+		SetTimestamp(now).
+		SetAmount(-amount).
+		SetBalance(-amount).
+		SetDescription(description).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = journal.Post(ctx, tx, dbTxn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.WriteCreatedEvent(ctx, tx, dbTxn)
+	if err != nil {
+		return nil, err
+	}
+	return dbTxn, nil
+}
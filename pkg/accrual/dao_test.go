@@ -0,0 +1,252 @@
+package accrual_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/accrual"
+	"transactor-server/pkg/db/ent"
+	entaccrual "transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/money"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var setupDB = func(t *testing.T) (*ent.Client, accrual.DAO) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Purchase with installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Withdrawal").SetID(3).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Interest").SetID(9).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Late Fee").SetID(10).SetIsDebit(true).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("45678").SetID(3).SetName("James Doe").ExecX(ctx)
+
+	rates, err := accrual.NewInterestRates(map[int]float64{1: 36.5, 3: 73})
+	require.NoError(t, err)
+
+	return client, accrual.NewDAO(client, accrual.WithInterestRates(rates), accrual.WithLateFee(money.MustParse("25")))
+}
+
+func TestDAOAccrueInterest(t *testing.T) {
+	t.Parallel()
+	client, dao := setupDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+	create := func(accountID, operationTypeID int, balance string) *ent.TransactionCreate {
+		return client.Transaction.Create().
+			SetAccountID(accountID).
+			SetOperationTypeID(operationTypeID).
+			SetAmount(money.MustParse(balance)).
+			SetBalance(money.MustParse(balance)).
+			SetTimestamp(now.AddDate(0, 0, -5))
+	}
+
+	// account 1 owes 1000 of purchases & 500 of withdrawals
+	create(1, 1, "-600").ExecX(ctx)
+	create(1, 1, "-400").ExecX(ctx)
+	create(1, 3, "-500").ExecX(ctx)
+	// installments which are not due yet and operation types without a rate do not accrue
+	create(1, 2, "-300").ExecX(ctx)
+	create(1, 1, "-200").SetDueDate(now.AddDate(0, 0, 1)).ExecX(ctx)
+	// account 2 only owes what does not accrue & account 3 is in credit
+	create(2, 2, "-100").ExecX(ctx)
+	create(3, 1, "100").ExecX(ctx)
+
+	accountIDs, err := dao.InterestAccounts(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, accountIDs)
+
+	dbAccruals, err := dao.AccrueInterest(ctx, 1, now)
+	require.NoError(t, err)
+	require.Len(t, dbAccruals, 1)
+	dbAccrual := dbAccruals[0]
+	require.Equal(t, entaccrual.KindInterest, dbAccrual.Kind)
+	require.Equal(t, time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC), dbAccrual.Date.UTC())
+	// 0.1% a day of 1000 and 0.2% a day of 500
+	require.Equal(t, money.MustParse("2"), dbAccrual.Amount)
+
+	dbTxn := client.Transaction.GetX(ctx, *dbAccrual.TransactionID)
+	require.Equal(t, 9, dbTxn.OperationTypeID)
+	require.Equal(t, money.MustParse("-2"), dbTxn.Amount)
+	require.Equal(t, money.MustParse("-2"), dbTxn.Balance)
+	require.Equal(t, "Interest for 2026-10-18", *dbTxn.Description)
+	// posted against interest income
+	require.Equal(t, 1, client.JournalEntry.Query().Where(journalentry.TransactionID(dbTxn.ID), journalentry.LedgerEQ(journalentry.LedgerInterestIncome)).CountX(ctx))
+
+	// the same day is accrued only once
+	accountIDs, err = dao.InterestAccounts(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, accountIDs)
+
+	dbAccruals, err = dao.AccrueInterest(ctx, 1, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, dbAccruals)
+	require.Equal(t, 8, client.Transaction.Query().CountX(ctx))
+
+	// but the next day is
+	accountIDs, err = dao.InterestAccounts(ctx, now.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, accountIDs)
+
+	// nothing is booked when the interest rounds to 0, but the day is still accrued
+	dbAccruals, err = dao.AccrueInterest(ctx, 2, now)
+	require.NoError(t, err)
+	require.Len(t, dbAccruals, 1)
+	require.Zero(t, dbAccruals[0].Amount)
+	require.Nil(t, dbAccruals[0].TransactionID)
+	require.Equal(t, 8, client.Transaction.Query().CountX(ctx))
+
+	dbAccruals, err = dao.AccrueInterest(ctx, 2, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, dbAccruals)
+}
+
+func TestDAOAccrueInterestMissedDays(t *testing.T) {
+	t.Parallel()
+	client, dao := setupDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+	create := func(balance string, timestamp time.Time) {
+		client.Transaction.Create().
+			SetAccountID(1).
+			SetOperationTypeID(1).
+			SetAmount(money.MustParse(balance)).
+			SetBalance(money.MustParse(balance)).
+			SetTimestamp(timestamp).
+			ExecX(ctx)
+	}
+
+	create("-1000", now.AddDate(0, 0, -10))
+	_, err := dao.AccrueInterest(ctx, 1, now.AddDate(0, 0, -4))
+	require.NoError(t, err)
+
+	// a debit booked after the last run only accrues from the day it was booked
+	create("-2000", now.AddDate(0, 0, -2).Add(time.Hour))
+
+	// the job did not run for 3 days, so they are accrued along with today
+	dbAccruals, err := dao.AccrueInterest(ctx, 1, now)
+	require.NoError(t, err)
+	require.Len(t, dbAccruals, 4)
+
+	amounts := []money.Amount{}
+	for i, dbAccrual := range dbAccruals {
+		require.Equal(t, time.Date(2026, time.October, 15+i, 0, 0, 0, 0, time.UTC), dbAccrual.Date.UTC())
+		amounts = append(amounts, dbAccrual.Amount)
+
+		dbTxn := client.Transaction.GetX(ctx, *dbAccrual.TransactionID)
+		require.Equal(t, "Interest for "+dbAccrual.Date.UTC().Format(time.DateOnly), *dbTxn.Description)
+	}
+	require.Equal(t, []money.Amount{money.MustParse("1"), money.MustParse("3"), money.MustParse("3"), money.MustParse("3")}, amounts)
+}
+
+func TestDAOChargeLateFee(t *testing.T) {
+	t.Parallel()
+	client, dao := setupDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+	createInvoice := func(accountID int, dueDate time.Time, paid string) *ent.Invoice {
+		periodEnd := dueDate.AddDate(0, 0, -10)
+		return client.Invoice.Create().
+			SetAccountID(accountID).
+			SetPeriodStart(periodEnd.AddDate(0, -1, 0)).
+			SetPeriodEnd(periodEnd).
+			SetTotalDue(money.MustParse("200")).
+			SetMinimumPayment(money.MustParse("20")).
+			SetPaidAmount(money.MustParse(paid)).
+			SetDueDate(dueDate).
+			SaveX(ctx)
+	}
+
+	overdue := createInvoice(1, now.AddDate(0, 0, -1), "10")
+	// the minimum payment was paid, or it is not due yet
+	createInvoice(2, now.AddDate(0, 0, -1), "20")
+	createInvoice(3, now.AddDate(0, 0, 1), "0")
+
+	dbInvoices, err := dao.OverdueInvoices(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, dbInvoices, 1)
+	require.Equal(t, overdue.ID, dbInvoices[0].ID)
+
+	dbAccrual, err := dao.ChargeLateFee(ctx, overdue.ID, now)
+	require.NoError(t, err)
+	require.Equal(t, entaccrual.KindLateFee, dbAccrual.Kind)
+	require.Equal(t, money.MustParse("25"), dbAccrual.Amount)
+	require.Equal(t, overdue.ID, *dbAccrual.InvoiceID)
+
+	dbTxn := client.Transaction.GetX(ctx, *dbAccrual.TransactionID)
+	require.Equal(t, 10, dbTxn.OperationTypeID)
+	require.Equal(t, 1, dbTxn.AccountID)
+	require.Equal(t, money.MustParse("-25"), dbTxn.Balance)
+	require.Equal(t, 1, client.JournalEntry.Query().Where(journalentry.TransactionID(dbTxn.ID), journalentry.LedgerEQ(journalentry.LedgerFeeIncome)).CountX(ctx))
+
+	// an invoice is charged only once, even on another day
+	dbInvoices, err = dao.OverdueInvoices(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, dbInvoices)
+
+	_, err = dao.ChargeLateFee(ctx, overdue.ID, now.AddDate(0, 0, 1))
+	require.True(t, ent.IsConstraintError(err))
+
+	// an invoice which is not overdue is not charged
+	dbAccrual, err = dao.ChargeLateFee(ctx, overdue.ID+2, now)
+	require.NoError(t, err)
+	require.Nil(t, dbAccrual)
+}
+
+func TestAccruerAccrueDue(t *testing.T) {
+	t.Parallel()
+	client, dao := setupDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+
+	client.Transaction.Create().
+		SetAccountID(1).
+		SetOperationTypeID(1).
+		SetAmount(money.MustParse("-1000")).
+		SetBalance(money.MustParse("-1000")).
+		SetTimestamp(now.AddDate(0, 0, -40)).
+		ExecX(ctx)
+	client.Invoice.Create().
+		SetAccountID(1).
+		SetPeriodStart(now.AddDate(0, -2, 0)).
+		SetPeriodEnd(now.AddDate(0, -1, 0)).
+		SetTotalDue(money.MustParse("1000")).
+		SetMinimumPayment(money.MustParse("100")).
+		SetDueDate(now.AddDate(0, 0, -20)).
+		ExecX(ctx)
+
+	accruer := accrual.NewAccruer(dao, time.Hour, zap.NewNop())
+
+	interest, lateFees, err := accruer.AccrueDue(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, interest)
+	require.Equal(t, 1, lateFees)
+
+	// rerunning on the same day charges nothing more
+	interest, lateFees, err = accruer.AccrueDue(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0, interest)
+	require.Equal(t, 0, lateFees)
+	require.Equal(t, 2, client.Accrual.Query().CountX(ctx))
+}
@@ -0,0 +1,82 @@
+package accrual
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/job"
+
+	"go.uber.org/zap"
+)
+
+// Accruer periodically charges the interest of the day and the late fees of the overdue invoices
+// an account accrues interest once a day however often it runs, so it can be rerun or run by several instances safely
+type Accruer struct {
+	dao      DAO
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+// NewAccruer returns a new Accruer which runs every interval
+func NewAccruer(dao DAO, interval time.Duration, logger *zap.Logger) *Accruer {
+	return &Accruer{
+		dao:      dao,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run accrues every interval until the context is cancelled
+func (a *Accruer) Run(ctx context.Context) error {
+	return job.Run(ctx, a.interval, a.logger, func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+		interest, lateFees, err := a.AccrueDue(ctx, now)
+		if err != nil || (interest == 0 && lateFees == 0) {
+			return nil, err
+		}
+		return []zap.Field{zap.Int("interest", interest), zap.Int("late_fees", lateFees)}, nil
+	})
+}
+
+// AccrueDue charges the interest of the days up to the day of now and the late fees of the invoices overdue at now
+// it returns how many interest and late fee debits it booked, what was charged meanwhile by another run is skipped
+func (a *Accruer) AccrueDue(ctx context.Context, now time.Time) (interest int, lateFees int, err error) {
+	accountIDs, err := a.dao.InterestAccounts(ctx, now)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, accountID := range accountIDs {
+		dbAccruals, err := a.dao.AccrueInterest(ctx, accountID, now)
+		if ent.IsConstraintError(err) {
+			continue
+		}
+		if err != nil {
+			return interest, lateFees, err
+		}
+		for _, dbAccrual := range dbAccruals {
+			if dbAccrual.TransactionID != nil {
+				interest++
+			}
+		}
+	}
+
+	dbInvoices, err := a.dao.OverdueInvoices(ctx, now)
+	if err != nil {
+		return interest, lateFees, err
+	}
+
+	for _, dbInvoice := range dbInvoices {
+		dbAccrual, err := a.dao.ChargeLateFee(ctx, dbInvoice.ID, now)
+		if ent.IsConstraintError(err) {
+			continue
+		}
+		if err != nil {
+			return interest, lateFees, err
+		}
+		if dbAccrual != nil {
+			lateFees++
+		}
+	}
+
+	return interest, lateFees, nil
+}
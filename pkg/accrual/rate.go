@@ -0,0 +1,45 @@
+package accrual
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"transactor-server/pkg/money"
+)
+
+// daysPerYear turns a yearly interest rate into a daily one
+const daysPerYear = 365
+
+// InterestRates are the yearly interest rates of the operation types which accrue interest, in basis points
+// operation types which are not in it do not accrue interest
+type InterestRates map[int]int64
+
+// NewInterestRates returns the InterestRates of yearly rates in percent per operation type id, like 36.5 for 36.5% a year
+func NewInterestRates(percents map[int]float64) (InterestRates, error) {
+	rates := InterestRates{}
+	for operationTypeID, percent := range percents {
+		if percent < 0 {
+			return nil, fmt.Errorf("interest rate %v of operation type %d must not be -ve", percent, operationTypeID)
+		}
+		if percent > 0 {
+			rates[operationTypeID] = int64(math.Round(percent * 100))
+		}
+	}
+	return rates, nil
+}
+
+// OperationTypeIDs returns the operation types which accrue interest in ascending order
+func (r InterestRates) OperationTypeIDs() []int {
+	ids := make([]int, 0, len(r))
+	for operationTypeID := range r {
+		ids = append(ids, operationTypeID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Daily returns the interest a day of outstanding debit of an operation type accrues, rounded half up to the cent
+func (r InterestRates) Daily(operationTypeID int, outstanding money.Amount) money.Amount {
+	const divisor = 10000 * daysPerYear
+	return money.Amount((int64(outstanding)*r[operationTypeID] + divisor/2) / divisor)
+}
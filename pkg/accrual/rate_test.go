@@ -0,0 +1,28 @@
+package accrual_test
+
+import (
+	"testing"
+	"transactor-server/pkg/accrual"
+	"transactor-server/pkg/money"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterestRates(t *testing.T) {
+	t.Parallel()
+
+	_, err := accrual.NewInterestRates(map[int]float64{1: -1})
+	require.Error(t, err)
+
+	rates, err := accrual.NewInterestRates(map[int]float64{3: 48, 1: 36.5, 2: 0})
+	require.NoError(t, err)
+	// a rate of 0 does not accrue, like an unlisted one
+	require.Equal(t, []int{1, 3}, rates.OperationTypeIDs())
+
+	// 36.5% a year is 0.1% a day
+	require.Equal(t, money.MustParse("1"), rates.Daily(1, money.MustParse("1000")))
+	// rounded half up to the cent
+	require.Equal(t, money.MustParse("0.01"), rates.Daily(1, money.MustParse("5")))
+	require.Equal(t, money.MustParse("0"), rates.Daily(1, money.MustParse("4.99")))
+	require.Equal(t, money.MustParse("0"), rates.Daily(2, money.MustParse("1000")))
+}
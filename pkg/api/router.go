@@ -4,9 +4,17 @@ import (
 	"fmt"
 	"net/http"
 	"transactor-server/pkg/account"
+	"transactor-server/pkg/billing"
 	"transactor-server/pkg/config"
+	"transactor-server/pkg/fx"
+	"transactor-server/pkg/hold"
+	"transactor-server/pkg/journal"
 	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/statement"
+	"transactor-server/pkg/stream"
 	"transactor-server/pkg/transaction"
+	"transactor-server/pkg/transfer"
+	"transactor-server/pkg/webhook"
 
 	zapotlp "github.com/SigNoz/zap_otlp"
 
@@ -39,8 +47,17 @@ import (
 // @description					A Basic way to secure APIs
 func NewRouter(
 	apiKey string,
+	idempotencyMiddleware fiber.Handler,
 	transactionAPI *transaction.API,
+	transferAPI *transfer.API,
+	holdAPI *hold.API,
 	accountAPI *account.API,
+	statementAPI *statement.API,
+	billingAPI *billing.API,
+	fxAPI *fx.API,
+	journalAPI *journal.API,
+	webhookAPI *webhook.API,
+	streamAPI *stream.API,
 
 	logger *zap.Logger,
 ) *fiber.App {
@@ -49,6 +66,8 @@ func NewRouter(
 		DisableStartupMessage: true,
 		// create a custom error handler
 		ErrorHandler: ErrorHandler,
+		// fiber limits the body of every route alike, the largest one is of a bulk transaction request
+		BodyLimit: transaction.MaxBulkBodySize,
 	})
 
 	app.Use(recover.New())
@@ -94,9 +113,29 @@ func NewRouter(
 	)
 
 	// mount transaction api routes on /api/v1/transactions
-	transactionAPI.Handle(apiRouter.Group("/transactions"))
+	// creating transactions can be retried safely by sending an Idempotency-Key header
+	transactionAPI.Handle(apiRouter.Group("/transactions", idempotencyMiddleware))
+	// mount transfer api routes on /api/v1/transfers, creating transfers is safe to retry the same way
+	transferAPI.Handle(apiRouter.Group("/transfers", idempotencyMiddleware))
+	// mount hold api routes on /api/v1/holds
+	holdAPI.Handle(apiRouter.Group("/holds", idempotencyMiddleware))
 	// mount account api routes on /api/v1/accounts
 	accountAPI.Handle(apiRouter.Group("/accounts"))
+	// mount account scoped transaction api routes on /api/v1/accounts/:id/transactions
+	transactionAPI.HandleAccount(apiRouter.Group("/accounts"))
+	// mount statement api routes on /api/v1/accounts/:id/statement
+	statementAPI.Handle(apiRouter.Group("/accounts"))
+	// mount invoice api routes on /api/v1/invoices and /api/v1/accounts/:id/invoices
+	billingAPI.Handle(apiRouter.Group("/invoices"))
+	billingAPI.HandleAccount(apiRouter.Group("/accounts"))
+	// mount admin fx rate api routes on /api/v1/admin/fx-rates
+	fxAPI.Handle(apiRouter.Group("/admin/fx-rates"))
+	// mount journal api routes on /api/v1/journal
+	journalAPI.Handle(apiRouter.Group("/journal"))
+	// mount event stream api routes on /api/v1/accounts/:id/events
+	streamAPI.HandleAccount(apiRouter.Group("/accounts"))
+	// mount webhook api routes on /api/v1/webhooks
+	webhookAPI.Handle(apiRouter.Group("/webhooks"))
 
 	return app
 }
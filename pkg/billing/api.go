@@ -0,0 +1,89 @@
+package billing
+
+import (
+	"net/http"
+	"strconv"
+
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// API is the api handler for invoice apis
+type API struct {
+	sevice Service
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+	}
+}
+
+// Handle sets up the routes of the invoices with their handler funcs
+func (a *API) Handle(router fiber.Router) {
+	router.Get("/:id", a.getInvoice)
+}
+
+// HandleAccount sets up the routes of the invoices of an account with their handler funcs
+func (a *API) HandleAccount(router fiber.Router) {
+	router.Get("/:id/invoices", a.listInvoices)
+}
+
+// getInvoice returns an existing invoice
+// @Summary      get an invoice
+// @Produce      json
+// @Tags		 billing
+// @Param        id    path     int  true  "invoice id"
+// @Success      200  {object}  Invoice
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/invoices/{id} [get]
+func (a *API) getInvoice(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to get the invoice
+	resp, err := a.sevice.Get(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// listInvoices returns the invoices of an account
+// @Summary      list the invoices of an account
+// @Description  an invoice is created when a billing cycle of the account closes, newest first
+// @Produce      json
+// @Tags		 billing
+// @Param        id    path     int  true  "account id"
+// @Success      200  {object}  ListResponse
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/accounts/{id}/invoices [get]
+func (a *API) listInvoices(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to list the invoices
+	resp, err := a.sevice.List(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
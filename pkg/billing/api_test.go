@@ -0,0 +1,119 @@
+package billing_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockBillingService) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	service := mocks.NewMockBillingService(t)
+
+	api := billing.NewAPI(service)
+	api.Handle(app.Group("/test/invoices"))
+	api.HandleAccount(app.Group("/test/accounts"))
+
+	return app, service
+}
+
+func TestAPIGet(t *testing.T) {
+	t.Run("id parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/invoices/abc", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/invoices/1", nil)
+
+		service.On("Get", mock.Anything, 1).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/invoices/1", nil)
+
+		service.On("Get", mock.Anything, 1).Return(billing.MapEntInvoiceToInvoice(dbInvoice), nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(373), gjson.GetBytes(b, "account_id").Int())
+		require.Equal(t, 250.5, gjson.GetBytes(b, "total_due").Float())
+		require.Equal(t, 25.05, gjson.GetBytes(b, "minimum_payment").Float())
+		require.Equal(t, "2026-10-16T00:00:00Z", gjson.GetBytes(b, "due_date").String())
+		require.Equal(t, "open", gjson.GetBytes(b, "status").String())
+	})
+}
+
+func TestAPIList(t *testing.T) {
+	t.Run("id parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/abc/invoices", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/invoices", nil)
+
+		service.On("List", mock.Anything, 373).Return(&billing.ListResponse{
+			Invoices: []*billing.Invoice{billing.MapEntInvoiceToInvoice(dbInvoice)},
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(1), gjson.GetBytes(b, "invoices.#").Int())
+		require.Equal(t, 100.0, gjson.GetBytes(b, "invoices.0.paid_amount").Float())
+	})
+}
@@ -0,0 +1,60 @@
+package billing
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/job"
+
+	"go.uber.org/zap"
+)
+
+// CycleCloser periodically closes the billing cycles which ended into invoices
+// cycles which were missed while the server was down are closed on the next run, one invoice for all of them
+type CycleCloser struct {
+	dao      DAO
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+// NewCycleCloser returns a new CycleCloser which runs every interval
+func NewCycleCloser(dao DAO, interval time.Duration, logger *zap.Logger) *CycleCloser {
+	return &CycleCloser{
+		dao:      dao,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run closes the due billing cycles every interval until the context is cancelled
+func (c *CycleCloser) Run(ctx context.Context) error {
+	return job.Run(ctx, c.interval, c.logger, func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+		closed, err := c.CloseDue(ctx, now)
+		if err != nil || closed == 0 {
+			return nil, err
+		}
+		return []zap.Field{zap.Int("closed_cycles", closed)}, nil
+	})
+}
+
+// CloseDue creates the invoices of the billing cycles which ended at or before now and returns how many it created
+// a cycle which another instance closed meanwhile is skipped
+func (c *CycleCloser) CloseDue(ctx context.Context, now time.Time) (int, error) {
+	cycles, err := c.dao.DueCycles(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, cycle := range cycles {
+		_, err := c.dao.Close(ctx, cycle)
+		if ent.IsConstraintError(err) {
+			continue
+		}
+		if err != nil {
+			return closed, err
+		}
+		closed++
+	}
+	return closed, nil
+}
@@ -0,0 +1,110 @@
+package billing
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/money"
+)
+
+// ApplyCredit pays the open invoice of the account of a credit with amount of it inside tx, the account must already be locked in tx
+// only the part of amount which the invoice still asks for is applied, the invoice is paid once nothing is left
+// a credit pays at most one invoice, so a credit which paid one already is left alone
+func ApplyCredit(ctx context.Context, tx *ent.Tx, credit *ent.Transaction, amount money.Amount) error {
+	paid, err := tx.InvoicePayment.
+		Query().
+		Where(invoicepayment.TransactionID(credit.ID)).
+		Exist(ctx)
+	if err != nil || paid {
+		return err
+	}
+
+	// there is at most one open invoice, closing a cycle supersedes the previous one
+	dbInvoice, err := tx.Invoice.
+		Query().
+		Where(
+			invoice.AccountID(credit.AccountID),
+			invoice.StatusEQ(invoice.StatusOpen),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	paidAmount := min(dbInvoice.PaidAmount+amount, dbInvoice.TotalDue)
+	if paidAmount <= dbInvoice.PaidAmount {
+		return nil
+	}
+
+	// the payment is recorded so that reversing the credit can take it back
+	err = tx.InvoicePayment.
+		Create().
+		SetInvoiceID(dbInvoice.ID).
+		SetTransactionID(credit.ID).
+		SetAmount(paidAmount - dbInvoice.PaidAmount).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	update := tx.Invoice.
+		UpdateOne(dbInvoice).
+		SetPaidAmount(paidAmount)
+	if paidAmount == dbInvoice.TotalDue {
+		update.SetStatus(invoice.StatusPaid)
+	}
+	return update.Exec(ctx)
+}
+
+// UnapplyCredit takes back what a reversed credit paid of an invoice inside tx, the account must already be locked in tx
+// a paid invoice is open again unless a later cycle has closed, the debits the credit paid are then part of the later invoice
+func UnapplyCredit(ctx context.Context, tx *ent.Tx, creditID int, now time.Time) error {
+	payment, err := tx.InvoicePayment.
+		Query().
+		Where(
+			invoicepayment.TransactionID(creditID),
+			invoicepayment.ReversedAtIsNil(),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = tx.InvoicePayment.UpdateOne(payment).SetReversedAt(now).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbInvoice, err := tx.Invoice.Get(ctx, payment.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	update := tx.Invoice.
+		UpdateOne(dbInvoice).
+		SetPaidAmount(dbInvoice.PaidAmount - payment.Amount)
+	if dbInvoice.Status == invoice.StatusPaid {
+		later, err := tx.Invoice.
+			Query().
+			Where(
+				invoice.AccountID(dbInvoice.AccountID),
+				invoice.PeriodEndGT(dbInvoice.PeriodEnd),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !later {
+			update.SetStatus(invoice.StatusOpen)
+		}
+	}
+	return update.Exec(ctx)
+}
@@ -0,0 +1,29 @@
+package billing
+
+import "time"
+
+// Cycle is a billing cycle of an account which has closed but has no invoice yet
+// it starts where the previous invoice of the account ended
+type Cycle struct {
+	AccountID  int
+	ClosingDay int
+	PeriodEnd  time.Time
+	DueDate    time.Time
+}
+
+// lastCycleEnd returns when the last billing cycle of an account closing on closingDay ended at or before now
+// a cycle ends at the end of its closing day in UTC, so purchases made on the closing day are part of it
+func lastCycleEnd(closingDay int, now time.Time) time.Time {
+	now = now.UTC()
+	end := time.Date(now.Year(), now.Month(), closingDay, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	if end.After(now) {
+		// the closing day is at most 28, so it exists in the previous month too
+		end = time.Date(now.Year(), now.Month()-1, closingDay, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	}
+	return end
+}
+
+// previousCycleEnd returns when the cycle before the one which ended at end ended
+func previousCycleEnd(closingDay int, end time.Time) time.Time {
+	return lastCycleEnd(closingDay, end.Add(-time.Nanosecond))
+}
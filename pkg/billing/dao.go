@@ -0,0 +1,196 @@
+package billing
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// DAO defines the data access object interface for invoice model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockBillingDAO --filename billing_dao.go
+type DAO interface {
+	// Get tries to find an existing invoice record in DB by id
+	Get(ctx context.Context, id int) (*ent.Invoice, error)
+	// Account tries to find an existing account record in DB by id
+	Account(ctx context.Context, id int) (*ent.Account, error)
+	// List returns the invoices of an account, newest first
+	List(ctx context.Context, accountID int) ([]*ent.Invoice, error)
+	// DueCycles returns the last closed billing cycle of every billed account which has no invoice yet
+	DueCycles(ctx context.Context, now time.Time) ([]*Cycle, error)
+	// Close creates the invoice of a billing cycle
+	// it returns a constraint error if the cycle was closed meanwhile
+	Close(ctx context.Context, cycle *Cycle) (*ent.Invoice, error)
+}
+
+type dao struct {
+	entClient      *ent.Client
+	minimumPayment MinimumPaymentRule
+}
+
+var _ DAO = (*dao)(nil)
+
+// DAOOption configures the DAO returned by NewDAO
+type DAOOption func(*dao)
+
+// WithMinimumPaymentRule sets how the minimum payment of an invoice is computed
+// it defaults to DefaultMinimumPaymentPercent of the total due but at least DefaultMinimumPaymentFloor
+func WithMinimumPaymentRule(rule MinimumPaymentRule) DAOOption {
+	return func(d *dao) {
+		d.minimumPayment = rule
+	}
+}
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client, opts ...DAOOption) DAO {
+	d := &dao{
+		entClient:      entClient,
+		minimumPayment: PercentageOfDue(DefaultMinimumPaymentPercent, money.MustParse(DefaultMinimumPaymentFloor)),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+func (d *dao) Get(ctx context.Context, id int) (*ent.Invoice, error) {
+	return d.entClient.Invoice.Get(ctx, id)
+}
+
+func (d *dao) Account(ctx context.Context, id int) (*ent.Account, error) {
+	return d.entClient.Account.Get(ctx, id)
+}
+
+func (d *dao) List(ctx context.Context, accountID int) ([]*ent.Invoice, error) {
+	return d.entClient.Invoice.
+		Query().
+		Where(invoice.AccountID(accountID)).
+		Order(invoice.ByPeriodEnd(sql.OrderDesc())).
+		All(ctx)
+}
+
+func (d *dao) DueCycles(ctx context.Context, now time.Time) ([]*Cycle, error) {
+	cycles := []*Cycle{}
+
+	// every closing day has its own last cycle end, so the accounts are looked up one closing day at a time
+	for closingDay := 1; closingDay <= 28; closingDay++ {
+		periodEnd := lastCycleEnd(closingDay, now)
+
+		dbAccounts, err := d.entClient.Account.
+			Query().
+			Where(
+				account.CycleClosingDay(closingDay),
+				// accounts opened after the cycle ended have their first cycle still open
+				account.CreateTimeLT(periodEnd),
+				account.Not(account.HasInvoicesWith(invoice.PeriodEndGTE(periodEnd))),
+			).
+			Order(account.ByID()).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dbAccount := range dbAccounts {
+			cycles = append(cycles, &Cycle{
+				AccountID:  dbAccount.ID,
+				ClosingDay: closingDay,
+				PeriodEnd:  periodEnd,
+				DueDate:    periodEnd.AddDate(0, 0, dbAccount.DueDateOffset),
+			})
+		}
+	}
+
+	return cycles, nil
+}
+
+// dueQuery returns what an account owes for the debits booked before a cycle ended and due by then
+// and what it has in credits which did not pay anything yet, both are +ve
+// sqlite numbers the parameters in the order they first appear, so $1 has to appear before $2
+const dueQuery = `SELECT
+	CAST(COALESCE(SUM(CASE WHEN balance < 0 AND timestamp < $1 AND (due_date IS NULL OR due_date < $1) THEN -balance ELSE 0 END), 0) AS BIGINT),
+	CAST(COALESCE(SUM(CASE WHEN balance > 0 THEN balance ELSE 0 END), 0) AS BIGINT)
+FROM transactions WHERE account_id = $2`
+
+func (d *dao) Close(ctx context.Context, cycle *Cycle) (dbInvoice *ent.Invoice, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		// the account lock keeps credits from paying debits while the total due is summed
+		_, err := db.LockAccount(ctx, tx, cycle.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// the cycle starts where the previous invoice ended, which is more than a month ago when cycles were missed
+		periodStart := previousCycleEnd(cycle.ClosingDay, cycle.PeriodEnd)
+		previous, err := tx.Invoice.
+			Query().
+			Where(invoice.AccountID(cycle.AccountID)).
+			Order(invoice.ByPeriodEnd(sql.OrderDesc())).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if previous != nil {
+			periodStart = previous.PeriodEnd
+		}
+
+		outstanding, unapplied, err := accountDue(ctx, tx, cycle.AccountID, cycle.PeriodEnd)
+		if err != nil {
+			return err
+		}
+
+		// the open invoices are part of the new total due, as what they ask for is still outstanding
+		totalDue := max(outstanding-unapplied, 0)
+
+		create := tx.Invoice.
+			Create().
+			SetAccountID(cycle.AccountID).
+			SetPeriodStart(periodStart).
+			SetPeriodEnd(cycle.PeriodEnd).
+			SetTotalDue(totalDue).
+			SetMinimumPayment(d.minimumPayment.MinimumPayment(totalDue)).
+			SetDueDate(cycle.DueDate)
+		if totalDue == 0 {
+			create.SetStatus(invoice.StatusPaid)
+		}
+
+		dbInvoice, err = create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		// the new invoice has what is left of the open one in its total due, so credits pay the new one from now on
+		return tx.Invoice.
+			Update().
+			Where(
+				invoice.AccountID(cycle.AccountID),
+				invoice.StatusEQ(invoice.StatusOpen),
+				invoice.IDNEQ(dbInvoice.ID),
+			).
+			SetStatus(invoice.StatusSuperseded).
+			Exec(ctx)
+	})
+	return dbInvoice, err
+}
+
+// accountDue returns the outstanding debit due by periodEnd and the unapplied credit of an account inside tx
+func accountDue(ctx context.Context, tx *ent.Tx, accountID int, periodEnd time.Time) (outstanding money.Amount, unapplied money.Amount, err error) {
+	rows, err := tx.Client().QueryContext(ctx, dueQuery, periodEnd, accountID)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.Scan(&outstanding, &unapplied)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return outstanding, unapplied, rows.Err()
+}
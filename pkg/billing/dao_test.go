@@ -0,0 +1,242 @@
+package billing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/money"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDAOClose(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Purchase with installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+
+	opened := time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC)
+	// billed on the 5th, not billed & opened after the last cycle ended
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCycleClosingDay(5).SetCreateTime(opened).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").SetCreateTime(opened).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("45678").SetID(3).SetName("James Doe").SetCycleClosingDay(5).SetCreateTime(time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC)).ExecX(ctx)
+
+	create := func(operationTypeID int, amount, balance string, timestamp time.Time) *ent.TransactionCreate {
+		return client.Transaction.Create().
+			SetAccountID(1).
+			SetOperationTypeID(operationTypeID).
+			SetAmount(money.MustParse(amount)).
+			SetBalance(money.MustParse(balance)).
+			SetTimestamp(timestamp)
+	}
+
+	// a purchase of the cycle which was paid partly
+	create(1, "-100", "-70", time.Date(2026, time.September, 20, 0, 0, 0, 0, time.UTC)).ExecX(ctx)
+	// on the closing day, so part of the cycle
+	create(1, "-10", "-10", time.Date(2026, time.October, 5, 23, 0, 0, 0, time.UTC)).ExecX(ctx)
+	// after the cycle ended
+	create(1, "-50", "-50", time.Date(2026, time.October, 6, 0, 0, 0, 0, time.UTC)).ExecX(ctx)
+	// an installment which is only due next cycle
+	purchase := create(2, "-40", "0", time.Date(2026, time.September, 20, 0, 0, 0, 0, time.UTC)).SaveX(ctx)
+	create(2, "-40", "-40", time.Date(2026, time.September, 20, 0, 0, 0, 0, time.UTC)).
+		SetParentID(purchase.ID).
+		SetInstallmentNumber(1).
+		SetDueDate(time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)).
+		ExecX(ctx)
+
+	dao := billing.NewDAO(client)
+
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2026, time.October, 6, 0, 0, 0, 0, time.UTC)
+
+	cycles, err := dao.DueCycles(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, []*billing.Cycle{{
+		AccountID:  1,
+		ClosingDay: 5,
+		PeriodEnd:  periodEnd,
+		DueDate:    periodEnd.AddDate(0, 0, 10),
+	}}, cycles)
+
+	dbInvoice, err := dao.Close(ctx, cycles[0])
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, time.September, 6, 0, 0, 0, 0, time.UTC), dbInvoice.PeriodStart.UTC())
+	require.Equal(t, periodEnd, dbInvoice.PeriodEnd.UTC())
+	require.Equal(t, money.MustParse("80"), dbInvoice.TotalDue)
+	// 10% is less than the floor
+	require.Equal(t, money.MustParse("20"), dbInvoice.MinimumPayment)
+	require.Equal(t, invoice.StatusOpen, dbInvoice.Status)
+
+	// the cycle is closed only once
+	cycles, err = dao.DueCycles(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, cycles)
+
+	_, err = dao.Close(ctx, &billing.Cycle{AccountID: 1, ClosingDay: 5, PeriodEnd: periodEnd, DueDate: periodEnd.AddDate(0, 0, 10)})
+	require.True(t, ent.IsConstraintError(err))
+
+	// the next cycle has what was not paid of the previous one and supersedes it
+	now = time.Date(2026, time.November, 18, 0, 0, 0, 0, time.UTC)
+	cycles, err = dao.DueCycles(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, cycles, 2)
+	require.Equal(t, 3, cycles[1].AccountID)
+
+	next, err := dao.Close(ctx, cycles[0])
+	require.NoError(t, err)
+	require.Equal(t, periodEnd, next.PeriodStart.UTC())
+	require.Equal(t, money.MustParse("170"), next.TotalDue)
+	require.Equal(t, money.MustParse("20"), next.MinimumPayment)
+
+	previous, err := dao.Get(ctx, dbInvoice.ID)
+	require.NoError(t, err)
+	require.Equal(t, invoice.StatusSuperseded, previous.Status)
+
+	dbInvoices, err := dao.List(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, dbInvoices, 2)
+	require.Equal(t, next.ID, dbInvoices[0].ID)
+
+	// nothing due, so the invoice is paid from the start
+	empty, err := dao.Close(ctx, cycles[1])
+	require.NoError(t, err)
+	require.Equal(t, money.Amount(0), empty.TotalDue)
+	require.Equal(t, invoice.StatusPaid, empty.Status)
+}
+
+func TestApplyCredit(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCycleClosingDay(5).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	periodEnd := time.Date(2026, time.October, 6, 0, 0, 0, 0, time.UTC)
+	dbInvoice := client.Invoice.Create().
+		SetAccountID(1).
+		SetPeriodStart(periodEnd.AddDate(0, -1, 0)).
+		SetPeriodEnd(periodEnd).
+		SetTotalDue(money.MustParse("100")).
+		SetMinimumPayment(money.MustParse("20")).
+		SetDueDate(periodEnd.AddDate(0, 0, 10)).
+		SaveX(ctx)
+
+	apply := func(accountID int, amount string) *ent.Transaction {
+		credit := client.Transaction.Create().
+			SetAccountID(accountID).
+			SetOperationTypeID(4).
+			SetTimestamp(time.Now()).
+			SetAmount(money.MustParse(amount)).
+			SetBalance(money.MustParse(amount)).
+			SaveX(ctx)
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		require.NoError(t, billing.ApplyCredit(ctx, tx, credit, credit.Amount))
+		require.NoError(t, tx.Commit())
+		return credit
+	}
+	unapply := func(credit *ent.Transaction) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		require.NoError(t, billing.UnapplyCredit(ctx, tx, credit.ID, time.Now()))
+		require.NoError(t, tx.Commit())
+	}
+
+	// an account without an open invoice
+	apply(2, "10")
+
+	first := apply(1, "60")
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.MustParse("60"), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusOpen, dbInvoice.Status)
+
+	// only what is still due is applied
+	second := apply(1, "60")
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.MustParse("100"), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusPaid, dbInvoice.Status)
+
+	// a paid invoice is left alone
+	third := apply(1, "10")
+	require.Equal(t, money.MustParse("100"), client.Invoice.GetX(ctx, dbInvoice.ID).PaidAmount)
+	require.Equal(t, 2, client.InvoicePayment.Query().CountX(ctx))
+
+	// a credit pays one invoice only once
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, billing.ApplyCredit(ctx, tx, first, money.MustParse("10")))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 2, client.InvoicePayment.Query().CountX(ctx))
+
+	// a credit which paid nothing has nothing to take back
+	unapply(third)
+	require.Equal(t, money.MustParse("100"), client.Invoice.GetX(ctx, dbInvoice.ID).PaidAmount)
+
+	// taking back only what the credit applied opens the invoice again
+	unapply(second)
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.MustParse("60"), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusOpen, dbInvoice.Status)
+
+	// a payment is taken back once
+	unapply(second)
+	require.Equal(t, money.MustParse("60"), client.Invoice.GetX(ctx, dbInvoice.ID).PaidAmount)
+
+	// a paid invoice stays paid once a later cycle has closed
+	apply(1, "40")
+	client.Invoice.Create().
+		SetAccountID(1).
+		SetPeriodStart(periodEnd).
+		SetPeriodEnd(periodEnd.AddDate(0, 1, 0)).
+		SetTotalDue(0).
+		SetMinimumPayment(0).
+		SetDueDate(periodEnd.AddDate(0, 1, 10)).
+		SetStatus(invoice.StatusPaid).
+		ExecX(ctx)
+	unapply(first)
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.MustParse("40"), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusPaid, dbInvoice.Status)
+}
+
+func TestCycleCloserCloseDue(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	opened := time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC)
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCycleClosingDay(5).SetCreateTime(opened).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").SetCycleClosingDay(28).SetDueDateOffset(20).SetCreateTime(opened).ExecX(ctx)
+
+	closer := billing.NewCycleCloser(billing.NewDAO(client, billing.WithMinimumPaymentRule(billing.FullDue())), time.Hour, zap.NewNop())
+
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	closed, err := closer.CloseDue(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 2, closed)
+
+	dbInvoice := client.Invoice.Query().Where(invoice.AccountID(2)).OnlyX(ctx)
+	require.Equal(t, time.Date(2026, time.September, 29, 0, 0, 0, 0, time.UTC), dbInvoice.PeriodEnd.UTC())
+	require.Equal(t, time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), dbInvoice.DueDate.UTC())
+
+	// running again closes nothing
+	closed, err = closer.CloseDue(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 0, closed)
+}
@@ -0,0 +1,23 @@
+package billing
+
+import "transactor-server/pkg/db/ent"
+
+// MapEntInvoiceToInvoice maps an ent.Invoice record to billing.Invoice model
+func MapEntInvoiceToInvoice(i *ent.Invoice) *Invoice {
+	if i == nil {
+		return nil
+	}
+
+	return &Invoice{
+		ID:             i.ID,
+		AccountID:      i.AccountID,
+		PeriodStart:    i.PeriodStart,
+		PeriodEnd:      i.PeriodEnd,
+		TotalDue:       i.TotalDue,
+		MinimumPayment: i.MinimumPayment,
+		DueDate:        i.DueDate,
+		PaidAmount:     i.PaidAmount,
+		Status:         i.Status.String(),
+		CreatedAt:      i.CreateTime,
+	}
+}
@@ -0,0 +1,80 @@
+package billing
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the billing.Service
+// it like name suggests adds metrics to each service call
+// on success it adds billing_service_<method>_success and on failure billing_service_<method>_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	getCounterSuccess  metric.Int64Counter
+	getCounterFailure  metric.Int64Counter
+	listCounterSuccess metric.Int64Counter
+	listCounterFailure metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	getCounterSuccess, err := meter.Int64Counter("billing_service_get_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterFailure, err := meter.Int64Counter("billing_service_get_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listCounterSuccess, err := meter.Int64Counter("billing_service_list_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listCounterFailure, err := meter.Int64Counter("billing_service_list_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:            service,
+		meter:              meter,
+		getCounterSuccess:  getCounterSuccess,
+		getCounterFailure:  getCounterFailure,
+		listCounterSuccess: listCounterSuccess,
+		listCounterFailure: listCounterFailure,
+	}
+}
+
+func (m *meteredSevice) Get(ctx context.Context, id int) (resp *Invoice, err error) {
+	defer func() {
+		if err == nil {
+			m.getCounterSuccess.Add(ctx, 1)
+		} else {
+			m.getCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Get(ctx, id)
+	return
+}
+
+func (m *meteredSevice) List(ctx context.Context, accountID int) (resp *ListResponse, err error) {
+	defer func() {
+		if err == nil {
+			m.listCounterSuccess.Add(ctx, 1)
+		} else {
+			m.listCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.List(ctx, accountID)
+	return
+}
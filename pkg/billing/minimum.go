@@ -0,0 +1,95 @@
+package billing
+
+import (
+	"fmt"
+	"math"
+	"transactor-server/pkg/money"
+)
+
+// names of the minimum payment rules which can be selected from config
+const (
+	MinimumPaymentPercentage = "percentage"
+	MinimumPaymentFull       = "full"
+)
+
+// DefaultMinimumPaymentPercent & DefaultMinimumPaymentFloor are used by the percentage rule when they are not configured
+const (
+	DefaultMinimumPaymentPercent = 10
+	DefaultMinimumPaymentFloor   = "20"
+)
+
+// MinimumPaymentRule decides the least which has to be paid of an invoice by its due date
+type MinimumPaymentRule interface {
+	// Name returns the config name of the rule
+	Name() string
+	// MinimumPayment returns the minimum payment of an invoice, it is never more than totalDue
+	MinimumPayment(totalDue money.Amount) money.Amount
+}
+
+type percentageRule struct {
+	// basisPoints is the percent * 100 so the rule stays in integers
+	basisPoints int64
+	floor       money.Amount
+}
+
+func (r *percentageRule) Name() string {
+	return MinimumPaymentPercentage
+}
+
+func (r *percentageRule) MinimumPayment(totalDue money.Amount) money.Amount {
+	// rounded up to the cent so the minimum payment is never less than the percent
+	minimum := money.Amount((int64(totalDue)*r.basisPoints + 9999) / 10000)
+	return min(max(minimum, r.floor), totalDue)
+}
+
+// PercentageOfDue asks for percent of the total due but at least floor, invoices which are less than floor are due in full
+func PercentageOfDue(percent float64, floor money.Amount) MinimumPaymentRule {
+	return &percentageRule{
+		basisPoints: int64(math.Round(percent * 100)),
+		floor:       floor,
+	}
+}
+
+type fullRule struct{}
+
+func (r *fullRule) Name() string {
+	return MinimumPaymentFull
+}
+
+func (r *fullRule) MinimumPayment(totalDue money.Amount) money.Amount {
+	return totalDue
+}
+
+// FullDue asks for the whole total due, like a charge card
+func FullDue() MinimumPaymentRule {
+	return &fullRule{}
+}
+
+// NewMinimumPaymentRule returns the rule with the given config name, an empty name means percentage
+// percent & floor are only used by the percentage rule and default to DefaultMinimumPaymentPercent & DefaultMinimumPaymentFloor
+func NewMinimumPaymentRule(name string, percent float64, floor string) (MinimumPaymentRule, error) {
+	switch name {
+	case "", MinimumPaymentPercentage:
+		if percent == 0 {
+			percent = DefaultMinimumPaymentPercent
+		}
+		if percent < 0 || percent > 100 {
+			return nil, fmt.Errorf("minimum payment percent %v must be between 0 and 100", percent)
+		}
+		if floor == "" {
+			floor = DefaultMinimumPaymentFloor
+		}
+		floorAmount, err := money.Parse(floor)
+		if err != nil {
+			return nil, fmt.Errorf("minimum payment floor: %w", err)
+		}
+		if floorAmount < 0 {
+			return nil, fmt.Errorf("minimum payment floor %s must not be -ve", floorAmount)
+		}
+		return PercentageOfDue(percent, floorAmount), nil
+	case MinimumPaymentFull:
+		return FullDue(), nil
+	default:
+		return nil, fmt.Errorf("unknown minimum payment rule %q", name)
+	}
+}
@@ -0,0 +1,62 @@
+package billing_test
+
+import (
+	"testing"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/money"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinimumPaymentRule(t *testing.T) {
+	t.Run("percentage", func(t *testing.T) {
+		t.Parallel()
+		rule := billing.PercentageOfDue(10, money.MustParse("20"))
+
+		for totalDue, minimum := range map[string]string{
+			"0":       "0",
+			"15":      "15",
+			"150":     "20",
+			"1000":    "100",
+			"1000.05": "100.01",
+		} {
+			require.Equal(t, money.MustParse(minimum), rule.MinimumPayment(money.MustParse(totalDue)), totalDue)
+		}
+	})
+
+	t.Run("full", func(t *testing.T) {
+		t.Parallel()
+		require.Equal(t, money.MustParse("1000"), billing.FullDue().MinimumPayment(money.MustParse("1000")))
+	})
+
+	t.Run("from config", func(t *testing.T) {
+		t.Parallel()
+
+		rule, err := billing.NewMinimumPaymentRule("", 0, "")
+		require.NoError(t, err)
+		require.Equal(t, billing.MinimumPaymentPercentage, rule.Name())
+		require.Equal(t, money.MustParse("20"), rule.MinimumPayment(money.MustParse("150")))
+
+		rule, err = billing.NewMinimumPaymentRule(billing.MinimumPaymentPercentage, 5, "10")
+		require.NoError(t, err)
+		require.Equal(t, money.MustParse("50"), rule.MinimumPayment(money.MustParse("1000")))
+
+		rule, err = billing.NewMinimumPaymentRule(billing.MinimumPaymentFull, 0, "")
+		require.NoError(t, err)
+		require.Equal(t, billing.MinimumPaymentFull, rule.Name())
+
+		for _, args := range []struct {
+			name    string
+			percent float64
+			floor   string
+		}{
+			{"revolving", 0, ""},
+			{billing.MinimumPaymentPercentage, 101, ""},
+			{billing.MinimumPaymentPercentage, 10, "abc"},
+			{billing.MinimumPaymentPercentage, 10, "-1"},
+		} {
+			_, err := billing.NewMinimumPaymentRule(args.name, args.percent, args.floor)
+			require.Error(t, err)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package billing
+
+import (
+	"context"
+	"transactor-server/pkg/pkgerr"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"go.uber.org/zap"
+)
+
+// Service handles the main business logic for invoice related things
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockBillingService  --filename billing_service.go
+type Service interface {
+	// Get tries to find an existing invoice in database layer
+	Get(context.Context, int) (*Invoice, error)
+	// List returns the invoices of an account, newest first
+	List(context.Context, int) (*ListResponse, error)
+}
+
+type service struct {
+	billingDAO DAO
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(
+	billingDAO DAO,
+
+	logger *zap.Logger,
+) Service {
+	return &service{
+		billingDAO: billingDAO,
+
+		logger: logger,
+	}
+}
+
+func (s *service) Get(ctx context.Context, id int) (*Invoice, error) {
+	// validates the id to be +ve
+	err := validation.Validate(id, validation.Min(1))
+	if err != nil {
+		return nil, pkgerr.WrapValidationError(err, "id")
+	}
+
+	// calls dao to get the record from database
+	dbInvoice, err := s.billingDAO.Get(ctx, id)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntInvoiceToInvoice(dbInvoice), nil
+}
+
+func (s *service) List(ctx context.Context, accountID int) (*ListResponse, error) {
+	// validates the account id to be +ve
+	err := validation.Validate(accountID, validation.Min(1))
+	if err != nil {
+		return nil, pkgerr.WrapValidationError(err, "account_id")
+	}
+
+	// make sure the account exists, an unknown account would otherwise have no invoices
+	_, err = s.billingDAO.Account(ctx, accountID)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	dbInvoices, err := s.billingDAO.List(ctx, accountID)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	resp := &ListResponse{
+		Invoices: make([]*Invoice, 0, len(dbInvoices)),
+	}
+	for _, dbInvoice := range dbInvoices {
+		resp.Invoices = append(resp.Invoices, MapEntInvoiceToInvoice(dbInvoice))
+	}
+
+	return resp, nil
+}
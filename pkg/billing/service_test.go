@@ -0,0 +1,125 @@
+package billing_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var dbInvoice = &ent.Invoice{
+	ID:             1,
+	AccountID:      373,
+	PeriodStart:    time.Date(2026, time.September, 6, 0, 0, 0, 0, time.UTC),
+	PeriodEnd:      time.Date(2026, time.October, 6, 0, 0, 0, 0, time.UTC),
+	TotalDue:       money.MustParse("250.50"),
+	MinimumPayment: money.MustParse("25.05"),
+	DueDate:        time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC),
+	PaidAmount:     money.MustParse("100"),
+	Status:         invoice.StatusOpen,
+}
+
+func TestServiceGet(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := billing.NewService(mocks.NewMockBillingDAO(t), zap.NewNop())
+
+		resp, err := service.Get(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		billingDAO := mocks.NewMockBillingDAO(t)
+		service := billing.NewService(billingDAO, zap.NewNop())
+
+		billingDAO.On("Get", mock.Anything, 1).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Get(context.Background(), 1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		billingDAO := mocks.NewMockBillingDAO(t)
+		service := billing.NewService(billingDAO, zap.NewNop())
+
+		billingDAO.On("Get", mock.Anything, 1).Return(dbInvoice, nil)
+
+		resp, err := service.Get(context.Background(), 1)
+
+		require.NoError(t, err)
+		require.Equal(t, 373, resp.AccountID)
+		require.Equal(t, money.MustParse("250.50"), resp.TotalDue)
+		require.Equal(t, money.MustParse("25.05"), resp.MinimumPayment)
+		require.Equal(t, "open", resp.Status)
+	})
+}
+
+func TestServiceList(t *testing.T) {
+	t.Run("account not found", func(t *testing.T) {
+		t.Parallel()
+		billingDAO := mocks.NewMockBillingDAO(t)
+		service := billing.NewService(billingDAO, zap.NewNop())
+
+		billingDAO.On("Account", mock.Anything, 373).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.List(context.Background(), 373)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("dao error", func(t *testing.T) {
+		t.Parallel()
+		billingDAO := mocks.NewMockBillingDAO(t)
+		service := billing.NewService(billingDAO, zap.NewNop())
+
+		billingDAO.On("Account", mock.Anything, 373).Return(&ent.Account{ID: 373}, nil)
+		billingDAO.On("List", mock.Anything, 373).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := service.List(context.Background(), 373)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		billingDAO := mocks.NewMockBillingDAO(t)
+		service := billing.NewService(billingDAO, zap.NewNop())
+
+		billingDAO.On("Account", mock.Anything, 373).Return(&ent.Account{ID: 373}, nil)
+		billingDAO.On("List", mock.Anything, 373).Return([]*ent.Invoice{dbInvoice}, nil)
+
+		resp, err := service.List(context.Background(), 373)
+
+		require.NoError(t, err)
+		require.Len(t, resp.Invoices, 1)
+		require.Equal(t, 1, resp.Invoices[0].ID)
+	})
+}
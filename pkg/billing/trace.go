@@ -0,0 +1,81 @@
+package billing
+
+import (
+	"context"
+	"transactor-server/pkg/config"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// tracedService is a middleware/wrapper to the billing.Service
+// it like name suggests adds a span to the each of the methods
+// on error it ensures that span is marked with error
+// it also logs request and response and error if any
+type tracedService struct {
+	service Service
+	logger  *zap.Logger
+}
+
+func NewTracedService(service Service, logger *zap.Logger) Service {
+	return &tracedService{
+		service: service,
+		logger:  logger,
+	}
+}
+
+var _ Service = (*tracedService)(nil)
+
+func (t *tracedService) Get(ctx context.Context, id int) (resp *Invoice, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "BillingService.Get")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling BillingService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	resp, err = t.service.Get(ctx, id)
+
+	if err != nil {
+		t.logger.Error("end BillingService.Get with error", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+	} else {
+		t.logger.Info("end BillingService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) List(ctx context.Context, accountID int) (resp *ListResponse, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "BillingService.List")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling BillingService.List", zapotlp.SpanCtx(ctx), zap.Int("account_id", accountID))
+
+	resp, err = t.service.List(ctx, accountID)
+
+	if err != nil {
+		t.logger.Error("end BillingService.List with error", zapotlp.SpanCtx(ctx), zap.Int("account_id", accountID), zap.Error(err))
+	} else {
+		t.logger.Info("end BillingService.List", zapotlp.SpanCtx(ctx), zap.Int("account_id", accountID), zap.Int("invoices", len(resp.Invoices)))
+	}
+
+	return
+}
@@ -0,0 +1,27 @@
+package billing
+
+import (
+	"time"
+	"transactor-server/pkg/money"
+)
+
+// Invoice is what an account owes at the end of a billing cycle
+type Invoice struct {
+	ID          int       `json:"id"`
+	AccountID   int       `json:"account_id"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	// TotalDue includes what is left of the previous invoice
+	TotalDue       money.Amount `json:"total_due" swaggertype:"number" example:"250.5"`
+	MinimumPayment money.Amount `json:"minimum_payment" swaggertype:"number" example:"25.05"`
+	DueDate        time.Time    `json:"due_date"`
+	// PaidAmount is how much of the total due the credits booked after the cycle closed paid
+	PaidAmount money.Amount `json:"paid_amount" swaggertype:"number" example:"100"`
+	// Status is open until the total due is paid, or superseded once the next cycle closed
+	Status    string    `json:"status" enums:"open,paid,superseded"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListResponse struct {
+	Invoices []*Invoice `json:"invoices"`
+}
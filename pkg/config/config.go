@@ -1,5 +1,10 @@
 package config
 
+import (
+	"fmt"
+	"time"
+)
+
 type Server struct {
 	Host            string `yaml:"host"`
 	Port            string `yaml:"port"`
@@ -21,9 +26,118 @@ type DB struct {
 	MigrationsFolder string `yaml:"migrations_folder"`
 }
 
+type Idempotency struct {
+	KeyTTL        time.Duration `yaml:"key_ttl"`
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+	// LockTimeout is how long a request holds its claim of a key, a retry takes over the claim of a request which is gone after it
+	LockTimeout time.Duration `yaml:"lock_timeout"`
+}
+
+type Transaction struct {
+	// AllocationStrategy decides which open debits a credit pays first
+	// one of fifo (default), highest_amount_first, installments_last or operation_type_priority
+	AllocationStrategy string `yaml:"allocation_strategy"`
+	// OperationTypePriority lists the operation type ids in the order they are paid with operation_type_priority
+	OperationTypePriority []int `yaml:"operation_type_priority"`
+	// HoldTTL is how long a hold reserves its amount before it is released automatically
+	HoldTTL time.Duration `yaml:"hold_ttl"`
+	// HoldSweepInterval is how often expired holds are released
+	HoldSweepInterval time.Duration `yaml:"hold_sweep_interval"`
+	// MaxBackdate is how far in the past the event timestamp of a new transaction can be
+	MaxBackdate time.Duration `yaml:"max_backdate"`
+}
+
+type Billing struct {
+	// CloseInterval is how often the billing cycles which ended are closed into invoices
+	CloseInterval time.Duration `yaml:"close_interval"`
+	// MinimumPaymentRule decides the minimum payment of an invoice, one of percentage (default) or full
+	MinimumPaymentRule string `yaml:"minimum_payment_rule"`
+	// MinimumPaymentPercent & MinimumPaymentFloor are only used by the percentage rule
+	MinimumPaymentPercent float64 `yaml:"minimum_payment_percent"`
+	MinimumPaymentFloor   string  `yaml:"minimum_payment_floor"`
+}
+
+type Accrual struct {
+	// Interval is how often interest and late fees are accrued, interest is accrued once a day however often it runs
+	Interval time.Duration `yaml:"interval"`
+	// InterestRates is the yearly interest rate in percent of the outstanding debit per operation type id
+	// operation types which are not listed do not accrue interest
+	InterestRates map[int]float64 `yaml:"interest_rates"`
+	// LateFee is charged once for every invoice which is past its due date without its minimum payment, 0 charges none
+	LateFee string `yaml:"late_fee"`
+}
+
+type Outbox struct {
+	// RelayInterval is how often the events which are not published yet are published
+	RelayInterval time.Duration `yaml:"relay_interval"`
+	// BatchSize is how many events the relay reads at a time
+	BatchSize int `yaml:"batch_size"`
+	// Publisher is where events are published, one of none (default), stdout, file or http
+	Publisher string `yaml:"publisher"`
+	// FilePath is the file the events are appended to by the file publisher, one JSON event per line
+	FilePath string `yaml:"file_path"`
+	// HTTPURL is where the events are POSTed to by the http publisher
+	HTTPURL string `yaml:"http_url"`
+	// HTTPTimeout is how long the http publisher waits for a response
+	HTTPTimeout time.Duration `yaml:"http_timeout"`
+	// Retention is how long published events are kept
+	Retention time.Duration `yaml:"retention"`
+	// SweepInterval is how often the events published longer than Retention ago are removed
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+}
+
+type Webhook struct {
+	// DeliverInterval is how often the due webhook deliveries are sent
+	DeliverInterval time.Duration `yaml:"deliver_interval"`
+	// BatchSize is how many due deliveries are sent every run
+	BatchSize int `yaml:"batch_size"`
+	// MaxAttempts is how many times a delivery is sent before it is dead
+	MaxAttempts int `yaml:"max_attempts"`
+	// Backoff is the wait before the first retry of a delivery, it doubles on every retry up to MaxBackoff
+	Backoff    time.Duration `yaml:"backoff"`
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+	// Timeout is how long a subscriber has to respond to a delivery
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type Stream struct {
+	// PollInterval is how often an open event stream looks for new events of its account
+	PollInterval time.Duration `yaml:"poll_interval"`
+}
+
 type Config struct {
-	Server Server `yaml:"server"`
-	DB     DB     `yaml:"db"`
+	Server      Server      `yaml:"server"`
+	DB          DB          `yaml:"db"`
+	Idempotency Idempotency `yaml:"idempotency"`
+	Transaction Transaction `yaml:"transaction"`
+	Billing     Billing     `yaml:"billing"`
+	Accrual     Accrual     `yaml:"accrual"`
+	Outbox      Outbox      `yaml:"outbox"`
+	Webhook     Webhook     `yaml:"webhook"`
+	Stream      Stream      `yaml:"stream"`
 }
 
 const AppName string = "transactor-server"
+
+// Validate returns an error when an interval of a background job is not +ve, a job can not run on it
+func (c *Config) Validate() error {
+	intervals := []struct {
+		name     string
+		interval time.Duration
+	}{
+		{"idempotency.sweep_interval", c.Idempotency.SweepInterval},
+		{"transaction.hold_sweep_interval", c.Transaction.HoldSweepInterval},
+		{"billing.close_interval", c.Billing.CloseInterval},
+		{"accrual.interval", c.Accrual.Interval},
+		{"outbox.relay_interval", c.Outbox.RelayInterval},
+		{"outbox.sweep_interval", c.Outbox.SweepInterval},
+		{"webhook.deliver_interval", c.Webhook.DeliverInterval},
+	}
+
+	for _, i := range intervals {
+		if i.interval <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", i.name, i.interval)
+		}
+	}
+	return nil
+}
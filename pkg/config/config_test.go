@@ -0,0 +1,30 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+	"transactor-server/pkg/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{
+		Idempotency: config.Idempotency{SweepInterval: time.Hour},
+		Transaction: config.Transaction{HoldSweepInterval: time.Minute},
+		Billing:     config.Billing{CloseInterval: time.Hour},
+		Accrual:     config.Accrual{Interval: time.Hour},
+		Outbox:      config.Outbox{RelayInterval: time.Second, SweepInterval: time.Hour},
+		Webhook:     config.Webhook{DeliverInterval: time.Second},
+	}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Accrual.Interval = 0
+	require.EqualError(t, cfg.Validate(), "accrual.interval must be positive, got 0s")
+
+	cfg.Accrual.Interval = time.Hour
+	cfg.Webhook.DeliverInterval = -time.Second
+	require.EqualError(t, cfg.Validate(), "webhook.deliver_interval must be positive, got -1s")
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// NetBalance returns the net open balance of an account inside tx, -ve when it owes more than it has available
+// credits add to it as soon as they are booked, so they restore the credit limit whether they pay a debit or not
+func NetBalance(ctx context.Context, tx *ent.Tx, accountID int) (money.Amount, error) {
+	sums := []struct {
+		NetBalance money.Amount `json:"net_balance"`
+	}{}
+
+	err := tx.Transaction.
+		Query().
+		Where(transaction.AccountID(accountID)).
+		Modify(func(s *sql.Selector) {
+			// postgres sums bigints as numeric so it is cast back to bigint
+			s.Select().AppendSelectExprAs(sql.Raw("CAST(COALESCE(SUM(balance), 0) AS BIGINT)"), "net_balance")
+		}).
+		Scan(ctx, &sums)
+	if err != nil || len(sums) == 0 {
+		return 0, err
+	}
+
+	return sums[0].NetBalance, nil
+}
+
+// HeldAmount returns the amount reserved by the active holds of an account which have not expired inside tx
+// it is always +ve
+func HeldAmount(ctx context.Context, tx *ent.Tx, accountID int) (money.Amount, error) {
+	sums := []struct {
+		Held money.Amount `json:"held"`
+	}{}
+
+	err := tx.Hold.
+		Query().
+		Where(
+			hold.AccountID(accountID),
+			hold.StatusEQ(hold.StatusActive),
+			hold.ExpiresAtGT(time.Now()),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select().AppendSelectExprAs(sql.Raw("CAST(COALESCE(SUM(amount), 0) AS BIGINT)"), "held")
+		}).
+		Scan(ctx, &sums)
+	if err != nil || len(sums) == 0 {
+		return 0, err
+	}
+
+	return sums[0].Held, nil
+}
@@ -0,0 +1,58 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/money"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalances(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	now := time.Now()
+	client.Transaction.Create().SetAccountID(1).SetOperationTypeID(1).SetTimestamp(now).SetAmount(money.MustParse("-50")).SetBalance(money.MustParse("-20")).ExecX(ctx)
+	client.Transaction.Create().SetAccountID(1).SetOperationTypeID(4).SetTimestamp(now).SetAmount(money.MustParse("30")).SetBalance(money.MustParse("5")).ExecX(ctx)
+	client.Transaction.Create().SetAccountID(2).SetOperationTypeID(4).SetTimestamp(now).SetAmount(money.MustParse("70")).SetBalance(money.MustParse("70")).ExecX(ctx)
+
+	client.Hold.Create().SetAccountID(1).SetOperationTypeID(1).SetAmount(money.MustParse("10")).SetExpiresAt(now.Add(time.Hour)).ExecX(ctx)
+	// released and expired holds reserve nothing
+	client.Hold.Create().SetAccountID(1).SetOperationTypeID(1).SetAmount(money.MustParse("20")).SetExpiresAt(now.Add(time.Hour)).SetStatus(hold.StatusReleased).ExecX(ctx)
+	client.Hold.Create().SetAccountID(1).SetOperationTypeID(1).SetAmount(money.MustParse("40")).SetExpiresAt(now.Add(-time.Hour)).ExecX(ctx)
+
+	err := db.WithTx(ctx, client, func(tx *ent.Tx) error {
+		balance, err := db.NetBalance(ctx, tx, 1)
+		require.NoError(t, err)
+		require.Equal(t, money.MustParse("-15"), balance)
+
+		held, err := db.HeldAmount(ctx, tx, 1)
+		require.NoError(t, err)
+		require.Equal(t, money.MustParse("10"), held)
+
+		// an account without transactions or holds has nothing
+		balance, err = db.NetBalance(ctx, tx, 3)
+		require.NoError(t, err)
+		require.Zero(t, balance)
+
+		held, err = db.HeldAmount(ctx, tx, 2)
+		require.NoError(t, err)
+		require.Zero(t, held)
+		return nil
+	})
+	require.NoError(t, err)
+}
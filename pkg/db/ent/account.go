@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -25,6 +26,14 @@ type Account struct {
 	Name string `json:"name,omitempty"`
 	// DocumentNumber holds the value of the "document_number" field.
 	DocumentNumber string `json:"document_number,omitempty"`
+	// CreditLimit holds the value of the "credit_limit" field.
+	CreditLimit *money.Amount `json:"credit_limit,omitempty"`
+	// CycleClosingDay holds the value of the "cycle_closing_day" field.
+	CycleClosingDay *int `json:"cycle_closing_day,omitempty"`
+	// DueDateOffset holds the value of the "due_date_offset" field.
+	DueDateOffset int `json:"due_date_offset,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AccountQuery when eager-loading is set.
 	Edges        AccountEdges `json:"edges"`
@@ -35,9 +44,23 @@ type Account struct {
 type AccountEdges struct {
 	// Transactions holds the value of the transactions edge.
 	Transactions []*Transaction `json:"transactions,omitempty"`
+	// OutgoingTransfers holds the value of the outgoing_transfers edge.
+	OutgoingTransfers []*Transfer `json:"outgoing_transfers,omitempty"`
+	// IncomingTransfers holds the value of the incoming_transfers edge.
+	IncomingTransfers []*Transfer `json:"incoming_transfers,omitempty"`
+	// Holds holds the value of the holds edge.
+	Holds []*Hold `json:"holds,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// Accruals holds the value of the accruals edge.
+	Accruals []*Accrual `json:"accruals,omitempty"`
+	// JournalEntries holds the value of the journal_entries edge.
+	JournalEntries []*JournalEntry `json:"journal_entries,omitempty"`
+	// OutboxEvents holds the value of the outbox_events edge.
+	OutboxEvents []*OutboxEvent `json:"outbox_events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [8]bool
 }
 
 // TransactionsOrErr returns the Transactions value or an error if the edge
@@ -49,14 +72,77 @@ func (e AccountEdges) TransactionsOrErr() ([]*Transaction, error) {
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
+// OutgoingTransfersOrErr returns the OutgoingTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) OutgoingTransfersOrErr() ([]*Transfer, error) {
+	if e.loadedTypes[1] {
+		return e.OutgoingTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "outgoing_transfers"}
+}
+
+// IncomingTransfersOrErr returns the IncomingTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) IncomingTransfersOrErr() ([]*Transfer, error) {
+	if e.loadedTypes[2] {
+		return e.IncomingTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "incoming_transfers"}
+}
+
+// HoldsOrErr returns the Holds value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) HoldsOrErr() ([]*Hold, error) {
+	if e.loadedTypes[3] {
+		return e.Holds, nil
+	}
+	return nil, &NotLoadedError{edge: "holds"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[4] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// AccrualsOrErr returns the Accruals value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) AccrualsOrErr() ([]*Accrual, error) {
+	if e.loadedTypes[5] {
+		return e.Accruals, nil
+	}
+	return nil, &NotLoadedError{edge: "accruals"}
+}
+
+// JournalEntriesOrErr returns the JournalEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) JournalEntriesOrErr() ([]*JournalEntry, error) {
+	if e.loadedTypes[6] {
+		return e.JournalEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "journal_entries"}
+}
+
+// OutboxEventsOrErr returns the OutboxEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) OutboxEventsOrErr() ([]*OutboxEvent, error) {
+	if e.loadedTypes[7] {
+		return e.OutboxEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "outbox_events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case account.FieldID:
+		case account.FieldID, account.FieldCreditLimit, account.FieldCycleClosingDay, account.FieldDueDateOffset:
 			values[i] = new(sql.NullInt64)
-		case account.FieldName, account.FieldDocumentNumber:
+		case account.FieldName, account.FieldDocumentNumber, account.FieldCurrency:
 			values[i] = new(sql.NullString)
 		case account.FieldCreateTime, account.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -105,6 +191,32 @@ func (a *Account) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.DocumentNumber = value.String
 			}
+		case account.FieldCreditLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_limit", values[i])
+			} else if value.Valid {
+				a.CreditLimit = new(money.Amount)
+				*a.CreditLimit = money.Amount(value.Int64)
+			}
+		case account.FieldCycleClosingDay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cycle_closing_day", values[i])
+			} else if value.Valid {
+				a.CycleClosingDay = new(int)
+				*a.CycleClosingDay = int(value.Int64)
+			}
+		case account.FieldDueDateOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date_offset", values[i])
+			} else if value.Valid {
+				a.DueDateOffset = int(value.Int64)
+			}
+		case account.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				a.Currency = value.String
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -123,6 +235,41 @@ func (a *Account) QueryTransactions() *TransactionQuery {
 	return NewAccountClient(a.config).QueryTransactions(a)
 }
 
+// QueryOutgoingTransfers queries the "outgoing_transfers" edge of the Account entity.
+func (a *Account) QueryOutgoingTransfers() *TransferQuery {
+	return NewAccountClient(a.config).QueryOutgoingTransfers(a)
+}
+
+// QueryIncomingTransfers queries the "incoming_transfers" edge of the Account entity.
+func (a *Account) QueryIncomingTransfers() *TransferQuery {
+	return NewAccountClient(a.config).QueryIncomingTransfers(a)
+}
+
+// QueryHolds queries the "holds" edge of the Account entity.
+func (a *Account) QueryHolds() *HoldQuery {
+	return NewAccountClient(a.config).QueryHolds(a)
+}
+
+// QueryInvoices queries the "invoices" edge of the Account entity.
+func (a *Account) QueryInvoices() *InvoiceQuery {
+	return NewAccountClient(a.config).QueryInvoices(a)
+}
+
+// QueryAccruals queries the "accruals" edge of the Account entity.
+func (a *Account) QueryAccruals() *AccrualQuery {
+	return NewAccountClient(a.config).QueryAccruals(a)
+}
+
+// QueryJournalEntries queries the "journal_entries" edge of the Account entity.
+func (a *Account) QueryJournalEntries() *JournalEntryQuery {
+	return NewAccountClient(a.config).QueryJournalEntries(a)
+}
+
+// QueryOutboxEvents queries the "outbox_events" edge of the Account entity.
+func (a *Account) QueryOutboxEvents() *OutboxEventQuery {
+	return NewAccountClient(a.config).QueryOutboxEvents(a)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -157,6 +304,22 @@ func (a *Account) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("document_number=")
 	builder.WriteString(a.DocumentNumber)
+	builder.WriteString(", ")
+	if v := a.CreditLimit; v != nil {
+		builder.WriteString("credit_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.CycleClosingDay; v != nil {
+		builder.WriteString("cycle_closing_day=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("due_date_offset=")
+	builder.WriteString(fmt.Sprintf("%v", a.DueDateOffset))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(a.Currency)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,8 +22,30 @@ const (
 	FieldName = "name"
 	// FieldDocumentNumber holds the string denoting the document_number field in the database.
 	FieldDocumentNumber = "document_number"
+	// FieldCreditLimit holds the string denoting the credit_limit field in the database.
+	FieldCreditLimit = "credit_limit"
+	// FieldCycleClosingDay holds the string denoting the cycle_closing_day field in the database.
+	FieldCycleClosingDay = "cycle_closing_day"
+	// FieldDueDateOffset holds the string denoting the due_date_offset field in the database.
+	FieldDueDateOffset = "due_date_offset"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
 	EdgeTransactions = "transactions"
+	// EdgeOutgoingTransfers holds the string denoting the outgoing_transfers edge name in mutations.
+	EdgeOutgoingTransfers = "outgoing_transfers"
+	// EdgeIncomingTransfers holds the string denoting the incoming_transfers edge name in mutations.
+	EdgeIncomingTransfers = "incoming_transfers"
+	// EdgeHolds holds the string denoting the holds edge name in mutations.
+	EdgeHolds = "holds"
+	// EdgeInvoices holds the string denoting the invoices edge name in mutations.
+	EdgeInvoices = "invoices"
+	// EdgeAccruals holds the string denoting the accruals edge name in mutations.
+	EdgeAccruals = "accruals"
+	// EdgeJournalEntries holds the string denoting the journal_entries edge name in mutations.
+	EdgeJournalEntries = "journal_entries"
+	// EdgeOutboxEvents holds the string denoting the outbox_events edge name in mutations.
+	EdgeOutboxEvents = "outbox_events"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
 	// TransactionsTable is the table that holds the transactions relation/edge.
@@ -33,6 +55,55 @@ const (
 	TransactionsInverseTable = "transactions"
 	// TransactionsColumn is the table column denoting the transactions relation/edge.
 	TransactionsColumn = "account_id"
+	// OutgoingTransfersTable is the table that holds the outgoing_transfers relation/edge.
+	OutgoingTransfersTable = "transfers"
+	// OutgoingTransfersInverseTable is the table name for the Transfer entity.
+	// It exists in this package in order to avoid circular dependency with the "transfer" package.
+	OutgoingTransfersInverseTable = "transfers"
+	// OutgoingTransfersColumn is the table column denoting the outgoing_transfers relation/edge.
+	OutgoingTransfersColumn = "source_account_id"
+	// IncomingTransfersTable is the table that holds the incoming_transfers relation/edge.
+	IncomingTransfersTable = "transfers"
+	// IncomingTransfersInverseTable is the table name for the Transfer entity.
+	// It exists in this package in order to avoid circular dependency with the "transfer" package.
+	IncomingTransfersInverseTable = "transfers"
+	// IncomingTransfersColumn is the table column denoting the incoming_transfers relation/edge.
+	IncomingTransfersColumn = "destination_account_id"
+	// HoldsTable is the table that holds the holds relation/edge.
+	HoldsTable = "holds"
+	// HoldsInverseTable is the table name for the Hold entity.
+	// It exists in this package in order to avoid circular dependency with the "hold" package.
+	HoldsInverseTable = "holds"
+	// HoldsColumn is the table column denoting the holds relation/edge.
+	HoldsColumn = "account_id"
+	// InvoicesTable is the table that holds the invoices relation/edge.
+	InvoicesTable = "invoices"
+	// InvoicesInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoicesInverseTable = "invoices"
+	// InvoicesColumn is the table column denoting the invoices relation/edge.
+	InvoicesColumn = "account_id"
+	// AccrualsTable is the table that holds the accruals relation/edge.
+	AccrualsTable = "accruals"
+	// AccrualsInverseTable is the table name for the Accrual entity.
+	// It exists in this package in order to avoid circular dependency with the "accrual" package.
+	AccrualsInverseTable = "accruals"
+	// AccrualsColumn is the table column denoting the accruals relation/edge.
+	AccrualsColumn = "account_id"
+	// JournalEntriesTable is the table that holds the journal_entries relation/edge.
+	JournalEntriesTable = "journal_entries"
+	// JournalEntriesInverseTable is the table name for the JournalEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "journalentry" package.
+	JournalEntriesInverseTable = "journal_entries"
+	// JournalEntriesColumn is the table column denoting the journal_entries relation/edge.
+	JournalEntriesColumn = "account_id"
+	// OutboxEventsTable is the table that holds the outbox_events relation/edge.
+	OutboxEventsTable = "outbox_events"
+	// OutboxEventsInverseTable is the table name for the OutboxEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "outboxevent" package.
+	OutboxEventsInverseTable = "outbox_events"
+	// OutboxEventsColumn is the table column denoting the outbox_events relation/edge.
+	OutboxEventsColumn = "account_id"
 )
 
 // Columns holds all SQL columns for account fields.
@@ -42,6 +113,10 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldName,
 	FieldDocumentNumber,
+	FieldCreditLimit,
+	FieldCycleClosingDay,
+	FieldDueDateOffset,
+	FieldCurrency,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -63,6 +138,16 @@ var (
 	UpdateDefaultUpdateTime func() time.Time
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// CycleClosingDayValidator is a validator for the "cycle_closing_day" field. It is called by the builders before save.
+	CycleClosingDayValidator func(int) error
+	// DefaultDueDateOffset holds the default value on creation for the "due_date_offset" field.
+	DefaultDueDateOffset int
+	// DueDateOffsetValidator is a validator for the "due_date_offset" field. It is called by the builders before save.
+	DueDateOffsetValidator func(int) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the Account queries.
@@ -93,6 +178,26 @@ func ByDocumentNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDocumentNumber, opts...).ToFunc()
 }
 
+// ByCreditLimit orders the results by the credit_limit field.
+func ByCreditLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditLimit, opts...).ToFunc()
+}
+
+// ByCycleClosingDay orders the results by the cycle_closing_day field.
+func ByCycleClosingDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCycleClosingDay, opts...).ToFunc()
+}
+
+// ByDueDateOffset orders the results by the due_date_offset field.
+func ByDueDateOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDateOffset, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
 // ByTransactionsCount orders the results by transactions count.
 func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -106,6 +211,104 @@ func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOutgoingTransfersCount orders the results by outgoing_transfers count.
+func ByOutgoingTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOutgoingTransfersStep(), opts...)
+	}
+}
+
+// ByOutgoingTransfers orders the results by outgoing_transfers terms.
+func ByOutgoingTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOutgoingTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIncomingTransfersCount orders the results by incoming_transfers count.
+func ByIncomingTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIncomingTransfersStep(), opts...)
+	}
+}
+
+// ByIncomingTransfers orders the results by incoming_transfers terms.
+func ByIncomingTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIncomingTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHoldsCount orders the results by holds count.
+func ByHoldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHoldsStep(), opts...)
+	}
+}
+
+// ByHolds orders the results by holds terms.
+func ByHolds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHoldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvoicesCount orders the results by invoices count.
+func ByInvoicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvoicesStep(), opts...)
+	}
+}
+
+// ByInvoices orders the results by invoices terms.
+func ByInvoices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccrualsCount orders the results by accruals count.
+func ByAccrualsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccrualsStep(), opts...)
+	}
+}
+
+// ByAccruals orders the results by accruals terms.
+func ByAccruals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccrualsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByJournalEntriesCount orders the results by journal_entries count.
+func ByJournalEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJournalEntriesStep(), opts...)
+	}
+}
+
+// ByJournalEntries orders the results by journal_entries terms.
+func ByJournalEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJournalEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOutboxEventsCount orders the results by outbox_events count.
+func ByOutboxEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOutboxEventsStep(), opts...)
+	}
+}
+
+// ByOutboxEvents orders the results by outbox_events terms.
+func ByOutboxEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOutboxEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTransactionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -113,3 +316,52 @@ func newTransactionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
 	)
 }
+func newOutgoingTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OutgoingTransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OutgoingTransfersTable, OutgoingTransfersColumn),
+	)
+}
+func newIncomingTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IncomingTransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IncomingTransfersTable, IncomingTransfersColumn),
+	)
+}
+func newHoldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HoldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HoldsTable, HoldsColumn),
+	)
+}
+func newInvoicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+	)
+}
+func newAccrualsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccrualsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccrualsTable, AccrualsColumn),
+	)
+}
+func newJournalEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JournalEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JournalEntriesTable, JournalEntriesColumn),
+	)
+}
+func newOutboxEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OutboxEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OutboxEventsTable, OutboxEventsColumn),
+	)
+}
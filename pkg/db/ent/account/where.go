@@ -5,6 +5,7 @@ package account
 import (
 	"time"
 	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -75,6 +76,27 @@ func DocumentNumber(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldDocumentNumber, v))
 }
 
+// CreditLimit applies equality check predicate on the "credit_limit" field. It's identical to CreditLimitEQ.
+func CreditLimit(v money.Amount) predicate.Account {
+	vc := int64(v)
+	return predicate.Account(sql.FieldEQ(FieldCreditLimit, vc))
+}
+
+// CycleClosingDay applies equality check predicate on the "cycle_closing_day" field. It's identical to CycleClosingDayEQ.
+func CycleClosingDay(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCycleClosingDay, v))
+}
+
+// DueDateOffset applies equality check predicate on the "due_date_offset" field. It's identical to DueDateOffsetEQ.
+func DueDateOffset(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldDueDateOffset, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCurrency, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreateTime, v))
@@ -285,6 +307,225 @@ func DocumentNumberContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldDocumentNumber, v))
 }
 
+// CreditLimitEQ applies the EQ predicate on the "credit_limit" field.
+func CreditLimitEQ(v money.Amount) predicate.Account {
+	vc := int64(v)
+	return predicate.Account(sql.FieldEQ(FieldCreditLimit, vc))
+}
+
+// CreditLimitNEQ applies the NEQ predicate on the "credit_limit" field.
+func CreditLimitNEQ(v money.Amount) predicate.Account {
+	vc := int64(v)
+	return predicate.Account(sql.FieldNEQ(FieldCreditLimit, vc))
+}
+
+// CreditLimitIn applies the In predicate on the "credit_limit" field.
+func CreditLimitIn(vs ...money.Amount) predicate.Account {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Account(sql.FieldIn(FieldCreditLimit, v...))
+}
+
+// CreditLimitNotIn applies the NotIn predicate on the "credit_limit" field.
+func CreditLimitNotIn(vs ...money.Amount) predicate.Account {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Account(sql.FieldNotIn(FieldCreditLimit, v...))
+}
+
+// CreditLimitGT applies the GT predicate on the "credit_limit" field.
+func CreditLimitGT(v money.Amount) predicate.Account {
+	vc := int64(v)
+	return predicate.Account(sql.FieldGT(FieldCreditLimit, vc))
+}
+
+// CreditLimitGTE applies the GTE predicate on the "credit_limit" field.
+func CreditLimitGTE(v money.Amount) predicate.Account {
+	vc := int64(v)
+	return predicate.Account(sql.FieldGTE(FieldCreditLimit, vc))
+}
+
+// CreditLimitLT applies the LT predicate on the "credit_limit" field.
+func CreditLimitLT(v money.Amount) predicate.Account {
+	vc := int64(v)
+	return predicate.Account(sql.FieldLT(FieldCreditLimit, vc))
+}
+
+// CreditLimitLTE applies the LTE predicate on the "credit_limit" field.
+func CreditLimitLTE(v money.Amount) predicate.Account {
+	vc := int64(v)
+	return predicate.Account(sql.FieldLTE(FieldCreditLimit, vc))
+}
+
+// CreditLimitIsNil applies the IsNil predicate on the "credit_limit" field.
+func CreditLimitIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldCreditLimit))
+}
+
+// CreditLimitNotNil applies the NotNil predicate on the "credit_limit" field.
+func CreditLimitNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldCreditLimit))
+}
+
+// CycleClosingDayEQ applies the EQ predicate on the "cycle_closing_day" field.
+func CycleClosingDayEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCycleClosingDay, v))
+}
+
+// CycleClosingDayNEQ applies the NEQ predicate on the "cycle_closing_day" field.
+func CycleClosingDayNEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCycleClosingDay, v))
+}
+
+// CycleClosingDayIn applies the In predicate on the "cycle_closing_day" field.
+func CycleClosingDayIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCycleClosingDay, vs...))
+}
+
+// CycleClosingDayNotIn applies the NotIn predicate on the "cycle_closing_day" field.
+func CycleClosingDayNotIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCycleClosingDay, vs...))
+}
+
+// CycleClosingDayGT applies the GT predicate on the "cycle_closing_day" field.
+func CycleClosingDayGT(v int) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCycleClosingDay, v))
+}
+
+// CycleClosingDayGTE applies the GTE predicate on the "cycle_closing_day" field.
+func CycleClosingDayGTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCycleClosingDay, v))
+}
+
+// CycleClosingDayLT applies the LT predicate on the "cycle_closing_day" field.
+func CycleClosingDayLT(v int) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCycleClosingDay, v))
+}
+
+// CycleClosingDayLTE applies the LTE predicate on the "cycle_closing_day" field.
+func CycleClosingDayLTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCycleClosingDay, v))
+}
+
+// CycleClosingDayIsNil applies the IsNil predicate on the "cycle_closing_day" field.
+func CycleClosingDayIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldCycleClosingDay))
+}
+
+// CycleClosingDayNotNil applies the NotNil predicate on the "cycle_closing_day" field.
+func CycleClosingDayNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldCycleClosingDay))
+}
+
+// DueDateOffsetEQ applies the EQ predicate on the "due_date_offset" field.
+func DueDateOffsetEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldDueDateOffset, v))
+}
+
+// DueDateOffsetNEQ applies the NEQ predicate on the "due_date_offset" field.
+func DueDateOffsetNEQ(v int) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldDueDateOffset, v))
+}
+
+// DueDateOffsetIn applies the In predicate on the "due_date_offset" field.
+func DueDateOffsetIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldDueDateOffset, vs...))
+}
+
+// DueDateOffsetNotIn applies the NotIn predicate on the "due_date_offset" field.
+func DueDateOffsetNotIn(vs ...int) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldDueDateOffset, vs...))
+}
+
+// DueDateOffsetGT applies the GT predicate on the "due_date_offset" field.
+func DueDateOffsetGT(v int) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldDueDateOffset, v))
+}
+
+// DueDateOffsetGTE applies the GTE predicate on the "due_date_offset" field.
+func DueDateOffsetGTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldDueDateOffset, v))
+}
+
+// DueDateOffsetLT applies the LT predicate on the "due_date_offset" field.
+func DueDateOffsetLT(v int) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldDueDateOffset, v))
+}
+
+// DueDateOffsetLTE applies the LTE predicate on the "due_date_offset" field.
+func DueDateOffsetLTE(v int) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldDueDateOffset, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldCurrency, v))
+}
+
 // HasTransactions applies the HasEdge predicate on the "transactions" edge.
 func HasTransactions() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -308,6 +549,167 @@ func HasTransactionsWith(preds ...predicate.Transaction) predicate.Account {
 	})
 }
 
+// HasOutgoingTransfers applies the HasEdge predicate on the "outgoing_transfers" edge.
+func HasOutgoingTransfers() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OutgoingTransfersTable, OutgoingTransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOutgoingTransfersWith applies the HasEdge predicate on the "outgoing_transfers" edge with a given conditions (other predicates).
+func HasOutgoingTransfersWith(preds ...predicate.Transfer) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newOutgoingTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncomingTransfers applies the HasEdge predicate on the "incoming_transfers" edge.
+func HasIncomingTransfers() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IncomingTransfersTable, IncomingTransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncomingTransfersWith applies the HasEdge predicate on the "incoming_transfers" edge with a given conditions (other predicates).
+func HasIncomingTransfersWith(preds ...predicate.Transfer) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newIncomingTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHolds applies the HasEdge predicate on the "holds" edge.
+func HasHolds() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HoldsTable, HoldsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHoldsWith applies the HasEdge predicate on the "holds" edge with a given conditions (other predicates).
+func HasHoldsWith(preds ...predicate.Hold) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newHoldsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoices applies the HasEdge predicate on the "invoices" edge.
+func HasInvoices() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoicesWith applies the HasEdge predicate on the "invoices" edge with a given conditions (other predicates).
+func HasInvoicesWith(preds ...predicate.Invoice) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newInvoicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccruals applies the HasEdge predicate on the "accruals" edge.
+func HasAccruals() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccrualsTable, AccrualsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccrualsWith applies the HasEdge predicate on the "accruals" edge with a given conditions (other predicates).
+func HasAccrualsWith(preds ...predicate.Accrual) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newAccrualsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJournalEntries applies the HasEdge predicate on the "journal_entries" edge.
+func HasJournalEntries() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JournalEntriesTable, JournalEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJournalEntriesWith applies the HasEdge predicate on the "journal_entries" edge with a given conditions (other predicates).
+func HasJournalEntriesWith(preds ...predicate.JournalEntry) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newJournalEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOutboxEvents applies the HasEdge predicate on the "outbox_events" edge.
+func HasOutboxEvents() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OutboxEventsTable, OutboxEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOutboxEventsWith applies the HasEdge predicate on the "outbox_events" edge with a given conditions (other predicates).
+func HasOutboxEventsWith(preds ...predicate.OutboxEvent) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newOutboxEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Account) predicate.Account {
 	return predicate.Account(sql.AndPredicates(predicates...))
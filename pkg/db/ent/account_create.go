@@ -8,7 +8,14 @@ import (
 	"fmt"
 	"time"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/db/ent/outboxevent"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -63,6 +70,62 @@ func (ac *AccountCreate) SetDocumentNumber(s string) *AccountCreate {
 	return ac
 }
 
+// SetCreditLimit sets the "credit_limit" field.
+func (ac *AccountCreate) SetCreditLimit(m money.Amount) *AccountCreate {
+	ac.mutation.SetCreditLimit(m)
+	return ac
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableCreditLimit(m *money.Amount) *AccountCreate {
+	if m != nil {
+		ac.SetCreditLimit(*m)
+	}
+	return ac
+}
+
+// SetCycleClosingDay sets the "cycle_closing_day" field.
+func (ac *AccountCreate) SetCycleClosingDay(i int) *AccountCreate {
+	ac.mutation.SetCycleClosingDay(i)
+	return ac
+}
+
+// SetNillableCycleClosingDay sets the "cycle_closing_day" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableCycleClosingDay(i *int) *AccountCreate {
+	if i != nil {
+		ac.SetCycleClosingDay(*i)
+	}
+	return ac
+}
+
+// SetDueDateOffset sets the "due_date_offset" field.
+func (ac *AccountCreate) SetDueDateOffset(i int) *AccountCreate {
+	ac.mutation.SetDueDateOffset(i)
+	return ac
+}
+
+// SetNillableDueDateOffset sets the "due_date_offset" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableDueDateOffset(i *int) *AccountCreate {
+	if i != nil {
+		ac.SetDueDateOffset(*i)
+	}
+	return ac
+}
+
+// SetCurrency sets the "currency" field.
+func (ac *AccountCreate) SetCurrency(s string) *AccountCreate {
+	ac.mutation.SetCurrency(s)
+	return ac
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableCurrency(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetCurrency(*s)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AccountCreate) SetID(i int) *AccountCreate {
 	ac.mutation.SetID(i)
@@ -84,6 +147,111 @@ func (ac *AccountCreate) AddTransactions(t ...*Transaction) *AccountCreate {
 	return ac.AddTransactionIDs(ids...)
 }
 
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Transfer entity by IDs.
+func (ac *AccountCreate) AddOutgoingTransferIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddOutgoingTransferIDs(ids...)
+	return ac
+}
+
+// AddOutgoingTransfers adds the "outgoing_transfers" edges to the Transfer entity.
+func (ac *AccountCreate) AddOutgoingTransfers(t ...*Transfer) *AccountCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ac.AddOutgoingTransferIDs(ids...)
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Transfer entity by IDs.
+func (ac *AccountCreate) AddIncomingTransferIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddIncomingTransferIDs(ids...)
+	return ac
+}
+
+// AddIncomingTransfers adds the "incoming_transfers" edges to the Transfer entity.
+func (ac *AccountCreate) AddIncomingTransfers(t ...*Transfer) *AccountCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ac.AddIncomingTransferIDs(ids...)
+}
+
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (ac *AccountCreate) AddHoldIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddHoldIDs(ids...)
+	return ac
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (ac *AccountCreate) AddHolds(h ...*Hold) *AccountCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ac.AddHoldIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (ac *AccountCreate) AddInvoiceIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddInvoiceIDs(ids...)
+	return ac
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (ac *AccountCreate) AddInvoices(i ...*Invoice) *AccountCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ac.AddInvoiceIDs(ids...)
+}
+
+// AddAccrualIDs adds the "accruals" edge to the Accrual entity by IDs.
+func (ac *AccountCreate) AddAccrualIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddAccrualIDs(ids...)
+	return ac
+}
+
+// AddAccruals adds the "accruals" edges to the Accrual entity.
+func (ac *AccountCreate) AddAccruals(a ...*Accrual) *AccountCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddAccrualIDs(ids...)
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
+func (ac *AccountCreate) AddJournalEntryIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddJournalEntryIDs(ids...)
+	return ac
+}
+
+// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
+func (ac *AccountCreate) AddJournalEntries(j ...*JournalEntry) *AccountCreate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return ac.AddJournalEntryIDs(ids...)
+}
+
+// AddOutboxEventIDs adds the "outbox_events" edge to the OutboxEvent entity by IDs.
+func (ac *AccountCreate) AddOutboxEventIDs(ids ...int) *AccountCreate {
+	ac.mutation.AddOutboxEventIDs(ids...)
+	return ac
+}
+
+// AddOutboxEvents adds the "outbox_events" edges to the OutboxEvent entity.
+func (ac *AccountCreate) AddOutboxEvents(o ...*OutboxEvent) *AccountCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ac.AddOutboxEventIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (ac *AccountCreate) Mutation() *AccountMutation {
 	return ac.mutation
@@ -127,6 +295,14 @@ func (ac *AccountCreate) defaults() {
 		v := account.DefaultUpdateTime()
 		ac.mutation.SetUpdateTime(v)
 	}
+	if _, ok := ac.mutation.DueDateOffset(); !ok {
+		v := account.DefaultDueDateOffset
+		ac.mutation.SetDueDateOffset(v)
+	}
+	if _, ok := ac.mutation.Currency(); !ok {
+		v := account.DefaultCurrency
+		ac.mutation.SetCurrency(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -148,6 +324,27 @@ func (ac *AccountCreate) check() error {
 	if _, ok := ac.mutation.DocumentNumber(); !ok {
 		return &ValidationError{Name: "document_number", err: errors.New(`ent: missing required field "Account.document_number"`)}
 	}
+	if v, ok := ac.mutation.CycleClosingDay(); ok {
+		if err := account.CycleClosingDayValidator(v); err != nil {
+			return &ValidationError{Name: "cycle_closing_day", err: fmt.Errorf(`ent: validator failed for field "Account.cycle_closing_day": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.DueDateOffset(); !ok {
+		return &ValidationError{Name: "due_date_offset", err: errors.New(`ent: missing required field "Account.due_date_offset"`)}
+	}
+	if v, ok := ac.mutation.DueDateOffset(); ok {
+		if err := account.DueDateOffsetValidator(v); err != nil {
+			return &ValidationError{Name: "due_date_offset", err: fmt.Errorf(`ent: validator failed for field "Account.due_date_offset": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Account.currency"`)}
+	}
+	if v, ok := ac.mutation.Currency(); ok {
+		if err := account.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Account.currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -197,6 +394,22 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldDocumentNumber, field.TypeString, value)
 		_node.DocumentNumber = value
 	}
+	if value, ok := ac.mutation.CreditLimit(); ok {
+		_spec.SetField(account.FieldCreditLimit, field.TypeInt64, value)
+		_node.CreditLimit = &value
+	}
+	if value, ok := ac.mutation.CycleClosingDay(); ok {
+		_spec.SetField(account.FieldCycleClosingDay, field.TypeInt, value)
+		_node.CycleClosingDay = &value
+	}
+	if value, ok := ac.mutation.DueDateOffset(); ok {
+		_spec.SetField(account.FieldDueDateOffset, field.TypeInt, value)
+		_node.DueDateOffset = value
+	}
+	if value, ok := ac.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
 	if nodes := ac.mutation.TransactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -213,6 +426,118 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.OutgoingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutgoingTransfersTable,
+			Columns: []string{account.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.IncomingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IncomingTransfersTable,
+			Columns: []string{account.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldsTable,
+			Columns: []string{account.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvoicesTable,
+			Columns: []string{account.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.AccrualsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AccrualsTable,
+			Columns: []string{account.AccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.JournalEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.JournalEntriesTable,
+			Columns: []string{account.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.OutboxEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutboxEventsTable,
+			Columns: []string{account.OutboxEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -301,6 +626,72 @@ func (u *AccountUpsert) UpdateDocumentNumber() *AccountUpsert {
 	return u
 }
 
+// SetCreditLimit sets the "credit_limit" field.
+func (u *AccountUpsert) SetCreditLimit(v money.Amount) *AccountUpsert {
+	u.Set(account.FieldCreditLimit, v)
+	return u
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateCreditLimit() *AccountUpsert {
+	u.SetExcluded(account.FieldCreditLimit)
+	return u
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *AccountUpsert) AddCreditLimit(v money.Amount) *AccountUpsert {
+	u.Add(account.FieldCreditLimit, v)
+	return u
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *AccountUpsert) ClearCreditLimit() *AccountUpsert {
+	u.SetNull(account.FieldCreditLimit)
+	return u
+}
+
+// SetCycleClosingDay sets the "cycle_closing_day" field.
+func (u *AccountUpsert) SetCycleClosingDay(v int) *AccountUpsert {
+	u.Set(account.FieldCycleClosingDay, v)
+	return u
+}
+
+// UpdateCycleClosingDay sets the "cycle_closing_day" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateCycleClosingDay() *AccountUpsert {
+	u.SetExcluded(account.FieldCycleClosingDay)
+	return u
+}
+
+// AddCycleClosingDay adds v to the "cycle_closing_day" field.
+func (u *AccountUpsert) AddCycleClosingDay(v int) *AccountUpsert {
+	u.Add(account.FieldCycleClosingDay, v)
+	return u
+}
+
+// ClearCycleClosingDay clears the value of the "cycle_closing_day" field.
+func (u *AccountUpsert) ClearCycleClosingDay() *AccountUpsert {
+	u.SetNull(account.FieldCycleClosingDay)
+	return u
+}
+
+// SetDueDateOffset sets the "due_date_offset" field.
+func (u *AccountUpsert) SetDueDateOffset(v int) *AccountUpsert {
+	u.Set(account.FieldDueDateOffset, v)
+	return u
+}
+
+// UpdateDueDateOffset sets the "due_date_offset" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateDueDateOffset() *AccountUpsert {
+	u.SetExcluded(account.FieldDueDateOffset)
+	return u
+}
+
+// AddDueDateOffset adds v to the "due_date_offset" field.
+func (u *AccountUpsert) AddDueDateOffset(v int) *AccountUpsert {
+	u.Add(account.FieldDueDateOffset, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -321,6 +712,9 @@ func (u *AccountUpsertOne) UpdateNewValues() *AccountUpsertOne {
 		if _, exists := u.create.mutation.CreateTime(); exists {
 			s.SetIgnore(account.FieldCreateTime)
 		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(account.FieldCurrency)
+		}
 	}))
 	return u
 }
@@ -394,6 +788,83 @@ func (u *AccountUpsertOne) UpdateDocumentNumber() *AccountUpsertOne {
 	})
 }
 
+// SetCreditLimit sets the "credit_limit" field.
+func (u *AccountUpsertOne) SetCreditLimit(v money.Amount) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *AccountUpsertOne) AddCreditLimit(v money.Amount) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateCreditLimit() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *AccountUpsertOne) ClearCreditLimit() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCreditLimit()
+	})
+}
+
+// SetCycleClosingDay sets the "cycle_closing_day" field.
+func (u *AccountUpsertOne) SetCycleClosingDay(v int) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCycleClosingDay(v)
+	})
+}
+
+// AddCycleClosingDay adds v to the "cycle_closing_day" field.
+func (u *AccountUpsertOne) AddCycleClosingDay(v int) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddCycleClosingDay(v)
+	})
+}
+
+// UpdateCycleClosingDay sets the "cycle_closing_day" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateCycleClosingDay() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCycleClosingDay()
+	})
+}
+
+// ClearCycleClosingDay clears the value of the "cycle_closing_day" field.
+func (u *AccountUpsertOne) ClearCycleClosingDay() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCycleClosingDay()
+	})
+}
+
+// SetDueDateOffset sets the "due_date_offset" field.
+func (u *AccountUpsertOne) SetDueDateOffset(v int) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetDueDateOffset(v)
+	})
+}
+
+// AddDueDateOffset adds v to the "due_date_offset" field.
+func (u *AccountUpsertOne) AddDueDateOffset(v int) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddDueDateOffset(v)
+	})
+}
+
+// UpdateDueDateOffset sets the "due_date_offset" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateDueDateOffset() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateDueDateOffset()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -579,6 +1050,9 @@ func (u *AccountUpsertBulk) UpdateNewValues() *AccountUpsertBulk {
 			if _, exists := b.mutation.CreateTime(); exists {
 				s.SetIgnore(account.FieldCreateTime)
 			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(account.FieldCurrency)
+			}
 		}
 	}))
 	return u
@@ -653,6 +1127,83 @@ func (u *AccountUpsertBulk) UpdateDocumentNumber() *AccountUpsertBulk {
 	})
 }
 
+// SetCreditLimit sets the "credit_limit" field.
+func (u *AccountUpsertBulk) SetCreditLimit(v money.Amount) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *AccountUpsertBulk) AddCreditLimit(v money.Amount) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateCreditLimit() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *AccountUpsertBulk) ClearCreditLimit() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCreditLimit()
+	})
+}
+
+// SetCycleClosingDay sets the "cycle_closing_day" field.
+func (u *AccountUpsertBulk) SetCycleClosingDay(v int) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCycleClosingDay(v)
+	})
+}
+
+// AddCycleClosingDay adds v to the "cycle_closing_day" field.
+func (u *AccountUpsertBulk) AddCycleClosingDay(v int) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddCycleClosingDay(v)
+	})
+}
+
+// UpdateCycleClosingDay sets the "cycle_closing_day" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateCycleClosingDay() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCycleClosingDay()
+	})
+}
+
+// ClearCycleClosingDay clears the value of the "cycle_closing_day" field.
+func (u *AccountUpsertBulk) ClearCycleClosingDay() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCycleClosingDay()
+	})
+}
+
+// SetDueDateOffset sets the "due_date_offset" field.
+func (u *AccountUpsertBulk) SetDueDateOffset(v int) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetDueDateOffset(v)
+	})
+}
+
+// AddDueDateOffset adds v to the "due_date_offset" field.
+func (u *AccountUpsertBulk) AddDueDateOffset(v int) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddDueDateOffset(v)
+	})
+}
+
+// UpdateDueDateOffset sets the "due_date_offset" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateDueDateOffset() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateDueDateOffset()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -8,8 +8,14 @@ import (
 	"fmt"
 	"math"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/db/ent/outboxevent"
 	"transactor-server/pkg/db/ent/predicate"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,12 +26,19 @@ import (
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	ctx              *QueryContext
-	order            []account.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Account
-	withTransactions *TransactionQuery
-	modifiers        []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []account.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Account
+	withTransactions      *TransactionQuery
+	withOutgoingTransfers *TransferQuery
+	withIncomingTransfers *TransferQuery
+	withHolds             *HoldQuery
+	withInvoices          *InvoiceQuery
+	withAccruals          *AccrualQuery
+	withJournalEntries    *JournalEntryQuery
+	withOutboxEvents      *OutboxEventQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +97,160 @@ func (aq *AccountQuery) QueryTransactions() *TransactionQuery {
 	return query
 }
 
+// QueryOutgoingTransfers chains the current query on the "outgoing_transfers" edge.
+func (aq *AccountQuery) QueryOutgoingTransfers() *TransferQuery {
+	query := (&TransferClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.OutgoingTransfersTable, account.OutgoingTransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIncomingTransfers chains the current query on the "incoming_transfers" edge.
+func (aq *AccountQuery) QueryIncomingTransfers() *TransferQuery {
+	query := (&TransferClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.IncomingTransfersTable, account.IncomingTransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHolds chains the current query on the "holds" edge.
+func (aq *AccountQuery) QueryHolds() *HoldQuery {
+	query := (&HoldClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(hold.Table, hold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.HoldsTable, account.HoldsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoices chains the current query on the "invoices" edge.
+func (aq *AccountQuery) QueryInvoices() *InvoiceQuery {
+	query := (&InvoiceClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.InvoicesTable, account.InvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccruals chains the current query on the "accruals" edge.
+func (aq *AccountQuery) QueryAccruals() *AccrualQuery {
+	query := (&AccrualClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(accrual.Table, accrual.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.AccrualsTable, account.AccrualsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryJournalEntries chains the current query on the "journal_entries" edge.
+func (aq *AccountQuery) QueryJournalEntries() *JournalEntryQuery {
+	query := (&JournalEntryClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(journalentry.Table, journalentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.JournalEntriesTable, account.JournalEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOutboxEvents chains the current query on the "outbox_events" edge.
+func (aq *AccountQuery) QueryOutboxEvents() *OutboxEventQuery {
+	query := (&OutboxEventClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(outboxevent.Table, outboxevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.OutboxEventsTable, account.OutboxEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (aq *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -271,12 +438,19 @@ func (aq *AccountQuery) Clone() *AccountQuery {
 		return nil
 	}
 	return &AccountQuery{
-		config:           aq.config,
-		ctx:              aq.ctx.Clone(),
-		order:            append([]account.OrderOption{}, aq.order...),
-		inters:           append([]Interceptor{}, aq.inters...),
-		predicates:       append([]predicate.Account{}, aq.predicates...),
-		withTransactions: aq.withTransactions.Clone(),
+		config:                aq.config,
+		ctx:                   aq.ctx.Clone(),
+		order:                 append([]account.OrderOption{}, aq.order...),
+		inters:                append([]Interceptor{}, aq.inters...),
+		predicates:            append([]predicate.Account{}, aq.predicates...),
+		withTransactions:      aq.withTransactions.Clone(),
+		withOutgoingTransfers: aq.withOutgoingTransfers.Clone(),
+		withIncomingTransfers: aq.withIncomingTransfers.Clone(),
+		withHolds:             aq.withHolds.Clone(),
+		withInvoices:          aq.withInvoices.Clone(),
+		withAccruals:          aq.withAccruals.Clone(),
+		withJournalEntries:    aq.withJournalEntries.Clone(),
+		withOutboxEvents:      aq.withOutboxEvents.Clone(),
 		// clone intermediate query.
 		sql:       aq.sql.Clone(),
 		path:      aq.path,
@@ -295,6 +469,83 @@ func (aq *AccountQuery) WithTransactions(opts ...func(*TransactionQuery)) *Accou
 	return aq
 }
 
+// WithOutgoingTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "outgoing_transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithOutgoingTransfers(opts ...func(*TransferQuery)) *AccountQuery {
+	query := (&TransferClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withOutgoingTransfers = query
+	return aq
+}
+
+// WithIncomingTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "incoming_transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithIncomingTransfers(opts ...func(*TransferQuery)) *AccountQuery {
+	query := (&TransferClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withIncomingTransfers = query
+	return aq
+}
+
+// WithHolds tells the query-builder to eager-load the nodes that are connected to
+// the "holds" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithHolds(opts ...func(*HoldQuery)) *AccountQuery {
+	query := (&HoldClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withHolds = query
+	return aq
+}
+
+// WithInvoices tells the query-builder to eager-load the nodes that are connected to
+// the "invoices" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithInvoices(opts ...func(*InvoiceQuery)) *AccountQuery {
+	query := (&InvoiceClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withInvoices = query
+	return aq
+}
+
+// WithAccruals tells the query-builder to eager-load the nodes that are connected to
+// the "accruals" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithAccruals(opts ...func(*AccrualQuery)) *AccountQuery {
+	query := (&AccrualClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withAccruals = query
+	return aq
+}
+
+// WithJournalEntries tells the query-builder to eager-load the nodes that are connected to
+// the "journal_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithJournalEntries(opts ...func(*JournalEntryQuery)) *AccountQuery {
+	query := (&JournalEntryClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withJournalEntries = query
+	return aq
+}
+
+// WithOutboxEvents tells the query-builder to eager-load the nodes that are connected to
+// the "outbox_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithOutboxEvents(opts ...func(*OutboxEventQuery)) *AccountQuery {
+	query := (&OutboxEventClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withOutboxEvents = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +624,15 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	var (
 		nodes       = []*Account{}
 		_spec       = aq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [8]bool{
 			aq.withTransactions != nil,
+			aq.withOutgoingTransfers != nil,
+			aq.withIncomingTransfers != nil,
+			aq.withHolds != nil,
+			aq.withInvoices != nil,
+			aq.withAccruals != nil,
+			aq.withJournalEntries != nil,
+			aq.withOutboxEvents != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -405,6 +663,55 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
+	if query := aq.withOutgoingTransfers; query != nil {
+		if err := aq.loadOutgoingTransfers(ctx, query, nodes,
+			func(n *Account) { n.Edges.OutgoingTransfers = []*Transfer{} },
+			func(n *Account, e *Transfer) { n.Edges.OutgoingTransfers = append(n.Edges.OutgoingTransfers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withIncomingTransfers; query != nil {
+		if err := aq.loadIncomingTransfers(ctx, query, nodes,
+			func(n *Account) { n.Edges.IncomingTransfers = []*Transfer{} },
+			func(n *Account, e *Transfer) { n.Edges.IncomingTransfers = append(n.Edges.IncomingTransfers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withHolds; query != nil {
+		if err := aq.loadHolds(ctx, query, nodes,
+			func(n *Account) { n.Edges.Holds = []*Hold{} },
+			func(n *Account, e *Hold) { n.Edges.Holds = append(n.Edges.Holds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withInvoices; query != nil {
+		if err := aq.loadInvoices(ctx, query, nodes,
+			func(n *Account) { n.Edges.Invoices = []*Invoice{} },
+			func(n *Account, e *Invoice) { n.Edges.Invoices = append(n.Edges.Invoices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withAccruals; query != nil {
+		if err := aq.loadAccruals(ctx, query, nodes,
+			func(n *Account) { n.Edges.Accruals = []*Accrual{} },
+			func(n *Account, e *Accrual) { n.Edges.Accruals = append(n.Edges.Accruals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withJournalEntries; query != nil {
+		if err := aq.loadJournalEntries(ctx, query, nodes,
+			func(n *Account) { n.Edges.JournalEntries = []*JournalEntry{} },
+			func(n *Account, e *JournalEntry) { n.Edges.JournalEntries = append(n.Edges.JournalEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withOutboxEvents; query != nil {
+		if err := aq.loadOutboxEvents(ctx, query, nodes,
+			func(n *Account) { n.Edges.OutboxEvents = []*OutboxEvent{} },
+			func(n *Account, e *OutboxEvent) { n.Edges.OutboxEvents = append(n.Edges.OutboxEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -438,6 +745,219 @@ func (aq *AccountQuery) loadTransactions(ctx context.Context, query *Transaction
 	}
 	return nil
 }
+func (aq *AccountQuery) loadOutgoingTransfers(ctx context.Context, query *TransferQuery, nodes []*Account, init func(*Account), assign func(*Account, *Transfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transfer.FieldSourceAccountID)
+	}
+	query.Where(predicate.Transfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.OutgoingTransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SourceAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "source_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadIncomingTransfers(ctx context.Context, query *TransferQuery, nodes []*Account, init func(*Account), assign func(*Account, *Transfer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transfer.FieldDestinationAccountID)
+	}
+	query.Where(predicate.Transfer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.IncomingTransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DestinationAccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "destination_account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadHolds(ctx context.Context, query *HoldQuery, nodes []*Account, init func(*Account), assign func(*Account, *Hold)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(hold.FieldAccountID)
+	}
+	query.Where(predicate.Hold(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.HoldsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadInvoices(ctx context.Context, query *InvoiceQuery, nodes []*Account, init func(*Account), assign func(*Account, *Invoice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invoice.FieldAccountID)
+	}
+	query.Where(predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.InvoicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadAccruals(ctx context.Context, query *AccrualQuery, nodes []*Account, init func(*Account), assign func(*Account, *Accrual)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accrual.FieldAccountID)
+	}
+	query.Where(predicate.Accrual(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.AccrualsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadJournalEntries(ctx context.Context, query *JournalEntryQuery, nodes []*Account, init func(*Account), assign func(*Account, *JournalEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(journalentry.FieldAccountID)
+	}
+	query.Where(predicate.JournalEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.JournalEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadOutboxEvents(ctx context.Context, query *OutboxEventQuery, nodes []*Account, init func(*Account), assign func(*Account, *OutboxEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(outboxevent.FieldAccountID)
+	}
+	query.Where(predicate.OutboxEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.OutboxEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -8,8 +8,15 @@ import (
 	"fmt"
 	"time"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/db/ent/outboxevent"
 	"transactor-server/pkg/db/ent/predicate"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -64,6 +71,81 @@ func (au *AccountUpdate) SetNillableDocumentNumber(s *string) *AccountUpdate {
 	return au
 }
 
+// SetCreditLimit sets the "credit_limit" field.
+func (au *AccountUpdate) SetCreditLimit(m money.Amount) *AccountUpdate {
+	au.mutation.ResetCreditLimit()
+	au.mutation.SetCreditLimit(m)
+	return au
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableCreditLimit(m *money.Amount) *AccountUpdate {
+	if m != nil {
+		au.SetCreditLimit(*m)
+	}
+	return au
+}
+
+// AddCreditLimit adds m to the "credit_limit" field.
+func (au *AccountUpdate) AddCreditLimit(m money.Amount) *AccountUpdate {
+	au.mutation.AddCreditLimit(m)
+	return au
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (au *AccountUpdate) ClearCreditLimit() *AccountUpdate {
+	au.mutation.ClearCreditLimit()
+	return au
+}
+
+// SetCycleClosingDay sets the "cycle_closing_day" field.
+func (au *AccountUpdate) SetCycleClosingDay(i int) *AccountUpdate {
+	au.mutation.ResetCycleClosingDay()
+	au.mutation.SetCycleClosingDay(i)
+	return au
+}
+
+// SetNillableCycleClosingDay sets the "cycle_closing_day" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableCycleClosingDay(i *int) *AccountUpdate {
+	if i != nil {
+		au.SetCycleClosingDay(*i)
+	}
+	return au
+}
+
+// AddCycleClosingDay adds i to the "cycle_closing_day" field.
+func (au *AccountUpdate) AddCycleClosingDay(i int) *AccountUpdate {
+	au.mutation.AddCycleClosingDay(i)
+	return au
+}
+
+// ClearCycleClosingDay clears the value of the "cycle_closing_day" field.
+func (au *AccountUpdate) ClearCycleClosingDay() *AccountUpdate {
+	au.mutation.ClearCycleClosingDay()
+	return au
+}
+
+// SetDueDateOffset sets the "due_date_offset" field.
+func (au *AccountUpdate) SetDueDateOffset(i int) *AccountUpdate {
+	au.mutation.ResetDueDateOffset()
+	au.mutation.SetDueDateOffset(i)
+	return au
+}
+
+// SetNillableDueDateOffset sets the "due_date_offset" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableDueDateOffset(i *int) *AccountUpdate {
+	if i != nil {
+		au.SetDueDateOffset(*i)
+	}
+	return au
+}
+
+// AddDueDateOffset adds i to the "due_date_offset" field.
+func (au *AccountUpdate) AddDueDateOffset(i int) *AccountUpdate {
+	au.mutation.AddDueDateOffset(i)
+	return au
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
 func (au *AccountUpdate) AddTransactionIDs(ids ...int) *AccountUpdate {
 	au.mutation.AddTransactionIDs(ids...)
@@ -79,6 +161,111 @@ func (au *AccountUpdate) AddTransactions(t ...*Transaction) *AccountUpdate {
 	return au.AddTransactionIDs(ids...)
 }
 
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Transfer entity by IDs.
+func (au *AccountUpdate) AddOutgoingTransferIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddOutgoingTransferIDs(ids...)
+	return au
+}
+
+// AddOutgoingTransfers adds the "outgoing_transfers" edges to the Transfer entity.
+func (au *AccountUpdate) AddOutgoingTransfers(t ...*Transfer) *AccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.AddOutgoingTransferIDs(ids...)
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Transfer entity by IDs.
+func (au *AccountUpdate) AddIncomingTransferIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddIncomingTransferIDs(ids...)
+	return au
+}
+
+// AddIncomingTransfers adds the "incoming_transfers" edges to the Transfer entity.
+func (au *AccountUpdate) AddIncomingTransfers(t ...*Transfer) *AccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.AddIncomingTransferIDs(ids...)
+}
+
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (au *AccountUpdate) AddHoldIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddHoldIDs(ids...)
+	return au
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (au *AccountUpdate) AddHolds(h ...*Hold) *AccountUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return au.AddHoldIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (au *AccountUpdate) AddInvoiceIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddInvoiceIDs(ids...)
+	return au
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (au *AccountUpdate) AddInvoices(i ...*Invoice) *AccountUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return au.AddInvoiceIDs(ids...)
+}
+
+// AddAccrualIDs adds the "accruals" edge to the Accrual entity by IDs.
+func (au *AccountUpdate) AddAccrualIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddAccrualIDs(ids...)
+	return au
+}
+
+// AddAccruals adds the "accruals" edges to the Accrual entity.
+func (au *AccountUpdate) AddAccruals(a ...*Accrual) *AccountUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddAccrualIDs(ids...)
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
+func (au *AccountUpdate) AddJournalEntryIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddJournalEntryIDs(ids...)
+	return au
+}
+
+// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
+func (au *AccountUpdate) AddJournalEntries(j ...*JournalEntry) *AccountUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return au.AddJournalEntryIDs(ids...)
+}
+
+// AddOutboxEventIDs adds the "outbox_events" edge to the OutboxEvent entity by IDs.
+func (au *AccountUpdate) AddOutboxEventIDs(ids ...int) *AccountUpdate {
+	au.mutation.AddOutboxEventIDs(ids...)
+	return au
+}
+
+// AddOutboxEvents adds the "outbox_events" edges to the OutboxEvent entity.
+func (au *AccountUpdate) AddOutboxEvents(o ...*OutboxEvent) *AccountUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return au.AddOutboxEventIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (au *AccountUpdate) Mutation() *AccountMutation {
 	return au.mutation
@@ -105,6 +292,153 @@ func (au *AccountUpdate) RemoveTransactions(t ...*Transaction) *AccountUpdate {
 	return au.RemoveTransactionIDs(ids...)
 }
 
+// ClearOutgoingTransfers clears all "outgoing_transfers" edges to the Transfer entity.
+func (au *AccountUpdate) ClearOutgoingTransfers() *AccountUpdate {
+	au.mutation.ClearOutgoingTransfers()
+	return au
+}
+
+// RemoveOutgoingTransferIDs removes the "outgoing_transfers" edge to Transfer entities by IDs.
+func (au *AccountUpdate) RemoveOutgoingTransferIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveOutgoingTransferIDs(ids...)
+	return au
+}
+
+// RemoveOutgoingTransfers removes "outgoing_transfers" edges to Transfer entities.
+func (au *AccountUpdate) RemoveOutgoingTransfers(t ...*Transfer) *AccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.RemoveOutgoingTransferIDs(ids...)
+}
+
+// ClearIncomingTransfers clears all "incoming_transfers" edges to the Transfer entity.
+func (au *AccountUpdate) ClearIncomingTransfers() *AccountUpdate {
+	au.mutation.ClearIncomingTransfers()
+	return au
+}
+
+// RemoveIncomingTransferIDs removes the "incoming_transfers" edge to Transfer entities by IDs.
+func (au *AccountUpdate) RemoveIncomingTransferIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveIncomingTransferIDs(ids...)
+	return au
+}
+
+// RemoveIncomingTransfers removes "incoming_transfers" edges to Transfer entities.
+func (au *AccountUpdate) RemoveIncomingTransfers(t ...*Transfer) *AccountUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return au.RemoveIncomingTransferIDs(ids...)
+}
+
+// ClearHolds clears all "holds" edges to the Hold entity.
+func (au *AccountUpdate) ClearHolds() *AccountUpdate {
+	au.mutation.ClearHolds()
+	return au
+}
+
+// RemoveHoldIDs removes the "holds" edge to Hold entities by IDs.
+func (au *AccountUpdate) RemoveHoldIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveHoldIDs(ids...)
+	return au
+}
+
+// RemoveHolds removes "holds" edges to Hold entities.
+func (au *AccountUpdate) RemoveHolds(h ...*Hold) *AccountUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return au.RemoveHoldIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (au *AccountUpdate) ClearInvoices() *AccountUpdate {
+	au.mutation.ClearInvoices()
+	return au
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (au *AccountUpdate) RemoveInvoiceIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveInvoiceIDs(ids...)
+	return au
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (au *AccountUpdate) RemoveInvoices(i ...*Invoice) *AccountUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return au.RemoveInvoiceIDs(ids...)
+}
+
+// ClearAccruals clears all "accruals" edges to the Accrual entity.
+func (au *AccountUpdate) ClearAccruals() *AccountUpdate {
+	au.mutation.ClearAccruals()
+	return au
+}
+
+// RemoveAccrualIDs removes the "accruals" edge to Accrual entities by IDs.
+func (au *AccountUpdate) RemoveAccrualIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveAccrualIDs(ids...)
+	return au
+}
+
+// RemoveAccruals removes "accruals" edges to Accrual entities.
+func (au *AccountUpdate) RemoveAccruals(a ...*Accrual) *AccountUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveAccrualIDs(ids...)
+}
+
+// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
+func (au *AccountUpdate) ClearJournalEntries() *AccountUpdate {
+	au.mutation.ClearJournalEntries()
+	return au
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
+func (au *AccountUpdate) RemoveJournalEntryIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveJournalEntryIDs(ids...)
+	return au
+}
+
+// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
+func (au *AccountUpdate) RemoveJournalEntries(j ...*JournalEntry) *AccountUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return au.RemoveJournalEntryIDs(ids...)
+}
+
+// ClearOutboxEvents clears all "outbox_events" edges to the OutboxEvent entity.
+func (au *AccountUpdate) ClearOutboxEvents() *AccountUpdate {
+	au.mutation.ClearOutboxEvents()
+	return au
+}
+
+// RemoveOutboxEventIDs removes the "outbox_events" edge to OutboxEvent entities by IDs.
+func (au *AccountUpdate) RemoveOutboxEventIDs(ids ...int) *AccountUpdate {
+	au.mutation.RemoveOutboxEventIDs(ids...)
+	return au
+}
+
+// RemoveOutboxEvents removes "outbox_events" edges to OutboxEvent entities.
+func (au *AccountUpdate) RemoveOutboxEvents(o ...*OutboxEvent) *AccountUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return au.RemoveOutboxEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AccountUpdate) Save(ctx context.Context) (int, error) {
 	au.defaults()
@@ -148,6 +482,16 @@ func (au *AccountUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
 		}
 	}
+	if v, ok := au.mutation.CycleClosingDay(); ok {
+		if err := account.CycleClosingDayValidator(v); err != nil {
+			return &ValidationError{Name: "cycle_closing_day", err: fmt.Errorf(`ent: validator failed for field "Account.cycle_closing_day": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.DueDateOffset(); ok {
+		if err := account.DueDateOffsetValidator(v); err != nil {
+			return &ValidationError{Name: "due_date_offset", err: fmt.Errorf(`ent: validator failed for field "Account.due_date_offset": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -178,6 +522,30 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.DocumentNumber(); ok {
 		_spec.SetField(account.FieldDocumentNumber, field.TypeString, value)
 	}
+	if value, ok := au.mutation.CreditLimit(); ok {
+		_spec.SetField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if au.mutation.CreditLimitCleared() {
+		_spec.ClearField(account.FieldCreditLimit, field.TypeInt64)
+	}
+	if value, ok := au.mutation.CycleClosingDay(); ok {
+		_spec.SetField(account.FieldCycleClosingDay, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedCycleClosingDay(); ok {
+		_spec.AddField(account.FieldCycleClosingDay, field.TypeInt, value)
+	}
+	if au.mutation.CycleClosingDayCleared() {
+		_spec.ClearField(account.FieldCycleClosingDay, field.TypeInt)
+	}
+	if value, ok := au.mutation.DueDateOffset(); ok {
+		_spec.SetField(account.FieldDueDateOffset, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedDueDateOffset(); ok {
+		_spec.AddField(account.FieldDueDateOffset, field.TypeInt, value)
+	}
 	if au.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -223,101 +591,743 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(au.modifiers...)
-	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{account.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if au.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutgoingTransfersTable,
+			Columns: []string{account.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	au.mutation.done = true
-	return n, nil
-}
-
-// AccountUpdateOne is the builder for updating a single Account entity.
-type AccountUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *AccountMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (auo *AccountUpdateOne) SetUpdateTime(t time.Time) *AccountUpdateOne {
-	auo.mutation.SetUpdateTime(t)
-	return auo
-}
-
-// SetName sets the "name" field.
-func (auo *AccountUpdateOne) SetName(s string) *AccountUpdateOne {
-	auo.mutation.SetName(s)
-	return auo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (auo *AccountUpdateOne) SetNillableName(s *string) *AccountUpdateOne {
-	if s != nil {
-		auo.SetName(*s)
+	if nodes := au.mutation.RemovedOutgoingTransfersIDs(); len(nodes) > 0 && !au.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutgoingTransfersTable,
+			Columns: []string{account.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return auo
-}
-
-// SetDocumentNumber sets the "document_number" field.
-func (auo *AccountUpdateOne) SetDocumentNumber(s string) *AccountUpdateOne {
-	auo.mutation.SetDocumentNumber(s)
-	return auo
-}
-
-// SetNillableDocumentNumber sets the "document_number" field if the given value is not nil.
-func (auo *AccountUpdateOne) SetNillableDocumentNumber(s *string) *AccountUpdateOne {
-	if s != nil {
-		auo.SetDocumentNumber(*s)
+	if nodes := au.mutation.OutgoingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutgoingTransfersTable,
+			Columns: []string{account.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return auo
-}
-
-// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
-func (auo *AccountUpdateOne) AddTransactionIDs(ids ...int) *AccountUpdateOne {
-	auo.mutation.AddTransactionIDs(ids...)
-	return auo
-}
-
-// AddTransactions adds the "transactions" edges to the Transaction entity.
-func (auo *AccountUpdateOne) AddTransactions(t ...*Transaction) *AccountUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
+	if au.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IncomingTransfersTable,
+			Columns: []string{account.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedIncomingTransfersIDs(); len(nodes) > 0 && !au.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IncomingTransfersTable,
+			Columns: []string{account.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.IncomingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IncomingTransfersTable,
+			Columns: []string{account.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldsTable,
+			Columns: []string{account.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedHoldsIDs(); len(nodes) > 0 && !au.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldsTable,
+			Columns: []string{account.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldsTable,
+			Columns: []string{account.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvoicesTable,
+			Columns: []string{account.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !au.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvoicesTable,
+			Columns: []string{account.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvoicesTable,
+			Columns: []string{account.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.AccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AccrualsTable,
+			Columns: []string{account.AccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedAccrualsIDs(); len(nodes) > 0 && !au.mutation.AccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AccrualsTable,
+			Columns: []string{account.AccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.AccrualsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AccrualsTable,
+			Columns: []string{account.AccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.JournalEntriesTable,
+			Columns: []string{account.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedJournalEntriesIDs(); len(nodes) > 0 && !au.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.JournalEntriesTable,
+			Columns: []string{account.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.JournalEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.JournalEntriesTable,
+			Columns: []string{account.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.OutboxEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutboxEventsTable,
+			Columns: []string{account.OutboxEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedOutboxEventsIDs(); len(nodes) > 0 && !au.mutation.OutboxEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutboxEventsTable,
+			Columns: []string{account.OutboxEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.OutboxEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutboxEventsTable,
+			Columns: []string{account.OutboxEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(au.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{account.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AccountUpdateOne is the builder for updating a single Account entity.
+type AccountUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccountMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (auo *AccountUpdateOne) SetUpdateTime(t time.Time) *AccountUpdateOne {
+	auo.mutation.SetUpdateTime(t)
+	return auo
+}
+
+// SetName sets the "name" field.
+func (auo *AccountUpdateOne) SetName(s string) *AccountUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableName(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetName(*s)
+	}
+	return auo
+}
+
+// SetDocumentNumber sets the "document_number" field.
+func (auo *AccountUpdateOne) SetDocumentNumber(s string) *AccountUpdateOne {
+	auo.mutation.SetDocumentNumber(s)
+	return auo
+}
+
+// SetNillableDocumentNumber sets the "document_number" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableDocumentNumber(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetDocumentNumber(*s)
+	}
+	return auo
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (auo *AccountUpdateOne) SetCreditLimit(m money.Amount) *AccountUpdateOne {
+	auo.mutation.ResetCreditLimit()
+	auo.mutation.SetCreditLimit(m)
+	return auo
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableCreditLimit(m *money.Amount) *AccountUpdateOne {
+	if m != nil {
+		auo.SetCreditLimit(*m)
+	}
+	return auo
+}
+
+// AddCreditLimit adds m to the "credit_limit" field.
+func (auo *AccountUpdateOne) AddCreditLimit(m money.Amount) *AccountUpdateOne {
+	auo.mutation.AddCreditLimit(m)
+	return auo
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (auo *AccountUpdateOne) ClearCreditLimit() *AccountUpdateOne {
+	auo.mutation.ClearCreditLimit()
+	return auo
+}
+
+// SetCycleClosingDay sets the "cycle_closing_day" field.
+func (auo *AccountUpdateOne) SetCycleClosingDay(i int) *AccountUpdateOne {
+	auo.mutation.ResetCycleClosingDay()
+	auo.mutation.SetCycleClosingDay(i)
+	return auo
+}
+
+// SetNillableCycleClosingDay sets the "cycle_closing_day" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableCycleClosingDay(i *int) *AccountUpdateOne {
+	if i != nil {
+		auo.SetCycleClosingDay(*i)
+	}
+	return auo
+}
+
+// AddCycleClosingDay adds i to the "cycle_closing_day" field.
+func (auo *AccountUpdateOne) AddCycleClosingDay(i int) *AccountUpdateOne {
+	auo.mutation.AddCycleClosingDay(i)
+	return auo
+}
+
+// ClearCycleClosingDay clears the value of the "cycle_closing_day" field.
+func (auo *AccountUpdateOne) ClearCycleClosingDay() *AccountUpdateOne {
+	auo.mutation.ClearCycleClosingDay()
+	return auo
+}
+
+// SetDueDateOffset sets the "due_date_offset" field.
+func (auo *AccountUpdateOne) SetDueDateOffset(i int) *AccountUpdateOne {
+	auo.mutation.ResetDueDateOffset()
+	auo.mutation.SetDueDateOffset(i)
+	return auo
+}
+
+// SetNillableDueDateOffset sets the "due_date_offset" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableDueDateOffset(i *int) *AccountUpdateOne {
+	if i != nil {
+		auo.SetDueDateOffset(*i)
+	}
+	return auo
+}
+
+// AddDueDateOffset adds i to the "due_date_offset" field.
+func (auo *AccountUpdateOne) AddDueDateOffset(i int) *AccountUpdateOne {
+	auo.mutation.AddDueDateOffset(i)
+	return auo
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (auo *AccountUpdateOne) AddTransactionIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddTransactionIDs(ids...)
+	return auo
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (auo *AccountUpdateOne) AddTransactions(t ...*Transaction) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
 		ids[i] = t[i].ID
 	}
 	return auo.AddTransactionIDs(ids...)
 }
 
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Transfer entity by IDs.
+func (auo *AccountUpdateOne) AddOutgoingTransferIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddOutgoingTransferIDs(ids...)
+	return auo
+}
+
+// AddOutgoingTransfers adds the "outgoing_transfers" edges to the Transfer entity.
+func (auo *AccountUpdateOne) AddOutgoingTransfers(t ...*Transfer) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.AddOutgoingTransferIDs(ids...)
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Transfer entity by IDs.
+func (auo *AccountUpdateOne) AddIncomingTransferIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddIncomingTransferIDs(ids...)
+	return auo
+}
+
+// AddIncomingTransfers adds the "incoming_transfers" edges to the Transfer entity.
+func (auo *AccountUpdateOne) AddIncomingTransfers(t ...*Transfer) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.AddIncomingTransferIDs(ids...)
+}
+
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (auo *AccountUpdateOne) AddHoldIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddHoldIDs(ids...)
+	return auo
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (auo *AccountUpdateOne) AddHolds(h ...*Hold) *AccountUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return auo.AddHoldIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (auo *AccountUpdateOne) AddInvoiceIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddInvoiceIDs(ids...)
+	return auo
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (auo *AccountUpdateOne) AddInvoices(i ...*Invoice) *AccountUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return auo.AddInvoiceIDs(ids...)
+}
+
+// AddAccrualIDs adds the "accruals" edge to the Accrual entity by IDs.
+func (auo *AccountUpdateOne) AddAccrualIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddAccrualIDs(ids...)
+	return auo
+}
+
+// AddAccruals adds the "accruals" edges to the Accrual entity.
+func (auo *AccountUpdateOne) AddAccruals(a ...*Accrual) *AccountUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddAccrualIDs(ids...)
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
+func (auo *AccountUpdateOne) AddJournalEntryIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddJournalEntryIDs(ids...)
+	return auo
+}
+
+// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
+func (auo *AccountUpdateOne) AddJournalEntries(j ...*JournalEntry) *AccountUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return auo.AddJournalEntryIDs(ids...)
+}
+
+// AddOutboxEventIDs adds the "outbox_events" edge to the OutboxEvent entity by IDs.
+func (auo *AccountUpdateOne) AddOutboxEventIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.AddOutboxEventIDs(ids...)
+	return auo
+}
+
+// AddOutboxEvents adds the "outbox_events" edges to the OutboxEvent entity.
+func (auo *AccountUpdateOne) AddOutboxEvents(o ...*OutboxEvent) *AccountUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return auo.AddOutboxEventIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (auo *AccountUpdateOne) Mutation() *AccountMutation {
 	return auo.mutation
 }
 
-// ClearTransactions clears all "transactions" edges to the Transaction entity.
-func (auo *AccountUpdateOne) ClearTransactions() *AccountUpdateOne {
-	auo.mutation.ClearTransactions()
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (auo *AccountUpdateOne) ClearTransactions() *AccountUpdateOne {
+	auo.mutation.ClearTransactions()
+	return auo
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (auo *AccountUpdateOne) RemoveTransactionIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveTransactionIDs(ids...)
+	return auo
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (auo *AccountUpdateOne) RemoveTransactions(t ...*Transaction) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.RemoveTransactionIDs(ids...)
+}
+
+// ClearOutgoingTransfers clears all "outgoing_transfers" edges to the Transfer entity.
+func (auo *AccountUpdateOne) ClearOutgoingTransfers() *AccountUpdateOne {
+	auo.mutation.ClearOutgoingTransfers()
+	return auo
+}
+
+// RemoveOutgoingTransferIDs removes the "outgoing_transfers" edge to Transfer entities by IDs.
+func (auo *AccountUpdateOne) RemoveOutgoingTransferIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveOutgoingTransferIDs(ids...)
+	return auo
+}
+
+// RemoveOutgoingTransfers removes "outgoing_transfers" edges to Transfer entities.
+func (auo *AccountUpdateOne) RemoveOutgoingTransfers(t ...*Transfer) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.RemoveOutgoingTransferIDs(ids...)
+}
+
+// ClearIncomingTransfers clears all "incoming_transfers" edges to the Transfer entity.
+func (auo *AccountUpdateOne) ClearIncomingTransfers() *AccountUpdateOne {
+	auo.mutation.ClearIncomingTransfers()
+	return auo
+}
+
+// RemoveIncomingTransferIDs removes the "incoming_transfers" edge to Transfer entities by IDs.
+func (auo *AccountUpdateOne) RemoveIncomingTransferIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveIncomingTransferIDs(ids...)
+	return auo
+}
+
+// RemoveIncomingTransfers removes "incoming_transfers" edges to Transfer entities.
+func (auo *AccountUpdateOne) RemoveIncomingTransfers(t ...*Transfer) *AccountUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return auo.RemoveIncomingTransferIDs(ids...)
+}
+
+// ClearHolds clears all "holds" edges to the Hold entity.
+func (auo *AccountUpdateOne) ClearHolds() *AccountUpdateOne {
+	auo.mutation.ClearHolds()
+	return auo
+}
+
+// RemoveHoldIDs removes the "holds" edge to Hold entities by IDs.
+func (auo *AccountUpdateOne) RemoveHoldIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveHoldIDs(ids...)
+	return auo
+}
+
+// RemoveHolds removes "holds" edges to Hold entities.
+func (auo *AccountUpdateOne) RemoveHolds(h ...*Hold) *AccountUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return auo.RemoveHoldIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (auo *AccountUpdateOne) ClearInvoices() *AccountUpdateOne {
+	auo.mutation.ClearInvoices()
+	return auo
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (auo *AccountUpdateOne) RemoveInvoiceIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveInvoiceIDs(ids...)
+	return auo
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (auo *AccountUpdateOne) RemoveInvoices(i ...*Invoice) *AccountUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return auo.RemoveInvoiceIDs(ids...)
+}
+
+// ClearAccruals clears all "accruals" edges to the Accrual entity.
+func (auo *AccountUpdateOne) ClearAccruals() *AccountUpdateOne {
+	auo.mutation.ClearAccruals()
+	return auo
+}
+
+// RemoveAccrualIDs removes the "accruals" edge to Accrual entities by IDs.
+func (auo *AccountUpdateOne) RemoveAccrualIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveAccrualIDs(ids...)
+	return auo
+}
+
+// RemoveAccruals removes "accruals" edges to Accrual entities.
+func (auo *AccountUpdateOne) RemoveAccruals(a ...*Accrual) *AccountUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveAccrualIDs(ids...)
+}
+
+// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
+func (auo *AccountUpdateOne) ClearJournalEntries() *AccountUpdateOne {
+	auo.mutation.ClearJournalEntries()
+	return auo
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
+func (auo *AccountUpdateOne) RemoveJournalEntryIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveJournalEntryIDs(ids...)
+	return auo
+}
+
+// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
+func (auo *AccountUpdateOne) RemoveJournalEntries(j ...*JournalEntry) *AccountUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return auo.RemoveJournalEntryIDs(ids...)
+}
+
+// ClearOutboxEvents clears all "outbox_events" edges to the OutboxEvent entity.
+func (auo *AccountUpdateOne) ClearOutboxEvents() *AccountUpdateOne {
+	auo.mutation.ClearOutboxEvents()
 	return auo
 }
 
-// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
-func (auo *AccountUpdateOne) RemoveTransactionIDs(ids ...int) *AccountUpdateOne {
-	auo.mutation.RemoveTransactionIDs(ids...)
+// RemoveOutboxEventIDs removes the "outbox_events" edge to OutboxEvent entities by IDs.
+func (auo *AccountUpdateOne) RemoveOutboxEventIDs(ids ...int) *AccountUpdateOne {
+	auo.mutation.RemoveOutboxEventIDs(ids...)
 	return auo
 }
 
-// RemoveTransactions removes "transactions" edges to Transaction entities.
-func (auo *AccountUpdateOne) RemoveTransactions(t ...*Transaction) *AccountUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+// RemoveOutboxEvents removes "outbox_events" edges to OutboxEvent entities.
+func (auo *AccountUpdateOne) RemoveOutboxEvents(o ...*OutboxEvent) *AccountUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
 	}
-	return auo.RemoveTransactionIDs(ids...)
+	return auo.RemoveOutboxEventIDs(ids...)
 }
 
 // Where appends a list predicates to the AccountUpdate builder.
@@ -376,6 +1386,16 @@ func (auo *AccountUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Account.name": %w`, err)}
 		}
 	}
+	if v, ok := auo.mutation.CycleClosingDay(); ok {
+		if err := account.CycleClosingDayValidator(v); err != nil {
+			return &ValidationError{Name: "cycle_closing_day", err: fmt.Errorf(`ent: validator failed for field "Account.cycle_closing_day": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.DueDateOffset(); ok {
+		if err := account.DueDateOffsetValidator(v); err != nil {
+			return &ValidationError{Name: "due_date_offset", err: fmt.Errorf(`ent: validator failed for field "Account.due_date_offset": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -423,6 +1443,30 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 	if value, ok := auo.mutation.DocumentNumber(); ok {
 		_spec.SetField(account.FieldDocumentNumber, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.CreditLimit(); ok {
+		_spec.SetField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if auo.mutation.CreditLimitCleared() {
+		_spec.ClearField(account.FieldCreditLimit, field.TypeInt64)
+	}
+	if value, ok := auo.mutation.CycleClosingDay(); ok {
+		_spec.SetField(account.FieldCycleClosingDay, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedCycleClosingDay(); ok {
+		_spec.AddField(account.FieldCycleClosingDay, field.TypeInt, value)
+	}
+	if auo.mutation.CycleClosingDayCleared() {
+		_spec.ClearField(account.FieldCycleClosingDay, field.TypeInt)
+	}
+	if value, ok := auo.mutation.DueDateOffset(); ok {
+		_spec.SetField(account.FieldDueDateOffset, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedDueDateOffset(); ok {
+		_spec.AddField(account.FieldDueDateOffset, field.TypeInt, value)
+	}
 	if auo.mutation.TransactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -468,6 +1512,321 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutgoingTransfersTable,
+			Columns: []string{account.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedOutgoingTransfersIDs(); len(nodes) > 0 && !auo.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutgoingTransfersTable,
+			Columns: []string{account.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OutgoingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutgoingTransfersTable,
+			Columns: []string{account.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IncomingTransfersTable,
+			Columns: []string{account.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedIncomingTransfersIDs(); len(nodes) > 0 && !auo.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IncomingTransfersTable,
+			Columns: []string{account.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.IncomingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.IncomingTransfersTable,
+			Columns: []string{account.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldsTable,
+			Columns: []string{account.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedHoldsIDs(); len(nodes) > 0 && !auo.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldsTable,
+			Columns: []string{account.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.HoldsTable,
+			Columns: []string{account.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvoicesTable,
+			Columns: []string{account.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !auo.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvoicesTable,
+			Columns: []string{account.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.InvoicesTable,
+			Columns: []string{account.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.AccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AccrualsTable,
+			Columns: []string{account.AccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedAccrualsIDs(); len(nodes) > 0 && !auo.mutation.AccrualsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AccrualsTable,
+			Columns: []string{account.AccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.AccrualsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.AccrualsTable,
+			Columns: []string{account.AccrualsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.JournalEntriesTable,
+			Columns: []string{account.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedJournalEntriesIDs(); len(nodes) > 0 && !auo.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.JournalEntriesTable,
+			Columns: []string{account.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.JournalEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.JournalEntriesTable,
+			Columns: []string{account.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.OutboxEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutboxEventsTable,
+			Columns: []string{account.OutboxEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedOutboxEventsIDs(); len(nodes) > 0 && !auo.mutation.OutboxEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutboxEventsTable,
+			Columns: []string{account.OutboxEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.OutboxEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.OutboxEventsTable,
+			Columns: []string{account.OutboxEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(auo.modifiers...)
 	_node = &Account{config: auo.config}
 	_spec.Assign = _node.assignValues
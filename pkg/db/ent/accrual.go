@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Accrual is the model entity for the Accrual schema.
+type Accrual struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind accrual.Kind `json:"kind,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID *int `json:"transaction_id,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID *int `json:"invoice_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccrualQuery when eager-loading is set.
+	Edges        AccrualEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccrualEdges holds the relations/edges for other nodes in the graph.
+type AccrualEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccrualEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccrualEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccrualEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Accrual) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accrual.FieldID, accrual.FieldAccountID, accrual.FieldAmount, accrual.FieldTransactionID, accrual.FieldInvoiceID:
+			values[i] = new(sql.NullInt64)
+		case accrual.FieldKind:
+			values[i] = new(sql.NullString)
+		case accrual.FieldCreateTime, accrual.FieldUpdateTime, accrual.FieldDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Accrual fields.
+func (a *Accrual) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accrual.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case accrual.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				a.CreateTime = value.Time
+			}
+		case accrual.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				a.UpdateTime = value.Time
+			}
+		case accrual.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				a.AccountID = int(value.Int64)
+			}
+		case accrual.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				a.Date = value.Time
+			}
+		case accrual.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				a.Kind = accrual.Kind(value.String)
+			}
+		case accrual.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				a.Amount = money.Amount(value.Int64)
+			}
+		case accrual.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				a.TransactionID = new(int)
+				*a.TransactionID = int(value.Int64)
+			}
+		case accrual.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				a.InvoiceID = new(int)
+				*a.InvoiceID = int(value.Int64)
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Accrual.
+// This includes values selected through modifiers, order, etc.
+func (a *Accrual) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Accrual entity.
+func (a *Accrual) QueryAccount() *AccountQuery {
+	return NewAccrualClient(a.config).QueryAccount(a)
+}
+
+// QueryTransaction queries the "transaction" edge of the Accrual entity.
+func (a *Accrual) QueryTransaction() *TransactionQuery {
+	return NewAccrualClient(a.config).QueryTransaction(a)
+}
+
+// QueryInvoice queries the "invoice" edge of the Accrual entity.
+func (a *Accrual) QueryInvoice() *InvoiceQuery {
+	return NewAccrualClient(a.config).QueryInvoice(a)
+}
+
+// Update returns a builder for updating this Accrual.
+// Note that you need to call Accrual.Unwrap() before calling this method if this Accrual
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Accrual) Update() *AccrualUpdateOne {
+	return NewAccrualClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Accrual entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Accrual) Unwrap() *Accrual {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Accrual is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Accrual) String() string {
+	var builder strings.Builder
+	builder.WriteString("Accrual(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(a.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(a.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(a.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", a.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", a.Amount))
+	builder.WriteString(", ")
+	if v := a.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.InvoiceID; v != nil {
+		builder.WriteString("invoice_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Accruals is a parsable slice of Accrual.
+type Accruals []*Accrual
@@ -0,0 +1,724 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccrualCreate is the builder for creating a Accrual entity.
+type AccrualCreate struct {
+	config
+	mutation *AccrualMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ac *AccrualCreate) SetCreateTime(t time.Time) *AccrualCreate {
+	ac.mutation.SetCreateTime(t)
+	return ac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ac *AccrualCreate) SetNillableCreateTime(t *time.Time) *AccrualCreate {
+	if t != nil {
+		ac.SetCreateTime(*t)
+	}
+	return ac
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ac *AccrualCreate) SetUpdateTime(t time.Time) *AccrualCreate {
+	ac.mutation.SetUpdateTime(t)
+	return ac
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ac *AccrualCreate) SetNillableUpdateTime(t *time.Time) *AccrualCreate {
+	if t != nil {
+		ac.SetUpdateTime(*t)
+	}
+	return ac
+}
+
+// SetAccountID sets the "account_id" field.
+func (ac *AccrualCreate) SetAccountID(i int) *AccrualCreate {
+	ac.mutation.SetAccountID(i)
+	return ac
+}
+
+// SetDate sets the "date" field.
+func (ac *AccrualCreate) SetDate(t time.Time) *AccrualCreate {
+	ac.mutation.SetDate(t)
+	return ac
+}
+
+// SetKind sets the "kind" field.
+func (ac *AccrualCreate) SetKind(a accrual.Kind) *AccrualCreate {
+	ac.mutation.SetKind(a)
+	return ac
+}
+
+// SetAmount sets the "amount" field.
+func (ac *AccrualCreate) SetAmount(m money.Amount) *AccrualCreate {
+	ac.mutation.SetAmount(m)
+	return ac
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (ac *AccrualCreate) SetTransactionID(i int) *AccrualCreate {
+	ac.mutation.SetTransactionID(i)
+	return ac
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (ac *AccrualCreate) SetNillableTransactionID(i *int) *AccrualCreate {
+	if i != nil {
+		ac.SetTransactionID(*i)
+	}
+	return ac
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (ac *AccrualCreate) SetInvoiceID(i int) *AccrualCreate {
+	ac.mutation.SetInvoiceID(i)
+	return ac
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (ac *AccrualCreate) SetNillableInvoiceID(i *int) *AccrualCreate {
+	if i != nil {
+		ac.SetInvoiceID(*i)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AccrualCreate) SetID(i int) *AccrualCreate {
+	ac.mutation.SetID(i)
+	return ac
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (ac *AccrualCreate) SetAccount(a *Account) *AccrualCreate {
+	return ac.SetAccountID(a.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (ac *AccrualCreate) SetTransaction(t *Transaction) *AccrualCreate {
+	return ac.SetTransactionID(t.ID)
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (ac *AccrualCreate) SetInvoice(i *Invoice) *AccrualCreate {
+	return ac.SetInvoiceID(i.ID)
+}
+
+// Mutation returns the AccrualMutation object of the builder.
+func (ac *AccrualCreate) Mutation() *AccrualMutation {
+	return ac.mutation
+}
+
+// Save creates the Accrual in the database.
+func (ac *AccrualCreate) Save(ctx context.Context) (*Accrual, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AccrualCreate) SaveX(ctx context.Context) *Accrual {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AccrualCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AccrualCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AccrualCreate) defaults() {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		v := accrual.DefaultCreateTime()
+		ac.mutation.SetCreateTime(v)
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		v := accrual.DefaultUpdateTime()
+		ac.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AccrualCreate) check() error {
+	if _, ok := ac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Accrual.create_time"`)}
+	}
+	if _, ok := ac.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Accrual.update_time"`)}
+	}
+	if _, ok := ac.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Accrual.account_id"`)}
+	}
+	if _, ok := ac.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Accrual.date"`)}
+	}
+	if _, ok := ac.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Accrual.kind"`)}
+	}
+	if v, ok := ac.mutation.Kind(); ok {
+		if err := accrual.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Accrual.kind": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Accrual.amount"`)}
+	}
+	if len(ac.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Accrual.account"`)}
+	}
+	return nil
+}
+
+func (ac *AccrualCreate) sqlSave(ctx context.Context) (*Accrual, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AccrualCreate) createSpec() (*Accrual, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Accrual{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(accrual.Table, sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ac.conflict
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.CreateTime(); ok {
+		_spec.SetField(accrual.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ac.mutation.UpdateTime(); ok {
+		_spec.SetField(accrual.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ac.mutation.Date(); ok {
+		_spec.SetField(accrual.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := ac.mutation.Kind(); ok {
+		_spec.SetField(accrual.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := ac.mutation.Amount(); ok {
+		_spec.SetField(accrual.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if nodes := ac.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accrual.AccountTable,
+			Columns: []string{accrual.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   accrual.TransactionTable,
+			Columns: []string{accrual.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   accrual.InvoiceTable,
+			Columns: []string{accrual.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvoiceID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Accrual.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccrualUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ac *AccrualCreate) OnConflict(opts ...sql.ConflictOption) *AccrualUpsertOne {
+	ac.conflict = opts
+	return &AccrualUpsertOne{
+		create: ac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Accrual.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ac *AccrualCreate) OnConflictColumns(columns ...string) *AccrualUpsertOne {
+	ac.conflict = append(ac.conflict, sql.ConflictColumns(columns...))
+	return &AccrualUpsertOne{
+		create: ac,
+	}
+}
+
+type (
+	// AccrualUpsertOne is the builder for "upsert"-ing
+	//  one Accrual node.
+	AccrualUpsertOne struct {
+		create *AccrualCreate
+	}
+
+	// AccrualUpsert is the "OnConflict" setter.
+	AccrualUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AccrualUpsert) SetUpdateTime(v time.Time) *AccrualUpsert {
+	u.Set(accrual.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AccrualUpsert) UpdateUpdateTime() *AccrualUpsert {
+	u.SetExcluded(accrual.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Accrual.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accrual.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccrualUpsertOne) UpdateNewValues() *AccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(accrual.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(accrual.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(accrual.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.Date(); exists {
+			s.SetIgnore(accrual.FieldDate)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(accrual.FieldKind)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(accrual.FieldAmount)
+		}
+		if _, exists := u.create.mutation.TransactionID(); exists {
+			s.SetIgnore(accrual.FieldTransactionID)
+		}
+		if _, exists := u.create.mutation.InvoiceID(); exists {
+			s.SetIgnore(accrual.FieldInvoiceID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Accrual.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccrualUpsertOne) Ignore() *AccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccrualUpsertOne) DoNothing() *AccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccrualCreate.OnConflict
+// documentation for more info.
+func (u *AccrualUpsertOne) Update(set func(*AccrualUpsert)) *AccrualUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccrualUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AccrualUpsertOne) SetUpdateTime(v time.Time) *AccrualUpsertOne {
+	return u.Update(func(s *AccrualUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AccrualUpsertOne) UpdateUpdateTime() *AccrualUpsertOne {
+	return u.Update(func(s *AccrualUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *AccrualUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccrualCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccrualUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccrualUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccrualUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AccrualCreateBulk is the builder for creating many Accrual entities in bulk.
+type AccrualCreateBulk struct {
+	config
+	err      error
+	builders []*AccrualCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Accrual entities in the database.
+func (acb *AccrualCreateBulk) Save(ctx context.Context) ([]*Accrual, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Accrual, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccrualMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = acb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AccrualCreateBulk) SaveX(ctx context.Context) []*Accrual {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AccrualCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AccrualCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Accrual.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccrualUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (acb *AccrualCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccrualUpsertBulk {
+	acb.conflict = opts
+	return &AccrualUpsertBulk{
+		create: acb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Accrual.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (acb *AccrualCreateBulk) OnConflictColumns(columns ...string) *AccrualUpsertBulk {
+	acb.conflict = append(acb.conflict, sql.ConflictColumns(columns...))
+	return &AccrualUpsertBulk{
+		create: acb,
+	}
+}
+
+// AccrualUpsertBulk is the builder for "upsert"-ing
+// a bulk of Accrual nodes.
+type AccrualUpsertBulk struct {
+	create *AccrualCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Accrual.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(accrual.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AccrualUpsertBulk) UpdateNewValues() *AccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(accrual.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(accrual.FieldCreateTime)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(accrual.FieldAccountID)
+			}
+			if _, exists := b.mutation.Date(); exists {
+				s.SetIgnore(accrual.FieldDate)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(accrual.FieldKind)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(accrual.FieldAmount)
+			}
+			if _, exists := b.mutation.TransactionID(); exists {
+				s.SetIgnore(accrual.FieldTransactionID)
+			}
+			if _, exists := b.mutation.InvoiceID(); exists {
+				s.SetIgnore(accrual.FieldInvoiceID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Accrual.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccrualUpsertBulk) Ignore() *AccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccrualUpsertBulk) DoNothing() *AccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccrualCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccrualUpsertBulk) Update(set func(*AccrualUpsert)) *AccrualUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccrualUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AccrualUpsertBulk) SetUpdateTime(v time.Time) *AccrualUpsertBulk {
+	return u.Update(func(s *AccrualUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AccrualUpsertBulk) UpdateUpdateTime() *AccrualUpsertBulk {
+	return u.Update(func(s *AccrualUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *AccrualUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccrualCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccrualCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccrualUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccrualUpdate is the builder for updating Accrual entities.
+type AccrualUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AccrualMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AccrualUpdate builder.
+func (au *AccrualUpdate) Where(ps ...predicate.Accrual) *AccrualUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (au *AccrualUpdate) SetUpdateTime(t time.Time) *AccrualUpdate {
+	au.mutation.SetUpdateTime(t)
+	return au
+}
+
+// Mutation returns the AccrualMutation object of the builder.
+func (au *AccrualUpdate) Mutation() *AccrualMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AccrualUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AccrualUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AccrualUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AccrualUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AccrualUpdate) defaults() {
+	if _, ok := au.mutation.UpdateTime(); !ok {
+		v := accrual.UpdateDefaultUpdateTime()
+		au.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AccrualUpdate) check() error {
+	if au.mutation.AccountCleared() && len(au.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Accrual.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (au *AccrualUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccrualUpdate {
+	au.modifiers = append(au.modifiers, modifiers...)
+	return au
+}
+
+func (au *AccrualUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accrual.Table, accrual.Columns, sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdateTime(); ok {
+		_spec.SetField(accrual.FieldUpdateTime, field.TypeTime, value)
+	}
+	_spec.AddModifiers(au.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accrual.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AccrualUpdateOne is the builder for updating a single Accrual entity.
+type AccrualUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AccrualMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (auo *AccrualUpdateOne) SetUpdateTime(t time.Time) *AccrualUpdateOne {
+	auo.mutation.SetUpdateTime(t)
+	return auo
+}
+
+// Mutation returns the AccrualMutation object of the builder.
+func (auo *AccrualUpdateOne) Mutation() *AccrualMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the AccrualUpdate builder.
+func (auo *AccrualUpdateOne) Where(ps ...predicate.Accrual) *AccrualUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AccrualUpdateOne) Select(field string, fields ...string) *AccrualUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Accrual entity.
+func (auo *AccrualUpdateOne) Save(ctx context.Context) (*Accrual, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AccrualUpdateOne) SaveX(ctx context.Context) *Accrual {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AccrualUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AccrualUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AccrualUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdateTime(); !ok {
+		v := accrual.UpdateDefaultUpdateTime()
+		auo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AccrualUpdateOne) check() error {
+	if auo.mutation.AccountCleared() && len(auo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Accrual.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (auo *AccrualUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AccrualUpdateOne {
+	auo.modifiers = append(auo.modifiers, modifiers...)
+	return auo
+}
+
+func (auo *AccrualUpdateOne) sqlSave(ctx context.Context) (_node *Accrual, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accrual.Table, accrual.Columns, sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Accrual.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accrual.FieldID)
+		for _, f := range fields {
+			if !accrual.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accrual.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdateTime(); ok {
+		_spec.SetField(accrual.FieldUpdateTime, field.TypeTime, value)
+	}
+	_spec.AddModifiers(auo.modifiers...)
+	_node = &Accrual{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accrual.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
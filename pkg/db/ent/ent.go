@@ -9,8 +9,20 @@ import (
 	"reflect"
 	"sync"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/fxrate"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/idempotencykey"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/journalentry"
 	"transactor-server/pkg/db/ent/operationtype"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/db/ent/settlement"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/db/ent/webhooksubscription"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -75,9 +87,21 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table:       account.ValidColumn,
-			operationtype.Table: operationtype.ValidColumn,
-			transaction.Table:   transaction.ValidColumn,
+			account.Table:             account.ValidColumn,
+			accrual.Table:             accrual.ValidColumn,
+			fxrate.Table:              fxrate.ValidColumn,
+			hold.Table:                hold.ValidColumn,
+			idempotencykey.Table:      idempotencykey.ValidColumn,
+			invoice.Table:             invoice.ValidColumn,
+			invoicepayment.Table:      invoicepayment.ValidColumn,
+			journalentry.Table:        journalentry.ValidColumn,
+			operationtype.Table:       operationtype.ValidColumn,
+			outboxevent.Table:         outboxevent.ValidColumn,
+			settlement.Table:          settlement.ValidColumn,
+			transaction.Table:         transaction.ValidColumn,
+			transfer.Table:            transfer.ValidColumn,
+			webhookdelivery.Table:     webhookdelivery.ValidColumn,
+			webhooksubscription.Table: webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/fxrate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FxRate is the model entity for the FxRate schema.
+type FxRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// BaseCurrency holds the value of the "base_currency" field.
+	BaseCurrency string `json:"base_currency,omitempty"`
+	// QuoteCurrency holds the value of the "quote_currency" field.
+	QuoteCurrency string `json:"quote_currency,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate         string `json:"rate,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FxRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fxrate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case fxrate.FieldBaseCurrency, fxrate.FieldQuoteCurrency, fxrate.FieldRate:
+			values[i] = new(sql.NullString)
+		case fxrate.FieldCreateTime, fxrate.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FxRate fields.
+func (fr *FxRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fxrate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			fr.ID = int(value.Int64)
+		case fxrate.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				fr.CreateTime = value.Time
+			}
+		case fxrate.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				fr.UpdateTime = value.Time
+			}
+		case fxrate.FieldBaseCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base_currency", values[i])
+			} else if value.Valid {
+				fr.BaseCurrency = value.String
+			}
+		case fxrate.FieldQuoteCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote_currency", values[i])
+			} else if value.Valid {
+				fr.QuoteCurrency = value.String
+			}
+		case fxrate.FieldRate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value.Valid {
+				fr.Rate = value.String
+			}
+		default:
+			fr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FxRate.
+// This includes values selected through modifiers, order, etc.
+func (fr *FxRate) Value(name string) (ent.Value, error) {
+	return fr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FxRate.
+// Note that you need to call FxRate.Unwrap() before calling this method if this FxRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fr *FxRate) Update() *FxRateUpdateOne {
+	return NewFxRateClient(fr.config).UpdateOne(fr)
+}
+
+// Unwrap unwraps the FxRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (fr *FxRate) Unwrap() *FxRate {
+	_tx, ok := fr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FxRate is not a transactional entity")
+	}
+	fr.config.driver = _tx.drv
+	return fr
+}
+
+// String implements the fmt.Stringer.
+func (fr *FxRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("FxRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", fr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(fr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(fr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("base_currency=")
+	builder.WriteString(fr.BaseCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("quote_currency=")
+	builder.WriteString(fr.QuoteCurrency)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fr.Rate)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FxRates is a parsable slice of FxRate.
+type FxRates []*FxRate
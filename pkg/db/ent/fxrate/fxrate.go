@@ -0,0 +1,96 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fxrate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the fxrate type in the database.
+	Label = "fx_rate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldBaseCurrency holds the string denoting the base_currency field in the database.
+	FieldBaseCurrency = "base_currency"
+	// FieldQuoteCurrency holds the string denoting the quote_currency field in the database.
+	FieldQuoteCurrency = "quote_currency"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// Table holds the table name of the fxrate in the database.
+	Table = "fx_rates"
+)
+
+// Columns holds all SQL columns for fxrate fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldBaseCurrency,
+	FieldQuoteCurrency,
+	FieldRate,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// BaseCurrencyValidator is a validator for the "base_currency" field. It is called by the builders before save.
+	BaseCurrencyValidator func(string) error
+	// QuoteCurrencyValidator is a validator for the "quote_currency" field. It is called by the builders before save.
+	QuoteCurrencyValidator func(string) error
+	// RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	RateValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the FxRate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByBaseCurrency orders the results by the base_currency field.
+func ByBaseCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseCurrency, opts...).ToFunc()
+}
+
+// ByQuoteCurrency orders the results by the quote_currency field.
+func ByQuoteCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuoteCurrency, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
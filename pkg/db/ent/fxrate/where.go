@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fxrate
+
+import (
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// BaseCurrency applies equality check predicate on the "base_currency" field. It's identical to BaseCurrencyEQ.
+func BaseCurrency(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldBaseCurrency, v))
+}
+
+// QuoteCurrency applies equality check predicate on the "quote_currency" field. It's identical to QuoteCurrencyEQ.
+func QuoteCurrency(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldQuoteCurrency, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldRate, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// BaseCurrencyEQ applies the EQ predicate on the "base_currency" field.
+func BaseCurrencyEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyNEQ applies the NEQ predicate on the "base_currency" field.
+func BaseCurrencyNEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyIn applies the In predicate on the "base_currency" field.
+func BaseCurrencyIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldBaseCurrency, vs...))
+}
+
+// BaseCurrencyNotIn applies the NotIn predicate on the "base_currency" field.
+func BaseCurrencyNotIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldBaseCurrency, vs...))
+}
+
+// BaseCurrencyGT applies the GT predicate on the "base_currency" field.
+func BaseCurrencyGT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyGTE applies the GTE predicate on the "base_currency" field.
+func BaseCurrencyGTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyLT applies the LT predicate on the "base_currency" field.
+func BaseCurrencyLT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyLTE applies the LTE predicate on the "base_currency" field.
+func BaseCurrencyLTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyContains applies the Contains predicate on the "base_currency" field.
+func BaseCurrencyContains(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContains(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyHasPrefix applies the HasPrefix predicate on the "base_currency" field.
+func BaseCurrencyHasPrefix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasPrefix(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyHasSuffix applies the HasSuffix predicate on the "base_currency" field.
+func BaseCurrencyHasSuffix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasSuffix(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyEqualFold applies the EqualFold predicate on the "base_currency" field.
+func BaseCurrencyEqualFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEqualFold(FieldBaseCurrency, v))
+}
+
+// BaseCurrencyContainsFold applies the ContainsFold predicate on the "base_currency" field.
+func BaseCurrencyContainsFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContainsFold(FieldBaseCurrency, v))
+}
+
+// QuoteCurrencyEQ applies the EQ predicate on the "quote_currency" field.
+func QuoteCurrencyEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyNEQ applies the NEQ predicate on the "quote_currency" field.
+func QuoteCurrencyNEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyIn applies the In predicate on the "quote_currency" field.
+func QuoteCurrencyIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldQuoteCurrency, vs...))
+}
+
+// QuoteCurrencyNotIn applies the NotIn predicate on the "quote_currency" field.
+func QuoteCurrencyNotIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldQuoteCurrency, vs...))
+}
+
+// QuoteCurrencyGT applies the GT predicate on the "quote_currency" field.
+func QuoteCurrencyGT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyGTE applies the GTE predicate on the "quote_currency" field.
+func QuoteCurrencyGTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyLT applies the LT predicate on the "quote_currency" field.
+func QuoteCurrencyLT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyLTE applies the LTE predicate on the "quote_currency" field.
+func QuoteCurrencyLTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyContains applies the Contains predicate on the "quote_currency" field.
+func QuoteCurrencyContains(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContains(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyHasPrefix applies the HasPrefix predicate on the "quote_currency" field.
+func QuoteCurrencyHasPrefix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasPrefix(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyHasSuffix applies the HasSuffix predicate on the "quote_currency" field.
+func QuoteCurrencyHasSuffix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasSuffix(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyEqualFold applies the EqualFold predicate on the "quote_currency" field.
+func QuoteCurrencyEqualFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEqualFold(FieldQuoteCurrency, v))
+}
+
+// QuoteCurrencyContainsFold applies the ContainsFold predicate on the "quote_currency" field.
+func QuoteCurrencyContainsFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContainsFold(FieldQuoteCurrency, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldLTE(FieldRate, v))
+}
+
+// RateContains applies the Contains predicate on the "rate" field.
+func RateContains(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContains(FieldRate, v))
+}
+
+// RateHasPrefix applies the HasPrefix predicate on the "rate" field.
+func RateHasPrefix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasPrefix(FieldRate, v))
+}
+
+// RateHasSuffix applies the HasSuffix predicate on the "rate" field.
+func RateHasSuffix(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldHasSuffix(FieldRate, v))
+}
+
+// RateEqualFold applies the EqualFold predicate on the "rate" field.
+func RateEqualFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldEqualFold(FieldRate, v))
+}
+
+// RateContainsFold applies the ContainsFold predicate on the "rate" field.
+func RateContainsFold(v string) predicate.FxRate {
+	return predicate.FxRate(sql.FieldContainsFold(FieldRate, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FxRate) predicate.FxRate {
+	return predicate.FxRate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FxRate) predicate.FxRate {
+	return predicate.FxRate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FxRate) predicate.FxRate {
+	return predicate.FxRate(sql.NotPredicates(p))
+}
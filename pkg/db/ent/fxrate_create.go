@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/fxrate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FxRateCreate is the builder for creating a FxRate entity.
+type FxRateCreate struct {
+	config
+	mutation *FxRateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (frc *FxRateCreate) SetCreateTime(t time.Time) *FxRateCreate {
+	frc.mutation.SetCreateTime(t)
+	return frc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (frc *FxRateCreate) SetNillableCreateTime(t *time.Time) *FxRateCreate {
+	if t != nil {
+		frc.SetCreateTime(*t)
+	}
+	return frc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (frc *FxRateCreate) SetUpdateTime(t time.Time) *FxRateCreate {
+	frc.mutation.SetUpdateTime(t)
+	return frc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (frc *FxRateCreate) SetNillableUpdateTime(t *time.Time) *FxRateCreate {
+	if t != nil {
+		frc.SetUpdateTime(*t)
+	}
+	return frc
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (frc *FxRateCreate) SetBaseCurrency(s string) *FxRateCreate {
+	frc.mutation.SetBaseCurrency(s)
+	return frc
+}
+
+// SetQuoteCurrency sets the "quote_currency" field.
+func (frc *FxRateCreate) SetQuoteCurrency(s string) *FxRateCreate {
+	frc.mutation.SetQuoteCurrency(s)
+	return frc
+}
+
+// SetRate sets the "rate" field.
+func (frc *FxRateCreate) SetRate(s string) *FxRateCreate {
+	frc.mutation.SetRate(s)
+	return frc
+}
+
+// SetID sets the "id" field.
+func (frc *FxRateCreate) SetID(i int) *FxRateCreate {
+	frc.mutation.SetID(i)
+	return frc
+}
+
+// Mutation returns the FxRateMutation object of the builder.
+func (frc *FxRateCreate) Mutation() *FxRateMutation {
+	return frc.mutation
+}
+
+// Save creates the FxRate in the database.
+func (frc *FxRateCreate) Save(ctx context.Context) (*FxRate, error) {
+	frc.defaults()
+	return withHooks(ctx, frc.sqlSave, frc.mutation, frc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (frc *FxRateCreate) SaveX(ctx context.Context) *FxRate {
+	v, err := frc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frc *FxRateCreate) Exec(ctx context.Context) error {
+	_, err := frc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frc *FxRateCreate) ExecX(ctx context.Context) {
+	if err := frc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (frc *FxRateCreate) defaults() {
+	if _, ok := frc.mutation.CreateTime(); !ok {
+		v := fxrate.DefaultCreateTime()
+		frc.mutation.SetCreateTime(v)
+	}
+	if _, ok := frc.mutation.UpdateTime(); !ok {
+		v := fxrate.DefaultUpdateTime()
+		frc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (frc *FxRateCreate) check() error {
+	if _, ok := frc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "FxRate.create_time"`)}
+	}
+	if _, ok := frc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "FxRate.update_time"`)}
+	}
+	if _, ok := frc.mutation.BaseCurrency(); !ok {
+		return &ValidationError{Name: "base_currency", err: errors.New(`ent: missing required field "FxRate.base_currency"`)}
+	}
+	if v, ok := frc.mutation.BaseCurrency(); ok {
+		if err := fxrate.BaseCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "base_currency", err: fmt.Errorf(`ent: validator failed for field "FxRate.base_currency": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.QuoteCurrency(); !ok {
+		return &ValidationError{Name: "quote_currency", err: errors.New(`ent: missing required field "FxRate.quote_currency"`)}
+	}
+	if v, ok := frc.mutation.QuoteCurrency(); ok {
+		if err := fxrate.QuoteCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "quote_currency", err: fmt.Errorf(`ent: validator failed for field "FxRate.quote_currency": %w`, err)}
+		}
+	}
+	if _, ok := frc.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "FxRate.rate"`)}
+	}
+	if v, ok := frc.mutation.Rate(); ok {
+		if err := fxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "FxRate.rate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (frc *FxRateCreate) sqlSave(ctx context.Context) (*FxRate, error) {
+	if err := frc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := frc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, frc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	frc.mutation.id = &_node.ID
+	frc.mutation.done = true
+	return _node, nil
+}
+
+func (frc *FxRateCreate) createSpec() (*FxRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FxRate{config: frc.config}
+		_spec = sqlgraph.NewCreateSpec(fxrate.Table, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = frc.conflict
+	if id, ok := frc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := frc.mutation.CreateTime(); ok {
+		_spec.SetField(fxrate.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := frc.mutation.UpdateTime(); ok {
+		_spec.SetField(fxrate.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := frc.mutation.BaseCurrency(); ok {
+		_spec.SetField(fxrate.FieldBaseCurrency, field.TypeString, value)
+		_node.BaseCurrency = value
+	}
+	if value, ok := frc.mutation.QuoteCurrency(); ok {
+		_spec.SetField(fxrate.FieldQuoteCurrency, field.TypeString, value)
+		_node.QuoteCurrency = value
+	}
+	if value, ok := frc.mutation.Rate(); ok {
+		_spec.SetField(fxrate.FieldRate, field.TypeString, value)
+		_node.Rate = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FxRate.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FxRateUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (frc *FxRateCreate) OnConflict(opts ...sql.ConflictOption) *FxRateUpsertOne {
+	frc.conflict = opts
+	return &FxRateUpsertOne{
+		create: frc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FxRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (frc *FxRateCreate) OnConflictColumns(columns ...string) *FxRateUpsertOne {
+	frc.conflict = append(frc.conflict, sql.ConflictColumns(columns...))
+	return &FxRateUpsertOne{
+		create: frc,
+	}
+}
+
+type (
+	// FxRateUpsertOne is the builder for "upsert"-ing
+	//  one FxRate node.
+	FxRateUpsertOne struct {
+		create *FxRateCreate
+	}
+
+	// FxRateUpsert is the "OnConflict" setter.
+	FxRateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FxRateUpsert) SetUpdateTime(v time.Time) *FxRateUpsert {
+	u.Set(fxrate.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FxRateUpsert) UpdateUpdateTime() *FxRateUpsert {
+	u.SetExcluded(fxrate.FieldUpdateTime)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *FxRateUpsert) SetRate(v string) *FxRateUpsert {
+	u.Set(fxrate.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *FxRateUpsert) UpdateRate() *FxRateUpsert {
+	u.SetExcluded(fxrate.FieldRate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.FxRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(fxrate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FxRateUpsertOne) UpdateNewValues() *FxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(fxrate.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(fxrate.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.BaseCurrency(); exists {
+			s.SetIgnore(fxrate.FieldBaseCurrency)
+		}
+		if _, exists := u.create.mutation.QuoteCurrency(); exists {
+			s.SetIgnore(fxrate.FieldQuoteCurrency)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FxRate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FxRateUpsertOne) Ignore() *FxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FxRateUpsertOne) DoNothing() *FxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FxRateCreate.OnConflict
+// documentation for more info.
+func (u *FxRateUpsertOne) Update(set func(*FxRateUpsert)) *FxRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FxRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FxRateUpsertOne) SetUpdateTime(v time.Time) *FxRateUpsertOne {
+	return u.Update(func(s *FxRateUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FxRateUpsertOne) UpdateUpdateTime() *FxRateUpsertOne {
+	return u.Update(func(s *FxRateUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *FxRateUpsertOne) SetRate(v string) *FxRateUpsertOne {
+	return u.Update(func(s *FxRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *FxRateUpsertOne) UpdateRate() *FxRateUpsertOne {
+	return u.Update(func(s *FxRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// Exec executes the query.
+func (u *FxRateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FxRateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FxRateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FxRateUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FxRateUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FxRateCreateBulk is the builder for creating many FxRate entities in bulk.
+type FxRateCreateBulk struct {
+	config
+	err      error
+	builders []*FxRateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FxRate entities in the database.
+func (frcb *FxRateCreateBulk) Save(ctx context.Context) ([]*FxRate, error) {
+	if frcb.err != nil {
+		return nil, frcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(frcb.builders))
+	nodes := make([]*FxRate, len(frcb.builders))
+	mutators := make([]Mutator, len(frcb.builders))
+	for i := range frcb.builders {
+		func(i int, root context.Context) {
+			builder := frcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FxRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, frcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = frcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, frcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, frcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (frcb *FxRateCreateBulk) SaveX(ctx context.Context) []*FxRate {
+	v, err := frcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (frcb *FxRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := frcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frcb *FxRateCreateBulk) ExecX(ctx context.Context) {
+	if err := frcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FxRate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FxRateUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (frcb *FxRateCreateBulk) OnConflict(opts ...sql.ConflictOption) *FxRateUpsertBulk {
+	frcb.conflict = opts
+	return &FxRateUpsertBulk{
+		create: frcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FxRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (frcb *FxRateCreateBulk) OnConflictColumns(columns ...string) *FxRateUpsertBulk {
+	frcb.conflict = append(frcb.conflict, sql.ConflictColumns(columns...))
+	return &FxRateUpsertBulk{
+		create: frcb,
+	}
+}
+
+// FxRateUpsertBulk is the builder for "upsert"-ing
+// a bulk of FxRate nodes.
+type FxRateUpsertBulk struct {
+	create *FxRateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FxRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(fxrate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *FxRateUpsertBulk) UpdateNewValues() *FxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(fxrate.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(fxrate.FieldCreateTime)
+			}
+			if _, exists := b.mutation.BaseCurrency(); exists {
+				s.SetIgnore(fxrate.FieldBaseCurrency)
+			}
+			if _, exists := b.mutation.QuoteCurrency(); exists {
+				s.SetIgnore(fxrate.FieldQuoteCurrency)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FxRate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FxRateUpsertBulk) Ignore() *FxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FxRateUpsertBulk) DoNothing() *FxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FxRateCreateBulk.OnConflict
+// documentation for more info.
+func (u *FxRateUpsertBulk) Update(set func(*FxRateUpsert)) *FxRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FxRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FxRateUpsertBulk) SetUpdateTime(v time.Time) *FxRateUpsertBulk {
+	return u.Update(func(s *FxRateUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FxRateUpsertBulk) UpdateUpdateTime() *FxRateUpsertBulk {
+	return u.Update(func(s *FxRateUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *FxRateUpsertBulk) SetRate(v string) *FxRateUpsertBulk {
+	return u.Update(func(s *FxRateUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *FxRateUpsertBulk) UpdateRate() *FxRateUpsertBulk {
+	return u.Update(func(s *FxRateUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// Exec executes the query.
+func (u *FxRateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FxRateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FxRateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FxRateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent/fxrate"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FxRateDelete is the builder for deleting a FxRate entity.
+type FxRateDelete struct {
+	config
+	hooks    []Hook
+	mutation *FxRateMutation
+}
+
+// Where appends a list predicates to the FxRateDelete builder.
+func (frd *FxRateDelete) Where(ps ...predicate.FxRate) *FxRateDelete {
+	frd.mutation.Where(ps...)
+	return frd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (frd *FxRateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, frd.sqlExec, frd.mutation, frd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frd *FxRateDelete) ExecX(ctx context.Context) int {
+	n, err := frd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (frd *FxRateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(fxrate.Table, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeInt))
+	if ps := frd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, frd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	frd.mutation.done = true
+	return affected, err
+}
+
+// FxRateDeleteOne is the builder for deleting a single FxRate entity.
+type FxRateDeleteOne struct {
+	frd *FxRateDelete
+}
+
+// Where appends a list predicates to the FxRateDelete builder.
+func (frdo *FxRateDeleteOne) Where(ps ...predicate.FxRate) *FxRateDeleteOne {
+	frdo.frd.mutation.Where(ps...)
+	return frdo
+}
+
+// Exec executes the deletion query.
+func (frdo *FxRateDeleteOne) Exec(ctx context.Context) error {
+	n, err := frdo.frd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fxrate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (frdo *FxRateDeleteOne) ExecX(ctx context.Context) {
+	if err := frdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
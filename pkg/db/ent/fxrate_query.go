@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"transactor-server/pkg/db/ent/fxrate"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FxRateQuery is the builder for querying FxRate entities.
+type FxRateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []fxrate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.FxRate
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the FxRateQuery builder.
+func (frq *FxRateQuery) Where(ps ...predicate.FxRate) *FxRateQuery {
+	frq.predicates = append(frq.predicates, ps...)
+	return frq
+}
+
+// Limit the number of records to be returned by this query.
+func (frq *FxRateQuery) Limit(limit int) *FxRateQuery {
+	frq.ctx.Limit = &limit
+	return frq
+}
+
+// Offset to start from.
+func (frq *FxRateQuery) Offset(offset int) *FxRateQuery {
+	frq.ctx.Offset = &offset
+	return frq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (frq *FxRateQuery) Unique(unique bool) *FxRateQuery {
+	frq.ctx.Unique = &unique
+	return frq
+}
+
+// Order specifies how the records should be ordered.
+func (frq *FxRateQuery) Order(o ...fxrate.OrderOption) *FxRateQuery {
+	frq.order = append(frq.order, o...)
+	return frq
+}
+
+// First returns the first FxRate entity from the query.
+// Returns a *NotFoundError when no FxRate was found.
+func (frq *FxRateQuery) First(ctx context.Context) (*FxRate, error) {
+	nodes, err := frq.Limit(1).All(setContextOp(ctx, frq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{fxrate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (frq *FxRateQuery) FirstX(ctx context.Context) *FxRate {
+	node, err := frq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first FxRate ID from the query.
+// Returns a *NotFoundError when no FxRate ID was found.
+func (frq *FxRateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = frq.Limit(1).IDs(setContextOp(ctx, frq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fxrate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (frq *FxRateQuery) FirstIDX(ctx context.Context) int {
+	id, err := frq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single FxRate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one FxRate entity is found.
+// Returns a *NotFoundError when no FxRate entities are found.
+func (frq *FxRateQuery) Only(ctx context.Context) (*FxRate, error) {
+	nodes, err := frq.Limit(2).All(setContextOp(ctx, frq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{fxrate.Label}
+	default:
+		return nil, &NotSingularError{fxrate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (frq *FxRateQuery) OnlyX(ctx context.Context) *FxRate {
+	node, err := frq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only FxRate ID in the query.
+// Returns a *NotSingularError when more than one FxRate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (frq *FxRateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = frq.Limit(2).IDs(setContextOp(ctx, frq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fxrate.Label}
+	default:
+		err = &NotSingularError{fxrate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (frq *FxRateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := frq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FxRates.
+func (frq *FxRateQuery) All(ctx context.Context) ([]*FxRate, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryAll)
+	if err := frq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*FxRate, *FxRateQuery]()
+	return withInterceptors[[]*FxRate](ctx, frq, qr, frq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (frq *FxRateQuery) AllX(ctx context.Context) []*FxRate {
+	nodes, err := frq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of FxRate IDs.
+func (frq *FxRateQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if frq.ctx.Unique == nil && frq.path != nil {
+		frq.Unique(true)
+	}
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryIDs)
+	if err = frq.Select(fxrate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (frq *FxRateQuery) IDsX(ctx context.Context) []int {
+	ids, err := frq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (frq *FxRateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryCount)
+	if err := frq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, frq, querierCount[*FxRateQuery](), frq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (frq *FxRateQuery) CountX(ctx context.Context) int {
+	count, err := frq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (frq *FxRateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, frq.ctx, ent.OpQueryExist)
+	switch _, err := frq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (frq *FxRateQuery) ExistX(ctx context.Context) bool {
+	exist, err := frq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the FxRateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (frq *FxRateQuery) Clone() *FxRateQuery {
+	if frq == nil {
+		return nil
+	}
+	return &FxRateQuery{
+		config:     frq.config,
+		ctx:        frq.ctx.Clone(),
+		order:      append([]fxrate.OrderOption{}, frq.order...),
+		inters:     append([]Interceptor{}, frq.inters...),
+		predicates: append([]predicate.FxRate{}, frq.predicates...),
+		// clone intermediate query.
+		sql:       frq.sql.Clone(),
+		path:      frq.path,
+		modifiers: append([]func(*sql.Selector){}, frq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FxRate.Query().
+//		GroupBy(fxrate.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (frq *FxRateQuery) GroupBy(field string, fields ...string) *FxRateGroupBy {
+	frq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &FxRateGroupBy{build: frq}
+	grbuild.flds = &frq.ctx.Fields
+	grbuild.label = fxrate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.FxRate.Query().
+//		Select(fxrate.FieldCreateTime).
+//		Scan(ctx, &v)
+func (frq *FxRateQuery) Select(fields ...string) *FxRateSelect {
+	frq.ctx.Fields = append(frq.ctx.Fields, fields...)
+	sbuild := &FxRateSelect{FxRateQuery: frq}
+	sbuild.label = fxrate.Label
+	sbuild.flds, sbuild.scan = &frq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a FxRateSelect configured with the given aggregations.
+func (frq *FxRateQuery) Aggregate(fns ...AggregateFunc) *FxRateSelect {
+	return frq.Select().Aggregate(fns...)
+}
+
+func (frq *FxRateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range frq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, frq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range frq.ctx.Fields {
+		if !fxrate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if frq.path != nil {
+		prev, err := frq.path(ctx)
+		if err != nil {
+			return err
+		}
+		frq.sql = prev
+	}
+	return nil
+}
+
+func (frq *FxRateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*FxRate, error) {
+	var (
+		nodes = []*FxRate{}
+		_spec = frq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*FxRate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &FxRate{config: frq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(frq.modifiers) > 0 {
+		_spec.Modifiers = frq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, frq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (frq *FxRateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := frq.querySpec()
+	if len(frq.modifiers) > 0 {
+		_spec.Modifiers = frq.modifiers
+	}
+	_spec.Node.Columns = frq.ctx.Fields
+	if len(frq.ctx.Fields) > 0 {
+		_spec.Unique = frq.ctx.Unique != nil && *frq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, frq.driver, _spec)
+}
+
+func (frq *FxRateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(fxrate.Table, fxrate.Columns, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeInt))
+	_spec.From = frq.sql
+	if unique := frq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if frq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := frq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fxrate.FieldID)
+		for i := range fields {
+			if fields[i] != fxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := frq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := frq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := frq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := frq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (frq *FxRateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(frq.driver.Dialect())
+	t1 := builder.Table(fxrate.Table)
+	columns := frq.ctx.Fields
+	if len(columns) == 0 {
+		columns = fxrate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if frq.sql != nil {
+		selector = frq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if frq.ctx.Unique != nil && *frq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range frq.modifiers {
+		m(selector)
+	}
+	for _, p := range frq.predicates {
+		p(selector)
+	}
+	for _, p := range frq.order {
+		p(selector)
+	}
+	if offset := frq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := frq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (frq *FxRateQuery) Modify(modifiers ...func(s *sql.Selector)) *FxRateSelect {
+	frq.modifiers = append(frq.modifiers, modifiers...)
+	return frq.Select()
+}
+
+// FxRateGroupBy is the group-by builder for FxRate entities.
+type FxRateGroupBy struct {
+	selector
+	build *FxRateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (frgb *FxRateGroupBy) Aggregate(fns ...AggregateFunc) *FxRateGroupBy {
+	frgb.fns = append(frgb.fns, fns...)
+	return frgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (frgb *FxRateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, frgb.build.ctx, ent.OpQueryGroupBy)
+	if err := frgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FxRateQuery, *FxRateGroupBy](ctx, frgb.build, frgb, frgb.build.inters, v)
+}
+
+func (frgb *FxRateGroupBy) sqlScan(ctx context.Context, root *FxRateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(frgb.fns))
+	for _, fn := range frgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*frgb.flds)+len(frgb.fns))
+		for _, f := range *frgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*frgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := frgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// FxRateSelect is the builder for selecting fields of FxRate entities.
+type FxRateSelect struct {
+	*FxRateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (frs *FxRateSelect) Aggregate(fns ...AggregateFunc) *FxRateSelect {
+	frs.fns = append(frs.fns, fns...)
+	return frs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (frs *FxRateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, frs.ctx, ent.OpQuerySelect)
+	if err := frs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*FxRateQuery, *FxRateSelect](ctx, frs.FxRateQuery, frs, frs.inters, v)
+}
+
+func (frs *FxRateSelect) sqlScan(ctx context.Context, root *FxRateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(frs.fns))
+	for _, fn := range frs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*frs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := frs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (frs *FxRateSelect) Modify(modifiers ...func(s *sql.Selector)) *FxRateSelect {
+	frs.modifiers = append(frs.modifiers, modifiers...)
+	return frs
+}
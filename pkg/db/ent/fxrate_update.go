@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/fxrate"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FxRateUpdate is the builder for updating FxRate entities.
+type FxRateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FxRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FxRateUpdate builder.
+func (fru *FxRateUpdate) Where(ps ...predicate.FxRate) *FxRateUpdate {
+	fru.mutation.Where(ps...)
+	return fru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (fru *FxRateUpdate) SetUpdateTime(t time.Time) *FxRateUpdate {
+	fru.mutation.SetUpdateTime(t)
+	return fru
+}
+
+// SetRate sets the "rate" field.
+func (fru *FxRateUpdate) SetRate(s string) *FxRateUpdate {
+	fru.mutation.SetRate(s)
+	return fru
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (fru *FxRateUpdate) SetNillableRate(s *string) *FxRateUpdate {
+	if s != nil {
+		fru.SetRate(*s)
+	}
+	return fru
+}
+
+// Mutation returns the FxRateMutation object of the builder.
+func (fru *FxRateUpdate) Mutation() *FxRateMutation {
+	return fru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fru *FxRateUpdate) Save(ctx context.Context) (int, error) {
+	fru.defaults()
+	return withHooks(ctx, fru.sqlSave, fru.mutation, fru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fru *FxRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := fru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fru *FxRateUpdate) Exec(ctx context.Context) error {
+	_, err := fru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fru *FxRateUpdate) ExecX(ctx context.Context) {
+	if err := fru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fru *FxRateUpdate) defaults() {
+	if _, ok := fru.mutation.UpdateTime(); !ok {
+		v := fxrate.UpdateDefaultUpdateTime()
+		fru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fru *FxRateUpdate) check() error {
+	if v, ok := fru.mutation.Rate(); ok {
+		if err := fxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "FxRate.rate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fru *FxRateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FxRateUpdate {
+	fru.modifiers = append(fru.modifiers, modifiers...)
+	return fru
+}
+
+func (fru *FxRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fxrate.Table, fxrate.Columns, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeInt))
+	if ps := fru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fru.mutation.UpdateTime(); ok {
+		_spec.SetField(fxrate.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := fru.mutation.Rate(); ok {
+		_spec.SetField(fxrate.FieldRate, field.TypeString, value)
+	}
+	_spec.AddModifiers(fru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, fru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fru.mutation.done = true
+	return n, nil
+}
+
+// FxRateUpdateOne is the builder for updating a single FxRate entity.
+type FxRateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FxRateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (fruo *FxRateUpdateOne) SetUpdateTime(t time.Time) *FxRateUpdateOne {
+	fruo.mutation.SetUpdateTime(t)
+	return fruo
+}
+
+// SetRate sets the "rate" field.
+func (fruo *FxRateUpdateOne) SetRate(s string) *FxRateUpdateOne {
+	fruo.mutation.SetRate(s)
+	return fruo
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (fruo *FxRateUpdateOne) SetNillableRate(s *string) *FxRateUpdateOne {
+	if s != nil {
+		fruo.SetRate(*s)
+	}
+	return fruo
+}
+
+// Mutation returns the FxRateMutation object of the builder.
+func (fruo *FxRateUpdateOne) Mutation() *FxRateMutation {
+	return fruo.mutation
+}
+
+// Where appends a list predicates to the FxRateUpdate builder.
+func (fruo *FxRateUpdateOne) Where(ps ...predicate.FxRate) *FxRateUpdateOne {
+	fruo.mutation.Where(ps...)
+	return fruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fruo *FxRateUpdateOne) Select(field string, fields ...string) *FxRateUpdateOne {
+	fruo.fields = append([]string{field}, fields...)
+	return fruo
+}
+
+// Save executes the query and returns the updated FxRate entity.
+func (fruo *FxRateUpdateOne) Save(ctx context.Context) (*FxRate, error) {
+	fruo.defaults()
+	return withHooks(ctx, fruo.sqlSave, fruo.mutation, fruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fruo *FxRateUpdateOne) SaveX(ctx context.Context) *FxRate {
+	node, err := fruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fruo *FxRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := fruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fruo *FxRateUpdateOne) ExecX(ctx context.Context) {
+	if err := fruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fruo *FxRateUpdateOne) defaults() {
+	if _, ok := fruo.mutation.UpdateTime(); !ok {
+		v := fxrate.UpdateDefaultUpdateTime()
+		fruo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fruo *FxRateUpdateOne) check() error {
+	if v, ok := fruo.mutation.Rate(); ok {
+		if err := fxrate.RateValidator(v); err != nil {
+			return &ValidationError{Name: "rate", err: fmt.Errorf(`ent: validator failed for field "FxRate.rate": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (fruo *FxRateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FxRateUpdateOne {
+	fruo.modifiers = append(fruo.modifiers, modifiers...)
+	return fruo
+}
+
+func (fruo *FxRateUpdateOne) sqlSave(ctx context.Context) (_node *FxRate, err error) {
+	if err := fruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fxrate.Table, fxrate.Columns, sqlgraph.NewFieldSpec(fxrate.FieldID, field.TypeInt))
+	id, ok := fruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FxRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fxrate.FieldID)
+		for _, f := range fields {
+			if !fxrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fxrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fruo.mutation.UpdateTime(); ok {
+		_spec.SetField(fxrate.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := fruo.mutation.Rate(); ok {
+		_spec.SetField(fxrate.FieldRate, field.TypeString, value)
+	}
+	_spec.AddModifiers(fruo.modifiers...)
+	_node = &FxRate{config: fruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fxrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fruo.mutation.done = true
+	return _node, nil
+}
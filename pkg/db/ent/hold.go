@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/operationtype"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Hold is the model entity for the Hold schema.
+type Hold struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// OperationTypeID holds the value of the "operation_type_id" field.
+	OperationTypeID int `json:"operation_type_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status hold.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CapturedAmount holds the value of the "captured_amount" field.
+	CapturedAmount *money.Amount `json:"captured_amount,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID *int `json:"transaction_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HoldQuery when eager-loading is set.
+	Edges        HoldEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HoldEdges holds the relations/edges for other nodes in the graph.
+type HoldEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// OperationType holds the value of the operation_type edge.
+	OperationType *OperationType `json:"operation_type,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// OperationTypeOrErr returns the OperationType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldEdges) OperationTypeOrErr() (*OperationType, error) {
+	if e.OperationType != nil {
+		return e.OperationType, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: operationtype.Label}
+	}
+	return nil, &NotLoadedError{edge: "operation_type"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HoldEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Hold) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hold.FieldID, hold.FieldAccountID, hold.FieldOperationTypeID, hold.FieldAmount, hold.FieldCapturedAmount, hold.FieldTransactionID:
+			values[i] = new(sql.NullInt64)
+		case hold.FieldStatus:
+			values[i] = new(sql.NullString)
+		case hold.FieldCreateTime, hold.FieldUpdateTime, hold.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Hold fields.
+func (h *Hold) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hold.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			h.ID = int(value.Int64)
+		case hold.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				h.CreateTime = value.Time
+			}
+		case hold.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				h.UpdateTime = value.Time
+			}
+		case hold.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				h.AccountID = int(value.Int64)
+			}
+		case hold.FieldOperationTypeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operation_type_id", values[i])
+			} else if value.Valid {
+				h.OperationTypeID = int(value.Int64)
+			}
+		case hold.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				h.Amount = money.Amount(value.Int64)
+			}
+		case hold.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				h.Status = hold.Status(value.String)
+			}
+		case hold.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				h.ExpiresAt = value.Time
+			}
+		case hold.FieldCapturedAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field captured_amount", values[i])
+			} else if value.Valid {
+				h.CapturedAmount = new(money.Amount)
+				*h.CapturedAmount = money.Amount(value.Int64)
+			}
+		case hold.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				h.TransactionID = new(int)
+				*h.TransactionID = int(value.Int64)
+			}
+		default:
+			h.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Hold.
+// This includes values selected through modifiers, order, etc.
+func (h *Hold) Value(name string) (ent.Value, error) {
+	return h.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Hold entity.
+func (h *Hold) QueryAccount() *AccountQuery {
+	return NewHoldClient(h.config).QueryAccount(h)
+}
+
+// QueryOperationType queries the "operation_type" edge of the Hold entity.
+func (h *Hold) QueryOperationType() *OperationTypeQuery {
+	return NewHoldClient(h.config).QueryOperationType(h)
+}
+
+// QueryTransaction queries the "transaction" edge of the Hold entity.
+func (h *Hold) QueryTransaction() *TransactionQuery {
+	return NewHoldClient(h.config).QueryTransaction(h)
+}
+
+// Update returns a builder for updating this Hold.
+// Note that you need to call Hold.Unwrap() before calling this method if this Hold
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (h *Hold) Update() *HoldUpdateOne {
+	return NewHoldClient(h.config).UpdateOne(h)
+}
+
+// Unwrap unwraps the Hold entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (h *Hold) Unwrap() *Hold {
+	_tx, ok := h.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Hold is not a transactional entity")
+	}
+	h.config.driver = _tx.drv
+	return h
+}
+
+// String implements the fmt.Stringer.
+func (h *Hold) String() string {
+	var builder strings.Builder
+	builder.WriteString("Hold(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", h.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(h.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(h.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", h.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("operation_type_id=")
+	builder.WriteString(fmt.Sprintf("%v", h.OperationTypeID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", h.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", h.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(h.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := h.CapturedAmount; v != nil {
+		builder.WriteString("captured_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := h.TransactionID; v != nil {
+		builder.WriteString("transaction_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holds is a parsable slice of Hold.
+type Holds []*Hold
@@ -0,0 +1,511 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hold
+
+import (
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Hold {
+	return predicate.Hold(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Hold {
+	return predicate.Hold(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Hold {
+	return predicate.Hold(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Hold {
+	return predicate.Hold(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldAccountID, v))
+}
+
+// OperationTypeID applies equality check predicate on the "operation_type_id" field. It's identical to OperationTypeIDEQ.
+func OperationTypeID(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldOperationTypeID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldEQ(FieldAmount, vc))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CapturedAmount applies equality check predicate on the "captured_amount" field. It's identical to CapturedAmountEQ.
+func CapturedAmount(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldEQ(FieldCapturedAmount, vc))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// OperationTypeIDEQ applies the EQ predicate on the "operation_type_id" field.
+func OperationTypeIDEQ(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldOperationTypeID, v))
+}
+
+// OperationTypeIDNEQ applies the NEQ predicate on the "operation_type_id" field.
+func OperationTypeIDNEQ(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldOperationTypeID, v))
+}
+
+// OperationTypeIDIn applies the In predicate on the "operation_type_id" field.
+func OperationTypeIDIn(vs ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldOperationTypeID, vs...))
+}
+
+// OperationTypeIDNotIn applies the NotIn predicate on the "operation_type_id" field.
+func OperationTypeIDNotIn(vs ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldOperationTypeID, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldEQ(FieldAmount, vc))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldNEQ(FieldAmount, vc))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...money.Amount) predicate.Hold {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Hold(sql.FieldIn(FieldAmount, v...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...money.Amount) predicate.Hold {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Hold(sql.FieldNotIn(FieldAmount, v...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldGT(FieldAmount, vc))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldGTE(FieldAmount, vc))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldLT(FieldAmount, vc))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldLTE(FieldAmount, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Hold {
+	return predicate.Hold(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CapturedAmountEQ applies the EQ predicate on the "captured_amount" field.
+func CapturedAmountEQ(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldEQ(FieldCapturedAmount, vc))
+}
+
+// CapturedAmountNEQ applies the NEQ predicate on the "captured_amount" field.
+func CapturedAmountNEQ(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldNEQ(FieldCapturedAmount, vc))
+}
+
+// CapturedAmountIn applies the In predicate on the "captured_amount" field.
+func CapturedAmountIn(vs ...money.Amount) predicate.Hold {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Hold(sql.FieldIn(FieldCapturedAmount, v...))
+}
+
+// CapturedAmountNotIn applies the NotIn predicate on the "captured_amount" field.
+func CapturedAmountNotIn(vs ...money.Amount) predicate.Hold {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Hold(sql.FieldNotIn(FieldCapturedAmount, v...))
+}
+
+// CapturedAmountGT applies the GT predicate on the "captured_amount" field.
+func CapturedAmountGT(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldGT(FieldCapturedAmount, vc))
+}
+
+// CapturedAmountGTE applies the GTE predicate on the "captured_amount" field.
+func CapturedAmountGTE(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldGTE(FieldCapturedAmount, vc))
+}
+
+// CapturedAmountLT applies the LT predicate on the "captured_amount" field.
+func CapturedAmountLT(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldLT(FieldCapturedAmount, vc))
+}
+
+// CapturedAmountLTE applies the LTE predicate on the "captured_amount" field.
+func CapturedAmountLTE(v money.Amount) predicate.Hold {
+	vc := int64(v)
+	return predicate.Hold(sql.FieldLTE(FieldCapturedAmount, vc))
+}
+
+// CapturedAmountIsNil applies the IsNil predicate on the "captured_amount" field.
+func CapturedAmountIsNil() predicate.Hold {
+	return predicate.Hold(sql.FieldIsNull(FieldCapturedAmount))
+}
+
+// CapturedAmountNotNil applies the NotNil predicate on the "captured_amount" field.
+func CapturedAmountNotNil() predicate.Hold {
+	return predicate.Hold(sql.FieldNotNull(FieldCapturedAmount))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v int) predicate.Hold {
+	return predicate.Hold(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...int) predicate.Hold {
+	return predicate.Hold(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.Hold {
+	return predicate.Hold(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.Hold {
+	return predicate.Hold(sql.FieldNotNull(FieldTransactionID))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Hold {
+	return predicate.Hold(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Hold {
+	return predicate.Hold(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOperationType applies the HasEdge predicate on the "operation_type" edge.
+func HasOperationType() predicate.Hold {
+	return predicate.Hold(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OperationTypeTable, OperationTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOperationTypeWith applies the HasEdge predicate on the "operation_type" edge with a given conditions (other predicates).
+func HasOperationTypeWith(preds ...predicate.OperationType) predicate.Hold {
+	return predicate.Hold(func(s *sql.Selector) {
+		step := newOperationTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransaction applies the HasEdge predicate on the "transaction" edge.
+func HasTransaction() predicate.Hold {
+	return predicate.Hold(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, TransactionTable, TransactionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionWith applies the HasEdge predicate on the "transaction" edge with a given conditions (other predicates).
+func HasTransactionWith(preds ...predicate.Transaction) predicate.Hold {
+	return predicate.Hold(func(s *sql.Selector) {
+		step := newTransactionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Hold) predicate.Hold {
+	return predicate.Hold(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Hold) predicate.Hold {
+	return predicate.Hold(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Hold) predicate.Hold {
+	return predicate.Hold(sql.NotPredicates(p))
+}
@@ -0,0 +1,920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/operationtype"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HoldCreate is the builder for creating a Hold entity.
+type HoldCreate struct {
+	config
+	mutation *HoldMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (hc *HoldCreate) SetCreateTime(t time.Time) *HoldCreate {
+	hc.mutation.SetCreateTime(t)
+	return hc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableCreateTime(t *time.Time) *HoldCreate {
+	if t != nil {
+		hc.SetCreateTime(*t)
+	}
+	return hc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (hc *HoldCreate) SetUpdateTime(t time.Time) *HoldCreate {
+	hc.mutation.SetUpdateTime(t)
+	return hc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableUpdateTime(t *time.Time) *HoldCreate {
+	if t != nil {
+		hc.SetUpdateTime(*t)
+	}
+	return hc
+}
+
+// SetAccountID sets the "account_id" field.
+func (hc *HoldCreate) SetAccountID(i int) *HoldCreate {
+	hc.mutation.SetAccountID(i)
+	return hc
+}
+
+// SetOperationTypeID sets the "operation_type_id" field.
+func (hc *HoldCreate) SetOperationTypeID(i int) *HoldCreate {
+	hc.mutation.SetOperationTypeID(i)
+	return hc
+}
+
+// SetAmount sets the "amount" field.
+func (hc *HoldCreate) SetAmount(m money.Amount) *HoldCreate {
+	hc.mutation.SetAmount(m)
+	return hc
+}
+
+// SetStatus sets the "status" field.
+func (hc *HoldCreate) SetStatus(h hold.Status) *HoldCreate {
+	hc.mutation.SetStatus(h)
+	return hc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableStatus(h *hold.Status) *HoldCreate {
+	if h != nil {
+		hc.SetStatus(*h)
+	}
+	return hc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (hc *HoldCreate) SetExpiresAt(t time.Time) *HoldCreate {
+	hc.mutation.SetExpiresAt(t)
+	return hc
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (hc *HoldCreate) SetCapturedAmount(m money.Amount) *HoldCreate {
+	hc.mutation.SetCapturedAmount(m)
+	return hc
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableCapturedAmount(m *money.Amount) *HoldCreate {
+	if m != nil {
+		hc.SetCapturedAmount(*m)
+	}
+	return hc
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (hc *HoldCreate) SetTransactionID(i int) *HoldCreate {
+	hc.mutation.SetTransactionID(i)
+	return hc
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (hc *HoldCreate) SetNillableTransactionID(i *int) *HoldCreate {
+	if i != nil {
+		hc.SetTransactionID(*i)
+	}
+	return hc
+}
+
+// SetID sets the "id" field.
+func (hc *HoldCreate) SetID(i int) *HoldCreate {
+	hc.mutation.SetID(i)
+	return hc
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (hc *HoldCreate) SetAccount(a *Account) *HoldCreate {
+	return hc.SetAccountID(a.ID)
+}
+
+// SetOperationType sets the "operation_type" edge to the OperationType entity.
+func (hc *HoldCreate) SetOperationType(o *OperationType) *HoldCreate {
+	return hc.SetOperationTypeID(o.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (hc *HoldCreate) SetTransaction(t *Transaction) *HoldCreate {
+	return hc.SetTransactionID(t.ID)
+}
+
+// Mutation returns the HoldMutation object of the builder.
+func (hc *HoldCreate) Mutation() *HoldMutation {
+	return hc.mutation
+}
+
+// Save creates the Hold in the database.
+func (hc *HoldCreate) Save(ctx context.Context) (*Hold, error) {
+	hc.defaults()
+	return withHooks(ctx, hc.sqlSave, hc.mutation, hc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hc *HoldCreate) SaveX(ctx context.Context) *Hold {
+	v, err := hc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hc *HoldCreate) Exec(ctx context.Context) error {
+	_, err := hc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hc *HoldCreate) ExecX(ctx context.Context) {
+	if err := hc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hc *HoldCreate) defaults() {
+	if _, ok := hc.mutation.CreateTime(); !ok {
+		v := hold.DefaultCreateTime()
+		hc.mutation.SetCreateTime(v)
+	}
+	if _, ok := hc.mutation.UpdateTime(); !ok {
+		v := hold.DefaultUpdateTime()
+		hc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := hc.mutation.Status(); !ok {
+		v := hold.DefaultStatus
+		hc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hc *HoldCreate) check() error {
+	if _, ok := hc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Hold.create_time"`)}
+	}
+	if _, ok := hc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Hold.update_time"`)}
+	}
+	if _, ok := hc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Hold.account_id"`)}
+	}
+	if _, ok := hc.mutation.OperationTypeID(); !ok {
+		return &ValidationError{Name: "operation_type_id", err: errors.New(`ent: missing required field "Hold.operation_type_id"`)}
+	}
+	if _, ok := hc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Hold.amount"`)}
+	}
+	if _, ok := hc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Hold.status"`)}
+	}
+	if v, ok := hc.mutation.Status(); ok {
+		if err := hold.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Hold.status": %w`, err)}
+		}
+	}
+	if _, ok := hc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Hold.expires_at"`)}
+	}
+	if len(hc.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Hold.account"`)}
+	}
+	if len(hc.mutation.OperationTypeIDs()) == 0 {
+		return &ValidationError{Name: "operation_type", err: errors.New(`ent: missing required edge "Hold.operation_type"`)}
+	}
+	return nil
+}
+
+func (hc *HoldCreate) sqlSave(ctx context.Context) (*Hold, error) {
+	if err := hc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	hc.mutation.id = &_node.ID
+	hc.mutation.done = true
+	return _node, nil
+}
+
+func (hc *HoldCreate) createSpec() (*Hold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Hold{config: hc.config}
+		_spec = sqlgraph.NewCreateSpec(hold.Table, sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = hc.conflict
+	if id, ok := hc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := hc.mutation.CreateTime(); ok {
+		_spec.SetField(hold.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := hc.mutation.UpdateTime(); ok {
+		_spec.SetField(hold.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := hc.mutation.Amount(); ok {
+		_spec.SetField(hold.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := hc.mutation.Status(); ok {
+		_spec.SetField(hold.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := hc.mutation.ExpiresAt(); ok {
+		_spec.SetField(hold.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := hc.mutation.CapturedAmount(); ok {
+		_spec.SetField(hold.FieldCapturedAmount, field.TypeInt64, value)
+		_node.CapturedAmount = &value
+	}
+	if nodes := hc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hold.AccountTable,
+			Columns: []string{hold.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hc.mutation.OperationTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   hold.OperationTypeTable,
+			Columns: []string{hold.OperationTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(operationtype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OperationTypeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hc.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   hold.TransactionTable,
+			Columns: []string{hold.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hold.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (hc *HoldCreate) OnConflict(opts ...sql.ConflictOption) *HoldUpsertOne {
+	hc.conflict = opts
+	return &HoldUpsertOne{
+		create: hc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hc *HoldCreate) OnConflictColumns(columns ...string) *HoldUpsertOne {
+	hc.conflict = append(hc.conflict, sql.ConflictColumns(columns...))
+	return &HoldUpsertOne{
+		create: hc,
+	}
+}
+
+type (
+	// HoldUpsertOne is the builder for "upsert"-ing
+	//  one Hold node.
+	HoldUpsertOne struct {
+		create *HoldCreate
+	}
+
+	// HoldUpsert is the "OnConflict" setter.
+	HoldUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HoldUpsert) SetUpdateTime(v time.Time) *HoldUpsert {
+	u.Set(hold.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateUpdateTime() *HoldUpsert {
+	u.SetExcluded(hold.FieldUpdateTime)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *HoldUpsert) SetStatus(v hold.Status) *HoldUpsert {
+	u.Set(hold.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateStatus() *HoldUpsert {
+	u.SetExcluded(hold.FieldStatus)
+	return u
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *HoldUpsert) SetCapturedAmount(v money.Amount) *HoldUpsert {
+	u.Set(hold.FieldCapturedAmount, v)
+	return u
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateCapturedAmount() *HoldUpsert {
+	u.SetExcluded(hold.FieldCapturedAmount)
+	return u
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *HoldUpsert) AddCapturedAmount(v money.Amount) *HoldUpsert {
+	u.Add(hold.FieldCapturedAmount, v)
+	return u
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (u *HoldUpsert) ClearCapturedAmount() *HoldUpsert {
+	u.SetNull(hold.FieldCapturedAmount)
+	return u
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *HoldUpsert) SetTransactionID(v int) *HoldUpsert {
+	u.Set(hold.FieldTransactionID, v)
+	return u
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *HoldUpsert) UpdateTransactionID() *HoldUpsert {
+	u.SetExcluded(hold.FieldTransactionID)
+	return u
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *HoldUpsert) ClearTransactionID() *HoldUpsert {
+	u.SetNull(hold.FieldTransactionID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HoldUpsertOne) UpdateNewValues() *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hold.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(hold.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(hold.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.OperationTypeID(); exists {
+			s.SetIgnore(hold.FieldOperationTypeID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(hold.FieldAmount)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(hold.FieldExpiresAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HoldUpsertOne) Ignore() *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldUpsertOne) DoNothing() *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldCreate.OnConflict
+// documentation for more info.
+func (u *HoldUpsertOne) Update(set func(*HoldUpsert)) *HoldUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HoldUpsertOne) SetUpdateTime(v time.Time) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateUpdateTime() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *HoldUpsertOne) SetStatus(v hold.Status) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateStatus() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *HoldUpsertOne) SetCapturedAmount(v money.Amount) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetCapturedAmount(v)
+	})
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *HoldUpsertOne) AddCapturedAmount(v money.Amount) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.AddCapturedAmount(v)
+	})
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateCapturedAmount() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateCapturedAmount()
+	})
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (u *HoldUpsertOne) ClearCapturedAmount() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.ClearCapturedAmount()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *HoldUpsertOne) SetTransactionID(v int) *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *HoldUpsertOne) UpdateTransactionID() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *HoldUpsertOne) ClearTransactionID() *HoldUpsertOne {
+	return u.Update(func(s *HoldUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HoldUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HoldUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HoldCreateBulk is the builder for creating many Hold entities in bulk.
+type HoldCreateBulk struct {
+	config
+	err      error
+	builders []*HoldCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Hold entities in the database.
+func (hcb *HoldCreateBulk) Save(ctx context.Context) ([]*Hold, error) {
+	if hcb.err != nil {
+		return nil, hcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hcb.builders))
+	nodes := make([]*Hold, len(hcb.builders))
+	mutators := make([]Mutator, len(hcb.builders))
+	for i := range hcb.builders {
+		func(i int, root context.Context) {
+			builder := hcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HoldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hcb *HoldCreateBulk) SaveX(ctx context.Context) []*Hold {
+	v, err := hcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hcb *HoldCreateBulk) Exec(ctx context.Context) error {
+	_, err := hcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hcb *HoldCreateBulk) ExecX(ctx context.Context) {
+	if err := hcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Hold.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HoldUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (hcb *HoldCreateBulk) OnConflict(opts ...sql.ConflictOption) *HoldUpsertBulk {
+	hcb.conflict = opts
+	return &HoldUpsertBulk{
+		create: hcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hcb *HoldCreateBulk) OnConflictColumns(columns ...string) *HoldUpsertBulk {
+	hcb.conflict = append(hcb.conflict, sql.ConflictColumns(columns...))
+	return &HoldUpsertBulk{
+		create: hcb,
+	}
+}
+
+// HoldUpsertBulk is the builder for "upsert"-ing
+// a bulk of Hold nodes.
+type HoldUpsertBulk struct {
+	create *HoldCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hold.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HoldUpsertBulk) UpdateNewValues() *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hold.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(hold.FieldCreateTime)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(hold.FieldAccountID)
+			}
+			if _, exists := b.mutation.OperationTypeID(); exists {
+				s.SetIgnore(hold.FieldOperationTypeID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(hold.FieldAmount)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(hold.FieldExpiresAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Hold.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HoldUpsertBulk) Ignore() *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HoldUpsertBulk) DoNothing() *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HoldCreateBulk.OnConflict
+// documentation for more info.
+func (u *HoldUpsertBulk) Update(set func(*HoldUpsert)) *HoldUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HoldUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HoldUpsertBulk) SetUpdateTime(v time.Time) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateUpdateTime() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *HoldUpsertBulk) SetStatus(v hold.Status) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateStatus() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (u *HoldUpsertBulk) SetCapturedAmount(v money.Amount) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetCapturedAmount(v)
+	})
+}
+
+// AddCapturedAmount adds v to the "captured_amount" field.
+func (u *HoldUpsertBulk) AddCapturedAmount(v money.Amount) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.AddCapturedAmount(v)
+	})
+}
+
+// UpdateCapturedAmount sets the "captured_amount" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateCapturedAmount() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateCapturedAmount()
+	})
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (u *HoldUpsertBulk) ClearCapturedAmount() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.ClearCapturedAmount()
+	})
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (u *HoldUpsertBulk) SetTransactionID(v int) *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.SetTransactionID(v)
+	})
+}
+
+// UpdateTransactionID sets the "transaction_id" field to the value that was provided on create.
+func (u *HoldUpsertBulk) UpdateTransactionID() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.UpdateTransactionID()
+	})
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (u *HoldUpsertBulk) ClearTransactionID() *HoldUpsertBulk {
+	return u.Update(func(s *HoldUpsert) {
+		s.ClearTransactionID()
+	})
+}
+
+// Exec executes the query.
+func (u *HoldUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HoldCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HoldCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HoldUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// HoldDelete is the builder for deleting a Hold entity.
+type HoldDelete struct {
+	config
+	hooks    []Hook
+	mutation *HoldMutation
+}
+
+// Where appends a list predicates to the HoldDelete builder.
+func (hd *HoldDelete) Where(ps ...predicate.Hold) *HoldDelete {
+	hd.mutation.Where(ps...)
+	return hd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hd *HoldDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, hd.sqlExec, hd.mutation, hd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hd *HoldDelete) ExecX(ctx context.Context) int {
+	n, err := hd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hd *HoldDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(hold.Table, sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt))
+	if ps := hd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hd.mutation.done = true
+	return affected, err
+}
+
+// HoldDeleteOne is the builder for deleting a single Hold entity.
+type HoldDeleteOne struct {
+	hd *HoldDelete
+}
+
+// Where appends a list predicates to the HoldDelete builder.
+func (hdo *HoldDeleteOne) Where(ps ...predicate.Hold) *HoldDeleteOne {
+	hdo.hd.mutation.Where(ps...)
+	return hdo
+}
+
+// Exec executes the deletion query.
+func (hdo *HoldDeleteOne) Exec(ctx context.Context) error {
+	n, err := hdo.hd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{hold.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hdo *HoldDeleteOne) ExecX(ctx context.Context) {
+	if err := hdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
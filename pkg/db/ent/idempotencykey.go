@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/idempotencykey"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// IdempotencyKey is the model entity for the IdempotencyKey schema.
+type IdempotencyKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope string `json:"scope,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// RequestHash holds the value of the "request_hash" field.
+	RequestHash string `json:"request_hash,omitempty"`
+	// ResponseStatus holds the value of the "response_status" field.
+	ResponseStatus *int `json:"response_status,omitempty"`
+	// ResponseBody holds the value of the "response_body" field.
+	ResponseBody []byte `json:"response_body,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdempotencyKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldResponseBody:
+			values[i] = new([]byte)
+		case idempotencykey.FieldID, idempotencykey.FieldResponseStatus:
+			values[i] = new(sql.NullInt64)
+		case idempotencykey.FieldScope, idempotencykey.FieldKey, idempotencykey.FieldRequestHash:
+			values[i] = new(sql.NullString)
+		case idempotencykey.FieldCreateTime, idempotencykey.FieldUpdateTime, idempotencykey.FieldLockedUntil, idempotencykey.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdempotencyKey fields.
+func (ik *IdempotencyKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ik.ID = int(value.Int64)
+		case idempotencykey.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ik.CreateTime = value.Time
+			}
+		case idempotencykey.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ik.UpdateTime = value.Time
+			}
+		case idempotencykey.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				ik.Scope = value.String
+			}
+		case idempotencykey.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ik.Key = value.String
+			}
+		case idempotencykey.FieldRequestHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_hash", values[i])
+			} else if value.Valid {
+				ik.RequestHash = value.String
+			}
+		case idempotencykey.FieldResponseStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status", values[i])
+			} else if value.Valid {
+				ik.ResponseStatus = new(int)
+				*ik.ResponseStatus = int(value.Int64)
+			}
+		case idempotencykey.FieldResponseBody:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response_body", values[i])
+			} else if value != nil {
+				ik.ResponseBody = *value
+			}
+		case idempotencykey.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				ik.LockedUntil = new(time.Time)
+				*ik.LockedUntil = value.Time
+			}
+		case idempotencykey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ik.ExpiresAt = value.Time
+			}
+		default:
+			ik.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IdempotencyKey.
+// This includes values selected through modifiers, order, etc.
+func (ik *IdempotencyKey) Value(name string) (ent.Value, error) {
+	return ik.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IdempotencyKey.
+// Note that you need to call IdempotencyKey.Unwrap() before calling this method if this IdempotencyKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ik *IdempotencyKey) Update() *IdempotencyKeyUpdateOne {
+	return NewIdempotencyKeyClient(ik.config).UpdateOne(ik)
+}
+
+// Unwrap unwraps the IdempotencyKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ik *IdempotencyKey) Unwrap() *IdempotencyKey {
+	_tx, ok := ik.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdempotencyKey is not a transactional entity")
+	}
+	ik.config.driver = _tx.drv
+	return ik
+}
+
+// String implements the fmt.Stringer.
+func (ik *IdempotencyKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdempotencyKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ik.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ik.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ik.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(ik.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(ik.Key)
+	builder.WriteString(", ")
+	builder.WriteString("request_hash=")
+	builder.WriteString(ik.RequestHash)
+	builder.WriteString(", ")
+	if v := ik.ResponseStatus; v != nil {
+		builder.WriteString("response_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("response_body=")
+	builder.WriteString(fmt.Sprintf("%v", ik.ResponseBody))
+	builder.WriteString(", ")
+	if v := ik.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ik.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdempotencyKeys is a parsable slice of IdempotencyKey.
+type IdempotencyKeys []*IdempotencyKey
@@ -0,0 +1,866 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/idempotencykey"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdempotencyKeyCreate is the builder for creating a IdempotencyKey entity.
+type IdempotencyKeyCreate struct {
+	config
+	mutation *IdempotencyKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ikc *IdempotencyKeyCreate) SetCreateTime(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetCreateTime(t)
+	return ikc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableCreateTime(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetCreateTime(*t)
+	}
+	return ikc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ikc *IdempotencyKeyCreate) SetUpdateTime(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetUpdateTime(t)
+	return ikc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableUpdateTime(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetUpdateTime(*t)
+	}
+	return ikc
+}
+
+// SetScope sets the "scope" field.
+func (ikc *IdempotencyKeyCreate) SetScope(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetScope(s)
+	return ikc
+}
+
+// SetKey sets the "key" field.
+func (ikc *IdempotencyKeyCreate) SetKey(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetKey(s)
+	return ikc
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (ikc *IdempotencyKeyCreate) SetRequestHash(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetRequestHash(s)
+	return ikc
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (ikc *IdempotencyKeyCreate) SetResponseStatus(i int) *IdempotencyKeyCreate {
+	ikc.mutation.SetResponseStatus(i)
+	return ikc
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableResponseStatus(i *int) *IdempotencyKeyCreate {
+	if i != nil {
+		ikc.SetResponseStatus(*i)
+	}
+	return ikc
+}
+
+// SetResponseBody sets the "response_body" field.
+func (ikc *IdempotencyKeyCreate) SetResponseBody(b []byte) *IdempotencyKeyCreate {
+	ikc.mutation.SetResponseBody(b)
+	return ikc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (ikc *IdempotencyKeyCreate) SetLockedUntil(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetLockedUntil(t)
+	return ikc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableLockedUntil(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetLockedUntil(*t)
+	}
+	return ikc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ikc *IdempotencyKeyCreate) SetExpiresAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetExpiresAt(t)
+	return ikc
+}
+
+// SetID sets the "id" field.
+func (ikc *IdempotencyKeyCreate) SetID(i int) *IdempotencyKeyCreate {
+	ikc.mutation.SetID(i)
+	return ikc
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikc *IdempotencyKeyCreate) Mutation() *IdempotencyKeyMutation {
+	return ikc.mutation
+}
+
+// Save creates the IdempotencyKey in the database.
+func (ikc *IdempotencyKeyCreate) Save(ctx context.Context) (*IdempotencyKey, error) {
+	ikc.defaults()
+	return withHooks(ctx, ikc.sqlSave, ikc.mutation, ikc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ikc *IdempotencyKeyCreate) SaveX(ctx context.Context) *IdempotencyKey {
+	v, err := ikc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikc *IdempotencyKeyCreate) Exec(ctx context.Context) error {
+	_, err := ikc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikc *IdempotencyKeyCreate) ExecX(ctx context.Context) {
+	if err := ikc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ikc *IdempotencyKeyCreate) defaults() {
+	if _, ok := ikc.mutation.CreateTime(); !ok {
+		v := idempotencykey.DefaultCreateTime()
+		ikc.mutation.SetCreateTime(v)
+	}
+	if _, ok := ikc.mutation.UpdateTime(); !ok {
+		v := idempotencykey.DefaultUpdateTime()
+		ikc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikc *IdempotencyKeyCreate) check() error {
+	if _, ok := ikc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "IdempotencyKey.create_time"`)}
+	}
+	if _, ok := ikc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "IdempotencyKey.update_time"`)}
+	}
+	if _, ok := ikc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "IdempotencyKey.scope"`)}
+	}
+	if v, ok := ikc.mutation.Scope(); ok {
+		if err := idempotencykey.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.scope": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "IdempotencyKey.key"`)}
+	}
+	if v, ok := ikc.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.RequestHash(); !ok {
+		return &ValidationError{Name: "request_hash", err: errors.New(`ent: missing required field "IdempotencyKey.request_hash"`)}
+	}
+	if _, ok := ikc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "IdempotencyKey.expires_at"`)}
+	}
+	return nil
+}
+
+func (ikc *IdempotencyKeyCreate) sqlSave(ctx context.Context) (*IdempotencyKey, error) {
+	if err := ikc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ikc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ikc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ikc.mutation.id = &_node.ID
+	ikc.mutation.done = true
+	return _node, nil
+}
+
+func (ikc *IdempotencyKeyCreate) createSpec() (*IdempotencyKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdempotencyKey{config: ikc.config}
+		_spec = sqlgraph.NewCreateSpec(idempotencykey.Table, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ikc.conflict
+	if id, ok := ikc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ikc.mutation.CreateTime(); ok {
+		_spec.SetField(idempotencykey.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ikc.mutation.UpdateTime(); ok {
+		_spec.SetField(idempotencykey.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ikc.mutation.Scope(); ok {
+		_spec.SetField(idempotencykey.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := ikc.mutation.Key(); ok {
+		_spec.SetField(idempotencykey.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := ikc.mutation.RequestHash(); ok {
+		_spec.SetField(idempotencykey.FieldRequestHash, field.TypeString, value)
+		_node.RequestHash = value
+	}
+	if value, ok := ikc.mutation.ResponseStatus(); ok {
+		_spec.SetField(idempotencykey.FieldResponseStatus, field.TypeInt, value)
+		_node.ResponseStatus = &value
+	}
+	if value, ok := ikc.mutation.ResponseBody(); ok {
+		_spec.SetField(idempotencykey.FieldResponseBody, field.TypeBytes, value)
+		_node.ResponseBody = value
+	}
+	if value, ok := ikc.mutation.LockedUntil(); ok {
+		_spec.SetField(idempotencykey.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := ikc.mutation.ExpiresAt(); ok {
+		_spec.SetField(idempotencykey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IdempotencyKey.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IdempotencyKeyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ikc *IdempotencyKeyCreate) OnConflict(opts ...sql.ConflictOption) *IdempotencyKeyUpsertOne {
+	ikc.conflict = opts
+	return &IdempotencyKeyUpsertOne{
+		create: ikc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ikc *IdempotencyKeyCreate) OnConflictColumns(columns ...string) *IdempotencyKeyUpsertOne {
+	ikc.conflict = append(ikc.conflict, sql.ConflictColumns(columns...))
+	return &IdempotencyKeyUpsertOne{
+		create: ikc,
+	}
+}
+
+type (
+	// IdempotencyKeyUpsertOne is the builder for "upsert"-ing
+	//  one IdempotencyKey node.
+	IdempotencyKeyUpsertOne struct {
+		create *IdempotencyKeyCreate
+	}
+
+	// IdempotencyKeyUpsert is the "OnConflict" setter.
+	IdempotencyKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *IdempotencyKeyUpsert) SetUpdateTime(v time.Time) *IdempotencyKeyUpsert {
+	u.Set(idempotencykey.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsert) UpdateUpdateTime() *IdempotencyKeyUpsert {
+	u.SetExcluded(idempotencykey.FieldUpdateTime)
+	return u
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *IdempotencyKeyUpsert) SetResponseStatus(v int) *IdempotencyKeyUpsert {
+	u.Set(idempotencykey.FieldResponseStatus, v)
+	return u
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsert) UpdateResponseStatus() *IdempotencyKeyUpsert {
+	u.SetExcluded(idempotencykey.FieldResponseStatus)
+	return u
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *IdempotencyKeyUpsert) AddResponseStatus(v int) *IdempotencyKeyUpsert {
+	u.Add(idempotencykey.FieldResponseStatus, v)
+	return u
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *IdempotencyKeyUpsert) ClearResponseStatus() *IdempotencyKeyUpsert {
+	u.SetNull(idempotencykey.FieldResponseStatus)
+	return u
+}
+
+// SetResponseBody sets the "response_body" field.
+func (u *IdempotencyKeyUpsert) SetResponseBody(v []byte) *IdempotencyKeyUpsert {
+	u.Set(idempotencykey.FieldResponseBody, v)
+	return u
+}
+
+// UpdateResponseBody sets the "response_body" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsert) UpdateResponseBody() *IdempotencyKeyUpsert {
+	u.SetExcluded(idempotencykey.FieldResponseBody)
+	return u
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (u *IdempotencyKeyUpsert) ClearResponseBody() *IdempotencyKeyUpsert {
+	u.SetNull(idempotencykey.FieldResponseBody)
+	return u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *IdempotencyKeyUpsert) SetLockedUntil(v time.Time) *IdempotencyKeyUpsert {
+	u.Set(idempotencykey.FieldLockedUntil, v)
+	return u
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsert) UpdateLockedUntil() *IdempotencyKeyUpsert {
+	u.SetExcluded(idempotencykey.FieldLockedUntil)
+	return u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *IdempotencyKeyUpsert) ClearLockedUntil() *IdempotencyKeyUpsert {
+	u.SetNull(idempotencykey.FieldLockedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(idempotencykey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IdempotencyKeyUpsertOne) UpdateNewValues() *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(idempotencykey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(idempotencykey.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Scope(); exists {
+			s.SetIgnore(idempotencykey.FieldScope)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(idempotencykey.FieldKey)
+		}
+		if _, exists := u.create.mutation.RequestHash(); exists {
+			s.SetIgnore(idempotencykey.FieldRequestHash)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(idempotencykey.FieldExpiresAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IdempotencyKeyUpsertOne) Ignore() *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IdempotencyKeyUpsertOne) DoNothing() *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IdempotencyKeyCreate.OnConflict
+// documentation for more info.
+func (u *IdempotencyKeyUpsertOne) Update(set func(*IdempotencyKeyUpsert)) *IdempotencyKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IdempotencyKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *IdempotencyKeyUpsertOne) SetUpdateTime(v time.Time) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertOne) UpdateUpdateTime() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *IdempotencyKeyUpsertOne) SetResponseStatus(v int) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *IdempotencyKeyUpsertOne) AddResponseStatus(v int) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertOne) UpdateResponseStatus() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *IdempotencyKeyUpsertOne) ClearResponseStatus() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearResponseStatus()
+	})
+}
+
+// SetResponseBody sets the "response_body" field.
+func (u *IdempotencyKeyUpsertOne) SetResponseBody(v []byte) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetResponseBody(v)
+	})
+}
+
+// UpdateResponseBody sets the "response_body" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertOne) UpdateResponseBody() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateResponseBody()
+	})
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (u *IdempotencyKeyUpsertOne) ClearResponseBody() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearResponseBody()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *IdempotencyKeyUpsertOne) SetLockedUntil(v time.Time) *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertOne) UpdateLockedUntil() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *IdempotencyKeyUpsertOne) ClearLockedUntil() *IdempotencyKeyUpsertOne {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *IdempotencyKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IdempotencyKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IdempotencyKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IdempotencyKeyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IdempotencyKeyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IdempotencyKeyCreateBulk is the builder for creating many IdempotencyKey entities in bulk.
+type IdempotencyKeyCreateBulk struct {
+	config
+	err      error
+	builders []*IdempotencyKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the IdempotencyKey entities in the database.
+func (ikcb *IdempotencyKeyCreateBulk) Save(ctx context.Context) ([]*IdempotencyKey, error) {
+	if ikcb.err != nil {
+		return nil, ikcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ikcb.builders))
+	nodes := make([]*IdempotencyKey, len(ikcb.builders))
+	mutators := make([]Mutator, len(ikcb.builders))
+	for i := range ikcb.builders {
+		func(i int, root context.Context) {
+			builder := ikcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdempotencyKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ikcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ikcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ikcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ikcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) SaveX(ctx context.Context) []*IdempotencyKey {
+	v, err := ikcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikcb *IdempotencyKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ikcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := ikcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IdempotencyKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IdempotencyKeyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ikcb *IdempotencyKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *IdempotencyKeyUpsertBulk {
+	ikcb.conflict = opts
+	return &IdempotencyKeyUpsertBulk{
+		create: ikcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ikcb *IdempotencyKeyCreateBulk) OnConflictColumns(columns ...string) *IdempotencyKeyUpsertBulk {
+	ikcb.conflict = append(ikcb.conflict, sql.ConflictColumns(columns...))
+	return &IdempotencyKeyUpsertBulk{
+		create: ikcb,
+	}
+}
+
+// IdempotencyKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of IdempotencyKey nodes.
+type IdempotencyKeyUpsertBulk struct {
+	create *IdempotencyKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(idempotencykey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IdempotencyKeyUpsertBulk) UpdateNewValues() *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(idempotencykey.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(idempotencykey.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Scope(); exists {
+				s.SetIgnore(idempotencykey.FieldScope)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(idempotencykey.FieldKey)
+			}
+			if _, exists := b.mutation.RequestHash(); exists {
+				s.SetIgnore(idempotencykey.FieldRequestHash)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(idempotencykey.FieldExpiresAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IdempotencyKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IdempotencyKeyUpsertBulk) Ignore() *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IdempotencyKeyUpsertBulk) DoNothing() *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IdempotencyKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *IdempotencyKeyUpsertBulk) Update(set func(*IdempotencyKeyUpsert)) *IdempotencyKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IdempotencyKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *IdempotencyKeyUpsertBulk) SetUpdateTime(v time.Time) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertBulk) UpdateUpdateTime() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *IdempotencyKeyUpsertBulk) SetResponseStatus(v int) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *IdempotencyKeyUpsertBulk) AddResponseStatus(v int) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertBulk) UpdateResponseStatus() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *IdempotencyKeyUpsertBulk) ClearResponseStatus() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearResponseStatus()
+	})
+}
+
+// SetResponseBody sets the "response_body" field.
+func (u *IdempotencyKeyUpsertBulk) SetResponseBody(v []byte) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetResponseBody(v)
+	})
+}
+
+// UpdateResponseBody sets the "response_body" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertBulk) UpdateResponseBody() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateResponseBody()
+	})
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (u *IdempotencyKeyUpsertBulk) ClearResponseBody() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearResponseBody()
+	})
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (u *IdempotencyKeyUpsertBulk) SetLockedUntil(v time.Time) *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.SetLockedUntil(v)
+	})
+}
+
+// UpdateLockedUntil sets the "locked_until" field to the value that was provided on create.
+func (u *IdempotencyKeyUpsertBulk) UpdateLockedUntil() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.UpdateLockedUntil()
+	})
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (u *IdempotencyKeyUpsertBulk) ClearLockedUntil() *IdempotencyKeyUpsertBulk {
+	return u.Update(func(s *IdempotencyKeyUpsert) {
+		s.ClearLockedUntil()
+	})
+}
+
+// Exec executes the query.
+func (u *IdempotencyKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IdempotencyKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IdempotencyKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IdempotencyKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// TotalDue holds the value of the "total_due" field.
+	TotalDue money.Amount `json:"total_due,omitempty"`
+	// MinimumPayment holds the value of the "minimum_payment" field.
+	MinimumPayment money.Amount `json:"minimum_payment,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate time.Time `json:"due_date,omitempty"`
+	// PaidAmount holds the value of the "paid_amount" field.
+	PaidAmount money.Amount `json:"paid_amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status invoice.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges        InvoiceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// LateFee holds the value of the late_fee edge.
+	LateFee *Accrual `json:"late_fee,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*InvoicePayment `json:"payments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// LateFeeOrErr returns the LateFee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoiceEdges) LateFeeOrErr() (*Accrual, error) {
+	if e.LateFee != nil {
+		return e.LateFee, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: accrual.Label}
+	}
+	return nil, &NotLoadedError{edge: "late_fee"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) PaymentsOrErr() ([]*InvoicePayment, error) {
+	if e.loadedTypes[2] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldID, invoice.FieldAccountID, invoice.FieldTotalDue, invoice.FieldMinimumPayment, invoice.FieldPaidAmount:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldStatus:
+			values[i] = new(sql.NullString)
+		case invoice.FieldCreateTime, invoice.FieldUpdateTime, invoice.FieldPeriodStart, invoice.FieldPeriodEnd, invoice.FieldDueDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (i *Invoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invoice.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case invoice.FieldCreateTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[j])
+			} else if value.Valid {
+				i.CreateTime = value.Time
+			}
+		case invoice.FieldUpdateTime:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[j])
+			} else if value.Valid {
+				i.UpdateTime = value.Time
+			}
+		case invoice.FieldAccountID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[j])
+			} else if value.Valid {
+				i.AccountID = int(value.Int64)
+			}
+		case invoice.FieldPeriodStart:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[j])
+			} else if value.Valid {
+				i.PeriodStart = value.Time
+			}
+		case invoice.FieldPeriodEnd:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[j])
+			} else if value.Valid {
+				i.PeriodEnd = value.Time
+			}
+		case invoice.FieldTotalDue:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_due", values[j])
+			} else if value.Valid {
+				i.TotalDue = money.Amount(value.Int64)
+			}
+		case invoice.FieldMinimumPayment:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minimum_payment", values[j])
+			} else if value.Valid {
+				i.MinimumPayment = money.Amount(value.Int64)
+			}
+		case invoice.FieldDueDate:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[j])
+			} else if value.Valid {
+				i.DueDate = value.Time
+			}
+		case invoice.FieldPaidAmount:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_amount", values[j])
+			} else if value.Valid {
+				i.PaidAmount = money.Amount(value.Int64)
+			}
+		case invoice.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = invoice.Status(value.String)
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invoice.
+// This includes values selected through modifiers, order, etc.
+func (i *Invoice) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the Invoice entity.
+func (i *Invoice) QueryAccount() *AccountQuery {
+	return NewInvoiceClient(i.config).QueryAccount(i)
+}
+
+// QueryLateFee queries the "late_fee" edge of the Invoice entity.
+func (i *Invoice) QueryLateFee() *AccrualQuery {
+	return NewInvoiceClient(i.config).QueryLateFee(i)
+}
+
+// QueryPayments queries the "payments" edge of the Invoice entity.
+func (i *Invoice) QueryPayments() *InvoicePaymentQuery {
+	return NewInvoiceClient(i.config).QueryPayments(i)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invoice) Update() *InvoiceUpdateOne {
+	return NewInvoiceClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invoice) Unwrap() *Invoice {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(i.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(i.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(i.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(i.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("total_due=")
+	builder.WriteString(fmt.Sprintf("%v", i.TotalDue))
+	builder.WriteString(", ")
+	builder.WriteString("minimum_payment=")
+	builder.WriteString(fmt.Sprintf("%v", i.MinimumPayment))
+	builder.WriteString(", ")
+	builder.WriteString("due_date=")
+	builder.WriteString(i.DueDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("paid_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.PaidAmount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
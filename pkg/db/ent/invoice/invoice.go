@@ -0,0 +1,238 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"fmt"
+	"time"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldTotalDue holds the string denoting the total_due field in the database.
+	FieldTotalDue = "total_due"
+	// FieldMinimumPayment holds the string denoting the minimum_payment field in the database.
+	FieldMinimumPayment = "minimum_payment"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
+	// FieldPaidAmount holds the string denoting the paid_amount field in the database.
+	FieldPaidAmount = "paid_amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeLateFee holds the string denoting the late_fee edge name in mutations.
+	EdgeLateFee = "late_fee"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "invoices"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// LateFeeTable is the table that holds the late_fee relation/edge.
+	LateFeeTable = "accruals"
+	// LateFeeInverseTable is the table name for the Accrual entity.
+	// It exists in this package in order to avoid circular dependency with the "accrual" package.
+	LateFeeInverseTable = "accruals"
+	// LateFeeColumn is the table column denoting the late_fee relation/edge.
+	LateFeeColumn = "invoice_id"
+	// PaymentsTable is the table that holds the payments relation/edge.
+	PaymentsTable = "invoice_payments"
+	// PaymentsInverseTable is the table name for the InvoicePayment entity.
+	// It exists in this package in order to avoid circular dependency with the "invoicepayment" package.
+	PaymentsInverseTable = "invoice_payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "invoice_id"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldAccountID,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldTotalDue,
+	FieldMinimumPayment,
+	FieldDueDate,
+	FieldPaidAmount,
+	FieldStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultPaidAmount holds the default value on creation for the "paid_amount" field.
+	DefaultPaidAmount money.Amount
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen       Status = "open"
+	StatusPaid       Status = "paid"
+	StatusSuperseded Status = "superseded"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusPaid, StatusSuperseded:
+		return nil
+	default:
+		return fmt.Errorf("invoice: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Invoice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByTotalDue orders the results by the total_due field.
+func ByTotalDue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalDue, opts...).ToFunc()
+}
+
+// ByMinimumPayment orders the results by the minimum_payment field.
+func ByMinimumPayment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinimumPayment, opts...).ToFunc()
+}
+
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
+// ByPaidAmount orders the results by the paid_amount field.
+func ByPaidAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLateFeeField orders the results by late_fee field.
+func ByLateFeeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLateFeeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentsCount orders the results by payments count.
+func ByPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentsStep(), opts...)
+	}
+}
+
+// ByPayments orders the results by payments terms.
+func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
+func newLateFeeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LateFeeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, LateFeeTable, LateFeeColumn),
+	)
+}
+func newPaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+	)
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAccountID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// TotalDue applies equality check predicate on the "total_due" field. It's identical to TotalDueEQ.
+func TotalDue(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldEQ(FieldTotalDue, vc))
+}
+
+// MinimumPayment applies equality check predicate on the "minimum_payment" field. It's identical to MinimumPaymentEQ.
+func MinimumPayment(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldEQ(FieldMinimumPayment, vc))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDueDate, v))
+}
+
+// PaidAmount applies equality check predicate on the "paid_amount" field. It's identical to PaidAmountEQ.
+func PaidAmount(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldEQ(FieldPaidAmount, vc))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...int) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// TotalDueEQ applies the EQ predicate on the "total_due" field.
+func TotalDueEQ(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldEQ(FieldTotalDue, vc))
+}
+
+// TotalDueNEQ applies the NEQ predicate on the "total_due" field.
+func TotalDueNEQ(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldNEQ(FieldTotalDue, vc))
+}
+
+// TotalDueIn applies the In predicate on the "total_due" field.
+func TotalDueIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Invoice(sql.FieldIn(FieldTotalDue, v...))
+}
+
+// TotalDueNotIn applies the NotIn predicate on the "total_due" field.
+func TotalDueNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Invoice(sql.FieldNotIn(FieldTotalDue, v...))
+}
+
+// TotalDueGT applies the GT predicate on the "total_due" field.
+func TotalDueGT(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldGT(FieldTotalDue, vc))
+}
+
+// TotalDueGTE applies the GTE predicate on the "total_due" field.
+func TotalDueGTE(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldGTE(FieldTotalDue, vc))
+}
+
+// TotalDueLT applies the LT predicate on the "total_due" field.
+func TotalDueLT(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldLT(FieldTotalDue, vc))
+}
+
+// TotalDueLTE applies the LTE predicate on the "total_due" field.
+func TotalDueLTE(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldLTE(FieldTotalDue, vc))
+}
+
+// MinimumPaymentEQ applies the EQ predicate on the "minimum_payment" field.
+func MinimumPaymentEQ(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldEQ(FieldMinimumPayment, vc))
+}
+
+// MinimumPaymentNEQ applies the NEQ predicate on the "minimum_payment" field.
+func MinimumPaymentNEQ(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldNEQ(FieldMinimumPayment, vc))
+}
+
+// MinimumPaymentIn applies the In predicate on the "minimum_payment" field.
+func MinimumPaymentIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Invoice(sql.FieldIn(FieldMinimumPayment, v...))
+}
+
+// MinimumPaymentNotIn applies the NotIn predicate on the "minimum_payment" field.
+func MinimumPaymentNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Invoice(sql.FieldNotIn(FieldMinimumPayment, v...))
+}
+
+// MinimumPaymentGT applies the GT predicate on the "minimum_payment" field.
+func MinimumPaymentGT(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldGT(FieldMinimumPayment, vc))
+}
+
+// MinimumPaymentGTE applies the GTE predicate on the "minimum_payment" field.
+func MinimumPaymentGTE(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldGTE(FieldMinimumPayment, vc))
+}
+
+// MinimumPaymentLT applies the LT predicate on the "minimum_payment" field.
+func MinimumPaymentLT(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldLT(FieldMinimumPayment, vc))
+}
+
+// MinimumPaymentLTE applies the LTE predicate on the "minimum_payment" field.
+func MinimumPaymentLTE(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldLTE(FieldMinimumPayment, vc))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldDueDate, v))
+}
+
+// PaidAmountEQ applies the EQ predicate on the "paid_amount" field.
+func PaidAmountEQ(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldEQ(FieldPaidAmount, vc))
+}
+
+// PaidAmountNEQ applies the NEQ predicate on the "paid_amount" field.
+func PaidAmountNEQ(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldNEQ(FieldPaidAmount, vc))
+}
+
+// PaidAmountIn applies the In predicate on the "paid_amount" field.
+func PaidAmountIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Invoice(sql.FieldIn(FieldPaidAmount, v...))
+}
+
+// PaidAmountNotIn applies the NotIn predicate on the "paid_amount" field.
+func PaidAmountNotIn(vs ...money.Amount) predicate.Invoice {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Invoice(sql.FieldNotIn(FieldPaidAmount, v...))
+}
+
+// PaidAmountGT applies the GT predicate on the "paid_amount" field.
+func PaidAmountGT(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldGT(FieldPaidAmount, vc))
+}
+
+// PaidAmountGTE applies the GTE predicate on the "paid_amount" field.
+func PaidAmountGTE(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldGTE(FieldPaidAmount, vc))
+}
+
+// PaidAmountLT applies the LT predicate on the "paid_amount" field.
+func PaidAmountLT(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldLT(FieldPaidAmount, vc))
+}
+
+// PaidAmountLTE applies the LTE predicate on the "paid_amount" field.
+func PaidAmountLTE(v money.Amount) predicate.Invoice {
+	vc := int64(v)
+	return predicate.Invoice(sql.FieldLTE(FieldPaidAmount, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLateFee applies the HasEdge predicate on the "late_fee" edge.
+func HasLateFee() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, LateFeeTable, LateFeeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLateFeeWith applies the HasEdge predicate on the "late_fee" edge with a given conditions (other predicates).
+func HasLateFeeWith(preds ...predicate.Accrual) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newLateFeeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayments applies the HasEdge predicate on the "payments" edge.
+func HasPayments() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentsWith applies the HasEdge predicate on the "payments" edge with a given conditions (other predicates).
+func HasPaymentsWith(preds ...predicate.InvoicePayment) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := newPaymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.NotPredicates(p))
+}
@@ -0,0 +1,894 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ic *InvoiceCreate) SetCreateTime(t time.Time) *InvoiceCreate {
+	ic.mutation.SetCreateTime(t)
+	return ic
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCreateTime(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetCreateTime(*t)
+	}
+	return ic
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ic *InvoiceCreate) SetUpdateTime(t time.Time) *InvoiceCreate {
+	ic.mutation.SetUpdateTime(t)
+	return ic
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableUpdateTime(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetUpdateTime(*t)
+	}
+	return ic
+}
+
+// SetAccountID sets the "account_id" field.
+func (ic *InvoiceCreate) SetAccountID(i int) *InvoiceCreate {
+	ic.mutation.SetAccountID(i)
+	return ic
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (ic *InvoiceCreate) SetPeriodStart(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPeriodStart(t)
+	return ic
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (ic *InvoiceCreate) SetPeriodEnd(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPeriodEnd(t)
+	return ic
+}
+
+// SetTotalDue sets the "total_due" field.
+func (ic *InvoiceCreate) SetTotalDue(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetTotalDue(m)
+	return ic
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (ic *InvoiceCreate) SetMinimumPayment(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetMinimumPayment(m)
+	return ic
+}
+
+// SetDueDate sets the "due_date" field.
+func (ic *InvoiceCreate) SetDueDate(t time.Time) *InvoiceCreate {
+	ic.mutation.SetDueDate(t)
+	return ic
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (ic *InvoiceCreate) SetPaidAmount(m money.Amount) *InvoiceCreate {
+	ic.mutation.SetPaidAmount(m)
+	return ic
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePaidAmount(m *money.Amount) *InvoiceCreate {
+	if m != nil {
+		ic.SetPaidAmount(*m)
+	}
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InvoiceCreate) SetStatus(i invoice.Status) *InvoiceCreate {
+	ic.mutation.SetStatus(i)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableStatus(i *invoice.Status) *InvoiceCreate {
+	if i != nil {
+		ic.SetStatus(*i)
+	}
+	return ic
+}
+
+// SetID sets the "id" field.
+func (ic *InvoiceCreate) SetID(i int) *InvoiceCreate {
+	ic.mutation.SetID(i)
+	return ic
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (ic *InvoiceCreate) SetAccount(a *Account) *InvoiceCreate {
+	return ic.SetAccountID(a.ID)
+}
+
+// SetLateFeeID sets the "late_fee" edge to the Accrual entity by ID.
+func (ic *InvoiceCreate) SetLateFeeID(id int) *InvoiceCreate {
+	ic.mutation.SetLateFeeID(id)
+	return ic
+}
+
+// SetNillableLateFeeID sets the "late_fee" edge to the Accrual entity by ID if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableLateFeeID(id *int) *InvoiceCreate {
+	if id != nil {
+		ic = ic.SetLateFeeID(*id)
+	}
+	return ic
+}
+
+// SetLateFee sets the "late_fee" edge to the Accrual entity.
+func (ic *InvoiceCreate) SetLateFee(a *Accrual) *InvoiceCreate {
+	return ic.SetLateFeeID(a.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the InvoicePayment entity by IDs.
+func (ic *InvoiceCreate) AddPaymentIDs(ids ...int) *InvoiceCreate {
+	ic.mutation.AddPaymentIDs(ids...)
+	return ic
+}
+
+// AddPayments adds the "payments" edges to the InvoicePayment entity.
+func (ic *InvoiceCreate) AddPayments(i ...*InvoicePayment) *InvoiceCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddPaymentIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (ic *InvoiceCreate) Mutation() *InvoiceMutation {
+	return ic.mutation
+}
+
+// Save creates the Invoice in the database.
+func (ic *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvoiceCreate) defaults() {
+	if _, ok := ic.mutation.CreateTime(); !ok {
+		v := invoice.DefaultCreateTime()
+		ic.mutation.SetCreateTime(v)
+	}
+	if _, ok := ic.mutation.UpdateTime(); !ok {
+		v := invoice.DefaultUpdateTime()
+		ic.mutation.SetUpdateTime(v)
+	}
+	if _, ok := ic.mutation.PaidAmount(); !ok {
+		v := invoice.DefaultPaidAmount
+		ic.mutation.SetPaidAmount(v)
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invoice.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvoiceCreate) check() error {
+	if _, ok := ic.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Invoice.create_time"`)}
+	}
+	if _, ok := ic.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Invoice.update_time"`)}
+	}
+	if _, ok := ic.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Invoice.account_id"`)}
+	}
+	if _, ok := ic.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "Invoice.period_start"`)}
+	}
+	if _, ok := ic.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "Invoice.period_end"`)}
+	}
+	if _, ok := ic.mutation.TotalDue(); !ok {
+		return &ValidationError{Name: "total_due", err: errors.New(`ent: missing required field "Invoice.total_due"`)}
+	}
+	if _, ok := ic.mutation.MinimumPayment(); !ok {
+		return &ValidationError{Name: "minimum_payment", err: errors.New(`ent: missing required field "Invoice.minimum_payment"`)}
+	}
+	if _, ok := ic.mutation.DueDate(); !ok {
+		return &ValidationError{Name: "due_date", err: errors.New(`ent: missing required field "Invoice.due_date"`)}
+	}
+	if _, ok := ic.mutation.PaidAmount(); !ok {
+		return &ValidationError{Name: "paid_amount", err: errors.New(`ent: missing required field "Invoice.paid_amount"`)}
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if len(ic.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Invoice.account"`)}
+	}
+	return nil
+}
+
+func (ic *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ic.conflict
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ic.mutation.CreateTime(); ok {
+		_spec.SetField(invoice.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ic.mutation.UpdateTime(); ok {
+		_spec.SetField(invoice.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ic.mutation.PeriodStart(); ok {
+		_spec.SetField(invoice.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := ic.mutation.PeriodEnd(); ok {
+		_spec.SetField(invoice.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := ic.mutation.TotalDue(); ok {
+		_spec.SetField(invoice.FieldTotalDue, field.TypeInt64, value)
+		_node.TotalDue = value
+	}
+	if value, ok := ic.mutation.MinimumPayment(); ok {
+		_spec.SetField(invoice.FieldMinimumPayment, field.TypeInt64, value)
+		_node.MinimumPayment = value
+	}
+	if value, ok := ic.mutation.DueDate(); ok {
+		_spec.SetField(invoice.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = value
+	}
+	if value, ok := ic.mutation.PaidAmount(); ok {
+		_spec.SetField(invoice.FieldPaidAmount, field.TypeInt64, value)
+		_node.PaidAmount = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := ic.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.AccountTable,
+			Columns: []string{invoice.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.LateFeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   invoice.LateFeeTable,
+			Columns: []string{invoice.LateFeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *InvoiceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertOne {
+	ic.conflict = opts
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *InvoiceCreate) OnConflictColumns(columns ...string) *InvoiceUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InvoiceUpsertOne is the builder for "upsert"-ing
+	//  one Invoice node.
+	InvoiceUpsertOne struct {
+		create *InvoiceCreate
+	}
+
+	// InvoiceUpsert is the "OnConflict" setter.
+	InvoiceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *InvoiceUpsert) SetUpdateTime(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateUpdateTime() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldUpdateTime)
+	return u
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (u *InvoiceUpsert) SetPaidAmount(v money.Amount) *InvoiceUpsert {
+	u.Set(invoice.FieldPaidAmount, v)
+	return u
+}
+
+// UpdatePaidAmount sets the "paid_amount" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePaidAmount() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPaidAmount)
+	return u
+}
+
+// AddPaidAmount adds v to the "paid_amount" field.
+func (u *InvoiceUpsert) AddPaidAmount(v money.Amount) *InvoiceUpsert {
+	u.Add(invoice.FieldPaidAmount, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsert) SetStatus(v invoice.Status) *InvoiceUpsert {
+	u.Set(invoice.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateStatus() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertOne) UpdateNewValues() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoice.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(invoice.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(invoice.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.PeriodStart(); exists {
+			s.SetIgnore(invoice.FieldPeriodStart)
+		}
+		if _, exists := u.create.mutation.PeriodEnd(); exists {
+			s.SetIgnore(invoice.FieldPeriodEnd)
+		}
+		if _, exists := u.create.mutation.TotalDue(); exists {
+			s.SetIgnore(invoice.FieldTotalDue)
+		}
+		if _, exists := u.create.mutation.MinimumPayment(); exists {
+			s.SetIgnore(invoice.FieldMinimumPayment)
+		}
+		if _, exists := u.create.mutation.DueDate(); exists {
+			s.SetIgnore(invoice.FieldDueDate)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceUpsertOne) Ignore() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertOne) DoNothing() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertOne) Update(set func(*InvoiceUpsert)) *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *InvoiceUpsertOne) SetUpdateTime(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateUpdateTime() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (u *InvoiceUpsertOne) SetPaidAmount(v money.Amount) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaidAmount(v)
+	})
+}
+
+// AddPaidAmount adds v to the "paid_amount" field.
+func (u *InvoiceUpsertOne) AddPaidAmount(v money.Amount) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddPaidAmount(v)
+	})
+}
+
+// UpdatePaidAmount sets the "paid_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePaidAmount() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaidAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsertOne) SetStatus(v invoice.Status) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateStatus() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invoice entities in the database.
+func (icb *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invoice, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *InvoiceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertBulk {
+	icb.conflict = opts
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *InvoiceCreateBulk) OnConflictColumns(columns ...string) *InvoiceUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertBulk{
+		create: icb,
+	}
+}
+
+// InvoiceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invoice nodes.
+type InvoiceUpsertBulk struct {
+	create *InvoiceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoice.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(invoice.FieldCreateTime)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(invoice.FieldAccountID)
+			}
+			if _, exists := b.mutation.PeriodStart(); exists {
+				s.SetIgnore(invoice.FieldPeriodStart)
+			}
+			if _, exists := b.mutation.PeriodEnd(); exists {
+				s.SetIgnore(invoice.FieldPeriodEnd)
+			}
+			if _, exists := b.mutation.TotalDue(); exists {
+				s.SetIgnore(invoice.FieldTotalDue)
+			}
+			if _, exists := b.mutation.MinimumPayment(); exists {
+				s.SetIgnore(invoice.FieldMinimumPayment)
+			}
+			if _, exists := b.mutation.DueDate(); exists {
+				s.SetIgnore(invoice.FieldDueDate)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) Ignore() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertBulk) Update(set func(*InvoiceUpsert)) *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *InvoiceUpsertBulk) SetUpdateTime(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateUpdateTime() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (u *InvoiceUpsertBulk) SetPaidAmount(v money.Amount) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaidAmount(v)
+	})
+}
+
+// AddPaidAmount adds v to the "paid_amount" field.
+func (u *InvoiceUpsertBulk) AddPaidAmount(v money.Amount) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddPaidAmount(v)
+	})
+}
+
+// UpdatePaidAmount sets the "paid_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePaidAmount() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaidAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InvoiceUpsertBulk) SetStatus(v invoice.Status) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateStatus() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InvoiceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (iu *InvoiceUpdate) SetUpdateTime(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetUpdateTime(t)
+	return iu
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (iu *InvoiceUpdate) SetPaidAmount(m money.Amount) *InvoiceUpdate {
+	iu.mutation.ResetPaidAmount()
+	iu.mutation.SetPaidAmount(m)
+	return iu
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePaidAmount(m *money.Amount) *InvoiceUpdate {
+	if m != nil {
+		iu.SetPaidAmount(*m)
+	}
+	return iu
+}
+
+// AddPaidAmount adds m to the "paid_amount" field.
+func (iu *InvoiceUpdate) AddPaidAmount(m money.Amount) *InvoiceUpdate {
+	iu.mutation.AddPaidAmount(m)
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvoiceUpdate) SetStatus(i invoice.Status) *InvoiceUpdate {
+	iu.mutation.SetStatus(i)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableStatus(i *invoice.Status) *InvoiceUpdate {
+	if i != nil {
+		iu.SetStatus(*i)
+	}
+	return iu
+}
+
+// SetLateFeeID sets the "late_fee" edge to the Accrual entity by ID.
+func (iu *InvoiceUpdate) SetLateFeeID(id int) *InvoiceUpdate {
+	iu.mutation.SetLateFeeID(id)
+	return iu
+}
+
+// SetNillableLateFeeID sets the "late_fee" edge to the Accrual entity by ID if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableLateFeeID(id *int) *InvoiceUpdate {
+	if id != nil {
+		iu = iu.SetLateFeeID(*id)
+	}
+	return iu
+}
+
+// SetLateFee sets the "late_fee" edge to the Accrual entity.
+func (iu *InvoiceUpdate) SetLateFee(a *Accrual) *InvoiceUpdate {
+	return iu.SetLateFeeID(a.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the InvoicePayment entity by IDs.
+func (iu *InvoiceUpdate) AddPaymentIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.AddPaymentIDs(ids...)
+	return iu
+}
+
+// AddPayments adds the "payments" edges to the InvoicePayment entity.
+func (iu *InvoiceUpdate) AddPayments(i ...*InvoicePayment) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddPaymentIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return iu.mutation
+}
+
+// ClearLateFee clears the "late_fee" edge to the Accrual entity.
+func (iu *InvoiceUpdate) ClearLateFee() *InvoiceUpdate {
+	iu.mutation.ClearLateFee()
+	return iu
+}
+
+// ClearPayments clears all "payments" edges to the InvoicePayment entity.
+func (iu *InvoiceUpdate) ClearPayments() *InvoiceUpdate {
+	iu.mutation.ClearPayments()
+	return iu
+}
+
+// RemovePaymentIDs removes the "payments" edge to InvoicePayment entities by IDs.
+func (iu *InvoiceUpdate) RemovePaymentIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.RemovePaymentIDs(ids...)
+	return iu
+}
+
+// RemovePayments removes "payments" edges to InvoicePayment entities.
+func (iu *InvoiceUpdate) RemovePayments(i ...*InvoicePayment) *InvoiceUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemovePaymentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	iu.defaults()
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InvoiceUpdate) defaults() {
+	if _, ok := iu.mutation.UpdateTime(); !ok {
+		v := invoice.UpdateDefaultUpdateTime()
+		iu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvoiceUpdate) check() error {
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if iu.mutation.AccountCleared() && len(iu.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iu *InvoiceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceUpdate {
+	iu.modifiers = append(iu.modifiers, modifiers...)
+	return iu
+}
+
+func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.UpdateTime(); ok {
+		_spec.SetField(invoice.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.PaidAmount(); ok {
+		_spec.SetField(invoice.FieldPaidAmount, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedPaidAmount(); ok {
+		_spec.AddField(invoice.FieldPaidAmount, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if iu.mutation.LateFeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   invoice.LateFeeTable,
+			Columns: []string{invoice.LateFeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.LateFeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   invoice.LateFeeTable,
+			Columns: []string{invoice.LateFeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !iu.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InvoiceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (iuo *InvoiceUpdateOne) SetUpdateTime(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetUpdateTime(t)
+	return iuo
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (iuo *InvoiceUpdateOne) SetPaidAmount(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.ResetPaidAmount()
+	iuo.mutation.SetPaidAmount(m)
+	return iuo
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePaidAmount(m *money.Amount) *InvoiceUpdateOne {
+	if m != nil {
+		iuo.SetPaidAmount(*m)
+	}
+	return iuo
+}
+
+// AddPaidAmount adds m to the "paid_amount" field.
+func (iuo *InvoiceUpdateOne) AddPaidAmount(m money.Amount) *InvoiceUpdateOne {
+	iuo.mutation.AddPaidAmount(m)
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvoiceUpdateOne) SetStatus(i invoice.Status) *InvoiceUpdateOne {
+	iuo.mutation.SetStatus(i)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableStatus(i *invoice.Status) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetStatus(*i)
+	}
+	return iuo
+}
+
+// SetLateFeeID sets the "late_fee" edge to the Accrual entity by ID.
+func (iuo *InvoiceUpdateOne) SetLateFeeID(id int) *InvoiceUpdateOne {
+	iuo.mutation.SetLateFeeID(id)
+	return iuo
+}
+
+// SetNillableLateFeeID sets the "late_fee" edge to the Accrual entity by ID if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableLateFeeID(id *int) *InvoiceUpdateOne {
+	if id != nil {
+		iuo = iuo.SetLateFeeID(*id)
+	}
+	return iuo
+}
+
+// SetLateFee sets the "late_fee" edge to the Accrual entity.
+func (iuo *InvoiceUpdateOne) SetLateFee(a *Accrual) *InvoiceUpdateOne {
+	return iuo.SetLateFeeID(a.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the InvoicePayment entity by IDs.
+func (iuo *InvoiceUpdateOne) AddPaymentIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.AddPaymentIDs(ids...)
+	return iuo
+}
+
+// AddPayments adds the "payments" edges to the InvoicePayment entity.
+func (iuo *InvoiceUpdateOne) AddPayments(i ...*InvoicePayment) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddPaymentIDs(ids...)
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return iuo.mutation
+}
+
+// ClearLateFee clears the "late_fee" edge to the Accrual entity.
+func (iuo *InvoiceUpdateOne) ClearLateFee() *InvoiceUpdateOne {
+	iuo.mutation.ClearLateFee()
+	return iuo
+}
+
+// ClearPayments clears all "payments" edges to the InvoicePayment entity.
+func (iuo *InvoiceUpdateOne) ClearPayments() *InvoiceUpdateOne {
+	iuo.mutation.ClearPayments()
+	return iuo
+}
+
+// RemovePaymentIDs removes the "payments" edge to InvoicePayment entities by IDs.
+func (iuo *InvoiceUpdateOne) RemovePaymentIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.RemovePaymentIDs(ids...)
+	return iuo
+}
+
+// RemovePayments removes "payments" edges to InvoicePayment entities.
+func (iuo *InvoiceUpdateOne) RemovePayments(i ...*InvoicePayment) *InvoiceUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemovePaymentIDs(ids...)
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iuo *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (iuo *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	iuo.defaults()
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InvoiceUpdateOne) defaults() {
+	if _, ok := iuo.mutation.UpdateTime(); !ok {
+		v := invoice.UpdateDefaultUpdateTime()
+		iuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvoiceUpdateOne) check() error {
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if iuo.mutation.AccountCleared() && len(iuo.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Invoice.account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iuo *InvoiceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoiceUpdateOne {
+	iuo.modifiers = append(iuo.modifiers, modifiers...)
+	return iuo
+}
+
+func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.UpdateTime(); ok {
+		_spec.SetField(invoice.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.PaidAmount(); ok {
+		_spec.SetField(invoice.FieldPaidAmount, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedPaidAmount(); ok {
+		_spec.AddField(invoice.FieldPaidAmount, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if iuo.mutation.LateFeeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   invoice.LateFeeTable,
+			Columns: []string{invoice.LateFeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.LateFeeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   invoice.LateFeeTable,
+			Columns: []string{invoice.LateFeeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !iuo.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invoice.PaymentsTable,
+			Columns: []string{invoice.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iuo.modifiers...)
+	_node = &Invoice{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InvoicePayment is the model entity for the InvoicePayment schema.
+type InvoicePayment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID int `json:"transaction_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount,omitempty"`
+	// ReversedAt holds the value of the "reversed_at" field.
+	ReversedAt *time.Time `json:"reversed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoicePaymentQuery when eager-loading is set.
+	Edges        InvoicePaymentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InvoicePaymentEdges holds the relations/edges for other nodes in the graph.
+type InvoicePaymentEdges struct {
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoicePaymentEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.Invoice != nil {
+		return e.Invoice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: invoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvoicePaymentEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoicePayment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoicepayment.FieldID, invoicepayment.FieldInvoiceID, invoicepayment.FieldTransactionID, invoicepayment.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case invoicepayment.FieldCreateTime, invoicepayment.FieldUpdateTime, invoicepayment.FieldReversedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoicePayment fields.
+func (ip *InvoicePayment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoicepayment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ip.ID = int(value.Int64)
+		case invoicepayment.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ip.CreateTime = value.Time
+			}
+		case invoicepayment.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ip.UpdateTime = value.Time
+			}
+		case invoicepayment.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				ip.InvoiceID = int(value.Int64)
+			}
+		case invoicepayment.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				ip.TransactionID = int(value.Int64)
+			}
+		case invoicepayment.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ip.Amount = money.Amount(value.Int64)
+			}
+		case invoicepayment.FieldReversedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reversed_at", values[i])
+			} else if value.Valid {
+				ip.ReversedAt = new(time.Time)
+				*ip.ReversedAt = value.Time
+			}
+		default:
+			ip.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoicePayment.
+// This includes values selected through modifiers, order, etc.
+func (ip *InvoicePayment) Value(name string) (ent.Value, error) {
+	return ip.selectValues.Get(name)
+}
+
+// QueryInvoice queries the "invoice" edge of the InvoicePayment entity.
+func (ip *InvoicePayment) QueryInvoice() *InvoiceQuery {
+	return NewInvoicePaymentClient(ip.config).QueryInvoice(ip)
+}
+
+// QueryTransaction queries the "transaction" edge of the InvoicePayment entity.
+func (ip *InvoicePayment) QueryTransaction() *TransactionQuery {
+	return NewInvoicePaymentClient(ip.config).QueryTransaction(ip)
+}
+
+// Update returns a builder for updating this InvoicePayment.
+// Note that you need to call InvoicePayment.Unwrap() before calling this method if this InvoicePayment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ip *InvoicePayment) Update() *InvoicePaymentUpdateOne {
+	return NewInvoicePaymentClient(ip.config).UpdateOne(ip)
+}
+
+// Unwrap unwraps the InvoicePayment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ip *InvoicePayment) Unwrap() *InvoicePayment {
+	_tx, ok := ip.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoicePayment is not a transactional entity")
+	}
+	ip.config.driver = _tx.drv
+	return ip
+}
+
+// String implements the fmt.Stringer.
+func (ip *InvoicePayment) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoicePayment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ip.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ip.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ip.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", ip.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(fmt.Sprintf("%v", ip.TransactionID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ip.Amount))
+	builder.WriteString(", ")
+	if v := ip.ReversedAt; v != nil {
+		builder.WriteString("reversed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoicePayments is a parsable slice of InvoicePayment.
+type InvoicePayments []*InvoicePayment
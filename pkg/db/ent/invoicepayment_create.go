@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoicePaymentCreate is the builder for creating a InvoicePayment entity.
+type InvoicePaymentCreate struct {
+	config
+	mutation *InvoicePaymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (ipc *InvoicePaymentCreate) SetCreateTime(t time.Time) *InvoicePaymentCreate {
+	ipc.mutation.SetCreateTime(t)
+	return ipc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (ipc *InvoicePaymentCreate) SetNillableCreateTime(t *time.Time) *InvoicePaymentCreate {
+	if t != nil {
+		ipc.SetCreateTime(*t)
+	}
+	return ipc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ipc *InvoicePaymentCreate) SetUpdateTime(t time.Time) *InvoicePaymentCreate {
+	ipc.mutation.SetUpdateTime(t)
+	return ipc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (ipc *InvoicePaymentCreate) SetNillableUpdateTime(t *time.Time) *InvoicePaymentCreate {
+	if t != nil {
+		ipc.SetUpdateTime(*t)
+	}
+	return ipc
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (ipc *InvoicePaymentCreate) SetInvoiceID(i int) *InvoicePaymentCreate {
+	ipc.mutation.SetInvoiceID(i)
+	return ipc
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (ipc *InvoicePaymentCreate) SetTransactionID(i int) *InvoicePaymentCreate {
+	ipc.mutation.SetTransactionID(i)
+	return ipc
+}
+
+// SetAmount sets the "amount" field.
+func (ipc *InvoicePaymentCreate) SetAmount(m money.Amount) *InvoicePaymentCreate {
+	ipc.mutation.SetAmount(m)
+	return ipc
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (ipc *InvoicePaymentCreate) SetReversedAt(t time.Time) *InvoicePaymentCreate {
+	ipc.mutation.SetReversedAt(t)
+	return ipc
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (ipc *InvoicePaymentCreate) SetNillableReversedAt(t *time.Time) *InvoicePaymentCreate {
+	if t != nil {
+		ipc.SetReversedAt(*t)
+	}
+	return ipc
+}
+
+// SetID sets the "id" field.
+func (ipc *InvoicePaymentCreate) SetID(i int) *InvoicePaymentCreate {
+	ipc.mutation.SetID(i)
+	return ipc
+}
+
+// SetInvoice sets the "invoice" edge to the Invoice entity.
+func (ipc *InvoicePaymentCreate) SetInvoice(i *Invoice) *InvoicePaymentCreate {
+	return ipc.SetInvoiceID(i.ID)
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (ipc *InvoicePaymentCreate) SetTransaction(t *Transaction) *InvoicePaymentCreate {
+	return ipc.SetTransactionID(t.ID)
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (ipc *InvoicePaymentCreate) Mutation() *InvoicePaymentMutation {
+	return ipc.mutation
+}
+
+// Save creates the InvoicePayment in the database.
+func (ipc *InvoicePaymentCreate) Save(ctx context.Context) (*InvoicePayment, error) {
+	ipc.defaults()
+	return withHooks(ctx, ipc.sqlSave, ipc.mutation, ipc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ipc *InvoicePaymentCreate) SaveX(ctx context.Context) *InvoicePayment {
+	v, err := ipc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ipc *InvoicePaymentCreate) Exec(ctx context.Context) error {
+	_, err := ipc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipc *InvoicePaymentCreate) ExecX(ctx context.Context) {
+	if err := ipc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipc *InvoicePaymentCreate) defaults() {
+	if _, ok := ipc.mutation.CreateTime(); !ok {
+		v := invoicepayment.DefaultCreateTime()
+		ipc.mutation.SetCreateTime(v)
+	}
+	if _, ok := ipc.mutation.UpdateTime(); !ok {
+		v := invoicepayment.DefaultUpdateTime()
+		ipc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipc *InvoicePaymentCreate) check() error {
+	if _, ok := ipc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "InvoicePayment.create_time"`)}
+	}
+	if _, ok := ipc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "InvoicePayment.update_time"`)}
+	}
+	if _, ok := ipc.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoicePayment.invoice_id"`)}
+	}
+	if _, ok := ipc.mutation.TransactionID(); !ok {
+		return &ValidationError{Name: "transaction_id", err: errors.New(`ent: missing required field "InvoicePayment.transaction_id"`)}
+	}
+	if _, ok := ipc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "InvoicePayment.amount"`)}
+	}
+	if len(ipc.mutation.InvoiceIDs()) == 0 {
+		return &ValidationError{Name: "invoice", err: errors.New(`ent: missing required edge "InvoicePayment.invoice"`)}
+	}
+	if len(ipc.mutation.TransactionIDs()) == 0 {
+		return &ValidationError{Name: "transaction", err: errors.New(`ent: missing required edge "InvoicePayment.transaction"`)}
+	}
+	return nil
+}
+
+func (ipc *InvoicePaymentCreate) sqlSave(ctx context.Context) (*InvoicePayment, error) {
+	if err := ipc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ipc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ipc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	ipc.mutation.id = &_node.ID
+	ipc.mutation.done = true
+	return _node, nil
+}
+
+func (ipc *InvoicePaymentCreate) createSpec() (*InvoicePayment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoicePayment{config: ipc.config}
+		_spec = sqlgraph.NewCreateSpec(invoicepayment.Table, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = ipc.conflict
+	if id, ok := ipc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ipc.mutation.CreateTime(); ok {
+		_spec.SetField(invoicepayment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := ipc.mutation.UpdateTime(); ok {
+		_spec.SetField(invoicepayment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := ipc.mutation.Amount(); ok {
+		_spec.SetField(invoicepayment.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := ipc.mutation.ReversedAt(); ok {
+		_spec.SetField(invoicepayment.FieldReversedAt, field.TypeTime, value)
+		_node.ReversedAt = &value
+	}
+	if nodes := ipc.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoicepayment.InvoiceTable,
+			Columns: []string{invoicepayment.InvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.InvoiceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ipc.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invoicepayment.TransactionTable,
+			Columns: []string{invoicepayment.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoicePayment.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoicePaymentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ipc *InvoicePaymentCreate) OnConflict(opts ...sql.ConflictOption) *InvoicePaymentUpsertOne {
+	ipc.conflict = opts
+	return &InvoicePaymentUpsertOne{
+		create: ipc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ipc *InvoicePaymentCreate) OnConflictColumns(columns ...string) *InvoicePaymentUpsertOne {
+	ipc.conflict = append(ipc.conflict, sql.ConflictColumns(columns...))
+	return &InvoicePaymentUpsertOne{
+		create: ipc,
+	}
+}
+
+type (
+	// InvoicePaymentUpsertOne is the builder for "upsert"-ing
+	//  one InvoicePayment node.
+	InvoicePaymentUpsertOne struct {
+		create *InvoicePaymentCreate
+	}
+
+	// InvoicePaymentUpsert is the "OnConflict" setter.
+	InvoicePaymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *InvoicePaymentUpsert) SetUpdateTime(v time.Time) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateUpdateTime() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldUpdateTime)
+	return u
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (u *InvoicePaymentUpsert) SetReversedAt(v time.Time) *InvoicePaymentUpsert {
+	u.Set(invoicepayment.FieldReversedAt, v)
+	return u
+}
+
+// UpdateReversedAt sets the "reversed_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsert) UpdateReversedAt() *InvoicePaymentUpsert {
+	u.SetExcluded(invoicepayment.FieldReversedAt)
+	return u
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (u *InvoicePaymentUpsert) ClearReversedAt() *InvoicePaymentUpsert {
+	u.SetNull(invoicepayment.FieldReversedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertOne) UpdateNewValues() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(invoicepayment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(invoicepayment.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.InvoiceID(); exists {
+			s.SetIgnore(invoicepayment.FieldInvoiceID)
+		}
+		if _, exists := u.create.mutation.TransactionID(); exists {
+			s.SetIgnore(invoicepayment.FieldTransactionID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(invoicepayment.FieldAmount)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoicePaymentUpsertOne) Ignore() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoicePaymentUpsertOne) DoNothing() *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoicePaymentCreate.OnConflict
+// documentation for more info.
+func (u *InvoicePaymentUpsertOne) Update(set func(*InvoicePaymentUpsert)) *InvoicePaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoicePaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *InvoicePaymentUpsertOne) SetUpdateTime(v time.Time) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateUpdateTime() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (u *InvoicePaymentUpsertOne) SetReversedAt(v time.Time) *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetReversedAt(v)
+	})
+}
+
+// UpdateReversedAt sets the "reversed_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertOne) UpdateReversedAt() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateReversedAt()
+	})
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (u *InvoicePaymentUpsertOne) ClearReversedAt() *InvoicePaymentUpsertOne {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearReversedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoicePaymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoicePaymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoicePaymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoicePaymentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoicePaymentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoicePaymentCreateBulk is the builder for creating many InvoicePayment entities in bulk.
+type InvoicePaymentCreateBulk struct {
+	config
+	err      error
+	builders []*InvoicePaymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InvoicePayment entities in the database.
+func (ipcb *InvoicePaymentCreateBulk) Save(ctx context.Context) ([]*InvoicePayment, error) {
+	if ipcb.err != nil {
+		return nil, ipcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ipcb.builders))
+	nodes := make([]*InvoicePayment, len(ipcb.builders))
+	mutators := make([]Mutator, len(ipcb.builders))
+	for i := range ipcb.builders {
+		func(i int, root context.Context) {
+			builder := ipcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoicePaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ipcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ipcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ipcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ipcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipcb *InvoicePaymentCreateBulk) SaveX(ctx context.Context) []*InvoicePayment {
+	v, err := ipcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ipcb *InvoicePaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ipcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipcb *InvoicePaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := ipcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InvoicePayment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoicePaymentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (ipcb *InvoicePaymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoicePaymentUpsertBulk {
+	ipcb.conflict = opts
+	return &InvoicePaymentUpsertBulk{
+		create: ipcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ipcb *InvoicePaymentCreateBulk) OnConflictColumns(columns ...string) *InvoicePaymentUpsertBulk {
+	ipcb.conflict = append(ipcb.conflict, sql.ConflictColumns(columns...))
+	return &InvoicePaymentUpsertBulk{
+		create: ipcb,
+	}
+}
+
+// InvoicePaymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of InvoicePayment nodes.
+type InvoicePaymentUpsertBulk struct {
+	create *InvoicePaymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(invoicepayment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertBulk) UpdateNewValues() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(invoicepayment.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(invoicepayment.FieldCreateTime)
+			}
+			if _, exists := b.mutation.InvoiceID(); exists {
+				s.SetIgnore(invoicepayment.FieldInvoiceID)
+			}
+			if _, exists := b.mutation.TransactionID(); exists {
+				s.SetIgnore(invoicepayment.FieldTransactionID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(invoicepayment.FieldAmount)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InvoicePayment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoicePaymentUpsertBulk) Ignore() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoicePaymentUpsertBulk) DoNothing() *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoicePaymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoicePaymentUpsertBulk) Update(set func(*InvoicePaymentUpsert)) *InvoicePaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoicePaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *InvoicePaymentUpsertBulk) SetUpdateTime(v time.Time) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateUpdateTime() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (u *InvoicePaymentUpsertBulk) SetReversedAt(v time.Time) *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.SetReversedAt(v)
+	})
+}
+
+// UpdateReversedAt sets the "reversed_at" field to the value that was provided on create.
+func (u *InvoicePaymentUpsertBulk) UpdateReversedAt() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.UpdateReversedAt()
+	})
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (u *InvoicePaymentUpsertBulk) ClearReversedAt() *InvoicePaymentUpsertBulk {
+	return u.Update(func(s *InvoicePaymentUpsert) {
+		s.ClearReversedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoicePaymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoicePaymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoicePaymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoicePaymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoicePaymentDelete is the builder for deleting a InvoicePayment entity.
+type InvoicePaymentDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoicePaymentMutation
+}
+
+// Where appends a list predicates to the InvoicePaymentDelete builder.
+func (ipd *InvoicePaymentDelete) Where(ps ...predicate.InvoicePayment) *InvoicePaymentDelete {
+	ipd.mutation.Where(ps...)
+	return ipd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ipd *InvoicePaymentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ipd.sqlExec, ipd.mutation, ipd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipd *InvoicePaymentDelete) ExecX(ctx context.Context) int {
+	n, err := ipd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ipd *InvoicePaymentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invoicepayment.Table, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt))
+	if ps := ipd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ipd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ipd.mutation.done = true
+	return affected, err
+}
+
+// InvoicePaymentDeleteOne is the builder for deleting a single InvoicePayment entity.
+type InvoicePaymentDeleteOne struct {
+	ipd *InvoicePaymentDelete
+}
+
+// Where appends a list predicates to the InvoicePaymentDelete builder.
+func (ipdo *InvoicePaymentDeleteOne) Where(ps ...predicate.InvoicePayment) *InvoicePaymentDeleteOne {
+	ipdo.ipd.mutation.Where(ps...)
+	return ipdo
+}
+
+// Exec executes the deletion query.
+func (ipdo *InvoicePaymentDeleteOne) Exec(ctx context.Context) error {
+	n, err := ipdo.ipd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoicepayment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipdo *InvoicePaymentDeleteOne) ExecX(ctx context.Context) {
+	if err := ipdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
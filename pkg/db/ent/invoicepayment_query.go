@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/transaction"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoicePaymentQuery is the builder for querying InvoicePayment entities.
+type InvoicePaymentQuery struct {
+	config
+	ctx             *QueryContext
+	order           []invoicepayment.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.InvoicePayment
+	withInvoice     *InvoiceQuery
+	withTransaction *TransactionQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoicePaymentQuery builder.
+func (ipq *InvoicePaymentQuery) Where(ps ...predicate.InvoicePayment) *InvoicePaymentQuery {
+	ipq.predicates = append(ipq.predicates, ps...)
+	return ipq
+}
+
+// Limit the number of records to be returned by this query.
+func (ipq *InvoicePaymentQuery) Limit(limit int) *InvoicePaymentQuery {
+	ipq.ctx.Limit = &limit
+	return ipq
+}
+
+// Offset to start from.
+func (ipq *InvoicePaymentQuery) Offset(offset int) *InvoicePaymentQuery {
+	ipq.ctx.Offset = &offset
+	return ipq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ipq *InvoicePaymentQuery) Unique(unique bool) *InvoicePaymentQuery {
+	ipq.ctx.Unique = &unique
+	return ipq
+}
+
+// Order specifies how the records should be ordered.
+func (ipq *InvoicePaymentQuery) Order(o ...invoicepayment.OrderOption) *InvoicePaymentQuery {
+	ipq.order = append(ipq.order, o...)
+	return ipq
+}
+
+// QueryInvoice chains the current query on the "invoice" edge.
+func (ipq *InvoicePaymentQuery) QueryInvoice() *InvoiceQuery {
+	query := (&InvoiceClient{config: ipq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoicepayment.Table, invoicepayment.FieldID, selector),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoicepayment.InvoiceTable, invoicepayment.InvoiceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransaction chains the current query on the "transaction" edge.
+func (ipq *InvoicePaymentQuery) QueryTransaction() *TransactionQuery {
+	query := (&TransactionClient{config: ipq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoicepayment.Table, invoicepayment.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, invoicepayment.TransactionTable, invoicepayment.TransactionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first InvoicePayment entity from the query.
+// Returns a *NotFoundError when no InvoicePayment was found.
+func (ipq *InvoicePaymentQuery) First(ctx context.Context) (*InvoicePayment, error) {
+	nodes, err := ipq.Limit(1).All(setContextOp(ctx, ipq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invoicepayment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) FirstX(ctx context.Context) *InvoicePayment {
+	node, err := ipq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvoicePayment ID from the query.
+// Returns a *NotFoundError when no InvoicePayment ID was found.
+func (ipq *InvoicePaymentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ipq.Limit(1).IDs(setContextOp(ctx, ipq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoicepayment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) FirstIDX(ctx context.Context) int {
+	id, err := ipq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvoicePayment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvoicePayment entity is found.
+// Returns a *NotFoundError when no InvoicePayment entities are found.
+func (ipq *InvoicePaymentQuery) Only(ctx context.Context) (*InvoicePayment, error) {
+	nodes, err := ipq.Limit(2).All(setContextOp(ctx, ipq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invoicepayment.Label}
+	default:
+		return nil, &NotSingularError{invoicepayment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) OnlyX(ctx context.Context) *InvoicePayment {
+	node, err := ipq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvoicePayment ID in the query.
+// Returns a *NotSingularError when more than one InvoicePayment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ipq *InvoicePaymentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ipq.Limit(2).IDs(setContextOp(ctx, ipq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoicepayment.Label}
+	default:
+		err = &NotSingularError{invoicepayment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ipq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoicePayments.
+func (ipq *InvoicePaymentQuery) All(ctx context.Context) ([]*InvoicePayment, error) {
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryAll)
+	if err := ipq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InvoicePayment, *InvoicePaymentQuery]()
+	return withInterceptors[[]*InvoicePayment](ctx, ipq, qr, ipq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) AllX(ctx context.Context) []*InvoicePayment {
+	nodes, err := ipq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvoicePayment IDs.
+func (ipq *InvoicePaymentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ipq.ctx.Unique == nil && ipq.path != nil {
+		ipq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryIDs)
+	if err = ipq.Select(invoicepayment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) IDsX(ctx context.Context) []int {
+	ids, err := ipq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ipq *InvoicePaymentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryCount)
+	if err := ipq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ipq, querierCount[*InvoicePaymentQuery](), ipq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) CountX(ctx context.Context) int {
+	count, err := ipq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ipq *InvoicePaymentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ipq.ctx, ent.OpQueryExist)
+	switch _, err := ipq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ipq *InvoicePaymentQuery) ExistX(ctx context.Context) bool {
+	exist, err := ipq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoicePaymentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ipq *InvoicePaymentQuery) Clone() *InvoicePaymentQuery {
+	if ipq == nil {
+		return nil
+	}
+	return &InvoicePaymentQuery{
+		config:          ipq.config,
+		ctx:             ipq.ctx.Clone(),
+		order:           append([]invoicepayment.OrderOption{}, ipq.order...),
+		inters:          append([]Interceptor{}, ipq.inters...),
+		predicates:      append([]predicate.InvoicePayment{}, ipq.predicates...),
+		withInvoice:     ipq.withInvoice.Clone(),
+		withTransaction: ipq.withTransaction.Clone(),
+		// clone intermediate query.
+		sql:       ipq.sql.Clone(),
+		path:      ipq.path,
+		modifiers: append([]func(*sql.Selector){}, ipq.modifiers...),
+	}
+}
+
+// WithInvoice tells the query-builder to eager-load the nodes that are connected to
+// the "invoice" edge. The optional arguments are used to configure the query builder of the edge.
+func (ipq *InvoicePaymentQuery) WithInvoice(opts ...func(*InvoiceQuery)) *InvoicePaymentQuery {
+	query := (&InvoiceClient{config: ipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ipq.withInvoice = query
+	return ipq
+}
+
+// WithTransaction tells the query-builder to eager-load the nodes that are connected to
+// the "transaction" edge. The optional arguments are used to configure the query builder of the edge.
+func (ipq *InvoicePaymentQuery) WithTransaction(opts ...func(*TransactionQuery)) *InvoicePaymentQuery {
+	query := (&TransactionClient{config: ipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ipq.withTransaction = query
+	return ipq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoicePayment.Query().
+//		GroupBy(invoicepayment.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ipq *InvoicePaymentQuery) GroupBy(field string, fields ...string) *InvoicePaymentGroupBy {
+	ipq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InvoicePaymentGroupBy{build: ipq}
+	grbuild.flds = &ipq.ctx.Fields
+	grbuild.label = invoicepayment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.InvoicePayment.Query().
+//		Select(invoicepayment.FieldCreateTime).
+//		Scan(ctx, &v)
+func (ipq *InvoicePaymentQuery) Select(fields ...string) *InvoicePaymentSelect {
+	ipq.ctx.Fields = append(ipq.ctx.Fields, fields...)
+	sbuild := &InvoicePaymentSelect{InvoicePaymentQuery: ipq}
+	sbuild.label = invoicepayment.Label
+	sbuild.flds, sbuild.scan = &ipq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InvoicePaymentSelect configured with the given aggregations.
+func (ipq *InvoicePaymentQuery) Aggregate(fns ...AggregateFunc) *InvoicePaymentSelect {
+	return ipq.Select().Aggregate(fns...)
+}
+
+func (ipq *InvoicePaymentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ipq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ipq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ipq.ctx.Fields {
+		if !invoicepayment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ipq.path != nil {
+		prev, err := ipq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ipq.sql = prev
+	}
+	return nil
+}
+
+func (ipq *InvoicePaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvoicePayment, error) {
+	var (
+		nodes       = []*InvoicePayment{}
+		_spec       = ipq.querySpec()
+		loadedTypes = [2]bool{
+			ipq.withInvoice != nil,
+			ipq.withTransaction != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InvoicePayment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InvoicePayment{config: ipq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ipq.modifiers) > 0 {
+		_spec.Modifiers = ipq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ipq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ipq.withInvoice; query != nil {
+		if err := ipq.loadInvoice(ctx, query, nodes, nil,
+			func(n *InvoicePayment, e *Invoice) { n.Edges.Invoice = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ipq.withTransaction; query != nil {
+		if err := ipq.loadTransaction(ctx, query, nodes, nil,
+			func(n *InvoicePayment, e *Transaction) { n.Edges.Transaction = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ipq *InvoicePaymentQuery) loadInvoice(ctx context.Context, query *InvoiceQuery, nodes []*InvoicePayment, init func(*InvoicePayment), assign func(*InvoicePayment, *Invoice)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*InvoicePayment)
+	for i := range nodes {
+		fk := nodes[i].InvoiceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(invoice.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "invoice_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ipq *InvoicePaymentQuery) loadTransaction(ctx context.Context, query *TransactionQuery, nodes []*InvoicePayment, init func(*InvoicePayment), assign func(*InvoicePayment, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*InvoicePayment)
+	for i := range nodes {
+		fk := nodes[i].TransactionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transaction_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ipq *InvoicePaymentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ipq.querySpec()
+	if len(ipq.modifiers) > 0 {
+		_spec.Modifiers = ipq.modifiers
+	}
+	_spec.Node.Columns = ipq.ctx.Fields
+	if len(ipq.ctx.Fields) > 0 {
+		_spec.Unique = ipq.ctx.Unique != nil && *ipq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ipq.driver, _spec)
+}
+
+func (ipq *InvoicePaymentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(invoicepayment.Table, invoicepayment.Columns, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt))
+	_spec.From = ipq.sql
+	if unique := ipq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ipq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ipq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicepayment.FieldID)
+		for i := range fields {
+			if fields[i] != invoicepayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ipq.withInvoice != nil {
+			_spec.Node.AddColumnOnce(invoicepayment.FieldInvoiceID)
+		}
+		if ipq.withTransaction != nil {
+			_spec.Node.AddColumnOnce(invoicepayment.FieldTransactionID)
+		}
+	}
+	if ps := ipq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ipq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ipq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ipq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ipq *InvoicePaymentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ipq.driver.Dialect())
+	t1 := builder.Table(invoicepayment.Table)
+	columns := ipq.ctx.Fields
+	if len(columns) == 0 {
+		columns = invoicepayment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ipq.sql != nil {
+		selector = ipq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ipq.ctx.Unique != nil && *ipq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ipq.modifiers {
+		m(selector)
+	}
+	for _, p := range ipq.predicates {
+		p(selector)
+	}
+	for _, p := range ipq.order {
+		p(selector)
+	}
+	if offset := ipq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ipq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ipq *InvoicePaymentQuery) Modify(modifiers ...func(s *sql.Selector)) *InvoicePaymentSelect {
+	ipq.modifiers = append(ipq.modifiers, modifiers...)
+	return ipq.Select()
+}
+
+// InvoicePaymentGroupBy is the group-by builder for InvoicePayment entities.
+type InvoicePaymentGroupBy struct {
+	selector
+	build *InvoicePaymentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ipgb *InvoicePaymentGroupBy) Aggregate(fns ...AggregateFunc) *InvoicePaymentGroupBy {
+	ipgb.fns = append(ipgb.fns, fns...)
+	return ipgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ipgb *InvoicePaymentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ipgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ipgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvoicePaymentQuery, *InvoicePaymentGroupBy](ctx, ipgb.build, ipgb, ipgb.build.inters, v)
+}
+
+func (ipgb *InvoicePaymentGroupBy) sqlScan(ctx context.Context, root *InvoicePaymentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ipgb.fns))
+	for _, fn := range ipgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ipgb.flds)+len(ipgb.fns))
+		for _, f := range *ipgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ipgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ipgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InvoicePaymentSelect is the builder for selecting fields of InvoicePayment entities.
+type InvoicePaymentSelect struct {
+	*InvoicePaymentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ips *InvoicePaymentSelect) Aggregate(fns ...AggregateFunc) *InvoicePaymentSelect {
+	ips.fns = append(ips.fns, fns...)
+	return ips
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ips *InvoicePaymentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ips.ctx, ent.OpQuerySelect)
+	if err := ips.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvoicePaymentQuery, *InvoicePaymentSelect](ctx, ips.InvoicePaymentQuery, ips, ips.inters, v)
+}
+
+func (ips *InvoicePaymentSelect) sqlScan(ctx context.Context, root *InvoicePaymentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ips.fns))
+	for _, fn := range ips.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ips.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ips.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ips *InvoicePaymentSelect) Modify(modifiers ...func(s *sql.Selector)) *InvoicePaymentSelect {
+	ips.modifiers = append(ips.modifiers, modifiers...)
+	return ips
+}
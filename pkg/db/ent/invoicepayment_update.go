@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoicePaymentUpdate is the builder for updating InvoicePayment entities.
+type InvoicePaymentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InvoicePaymentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InvoicePaymentUpdate builder.
+func (ipu *InvoicePaymentUpdate) Where(ps ...predicate.InvoicePayment) *InvoicePaymentUpdate {
+	ipu.mutation.Where(ps...)
+	return ipu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ipu *InvoicePaymentUpdate) SetUpdateTime(t time.Time) *InvoicePaymentUpdate {
+	ipu.mutation.SetUpdateTime(t)
+	return ipu
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (ipu *InvoicePaymentUpdate) SetReversedAt(t time.Time) *InvoicePaymentUpdate {
+	ipu.mutation.SetReversedAt(t)
+	return ipu
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (ipu *InvoicePaymentUpdate) SetNillableReversedAt(t *time.Time) *InvoicePaymentUpdate {
+	if t != nil {
+		ipu.SetReversedAt(*t)
+	}
+	return ipu
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (ipu *InvoicePaymentUpdate) ClearReversedAt() *InvoicePaymentUpdate {
+	ipu.mutation.ClearReversedAt()
+	return ipu
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (ipu *InvoicePaymentUpdate) Mutation() *InvoicePaymentMutation {
+	return ipu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ipu *InvoicePaymentUpdate) Save(ctx context.Context) (int, error) {
+	ipu.defaults()
+	return withHooks(ctx, ipu.sqlSave, ipu.mutation, ipu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipu *InvoicePaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := ipu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ipu *InvoicePaymentUpdate) Exec(ctx context.Context) error {
+	_, err := ipu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipu *InvoicePaymentUpdate) ExecX(ctx context.Context) {
+	if err := ipu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipu *InvoicePaymentUpdate) defaults() {
+	if _, ok := ipu.mutation.UpdateTime(); !ok {
+		v := invoicepayment.UpdateDefaultUpdateTime()
+		ipu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipu *InvoicePaymentUpdate) check() error {
+	if ipu.mutation.InvoiceCleared() && len(ipu.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.invoice"`)
+	}
+	if ipu.mutation.TransactionCleared() && len(ipu.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ipu *InvoicePaymentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoicePaymentUpdate {
+	ipu.modifiers = append(ipu.modifiers, modifiers...)
+	return ipu
+}
+
+func (ipu *InvoicePaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ipu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicepayment.Table, invoicepayment.Columns, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt))
+	if ps := ipu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipu.mutation.UpdateTime(); ok {
+		_spec.SetField(invoicepayment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ipu.mutation.ReversedAt(); ok {
+		_spec.SetField(invoicepayment.FieldReversedAt, field.TypeTime, value)
+	}
+	if ipu.mutation.ReversedAtCleared() {
+		_spec.ClearField(invoicepayment.FieldReversedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(ipu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ipu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ipu.mutation.done = true
+	return n, nil
+}
+
+// InvoicePaymentUpdateOne is the builder for updating a single InvoicePayment entity.
+type InvoicePaymentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InvoicePaymentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ipuo *InvoicePaymentUpdateOne) SetUpdateTime(t time.Time) *InvoicePaymentUpdateOne {
+	ipuo.mutation.SetUpdateTime(t)
+	return ipuo
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (ipuo *InvoicePaymentUpdateOne) SetReversedAt(t time.Time) *InvoicePaymentUpdateOne {
+	ipuo.mutation.SetReversedAt(t)
+	return ipuo
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (ipuo *InvoicePaymentUpdateOne) SetNillableReversedAt(t *time.Time) *InvoicePaymentUpdateOne {
+	if t != nil {
+		ipuo.SetReversedAt(*t)
+	}
+	return ipuo
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (ipuo *InvoicePaymentUpdateOne) ClearReversedAt() *InvoicePaymentUpdateOne {
+	ipuo.mutation.ClearReversedAt()
+	return ipuo
+}
+
+// Mutation returns the InvoicePaymentMutation object of the builder.
+func (ipuo *InvoicePaymentUpdateOne) Mutation() *InvoicePaymentMutation {
+	return ipuo.mutation
+}
+
+// Where appends a list predicates to the InvoicePaymentUpdate builder.
+func (ipuo *InvoicePaymentUpdateOne) Where(ps ...predicate.InvoicePayment) *InvoicePaymentUpdateOne {
+	ipuo.mutation.Where(ps...)
+	return ipuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ipuo *InvoicePaymentUpdateOne) Select(field string, fields ...string) *InvoicePaymentUpdateOne {
+	ipuo.fields = append([]string{field}, fields...)
+	return ipuo
+}
+
+// Save executes the query and returns the updated InvoicePayment entity.
+func (ipuo *InvoicePaymentUpdateOne) Save(ctx context.Context) (*InvoicePayment, error) {
+	ipuo.defaults()
+	return withHooks(ctx, ipuo.sqlSave, ipuo.mutation, ipuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ipuo *InvoicePaymentUpdateOne) SaveX(ctx context.Context) *InvoicePayment {
+	node, err := ipuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ipuo *InvoicePaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := ipuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ipuo *InvoicePaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := ipuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ipuo *InvoicePaymentUpdateOne) defaults() {
+	if _, ok := ipuo.mutation.UpdateTime(); !ok {
+		v := invoicepayment.UpdateDefaultUpdateTime()
+		ipuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ipuo *InvoicePaymentUpdateOne) check() error {
+	if ipuo.mutation.InvoiceCleared() && len(ipuo.mutation.InvoiceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.invoice"`)
+	}
+	if ipuo.mutation.TransactionCleared() && len(ipuo.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "InvoicePayment.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ipuo *InvoicePaymentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InvoicePaymentUpdateOne {
+	ipuo.modifiers = append(ipuo.modifiers, modifiers...)
+	return ipuo
+}
+
+func (ipuo *InvoicePaymentUpdateOne) sqlSave(ctx context.Context) (_node *InvoicePayment, err error) {
+	if err := ipuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoicepayment.Table, invoicepayment.Columns, sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt))
+	id, ok := ipuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoicePayment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ipuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoicepayment.FieldID)
+		for _, f := range fields {
+			if !invoicepayment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoicepayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ipuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ipuo.mutation.UpdateTime(); ok {
+		_spec.SetField(invoicepayment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := ipuo.mutation.ReversedAt(); ok {
+		_spec.SetField(invoicepayment.FieldReversedAt, field.TypeTime, value)
+	}
+	if ipuo.mutation.ReversedAtCleared() {
+		_spec.ClearField(invoicepayment.FieldReversedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(ipuo.modifiers...)
+	_node = &InvoicePayment{config: ipuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ipuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoicepayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ipuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// JournalEntry is the model entity for the JournalEntry schema.
+type JournalEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID int `json:"transaction_id,omitempty"`
+	// Ledger holds the value of the "ledger" field.
+	Ledger journalentry.Ledger `json:"ledger,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID *int `json:"account_id,omitempty"`
+	// Side holds the value of the "side" field.
+	Side journalentry.Side `json:"side,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JournalEntryQuery when eager-loading is set.
+	Edges        JournalEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// JournalEntryEdges holds the relations/edges for other nodes in the graph.
+type JournalEntryEdges struct {
+	// Transaction holds the value of the transaction edge.
+	Transaction *Transaction `json:"transaction,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TransactionOrErr returns the Transaction value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JournalEntryEdges) TransactionOrErr() (*Transaction, error) {
+	if e.Transaction != nil {
+		return e.Transaction, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "transaction"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JournalEntryEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JournalEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldID, journalentry.FieldTransactionID, journalentry.FieldAccountID, journalentry.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case journalentry.FieldLedger, journalentry.FieldSide, journalentry.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case journalentry.FieldCreateTime, journalentry.FieldUpdateTime, journalentry.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JournalEntry fields.
+func (je *JournalEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case journalentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			je.ID = int(value.Int64)
+		case journalentry.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				je.CreateTime = value.Time
+			}
+		case journalentry.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				je.UpdateTime = value.Time
+			}
+		case journalentry.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				je.TransactionID = int(value.Int64)
+			}
+		case journalentry.FieldLedger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ledger", values[i])
+			} else if value.Valid {
+				je.Ledger = journalentry.Ledger(value.String)
+			}
+		case journalentry.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				je.AccountID = new(int)
+				*je.AccountID = int(value.Int64)
+			}
+		case journalentry.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				je.Side = journalentry.Side(value.String)
+			}
+		case journalentry.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				je.Amount = money.Amount(value.Int64)
+			}
+		case journalentry.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				je.Currency = value.String
+			}
+		case journalentry.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				je.Timestamp = value.Time
+			}
+		default:
+			je.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JournalEntry.
+// This includes values selected through modifiers, order, etc.
+func (je *JournalEntry) Value(name string) (ent.Value, error) {
+	return je.selectValues.Get(name)
+}
+
+// QueryTransaction queries the "transaction" edge of the JournalEntry entity.
+func (je *JournalEntry) QueryTransaction() *TransactionQuery {
+	return NewJournalEntryClient(je.config).QueryTransaction(je)
+}
+
+// QueryAccount queries the "account" edge of the JournalEntry entity.
+func (je *JournalEntry) QueryAccount() *AccountQuery {
+	return NewJournalEntryClient(je.config).QueryAccount(je)
+}
+
+// Update returns a builder for updating this JournalEntry.
+// Note that you need to call JournalEntry.Unwrap() before calling this method if this JournalEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (je *JournalEntry) Update() *JournalEntryUpdateOne {
+	return NewJournalEntryClient(je.config).UpdateOne(je)
+}
+
+// Unwrap unwraps the JournalEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (je *JournalEntry) Unwrap() *JournalEntry {
+	_tx, ok := je.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JournalEntry is not a transactional entity")
+	}
+	je.config.driver = _tx.drv
+	return je
+}
+
+// String implements the fmt.Stringer.
+func (je *JournalEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("JournalEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", je.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(je.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(je.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(fmt.Sprintf("%v", je.TransactionID))
+	builder.WriteString(", ")
+	builder.WriteString("ledger=")
+	builder.WriteString(fmt.Sprintf("%v", je.Ledger))
+	builder.WriteString(", ")
+	if v := je.AccountID; v != nil {
+		builder.WriteString("account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(fmt.Sprintf("%v", je.Side))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", je.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(je.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(je.Timestamp.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JournalEntries is a parsable slice of JournalEntry.
+type JournalEntries []*JournalEntry
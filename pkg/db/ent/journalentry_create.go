@@ -0,0 +1,729 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JournalEntryCreate is the builder for creating a JournalEntry entity.
+type JournalEntryCreate struct {
+	config
+	mutation *JournalEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (jec *JournalEntryCreate) SetCreateTime(t time.Time) *JournalEntryCreate {
+	jec.mutation.SetCreateTime(t)
+	return jec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (jec *JournalEntryCreate) SetNillableCreateTime(t *time.Time) *JournalEntryCreate {
+	if t != nil {
+		jec.SetCreateTime(*t)
+	}
+	return jec
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (jec *JournalEntryCreate) SetUpdateTime(t time.Time) *JournalEntryCreate {
+	jec.mutation.SetUpdateTime(t)
+	return jec
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (jec *JournalEntryCreate) SetNillableUpdateTime(t *time.Time) *JournalEntryCreate {
+	if t != nil {
+		jec.SetUpdateTime(*t)
+	}
+	return jec
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (jec *JournalEntryCreate) SetTransactionID(i int) *JournalEntryCreate {
+	jec.mutation.SetTransactionID(i)
+	return jec
+}
+
+// SetLedger sets the "ledger" field.
+func (jec *JournalEntryCreate) SetLedger(j journalentry.Ledger) *JournalEntryCreate {
+	jec.mutation.SetLedger(j)
+	return jec
+}
+
+// SetAccountID sets the "account_id" field.
+func (jec *JournalEntryCreate) SetAccountID(i int) *JournalEntryCreate {
+	jec.mutation.SetAccountID(i)
+	return jec
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (jec *JournalEntryCreate) SetNillableAccountID(i *int) *JournalEntryCreate {
+	if i != nil {
+		jec.SetAccountID(*i)
+	}
+	return jec
+}
+
+// SetSide sets the "side" field.
+func (jec *JournalEntryCreate) SetSide(j journalentry.Side) *JournalEntryCreate {
+	jec.mutation.SetSide(j)
+	return jec
+}
+
+// SetAmount sets the "amount" field.
+func (jec *JournalEntryCreate) SetAmount(m money.Amount) *JournalEntryCreate {
+	jec.mutation.SetAmount(m)
+	return jec
+}
+
+// SetCurrency sets the "currency" field.
+func (jec *JournalEntryCreate) SetCurrency(s string) *JournalEntryCreate {
+	jec.mutation.SetCurrency(s)
+	return jec
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (jec *JournalEntryCreate) SetTimestamp(t time.Time) *JournalEntryCreate {
+	jec.mutation.SetTimestamp(t)
+	return jec
+}
+
+// SetID sets the "id" field.
+func (jec *JournalEntryCreate) SetID(i int) *JournalEntryCreate {
+	jec.mutation.SetID(i)
+	return jec
+}
+
+// SetTransaction sets the "transaction" edge to the Transaction entity.
+func (jec *JournalEntryCreate) SetTransaction(t *Transaction) *JournalEntryCreate {
+	return jec.SetTransactionID(t.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (jec *JournalEntryCreate) SetAccount(a *Account) *JournalEntryCreate {
+	return jec.SetAccountID(a.ID)
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (jec *JournalEntryCreate) Mutation() *JournalEntryMutation {
+	return jec.mutation
+}
+
+// Save creates the JournalEntry in the database.
+func (jec *JournalEntryCreate) Save(ctx context.Context) (*JournalEntry, error) {
+	jec.defaults()
+	return withHooks(ctx, jec.sqlSave, jec.mutation, jec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jec *JournalEntryCreate) SaveX(ctx context.Context) *JournalEntry {
+	v, err := jec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jec *JournalEntryCreate) Exec(ctx context.Context) error {
+	_, err := jec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jec *JournalEntryCreate) ExecX(ctx context.Context) {
+	if err := jec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jec *JournalEntryCreate) defaults() {
+	if _, ok := jec.mutation.CreateTime(); !ok {
+		v := journalentry.DefaultCreateTime()
+		jec.mutation.SetCreateTime(v)
+	}
+	if _, ok := jec.mutation.UpdateTime(); !ok {
+		v := journalentry.DefaultUpdateTime()
+		jec.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jec *JournalEntryCreate) check() error {
+	if _, ok := jec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "JournalEntry.create_time"`)}
+	}
+	if _, ok := jec.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "JournalEntry.update_time"`)}
+	}
+	if _, ok := jec.mutation.TransactionID(); !ok {
+		return &ValidationError{Name: "transaction_id", err: errors.New(`ent: missing required field "JournalEntry.transaction_id"`)}
+	}
+	if _, ok := jec.mutation.Ledger(); !ok {
+		return &ValidationError{Name: "ledger", err: errors.New(`ent: missing required field "JournalEntry.ledger"`)}
+	}
+	if v, ok := jec.mutation.Ledger(); ok {
+		if err := journalentry.LedgerValidator(v); err != nil {
+			return &ValidationError{Name: "ledger", err: fmt.Errorf(`ent: validator failed for field "JournalEntry.ledger": %w`, err)}
+		}
+	}
+	if _, ok := jec.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "JournalEntry.side"`)}
+	}
+	if v, ok := jec.mutation.Side(); ok {
+		if err := journalentry.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "JournalEntry.side": %w`, err)}
+		}
+	}
+	if _, ok := jec.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "JournalEntry.amount"`)}
+	}
+	if _, ok := jec.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "JournalEntry.currency"`)}
+	}
+	if v, ok := jec.mutation.Currency(); ok {
+		if err := journalentry.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "JournalEntry.currency": %w`, err)}
+		}
+	}
+	if _, ok := jec.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "JournalEntry.timestamp"`)}
+	}
+	if len(jec.mutation.TransactionIDs()) == 0 {
+		return &ValidationError{Name: "transaction", err: errors.New(`ent: missing required edge "JournalEntry.transaction"`)}
+	}
+	return nil
+}
+
+func (jec *JournalEntryCreate) sqlSave(ctx context.Context) (*JournalEntry, error) {
+	if err := jec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	jec.mutation.id = &_node.ID
+	jec.mutation.done = true
+	return _node, nil
+}
+
+func (jec *JournalEntryCreate) createSpec() (*JournalEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JournalEntry{config: jec.config}
+		_spec = sqlgraph.NewCreateSpec(journalentry.Table, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = jec.conflict
+	if id, ok := jec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := jec.mutation.CreateTime(); ok {
+		_spec.SetField(journalentry.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := jec.mutation.UpdateTime(); ok {
+		_spec.SetField(journalentry.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := jec.mutation.Ledger(); ok {
+		_spec.SetField(journalentry.FieldLedger, field.TypeEnum, value)
+		_node.Ledger = value
+	}
+	if value, ok := jec.mutation.Side(); ok {
+		_spec.SetField(journalentry.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := jec.mutation.Amount(); ok {
+		_spec.SetField(journalentry.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := jec.mutation.Currency(); ok {
+		_spec.SetField(journalentry.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := jec.mutation.Timestamp(); ok {
+		_spec.SetField(journalentry.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if nodes := jec.mutation.TransactionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   journalentry.TransactionTable,
+			Columns: []string{journalentry.TransactionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransactionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := jec.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   journalentry.AccountTable,
+			Columns: []string{journalentry.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JournalEntry.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JournalEntryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (jec *JournalEntryCreate) OnConflict(opts ...sql.ConflictOption) *JournalEntryUpsertOne {
+	jec.conflict = opts
+	return &JournalEntryUpsertOne{
+		create: jec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jec *JournalEntryCreate) OnConflictColumns(columns ...string) *JournalEntryUpsertOne {
+	jec.conflict = append(jec.conflict, sql.ConflictColumns(columns...))
+	return &JournalEntryUpsertOne{
+		create: jec,
+	}
+}
+
+type (
+	// JournalEntryUpsertOne is the builder for "upsert"-ing
+	//  one JournalEntry node.
+	JournalEntryUpsertOne struct {
+		create *JournalEntryCreate
+	}
+
+	// JournalEntryUpsert is the "OnConflict" setter.
+	JournalEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *JournalEntryUpsert) SetUpdateTime(v time.Time) *JournalEntryUpsert {
+	u.Set(journalentry.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *JournalEntryUpsert) UpdateUpdateTime() *JournalEntryUpsert {
+	u.SetExcluded(journalentry.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(journalentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JournalEntryUpsertOne) UpdateNewValues() *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(journalentry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(journalentry.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TransactionID(); exists {
+			s.SetIgnore(journalentry.FieldTransactionID)
+		}
+		if _, exists := u.create.mutation.Ledger(); exists {
+			s.SetIgnore(journalentry.FieldLedger)
+		}
+		if _, exists := u.create.mutation.AccountID(); exists {
+			s.SetIgnore(journalentry.FieldAccountID)
+		}
+		if _, exists := u.create.mutation.Side(); exists {
+			s.SetIgnore(journalentry.FieldSide)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(journalentry.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(journalentry.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.Timestamp(); exists {
+			s.SetIgnore(journalentry.FieldTimestamp)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JournalEntryUpsertOne) Ignore() *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JournalEntryUpsertOne) DoNothing() *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JournalEntryCreate.OnConflict
+// documentation for more info.
+func (u *JournalEntryUpsertOne) Update(set func(*JournalEntryUpsert)) *JournalEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JournalEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *JournalEntryUpsertOne) SetUpdateTime(v time.Time) *JournalEntryUpsertOne {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *JournalEntryUpsertOne) UpdateUpdateTime() *JournalEntryUpsertOne {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *JournalEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JournalEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JournalEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JournalEntryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JournalEntryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JournalEntryCreateBulk is the builder for creating many JournalEntry entities in bulk.
+type JournalEntryCreateBulk struct {
+	config
+	err      error
+	builders []*JournalEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JournalEntry entities in the database.
+func (jecb *JournalEntryCreateBulk) Save(ctx context.Context) ([]*JournalEntry, error) {
+	if jecb.err != nil {
+		return nil, jecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jecb.builders))
+	nodes := make([]*JournalEntry, len(jecb.builders))
+	mutators := make([]Mutator, len(jecb.builders))
+	for i := range jecb.builders {
+		func(i int, root context.Context) {
+			builder := jecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JournalEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jecb *JournalEntryCreateBulk) SaveX(ctx context.Context) []*JournalEntry {
+	v, err := jecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jecb *JournalEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := jecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jecb *JournalEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := jecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JournalEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JournalEntryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (jecb *JournalEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *JournalEntryUpsertBulk {
+	jecb.conflict = opts
+	return &JournalEntryUpsertBulk{
+		create: jecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jecb *JournalEntryCreateBulk) OnConflictColumns(columns ...string) *JournalEntryUpsertBulk {
+	jecb.conflict = append(jecb.conflict, sql.ConflictColumns(columns...))
+	return &JournalEntryUpsertBulk{
+		create: jecb,
+	}
+}
+
+// JournalEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of JournalEntry nodes.
+type JournalEntryUpsertBulk struct {
+	create *JournalEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(journalentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JournalEntryUpsertBulk) UpdateNewValues() *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(journalentry.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(journalentry.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TransactionID(); exists {
+				s.SetIgnore(journalentry.FieldTransactionID)
+			}
+			if _, exists := b.mutation.Ledger(); exists {
+				s.SetIgnore(journalentry.FieldLedger)
+			}
+			if _, exists := b.mutation.AccountID(); exists {
+				s.SetIgnore(journalentry.FieldAccountID)
+			}
+			if _, exists := b.mutation.Side(); exists {
+				s.SetIgnore(journalentry.FieldSide)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(journalentry.FieldAmount)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(journalentry.FieldCurrency)
+			}
+			if _, exists := b.mutation.Timestamp(); exists {
+				s.SetIgnore(journalentry.FieldTimestamp)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JournalEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JournalEntryUpsertBulk) Ignore() *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JournalEntryUpsertBulk) DoNothing() *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JournalEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *JournalEntryUpsertBulk) Update(set func(*JournalEntryUpsert)) *JournalEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JournalEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *JournalEntryUpsertBulk) SetUpdateTime(v time.Time) *JournalEntryUpsertBulk {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *JournalEntryUpsertBulk) UpdateUpdateTime() *JournalEntryUpsertBulk {
+	return u.Update(func(s *JournalEntryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *JournalEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the JournalEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for JournalEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JournalEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/db/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// JournalEntryUpdate is the builder for updating JournalEntry entities.
+type JournalEntryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JournalEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (jeu *JournalEntryUpdate) Where(ps ...predicate.JournalEntry) *JournalEntryUpdate {
+	jeu.mutation.Where(ps...)
+	return jeu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (jeu *JournalEntryUpdate) SetUpdateTime(t time.Time) *JournalEntryUpdate {
+	jeu.mutation.SetUpdateTime(t)
+	return jeu
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (jeu *JournalEntryUpdate) Mutation() *JournalEntryMutation {
+	return jeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jeu *JournalEntryUpdate) Save(ctx context.Context) (int, error) {
+	jeu.defaults()
+	return withHooks(ctx, jeu.sqlSave, jeu.mutation, jeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jeu *JournalEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := jeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jeu *JournalEntryUpdate) Exec(ctx context.Context) error {
+	_, err := jeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jeu *JournalEntryUpdate) ExecX(ctx context.Context) {
+	if err := jeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jeu *JournalEntryUpdate) defaults() {
+	if _, ok := jeu.mutation.UpdateTime(); !ok {
+		v := journalentry.UpdateDefaultUpdateTime()
+		jeu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jeu *JournalEntryUpdate) check() error {
+	if jeu.mutation.TransactionCleared() && len(jeu.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JournalEntry.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (jeu *JournalEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JournalEntryUpdate {
+	jeu.modifiers = append(jeu.modifiers, modifiers...)
+	return jeu
+}
+
+func (jeu *JournalEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := jeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	if ps := jeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jeu.mutation.UpdateTime(); ok {
+		_spec.SetField(journalentry.FieldUpdateTime, field.TypeTime, value)
+	}
+	_spec.AddModifiers(jeu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, jeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jeu.mutation.done = true
+	return n, nil
+}
+
+// JournalEntryUpdateOne is the builder for updating a single JournalEntry entity.
+type JournalEntryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JournalEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (jeuo *JournalEntryUpdateOne) SetUpdateTime(t time.Time) *JournalEntryUpdateOne {
+	jeuo.mutation.SetUpdateTime(t)
+	return jeuo
+}
+
+// Mutation returns the JournalEntryMutation object of the builder.
+func (jeuo *JournalEntryUpdateOne) Mutation() *JournalEntryMutation {
+	return jeuo.mutation
+}
+
+// Where appends a list predicates to the JournalEntryUpdate builder.
+func (jeuo *JournalEntryUpdateOne) Where(ps ...predicate.JournalEntry) *JournalEntryUpdateOne {
+	jeuo.mutation.Where(ps...)
+	return jeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jeuo *JournalEntryUpdateOne) Select(field string, fields ...string) *JournalEntryUpdateOne {
+	jeuo.fields = append([]string{field}, fields...)
+	return jeuo
+}
+
+// Save executes the query and returns the updated JournalEntry entity.
+func (jeuo *JournalEntryUpdateOne) Save(ctx context.Context) (*JournalEntry, error) {
+	jeuo.defaults()
+	return withHooks(ctx, jeuo.sqlSave, jeuo.mutation, jeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jeuo *JournalEntryUpdateOne) SaveX(ctx context.Context) *JournalEntry {
+	node, err := jeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jeuo *JournalEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := jeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jeuo *JournalEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := jeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jeuo *JournalEntryUpdateOne) defaults() {
+	if _, ok := jeuo.mutation.UpdateTime(); !ok {
+		v := journalentry.UpdateDefaultUpdateTime()
+		jeuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jeuo *JournalEntryUpdateOne) check() error {
+	if jeuo.mutation.TransactionCleared() && len(jeuo.mutation.TransactionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "JournalEntry.transaction"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (jeuo *JournalEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JournalEntryUpdateOne {
+	jeuo.modifiers = append(jeuo.modifiers, modifiers...)
+	return jeuo
+}
+
+func (jeuo *JournalEntryUpdateOne) sqlSave(ctx context.Context) (_node *JournalEntry, err error) {
+	if err := jeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(journalentry.Table, journalentry.Columns, sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt))
+	id, ok := jeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JournalEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, journalentry.FieldID)
+		for _, f := range fields {
+			if !journalentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != journalentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jeuo.mutation.UpdateTime(); ok {
+		_spec.SetField(journalentry.FieldUpdateTime, field.TypeTime, value)
+	}
+	_spec.AddModifiers(jeuo.modifiers...)
+	_node = &JournalEntry{config: jeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{journalentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jeuo.mutation.done = true
+	return _node, nil
+}
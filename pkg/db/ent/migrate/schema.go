@@ -15,6 +15,10 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "document_number", Type: field.TypeString, Unique: true},
+		{Name: "credit_limit", Type: field.TypeInt64, Nullable: true},
+		{Name: "cycle_closing_day", Type: field.TypeInt, Nullable: true},
+		{Name: "due_date_offset", Type: field.TypeInt, Default: 10},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "USD"},
 	}
 	// AccountsTable holds the schema information for the "accounts" table.
 	AccountsTable = &schema.Table{
@@ -22,6 +26,287 @@ var (
 		Columns:    AccountsColumns,
 		PrimaryKey: []*schema.Column{AccountsColumns[0]},
 	}
+	// AccrualsColumns holds the columns for the "accruals" table.
+	AccrualsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"interest", "late_fee"}},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "invoice_id", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// AccrualsTable holds the schema information for the "accruals" table.
+	AccrualsTable = &schema.Table{
+		Name:       "accruals",
+		Columns:    AccrualsColumns,
+		PrimaryKey: []*schema.Column{AccrualsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accruals_accounts_accruals",
+				Columns:    []*schema.Column{AccrualsColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "accruals_invoices_late_fee",
+				Columns:    []*schema.Column{AccrualsColumns[7]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "accruals_transactions_accrual",
+				Columns:    []*schema.Column{AccrualsColumns[8]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accrual_account_id_date_kind",
+				Unique:  true,
+				Columns: []*schema.Column{AccrualsColumns[6], AccrualsColumns[3], AccrualsColumns[4]},
+			},
+		},
+	}
+	// FxRatesColumns holds the columns for the "fx_rates" table.
+	FxRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "base_currency", Type: field.TypeString, Size: 3},
+		{Name: "quote_currency", Type: field.TypeString, Size: 3},
+		{Name: "rate", Type: field.TypeString, Size: 32},
+	}
+	// FxRatesTable holds the schema information for the "fx_rates" table.
+	FxRatesTable = &schema.Table{
+		Name:       "fx_rates",
+		Columns:    FxRatesColumns,
+		PrimaryKey: []*schema.Column{FxRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fxrate_base_currency_quote_currency",
+				Unique:  true,
+				Columns: []*schema.Column{FxRatesColumns[3], FxRatesColumns[4]},
+			},
+		},
+	}
+	// HoldsColumns holds the columns for the "holds" table.
+	HoldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "captured", "released", "expired"}, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "captured_amount", Type: field.TypeInt64, Nullable: true},
+		{Name: "account_id", Type: field.TypeInt},
+		{Name: "operation_type_id", Type: field.TypeInt},
+		{Name: "transaction_id", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// HoldsTable holds the schema information for the "holds" table.
+	HoldsTable = &schema.Table{
+		Name:       "holds",
+		Columns:    HoldsColumns,
+		PrimaryKey: []*schema.Column{HoldsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "holds_accounts_holds",
+				Columns:    []*schema.Column{HoldsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "holds_operation_types_holds",
+				Columns:    []*schema.Column{HoldsColumns[8]},
+				RefColumns: []*schema.Column{OperationTypesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "holds_transactions_hold",
+				Columns:    []*schema.Column{HoldsColumns[9]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hold_account_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{HoldsColumns[7], HoldsColumns[4]},
+			},
+			{
+				Name:    "hold_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{HoldsColumns[4], HoldsColumns[5]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "scope", Type: field.TypeString, Size: 64},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_scope_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[3], IdempotencyKeysColumns[4]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[9]},
+			},
+		},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "total_due", Type: field.TypeInt64},
+		{Name: "minimum_payment", Type: field.TypeInt64},
+		{Name: "due_date", Type: field.TypeTime},
+		{Name: "paid_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "paid", "superseded"}, Default: "open"},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_accounts_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[10]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_account_id_period_end",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[10], InvoicesColumns[4]},
+			},
+			{
+				Name:    "invoice_account_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[10], InvoicesColumns[9]},
+			},
+		},
+	}
+	// InvoicePaymentsColumns holds the columns for the "invoice_payments" table.
+	InvoicePaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "reversed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "transaction_id", Type: field.TypeInt, Unique: true},
+	}
+	// InvoicePaymentsTable holds the schema information for the "invoice_payments" table.
+	InvoicePaymentsTable = &schema.Table{
+		Name:       "invoice_payments",
+		Columns:    InvoicePaymentsColumns,
+		PrimaryKey: []*schema.Column{InvoicePaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoice_payments_invoices_payments",
+				Columns:    []*schema.Column{InvoicePaymentsColumns[5]},
+				RefColumns: []*schema.Column{InvoicesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "invoice_payments_transactions_invoice_payment",
+				Columns:    []*schema.Column{InvoicePaymentsColumns[6]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoicepayment_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicePaymentsColumns[5]},
+			},
+			{
+				Name:    "invoicepayment_transaction_id",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicePaymentsColumns[6]},
+			},
+		},
+	}
+	// JournalEntriesColumns holds the columns for the "journal_entries" table.
+	JournalEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "ledger", Type: field.TypeEnum, Enums: []string{"customer_receivable", "merchant_payable", "cash", "fee_income", "interest_income", "transfer_clearing", "suspense"}},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"debit", "credit"}},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Size: 3},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeInt, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeInt},
+	}
+	// JournalEntriesTable holds the schema information for the "journal_entries" table.
+	JournalEntriesTable = &schema.Table{
+		Name:       "journal_entries",
+		Columns:    JournalEntriesColumns,
+		PrimaryKey: []*schema.Column{JournalEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "journal_entries_accounts_journal_entries",
+				Columns:    []*schema.Column{JournalEntriesColumns[8]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "journal_entries_transactions_journal_entries",
+				Columns:    []*schema.Column{JournalEntriesColumns[9]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "journalentry_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{JournalEntriesColumns[9]},
+			},
+			{
+				Name:    "journalentry_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{JournalEntriesColumns[8]},
+			},
+			{
+				Name:    "journalentry_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{JournalEntriesColumns[7]},
+			},
+		},
+	}
 	// OperationTypesColumns holds the columns for the "operation_types" table.
 	OperationTypesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -36,16 +321,113 @@ var (
 		Columns:    OperationTypesColumns,
 		PrimaryKey: []*schema.Column{OperationTypesColumns[0]},
 	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString, Size: 64},
+		{Name: "aggregate_id", Type: field.TypeInt},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "account_id", Type: field.TypeInt},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "outbox_events_accounts_outbox_events",
+				Columns:    []*schema.Column{OutboxEventsColumns[9]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[6]},
+			},
+			{
+				Name:    "outboxevent_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[9]},
+			},
+		},
+	}
+	// SettlementsColumns holds the columns for the "settlements" table.
+	SettlementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "reversed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "credit_txn_id", Type: field.TypeInt},
+		{Name: "debit_txn_id", Type: field.TypeInt},
+	}
+	// SettlementsTable holds the schema information for the "settlements" table.
+	SettlementsTable = &schema.Table{
+		Name:       "settlements",
+		Columns:    SettlementsColumns,
+		PrimaryKey: []*schema.Column{SettlementsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "settlements_transactions_credit_settlements",
+				Columns:    []*schema.Column{SettlementsColumns[6]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "settlements_transactions_debit_settlements",
+				Columns:    []*schema.Column{SettlementsColumns[7]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlement_credit_txn_id",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementsColumns[6]},
+			},
+			{
+				Name:    "settlement_debit_txn_id",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementsColumns[7]},
+			},
+		},
+	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
-		{Name: "amount", Type: field.TypeFloat64},
-		{Name: "balance", Type: field.TypeFloat64},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "balance", Type: field.TypeInt64, Default: 0},
 		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "installment_number", Type: field.TypeInt, Nullable: true},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"posted", "reversed"}, Default: "posted"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "merchant_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "mcc", Type: field.TypeString, Nullable: true, Size: 4},
+		{Name: "external_reference", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "USD"},
+		{Name: "original_amount", Type: field.TypeInt64, Nullable: true},
+		{Name: "original_currency", Type: field.TypeString, Nullable: true, Size: 3},
+		{Name: "fx_rate", Type: field.TypeString, Nullable: true, Size: 32},
 		{Name: "account_id", Type: field.TypeInt},
 		{Name: "operation_type_id", Type: field.TypeInt},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reversal_of_id", Type: field.TypeInt, Nullable: true},
+		{Name: "transfer_id", Type: field.TypeInt, Nullable: true},
 	}
 	// TransactionsTable holds the schema information for the "transactions" table.
 	TransactionsTable = &schema.Table{
@@ -55,49 +437,218 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "transactions_accounts_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[6]},
+				Columns:    []*schema.Column{TransactionsColumns[18]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "transactions_operation_types_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[7]},
+				Columns:    []*schema.Column{TransactionsColumns[19]},
 				RefColumns: []*schema.Column{OperationTypesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "transactions_transactions_installments",
+				Columns:    []*schema.Column{TransactionsColumns[20]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_transactions_reversal",
+				Columns:    []*schema.Column{TransactionsColumns[21]},
+				RefColumns: []*schema.Column{TransactionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "transactions_transfers_transactions",
+				Columns:    []*schema.Column{TransactionsColumns[22]},
+				RefColumns: []*schema.Column{TransfersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "transaction_account_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[6]},
+				Columns: []*schema.Column{TransactionsColumns[18]},
 			},
 			{
 				Name:    "transaction_account_id_operation_type_id",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[6], TransactionsColumns[7]},
+				Columns: []*schema.Column{TransactionsColumns[18], TransactionsColumns[19]},
 			},
 			{
 				Name:    "transaction_account_id_timestamp",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[6], TransactionsColumns[5]},
+				Columns: []*schema.Column{TransactionsColumns[18], TransactionsColumns[5]},
 			},
 			{
 				Name:    "transaction_account_id_operation_type_id_timestamp",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[6], TransactionsColumns[7], TransactionsColumns[5]},
+				Columns: []*schema.Column{TransactionsColumns[18], TransactionsColumns[19], TransactionsColumns[5]},
+			},
+			{
+				Name:    "transaction_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[20]},
+			},
+			{
+				Name:    "transaction_transfer_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[22]},
+			},
+			{
+				Name:    "transaction_account_id_external_reference",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[18], TransactionsColumns[12]},
+			},
+		},
+	}
+	// TransfersColumns holds the columns for the "transfers" table.
+	TransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "source_account_id", Type: field.TypeInt},
+		{Name: "destination_account_id", Type: field.TypeInt},
+	}
+	// TransfersTable holds the schema information for the "transfers" table.
+	TransfersTable = &schema.Table{
+		Name:       "transfers",
+		Columns:    TransfersColumns,
+		PrimaryKey: []*schema.Column{TransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "transfers_accounts_outgoing_transfers",
+				Columns:    []*schema.Column{TransfersColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "transfers_accounts_incoming_transfers",
+				Columns:    []*schema.Column{TransfersColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transfer_source_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[5]},
+			},
+			{
+				Name:    "transfer_destination_account_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[6]},
 			},
 		},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "event_id", Type: field.TypeInt},
+		{Name: "subscription_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_outbox_events_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{OutboxEventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "webhook_deliveries_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{WebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_subscription_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10], WebhookDeliveriesColumns[9]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3], WebhookDeliveriesColumns[5]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "secret", Type: field.TypeString, Size: 128},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		AccrualsTable,
+		FxRatesTable,
+		HoldsTable,
+		IdempotencyKeysTable,
+		InvoicesTable,
+		InvoicePaymentsTable,
+		JournalEntriesTable,
 		OperationTypesTable,
+		OutboxEventsTable,
+		SettlementsTable,
 		TransactionsTable,
+		TransfersTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
 	}
 )
 
 func init() {
+	AccrualsTable.ForeignKeys[0].RefTable = AccountsTable
+	AccrualsTable.ForeignKeys[1].RefTable = InvoicesTable
+	AccrualsTable.ForeignKeys[2].RefTable = TransactionsTable
+	HoldsTable.ForeignKeys[0].RefTable = AccountsTable
+	HoldsTable.ForeignKeys[1].RefTable = OperationTypesTable
+	HoldsTable.ForeignKeys[2].RefTable = TransactionsTable
+	InvoicesTable.ForeignKeys[0].RefTable = AccountsTable
+	InvoicePaymentsTable.ForeignKeys[0].RefTable = InvoicesTable
+	InvoicePaymentsTable.ForeignKeys[1].RefTable = TransactionsTable
+	JournalEntriesTable.ForeignKeys[0].RefTable = AccountsTable
+	JournalEntriesTable.ForeignKeys[1].RefTable = TransactionsTable
+	OutboxEventsTable.ForeignKeys[0].RefTable = AccountsTable
+	SettlementsTable.ForeignKeys[0].RefTable = TransactionsTable
+	SettlementsTable.ForeignKeys[1].RefTable = TransactionsTable
 	TransactionsTable.ForeignKeys[0].RefTable = AccountsTable
 	TransactionsTable.ForeignKeys[1].RefTable = OperationTypesTable
+	TransactionsTable.ForeignKeys[2].RefTable = TransactionsTable
+	TransactionsTable.ForeignKeys[3].RefTable = TransactionsTable
+	TransactionsTable.ForeignKeys[4].RefTable = TransfersTable
+	TransfersTable.ForeignKeys[0].RefTable = AccountsTable
+	TransfersTable.ForeignKeys[1].RefTable = AccountsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = OutboxEventsTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = WebhookSubscriptionsTable
 }
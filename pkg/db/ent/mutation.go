@@ -4,14 +4,28 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/fxrate"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/idempotencykey"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/journalentry"
 	"transactor-server/pkg/db/ent/operationtype"
+	"transactor-server/pkg/db/ent/outboxevent"
 	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/settlement"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/db/ent/webhooksubscription"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -26,28 +40,68 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccount       = "Account"
-	TypeOperationType = "OperationType"
-	TypeTransaction   = "Transaction"
+	TypeAccount             = "Account"
+	TypeAccrual             = "Accrual"
+	TypeFxRate              = "FxRate"
+	TypeHold                = "Hold"
+	TypeIdempotencyKey      = "IdempotencyKey"
+	TypeInvoice             = "Invoice"
+	TypeInvoicePayment      = "InvoicePayment"
+	TypeJournalEntry        = "JournalEntry"
+	TypeOperationType       = "OperationType"
+	TypeOutboxEvent         = "OutboxEvent"
+	TypeSettlement          = "Settlement"
+	TypeTransaction         = "Transaction"
+	TypeTransfer            = "Transfer"
+	TypeWebhookDelivery     = "WebhookDelivery"
+	TypeWebhookSubscription = "WebhookSubscription"
 )
 
 // AccountMutation represents an operation that mutates the Account nodes in the graph.
 type AccountMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	create_time         *time.Time
-	update_time         *time.Time
-	name                *string
-	document_number     *string
-	clearedFields       map[string]struct{}
-	transactions        map[int]struct{}
-	removedtransactions map[int]struct{}
-	clearedtransactions bool
-	done                bool
-	oldValue            func(context.Context) (*Account, error)
-	predicates          []predicate.Account
+	op                        Op
+	typ                       string
+	id                        *int
+	create_time               *time.Time
+	update_time               *time.Time
+	name                      *string
+	document_number           *string
+	credit_limit              *money.Amount
+	addcredit_limit           *money.Amount
+	cycle_closing_day         *int
+	addcycle_closing_day      *int
+	due_date_offset           *int
+	adddue_date_offset        *int
+	currency                  *string
+	clearedFields             map[string]struct{}
+	transactions              map[int]struct{}
+	removedtransactions       map[int]struct{}
+	clearedtransactions       bool
+	outgoing_transfers        map[int]struct{}
+	removedoutgoing_transfers map[int]struct{}
+	clearedoutgoing_transfers bool
+	incoming_transfers        map[int]struct{}
+	removedincoming_transfers map[int]struct{}
+	clearedincoming_transfers bool
+	holds                     map[int]struct{}
+	removedholds              map[int]struct{}
+	clearedholds              bool
+	invoices                  map[int]struct{}
+	removedinvoices           map[int]struct{}
+	clearedinvoices           bool
+	accruals                  map[int]struct{}
+	removedaccruals           map[int]struct{}
+	clearedaccruals           bool
+	journal_entries           map[int]struct{}
+	removedjournal_entries    map[int]struct{}
+	clearedjournal_entries    bool
+	outbox_events             map[int]struct{}
+	removedoutbox_events      map[int]struct{}
+	clearedoutbox_events      bool
+	done                      bool
+	oldValue                  func(context.Context) (*Account, error)
+	predicates                []predicate.Account
 }
 
 var _ ent.Mutation = (*AccountMutation)(nil)
@@ -298,6 +352,238 @@ func (m *AccountMutation) ResetDocumentNumber() {
 	m.document_number = nil
 }
 
+// SetCreditLimit sets the "credit_limit" field.
+func (m *AccountMutation) SetCreditLimit(value money.Amount) {
+	m.credit_limit = &value
+	m.addcredit_limit = nil
+}
+
+// CreditLimit returns the value of the "credit_limit" field in the mutation.
+func (m *AccountMutation) CreditLimit() (r money.Amount, exists bool) {
+	v := m.credit_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditLimit returns the old "credit_limit" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldCreditLimit(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditLimit: %w", err)
+	}
+	return oldValue.CreditLimit, nil
+}
+
+// AddCreditLimit adds value to the "credit_limit" field.
+func (m *AccountMutation) AddCreditLimit(value money.Amount) {
+	if m.addcredit_limit != nil {
+		*m.addcredit_limit += value
+	} else {
+		m.addcredit_limit = &value
+	}
+}
+
+// AddedCreditLimit returns the value that was added to the "credit_limit" field in this mutation.
+func (m *AccountMutation) AddedCreditLimit() (r money.Amount, exists bool) {
+	v := m.addcredit_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (m *AccountMutation) ClearCreditLimit() {
+	m.credit_limit = nil
+	m.addcredit_limit = nil
+	m.clearedFields[account.FieldCreditLimit] = struct{}{}
+}
+
+// CreditLimitCleared returns if the "credit_limit" field was cleared in this mutation.
+func (m *AccountMutation) CreditLimitCleared() bool {
+	_, ok := m.clearedFields[account.FieldCreditLimit]
+	return ok
+}
+
+// ResetCreditLimit resets all changes to the "credit_limit" field.
+func (m *AccountMutation) ResetCreditLimit() {
+	m.credit_limit = nil
+	m.addcredit_limit = nil
+	delete(m.clearedFields, account.FieldCreditLimit)
+}
+
+// SetCycleClosingDay sets the "cycle_closing_day" field.
+func (m *AccountMutation) SetCycleClosingDay(i int) {
+	m.cycle_closing_day = &i
+	m.addcycle_closing_day = nil
+}
+
+// CycleClosingDay returns the value of the "cycle_closing_day" field in the mutation.
+func (m *AccountMutation) CycleClosingDay() (r int, exists bool) {
+	v := m.cycle_closing_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCycleClosingDay returns the old "cycle_closing_day" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldCycleClosingDay(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCycleClosingDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCycleClosingDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCycleClosingDay: %w", err)
+	}
+	return oldValue.CycleClosingDay, nil
+}
+
+// AddCycleClosingDay adds i to the "cycle_closing_day" field.
+func (m *AccountMutation) AddCycleClosingDay(i int) {
+	if m.addcycle_closing_day != nil {
+		*m.addcycle_closing_day += i
+	} else {
+		m.addcycle_closing_day = &i
+	}
+}
+
+// AddedCycleClosingDay returns the value that was added to the "cycle_closing_day" field in this mutation.
+func (m *AccountMutation) AddedCycleClosingDay() (r int, exists bool) {
+	v := m.addcycle_closing_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCycleClosingDay clears the value of the "cycle_closing_day" field.
+func (m *AccountMutation) ClearCycleClosingDay() {
+	m.cycle_closing_day = nil
+	m.addcycle_closing_day = nil
+	m.clearedFields[account.FieldCycleClosingDay] = struct{}{}
+}
+
+// CycleClosingDayCleared returns if the "cycle_closing_day" field was cleared in this mutation.
+func (m *AccountMutation) CycleClosingDayCleared() bool {
+	_, ok := m.clearedFields[account.FieldCycleClosingDay]
+	return ok
+}
+
+// ResetCycleClosingDay resets all changes to the "cycle_closing_day" field.
+func (m *AccountMutation) ResetCycleClosingDay() {
+	m.cycle_closing_day = nil
+	m.addcycle_closing_day = nil
+	delete(m.clearedFields, account.FieldCycleClosingDay)
+}
+
+// SetDueDateOffset sets the "due_date_offset" field.
+func (m *AccountMutation) SetDueDateOffset(i int) {
+	m.due_date_offset = &i
+	m.adddue_date_offset = nil
+}
+
+// DueDateOffset returns the value of the "due_date_offset" field in the mutation.
+func (m *AccountMutation) DueDateOffset() (r int, exists bool) {
+	v := m.due_date_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDateOffset returns the old "due_date_offset" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldDueDateOffset(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDateOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDateOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDateOffset: %w", err)
+	}
+	return oldValue.DueDateOffset, nil
+}
+
+// AddDueDateOffset adds i to the "due_date_offset" field.
+func (m *AccountMutation) AddDueDateOffset(i int) {
+	if m.adddue_date_offset != nil {
+		*m.adddue_date_offset += i
+	} else {
+		m.adddue_date_offset = &i
+	}
+}
+
+// AddedDueDateOffset returns the value that was added to the "due_date_offset" field in this mutation.
+func (m *AccountMutation) AddedDueDateOffset() (r int, exists bool) {
+	v := m.adddue_date_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDueDateOffset resets all changes to the "due_date_offset" field.
+func (m *AccountMutation) ResetDueDateOffset() {
+	m.due_date_offset = nil
+	m.adddue_date_offset = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *AccountMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *AccountMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *AccountMutation) ResetCurrency() {
+	m.currency = nil
+}
+
 // AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
 func (m *AccountMutation) AddTransactionIDs(ids ...int) {
 	if m.transactions == nil {
@@ -352,202 +638,13072 @@ func (m *AccountMutation) ResetTransactions() {
 	m.removedtransactions = nil
 }
 
-// Where appends a list predicates to the AccountMutation builder.
-func (m *AccountMutation) Where(ps ...predicate.Account) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the AccountMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AccountMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Account, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Transfer entity by ids.
+func (m *AccountMutation) AddOutgoingTransferIDs(ids ...int) {
+	if m.outgoing_transfers == nil {
+		m.outgoing_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.outgoing_transfers[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *AccountMutation) Op() Op {
-	return m.op
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AccountMutation) SetOp(op Op) {
-	m.op = op
+// ClearOutgoingTransfers clears the "outgoing_transfers" edge to the Transfer entity.
+func (m *AccountMutation) ClearOutgoingTransfers() {
+	m.clearedoutgoing_transfers = true
 }
 
-// Type returns the node type of this mutation (Account).
-func (m *AccountMutation) Type() string {
-	return m.typ
+// OutgoingTransfersCleared reports if the "outgoing_transfers" edge to the Transfer entity was cleared.
+func (m *AccountMutation) OutgoingTransfersCleared() bool {
+	return m.clearedoutgoing_transfers
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AccountMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.create_time != nil {
-		fields = append(fields, account.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, account.FieldUpdateTime)
-	}
-	if m.name != nil {
-		fields = append(fields, account.FieldName)
+// RemoveOutgoingTransferIDs removes the "outgoing_transfers" edge to the Transfer entity by IDs.
+func (m *AccountMutation) RemoveOutgoingTransferIDs(ids ...int) {
+	if m.removedoutgoing_transfers == nil {
+		m.removedoutgoing_transfers = make(map[int]struct{})
 	}
-	if m.document_number != nil {
-		fields = append(fields, account.FieldDocumentNumber)
+	for i := range ids {
+		delete(m.outgoing_transfers, ids[i])
+		m.removedoutgoing_transfers[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *AccountMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case account.FieldCreateTime:
-		return m.CreateTime()
-	case account.FieldUpdateTime:
-		return m.UpdateTime()
-	case account.FieldName:
-		return m.Name()
-	case account.FieldDocumentNumber:
-		return m.DocumentNumber()
+// RemovedOutgoingTransfers returns the removed IDs of the "outgoing_transfers" edge to the Transfer entity.
+func (m *AccountMutation) RemovedOutgoingTransfersIDs() (ids []int) {
+	for id := range m.removedoutgoing_transfers {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case account.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case account.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case account.FieldName:
-		return m.OldName(ctx)
-	case account.FieldDocumentNumber:
-		return m.OldDocumentNumber(ctx)
+// OutgoingTransfersIDs returns the "outgoing_transfers" edge IDs in the mutation.
+func (m *AccountMutation) OutgoingTransfersIDs() (ids []int) {
+	for id := range m.outgoing_transfers {
+		ids = append(ids, id)
 	}
-	return nil, fmt.Errorf("unknown Account field %s", name)
+	return
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *AccountMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case account.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case account.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case account.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case account.FieldDocumentNumber:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDocumentNumber(v)
-		return nil
+// ResetOutgoingTransfers resets all changes to the "outgoing_transfers" edge.
+func (m *AccountMutation) ResetOutgoingTransfers() {
+	m.outgoing_transfers = nil
+	m.clearedoutgoing_transfers = false
+	m.removedoutgoing_transfers = nil
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Transfer entity by ids.
+func (m *AccountMutation) AddIncomingTransferIDs(ids ...int) {
+	if m.incoming_transfers == nil {
+		m.incoming_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.incoming_transfers[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Account field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *AccountMutation) AddedFields() []string {
-	return nil
+// ClearIncomingTransfers clears the "incoming_transfers" edge to the Transfer entity.
+func (m *AccountMutation) ClearIncomingTransfers() {
+	m.clearedincoming_transfers = true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *AccountMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// IncomingTransfersCleared reports if the "incoming_transfers" edge to the Transfer entity was cleared.
+func (m *AccountMutation) IncomingTransfersCleared() bool {
+	return m.clearedincoming_transfers
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// RemoveIncomingTransferIDs removes the "incoming_transfers" edge to the Transfer entity by IDs.
+func (m *AccountMutation) RemoveIncomingTransferIDs(ids ...int) {
+	if m.removedincoming_transfers == nil {
+		m.removedincoming_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.incoming_transfers, ids[i])
+		m.removedincoming_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIncomingTransfers returns the removed IDs of the "incoming_transfers" edge to the Transfer entity.
+func (m *AccountMutation) RemovedIncomingTransfersIDs() (ids []int) {
+	for id := range m.removedincoming_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IncomingTransfersIDs returns the "incoming_transfers" edge IDs in the mutation.
+func (m *AccountMutation) IncomingTransfersIDs() (ids []int) {
+	for id := range m.incoming_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIncomingTransfers resets all changes to the "incoming_transfers" edge.
+func (m *AccountMutation) ResetIncomingTransfers() {
+	m.incoming_transfers = nil
+	m.clearedincoming_transfers = false
+	m.removedincoming_transfers = nil
+}
+
+// AddHoldIDs adds the "holds" edge to the Hold entity by ids.
+func (m *AccountMutation) AddHoldIDs(ids ...int) {
+	if m.holds == nil {
+		m.holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.holds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHolds clears the "holds" edge to the Hold entity.
+func (m *AccountMutation) ClearHolds() {
+	m.clearedholds = true
+}
+
+// HoldsCleared reports if the "holds" edge to the Hold entity was cleared.
+func (m *AccountMutation) HoldsCleared() bool {
+	return m.clearedholds
+}
+
+// RemoveHoldIDs removes the "holds" edge to the Hold entity by IDs.
+func (m *AccountMutation) RemoveHoldIDs(ids ...int) {
+	if m.removedholds == nil {
+		m.removedholds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.holds, ids[i])
+		m.removedholds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHolds returns the removed IDs of the "holds" edge to the Hold entity.
+func (m *AccountMutation) RemovedHoldsIDs() (ids []int) {
+	for id := range m.removedholds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HoldsIDs returns the "holds" edge IDs in the mutation.
+func (m *AccountMutation) HoldsIDs() (ids []int) {
+	for id := range m.holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHolds resets all changes to the "holds" edge.
+func (m *AccountMutation) ResetHolds() {
+	m.holds = nil
+	m.clearedholds = false
+	m.removedholds = nil
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by ids.
+func (m *AccountMutation) AddInvoiceIDs(ids ...int) {
+	if m.invoices == nil {
+		m.invoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.invoices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvoices clears the "invoices" edge to the Invoice entity.
+func (m *AccountMutation) ClearInvoices() {
+	m.clearedinvoices = true
+}
+
+// InvoicesCleared reports if the "invoices" edge to the Invoice entity was cleared.
+func (m *AccountMutation) InvoicesCleared() bool {
+	return m.clearedinvoices
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to the Invoice entity by IDs.
+func (m *AccountMutation) RemoveInvoiceIDs(ids ...int) {
+	if m.removedinvoices == nil {
+		m.removedinvoices = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.invoices, ids[i])
+		m.removedinvoices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvoices returns the removed IDs of the "invoices" edge to the Invoice entity.
+func (m *AccountMutation) RemovedInvoicesIDs() (ids []int) {
+	for id := range m.removedinvoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvoicesIDs returns the "invoices" edge IDs in the mutation.
+func (m *AccountMutation) InvoicesIDs() (ids []int) {
+	for id := range m.invoices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvoices resets all changes to the "invoices" edge.
+func (m *AccountMutation) ResetInvoices() {
+	m.invoices = nil
+	m.clearedinvoices = false
+	m.removedinvoices = nil
+}
+
+// AddAccrualIDs adds the "accruals" edge to the Accrual entity by ids.
+func (m *AccountMutation) AddAccrualIDs(ids ...int) {
+	if m.accruals == nil {
+		m.accruals = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.accruals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccruals clears the "accruals" edge to the Accrual entity.
+func (m *AccountMutation) ClearAccruals() {
+	m.clearedaccruals = true
+}
+
+// AccrualsCleared reports if the "accruals" edge to the Accrual entity was cleared.
+func (m *AccountMutation) AccrualsCleared() bool {
+	return m.clearedaccruals
+}
+
+// RemoveAccrualIDs removes the "accruals" edge to the Accrual entity by IDs.
+func (m *AccountMutation) RemoveAccrualIDs(ids ...int) {
+	if m.removedaccruals == nil {
+		m.removedaccruals = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.accruals, ids[i])
+		m.removedaccruals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccruals returns the removed IDs of the "accruals" edge to the Accrual entity.
+func (m *AccountMutation) RemovedAccrualsIDs() (ids []int) {
+	for id := range m.removedaccruals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccrualsIDs returns the "accruals" edge IDs in the mutation.
+func (m *AccountMutation) AccrualsIDs() (ids []int) {
+	for id := range m.accruals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccruals resets all changes to the "accruals" edge.
+func (m *AccountMutation) ResetAccruals() {
+	m.accruals = nil
+	m.clearedaccruals = false
+	m.removedaccruals = nil
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by ids.
+func (m *AccountMutation) AddJournalEntryIDs(ids ...int) {
+	if m.journal_entries == nil {
+		m.journal_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.journal_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJournalEntries clears the "journal_entries" edge to the JournalEntry entity.
+func (m *AccountMutation) ClearJournalEntries() {
+	m.clearedjournal_entries = true
+}
+
+// JournalEntriesCleared reports if the "journal_entries" edge to the JournalEntry entity was cleared.
+func (m *AccountMutation) JournalEntriesCleared() bool {
+	return m.clearedjournal_entries
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to the JournalEntry entity by IDs.
+func (m *AccountMutation) RemoveJournalEntryIDs(ids ...int) {
+	if m.removedjournal_entries == nil {
+		m.removedjournal_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.journal_entries, ids[i])
+		m.removedjournal_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJournalEntries returns the removed IDs of the "journal_entries" edge to the JournalEntry entity.
+func (m *AccountMutation) RemovedJournalEntriesIDs() (ids []int) {
+	for id := range m.removedjournal_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JournalEntriesIDs returns the "journal_entries" edge IDs in the mutation.
+func (m *AccountMutation) JournalEntriesIDs() (ids []int) {
+	for id := range m.journal_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJournalEntries resets all changes to the "journal_entries" edge.
+func (m *AccountMutation) ResetJournalEntries() {
+	m.journal_entries = nil
+	m.clearedjournal_entries = false
+	m.removedjournal_entries = nil
+}
+
+// AddOutboxEventIDs adds the "outbox_events" edge to the OutboxEvent entity by ids.
+func (m *AccountMutation) AddOutboxEventIDs(ids ...int) {
+	if m.outbox_events == nil {
+		m.outbox_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.outbox_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOutboxEvents clears the "outbox_events" edge to the OutboxEvent entity.
+func (m *AccountMutation) ClearOutboxEvents() {
+	m.clearedoutbox_events = true
+}
+
+// OutboxEventsCleared reports if the "outbox_events" edge to the OutboxEvent entity was cleared.
+func (m *AccountMutation) OutboxEventsCleared() bool {
+	return m.clearedoutbox_events
+}
+
+// RemoveOutboxEventIDs removes the "outbox_events" edge to the OutboxEvent entity by IDs.
+func (m *AccountMutation) RemoveOutboxEventIDs(ids ...int) {
+	if m.removedoutbox_events == nil {
+		m.removedoutbox_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.outbox_events, ids[i])
+		m.removedoutbox_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOutboxEvents returns the removed IDs of the "outbox_events" edge to the OutboxEvent entity.
+func (m *AccountMutation) RemovedOutboxEventsIDs() (ids []int) {
+	for id := range m.removedoutbox_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OutboxEventsIDs returns the "outbox_events" edge IDs in the mutation.
+func (m *AccountMutation) OutboxEventsIDs() (ids []int) {
+	for id := range m.outbox_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOutboxEvents resets all changes to the "outbox_events" edge.
+func (m *AccountMutation) ResetOutboxEvents() {
+	m.outbox_events = nil
+	m.clearedoutbox_events = false
+	m.removedoutbox_events = nil
+}
+
+// Where appends a list predicates to the AccountMutation builder.
+func (m *AccountMutation) Where(ps ...predicate.Account) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Account, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Account).
+func (m *AccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccountMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, account.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, account.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, account.FieldName)
+	}
+	if m.document_number != nil {
+		fields = append(fields, account.FieldDocumentNumber)
+	}
+	if m.credit_limit != nil {
+		fields = append(fields, account.FieldCreditLimit)
+	}
+	if m.cycle_closing_day != nil {
+		fields = append(fields, account.FieldCycleClosingDay)
+	}
+	if m.due_date_offset != nil {
+		fields = append(fields, account.FieldDueDateOffset)
+	}
+	if m.currency != nil {
+		fields = append(fields, account.FieldCurrency)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case account.FieldCreateTime:
+		return m.CreateTime()
+	case account.FieldUpdateTime:
+		return m.UpdateTime()
+	case account.FieldName:
+		return m.Name()
+	case account.FieldDocumentNumber:
+		return m.DocumentNumber()
+	case account.FieldCreditLimit:
+		return m.CreditLimit()
+	case account.FieldCycleClosingDay:
+		return m.CycleClosingDay()
+	case account.FieldDueDateOffset:
+		return m.DueDateOffset()
+	case account.FieldCurrency:
+		return m.Currency()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case account.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case account.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case account.FieldName:
+		return m.OldName(ctx)
+	case account.FieldDocumentNumber:
+		return m.OldDocumentNumber(ctx)
+	case account.FieldCreditLimit:
+		return m.OldCreditLimit(ctx)
+	case account.FieldCycleClosingDay:
+		return m.OldCycleClosingDay(ctx)
+	case account.FieldDueDateOffset:
+		return m.OldDueDateOffset(ctx)
+	case account.FieldCurrency:
+		return m.OldCurrency(ctx)
+	}
+	return nil, fmt.Errorf("unknown Account field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case account.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case account.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case account.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case account.FieldDocumentNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentNumber(v)
+		return nil
+	case account.FieldCreditLimit:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditLimit(v)
+		return nil
+	case account.FieldCycleClosingDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCycleClosingDay(v)
+		return nil
+	case account.FieldDueDateOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDateOffset(v)
+		return nil
+	case account.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Account field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccountMutation) AddedFields() []string {
+	var fields []string
+	if m.addcredit_limit != nil {
+		fields = append(fields, account.FieldCreditLimit)
+	}
+	if m.addcycle_closing_day != nil {
+		fields = append(fields, account.FieldCycleClosingDay)
+	}
+	if m.adddue_date_offset != nil {
+		fields = append(fields, account.FieldDueDateOffset)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case account.FieldCreditLimit:
+		return m.AddedCreditLimit()
+	case account.FieldCycleClosingDay:
+		return m.AddedCycleClosingDay()
+	case account.FieldDueDateOffset:
+		return m.AddedDueDateOffset()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case account.FieldCreditLimit:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreditLimit(v)
+		return nil
+	case account.FieldCycleClosingDay:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCycleClosingDay(v)
+		return nil
+	case account.FieldDueDateOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDueDateOffset(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Account numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(account.FieldCreditLimit) {
+		fields = append(fields, account.FieldCreditLimit)
+	}
+	if m.FieldCleared(account.FieldCycleClosingDay) {
+		fields = append(fields, account.FieldCycleClosingDay)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccountMutation) ClearField(name string) error {
+	switch name {
+	case account.FieldCreditLimit:
+		m.ClearCreditLimit()
+		return nil
+	case account.FieldCycleClosingDay:
+		m.ClearCycleClosingDay()
+		return nil
+	}
+	return fmt.Errorf("unknown Account nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccountMutation) ResetField(name string) error {
+	switch name {
+	case account.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case account.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case account.FieldName:
+		m.ResetName()
+		return nil
+	case account.FieldDocumentNumber:
+		m.ResetDocumentNumber()
+		return nil
+	case account.FieldCreditLimit:
+		m.ResetCreditLimit()
+		return nil
+	case account.FieldCycleClosingDay:
+		m.ResetCycleClosingDay()
+		return nil
+	case account.FieldDueDateOffset:
+		m.ResetDueDateOffset()
+		return nil
+	case account.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	}
+	return fmt.Errorf("unknown Account field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.transactions != nil {
+		edges = append(edges, account.EdgeTransactions)
+	}
+	if m.outgoing_transfers != nil {
+		edges = append(edges, account.EdgeOutgoingTransfers)
+	}
+	if m.incoming_transfers != nil {
+		edges = append(edges, account.EdgeIncomingTransfers)
+	}
+	if m.holds != nil {
+		edges = append(edges, account.EdgeHolds)
+	}
+	if m.invoices != nil {
+		edges = append(edges, account.EdgeInvoices)
+	}
+	if m.accruals != nil {
+		edges = append(edges, account.EdgeAccruals)
+	}
+	if m.journal_entries != nil {
+		edges = append(edges, account.EdgeJournalEntries)
+	}
+	if m.outbox_events != nil {
+		edges = append(edges, account.EdgeOutboxEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccountMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case account.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeOutgoingTransfers:
+		ids := make([]ent.Value, 0, len(m.outgoing_transfers))
+		for id := range m.outgoing_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeIncomingTransfers:
+		ids := make([]ent.Value, 0, len(m.incoming_transfers))
+		for id := range m.incoming_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeHolds:
+		ids := make([]ent.Value, 0, len(m.holds))
+		for id := range m.holds {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.invoices))
+		for id := range m.invoices {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeAccruals:
+		ids := make([]ent.Value, 0, len(m.accruals))
+		for id := range m.accruals {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeJournalEntries:
+		ids := make([]ent.Value, 0, len(m.journal_entries))
+		for id := range m.journal_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeOutboxEvents:
+		ids := make([]ent.Value, 0, len(m.outbox_events))
+		for id := range m.outbox_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedtransactions != nil {
+		edges = append(edges, account.EdgeTransactions)
+	}
+	if m.removedoutgoing_transfers != nil {
+		edges = append(edges, account.EdgeOutgoingTransfers)
+	}
+	if m.removedincoming_transfers != nil {
+		edges = append(edges, account.EdgeIncomingTransfers)
+	}
+	if m.removedholds != nil {
+		edges = append(edges, account.EdgeHolds)
+	}
+	if m.removedinvoices != nil {
+		edges = append(edges, account.EdgeInvoices)
+	}
+	if m.removedaccruals != nil {
+		edges = append(edges, account.EdgeAccruals)
+	}
+	if m.removedjournal_entries != nil {
+		edges = append(edges, account.EdgeJournalEntries)
+	}
+	if m.removedoutbox_events != nil {
+		edges = append(edges, account.EdgeOutboxEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case account.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeOutgoingTransfers:
+		ids := make([]ent.Value, 0, len(m.removedoutgoing_transfers))
+		for id := range m.removedoutgoing_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeIncomingTransfers:
+		ids := make([]ent.Value, 0, len(m.removedincoming_transfers))
+		for id := range m.removedincoming_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeHolds:
+		ids := make([]ent.Value, 0, len(m.removedholds))
+		for id := range m.removedholds {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeInvoices:
+		ids := make([]ent.Value, 0, len(m.removedinvoices))
+		for id := range m.removedinvoices {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeAccruals:
+		ids := make([]ent.Value, 0, len(m.removedaccruals))
+		for id := range m.removedaccruals {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeJournalEntries:
+		ids := make([]ent.Value, 0, len(m.removedjournal_entries))
+		for id := range m.removedjournal_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeOutboxEvents:
+		ids := make([]ent.Value, 0, len(m.removedoutbox_events))
+		for id := range m.removedoutbox_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedtransactions {
+		edges = append(edges, account.EdgeTransactions)
+	}
+	if m.clearedoutgoing_transfers {
+		edges = append(edges, account.EdgeOutgoingTransfers)
+	}
+	if m.clearedincoming_transfers {
+		edges = append(edges, account.EdgeIncomingTransfers)
+	}
+	if m.clearedholds {
+		edges = append(edges, account.EdgeHolds)
+	}
+	if m.clearedinvoices {
+		edges = append(edges, account.EdgeInvoices)
+	}
+	if m.clearedaccruals {
+		edges = append(edges, account.EdgeAccruals)
+	}
+	if m.clearedjournal_entries {
+		edges = append(edges, account.EdgeJournalEntries)
+	}
+	if m.clearedoutbox_events {
+		edges = append(edges, account.EdgeOutboxEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccountMutation) EdgeCleared(name string) bool {
+	switch name {
+	case account.EdgeTransactions:
+		return m.clearedtransactions
+	case account.EdgeOutgoingTransfers:
+		return m.clearedoutgoing_transfers
+	case account.EdgeIncomingTransfers:
+		return m.clearedincoming_transfers
+	case account.EdgeHolds:
+		return m.clearedholds
+	case account.EdgeInvoices:
+		return m.clearedinvoices
+	case account.EdgeAccruals:
+		return m.clearedaccruals
+	case account.EdgeJournalEntries:
+		return m.clearedjournal_entries
+	case account.EdgeOutboxEvents:
+		return m.clearedoutbox_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccountMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Account unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccountMutation) ResetEdge(name string) error {
+	switch name {
+	case account.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case account.EdgeOutgoingTransfers:
+		m.ResetOutgoingTransfers()
+		return nil
+	case account.EdgeIncomingTransfers:
+		m.ResetIncomingTransfers()
+		return nil
+	case account.EdgeHolds:
+		m.ResetHolds()
+		return nil
+	case account.EdgeInvoices:
+		m.ResetInvoices()
+		return nil
+	case account.EdgeAccruals:
+		m.ResetAccruals()
+		return nil
+	case account.EdgeJournalEntries:
+		m.ResetJournalEntries()
+		return nil
+	case account.EdgeOutboxEvents:
+		m.ResetOutboxEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Account edge %s", name)
+}
+
+// AccrualMutation represents an operation that mutates the Accrual nodes in the graph.
+type AccrualMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	date               *time.Time
+	kind               *accrual.Kind
+	amount             *money.Amount
+	addamount          *money.Amount
+	clearedFields      map[string]struct{}
+	account            *int
+	clearedaccount     bool
+	transaction        *int
+	clearedtransaction bool
+	invoice            *int
+	clearedinvoice     bool
+	done               bool
+	oldValue           func(context.Context) (*Accrual, error)
+	predicates         []predicate.Accrual
+}
+
+var _ ent.Mutation = (*AccrualMutation)(nil)
+
+// accrualOption allows management of the mutation configuration using functional options.
+type accrualOption func(*AccrualMutation)
+
+// newAccrualMutation creates new mutation for the Accrual entity.
+func newAccrualMutation(c config, op Op, opts ...accrualOption) *AccrualMutation {
+	m := &AccrualMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAccrual,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAccrualID sets the ID field of the mutation.
+func withAccrualID(id int) accrualOption {
+	return func(m *AccrualMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Accrual
+		)
+		m.oldValue = func(ctx context.Context) (*Accrual, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Accrual.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAccrual sets the old Accrual of the mutation.
+func withAccrual(node *Accrual) accrualOption {
+	return func(m *AccrualMutation) {
+		m.oldValue = func(context.Context) (*Accrual, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AccrualMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AccrualMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Accrual entities.
+func (m *AccrualMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AccrualMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AccrualMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Accrual.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *AccrualMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *AccrualMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *AccrualMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *AccrualMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *AccrualMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *AccrualMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *AccrualMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *AccrualMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *AccrualMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetDate sets the "date" field.
+func (m *AccrualMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *AccrualMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *AccrualMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *AccrualMutation) SetKind(a accrual.Kind) {
+	m.kind = &a
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AccrualMutation) Kind() (r accrual.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldKind(ctx context.Context) (v accrual.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *AccrualMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *AccrualMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *AccrualMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *AccrualMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *AccrualMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *AccrualMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *AccrualMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *AccrualMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldTransactionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (m *AccrualMutation) ClearTransactionID() {
+	m.transaction = nil
+	m.clearedFields[accrual.FieldTransactionID] = struct{}{}
+}
+
+// TransactionIDCleared returns if the "transaction_id" field was cleared in this mutation.
+func (m *AccrualMutation) TransactionIDCleared() bool {
+	_, ok := m.clearedFields[accrual.FieldTransactionID]
+	return ok
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *AccrualMutation) ResetTransactionID() {
+	m.transaction = nil
+	delete(m.clearedFields, accrual.FieldTransactionID)
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (m *AccrualMutation) SetInvoiceID(i int) {
+	m.invoice = &i
+}
+
+// InvoiceID returns the value of the "invoice_id" field in the mutation.
+func (m *AccrualMutation) InvoiceID() (r int, exists bool) {
+	v := m.invoice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvoiceID returns the old "invoice_id" field's value of the Accrual entity.
+// If the Accrual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccrualMutation) OldInvoiceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvoiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvoiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvoiceID: %w", err)
+	}
+	return oldValue.InvoiceID, nil
+}
+
+// ClearInvoiceID clears the value of the "invoice_id" field.
+func (m *AccrualMutation) ClearInvoiceID() {
+	m.invoice = nil
+	m.clearedFields[accrual.FieldInvoiceID] = struct{}{}
+}
+
+// InvoiceIDCleared returns if the "invoice_id" field was cleared in this mutation.
+func (m *AccrualMutation) InvoiceIDCleared() bool {
+	_, ok := m.clearedFields[accrual.FieldInvoiceID]
+	return ok
+}
+
+// ResetInvoiceID resets all changes to the "invoice_id" field.
+func (m *AccrualMutation) ResetInvoiceID() {
+	m.invoice = nil
+	delete(m.clearedFields, accrual.FieldInvoiceID)
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *AccrualMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[accrual.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *AccrualMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *AccrualMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *AccrualMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *AccrualMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[accrual.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *AccrualMutation) TransactionCleared() bool {
+	return m.TransactionIDCleared() || m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *AccrualMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *AccrualMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (m *AccrualMutation) ClearInvoice() {
+	m.clearedinvoice = true
+	m.clearedFields[accrual.FieldInvoiceID] = struct{}{}
+}
+
+// InvoiceCleared reports if the "invoice" edge to the Invoice entity was cleared.
+func (m *AccrualMutation) InvoiceCleared() bool {
+	return m.InvoiceIDCleared() || m.clearedinvoice
+}
+
+// InvoiceIDs returns the "invoice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InvoiceID instead. It exists only for internal usage by the builders.
+func (m *AccrualMutation) InvoiceIDs() (ids []int) {
+	if id := m.invoice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvoice resets all changes to the "invoice" edge.
+func (m *AccrualMutation) ResetInvoice() {
+	m.invoice = nil
+	m.clearedinvoice = false
+}
+
+// Where appends a list predicates to the AccrualMutation builder.
+func (m *AccrualMutation) Where(ps ...predicate.Accrual) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AccrualMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccrualMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Accrual, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AccrualMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AccrualMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Accrual).
+func (m *AccrualMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccrualMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, accrual.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, accrual.FieldUpdateTime)
+	}
+	if m.account != nil {
+		fields = append(fields, accrual.FieldAccountID)
+	}
+	if m.date != nil {
+		fields = append(fields, accrual.FieldDate)
+	}
+	if m.kind != nil {
+		fields = append(fields, accrual.FieldKind)
+	}
+	if m.amount != nil {
+		fields = append(fields, accrual.FieldAmount)
+	}
+	if m.transaction != nil {
+		fields = append(fields, accrual.FieldTransactionID)
+	}
+	if m.invoice != nil {
+		fields = append(fields, accrual.FieldInvoiceID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccrualMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case accrual.FieldCreateTime:
+		return m.CreateTime()
+	case accrual.FieldUpdateTime:
+		return m.UpdateTime()
+	case accrual.FieldAccountID:
+		return m.AccountID()
+	case accrual.FieldDate:
+		return m.Date()
+	case accrual.FieldKind:
+		return m.Kind()
+	case accrual.FieldAmount:
+		return m.Amount()
+	case accrual.FieldTransactionID:
+		return m.TransactionID()
+	case accrual.FieldInvoiceID:
+		return m.InvoiceID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccrualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case accrual.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case accrual.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case accrual.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case accrual.FieldDate:
+		return m.OldDate(ctx)
+	case accrual.FieldKind:
+		return m.OldKind(ctx)
+	case accrual.FieldAmount:
+		return m.OldAmount(ctx)
+	case accrual.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case accrual.FieldInvoiceID:
+		return m.OldInvoiceID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Accrual field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccrualMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case accrual.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case accrual.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case accrual.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case accrual.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case accrual.FieldKind:
+		v, ok := value.(accrual.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case accrual.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case accrual.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case accrual.FieldInvoiceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvoiceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Accrual field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccrualMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, accrual.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccrualMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case accrual.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccrualMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case accrual.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Accrual numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccrualMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(accrual.FieldTransactionID) {
+		fields = append(fields, accrual.FieldTransactionID)
+	}
+	if m.FieldCleared(accrual.FieldInvoiceID) {
+		fields = append(fields, accrual.FieldInvoiceID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccrualMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccrualMutation) ClearField(name string) error {
+	switch name {
+	case accrual.FieldTransactionID:
+		m.ClearTransactionID()
+		return nil
+	case accrual.FieldInvoiceID:
+		m.ClearInvoiceID()
+		return nil
+	}
+	return fmt.Errorf("unknown Accrual nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccrualMutation) ResetField(name string) error {
+	switch name {
+	case accrual.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case accrual.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case accrual.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case accrual.FieldDate:
+		m.ResetDate()
+		return nil
+	case accrual.FieldKind:
+		m.ResetKind()
+		return nil
+	case accrual.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case accrual.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case accrual.FieldInvoiceID:
+		m.ResetInvoiceID()
+		return nil
+	}
+	return fmt.Errorf("unknown Accrual field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccrualMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.account != nil {
+		edges = append(edges, accrual.EdgeAccount)
+	}
+	if m.transaction != nil {
+		edges = append(edges, accrual.EdgeTransaction)
+	}
+	if m.invoice != nil {
+		edges = append(edges, accrual.EdgeInvoice)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccrualMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case accrual.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case accrual.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	case accrual.EdgeInvoice:
+		if id := m.invoice; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccrualMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccrualMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccrualMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedaccount {
+		edges = append(edges, accrual.EdgeAccount)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, accrual.EdgeTransaction)
+	}
+	if m.clearedinvoice {
+		edges = append(edges, accrual.EdgeInvoice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccrualMutation) EdgeCleared(name string) bool {
+	switch name {
+	case accrual.EdgeAccount:
+		return m.clearedaccount
+	case accrual.EdgeTransaction:
+		return m.clearedtransaction
+	case accrual.EdgeInvoice:
+		return m.clearedinvoice
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccrualMutation) ClearEdge(name string) error {
+	switch name {
+	case accrual.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case accrual.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	case accrual.EdgeInvoice:
+		m.ClearInvoice()
+		return nil
+	}
+	return fmt.Errorf("unknown Accrual unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccrualMutation) ResetEdge(name string) error {
+	switch name {
+	case accrual.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case accrual.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	case accrual.EdgeInvoice:
+		m.ResetInvoice()
+		return nil
+	}
+	return fmt.Errorf("unknown Accrual edge %s", name)
+}
+
+// FxRateMutation represents an operation that mutates the FxRate nodes in the graph.
+type FxRateMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	create_time    *time.Time
+	update_time    *time.Time
+	base_currency  *string
+	quote_currency *string
+	rate           *string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*FxRate, error)
+	predicates     []predicate.FxRate
+}
+
+var _ ent.Mutation = (*FxRateMutation)(nil)
+
+// fxrateOption allows management of the mutation configuration using functional options.
+type fxrateOption func(*FxRateMutation)
+
+// newFxRateMutation creates new mutation for the FxRate entity.
+func newFxRateMutation(c config, op Op, opts ...fxrateOption) *FxRateMutation {
+	m := &FxRateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFxRate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFxRateID sets the ID field of the mutation.
+func withFxRateID(id int) fxrateOption {
+	return func(m *FxRateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FxRate
+		)
+		m.oldValue = func(ctx context.Context) (*FxRate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FxRate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFxRate sets the old FxRate of the mutation.
+func withFxRate(node *FxRate) fxrateOption {
+	return func(m *FxRateMutation) {
+		m.oldValue = func(context.Context) (*FxRate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FxRateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FxRateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FxRate entities.
+func (m *FxRateMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FxRateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FxRateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FxRate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FxRateMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FxRateMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FxRateMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FxRateMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FxRateMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FxRateMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetBaseCurrency sets the "base_currency" field.
+func (m *FxRateMutation) SetBaseCurrency(s string) {
+	m.base_currency = &s
+}
+
+// BaseCurrency returns the value of the "base_currency" field in the mutation.
+func (m *FxRateMutation) BaseCurrency() (r string, exists bool) {
+	v := m.base_currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseCurrency returns the old "base_currency" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldBaseCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseCurrency: %w", err)
+	}
+	return oldValue.BaseCurrency, nil
+}
+
+// ResetBaseCurrency resets all changes to the "base_currency" field.
+func (m *FxRateMutation) ResetBaseCurrency() {
+	m.base_currency = nil
+}
+
+// SetQuoteCurrency sets the "quote_currency" field.
+func (m *FxRateMutation) SetQuoteCurrency(s string) {
+	m.quote_currency = &s
+}
+
+// QuoteCurrency returns the value of the "quote_currency" field in the mutation.
+func (m *FxRateMutation) QuoteCurrency() (r string, exists bool) {
+	v := m.quote_currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuoteCurrency returns the old "quote_currency" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldQuoteCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuoteCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuoteCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuoteCurrency: %w", err)
+	}
+	return oldValue.QuoteCurrency, nil
+}
+
+// ResetQuoteCurrency resets all changes to the "quote_currency" field.
+func (m *FxRateMutation) ResetQuoteCurrency() {
+	m.quote_currency = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *FxRateMutation) SetRate(s string) {
+	m.rate = &s
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *FxRateMutation) Rate() (r string, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the FxRate entity.
+// If the FxRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FxRateMutation) OldRate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *FxRateMutation) ResetRate() {
+	m.rate = nil
+}
+
+// Where appends a list predicates to the FxRateMutation builder.
+func (m *FxRateMutation) Where(ps ...predicate.FxRate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FxRateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FxRateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FxRate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FxRateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FxRateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FxRate).
+func (m *FxRateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FxRateMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, fxrate.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, fxrate.FieldUpdateTime)
+	}
+	if m.base_currency != nil {
+		fields = append(fields, fxrate.FieldBaseCurrency)
+	}
+	if m.quote_currency != nil {
+		fields = append(fields, fxrate.FieldQuoteCurrency)
+	}
+	if m.rate != nil {
+		fields = append(fields, fxrate.FieldRate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FxRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fxrate.FieldCreateTime:
+		return m.CreateTime()
+	case fxrate.FieldUpdateTime:
+		return m.UpdateTime()
+	case fxrate.FieldBaseCurrency:
+		return m.BaseCurrency()
+	case fxrate.FieldQuoteCurrency:
+		return m.QuoteCurrency()
+	case fxrate.FieldRate:
+		return m.Rate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FxRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fxrate.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case fxrate.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case fxrate.FieldBaseCurrency:
+		return m.OldBaseCurrency(ctx)
+	case fxrate.FieldQuoteCurrency:
+		return m.OldQuoteCurrency(ctx)
+	case fxrate.FieldRate:
+		return m.OldRate(ctx)
+	}
+	return nil, fmt.Errorf("unknown FxRate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FxRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fxrate.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case fxrate.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case fxrate.FieldBaseCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseCurrency(v)
+		return nil
+	case fxrate.FieldQuoteCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuoteCurrency(v)
+		return nil
+	case fxrate.FieldRate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FxRate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FxRateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FxRateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FxRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FxRate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FxRateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FxRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FxRateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FxRate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FxRateMutation) ResetField(name string) error {
+	switch name {
+	case fxrate.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case fxrate.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case fxrate.FieldBaseCurrency:
+		m.ResetBaseCurrency()
+		return nil
+	case fxrate.FieldQuoteCurrency:
+		m.ResetQuoteCurrency()
+		return nil
+	case fxrate.FieldRate:
+		m.ResetRate()
+		return nil
+	}
+	return fmt.Errorf("unknown FxRate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FxRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FxRateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FxRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FxRateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FxRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FxRateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FxRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FxRate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FxRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FxRate edge %s", name)
+}
+
+// HoldMutation represents an operation that mutates the Hold nodes in the graph.
+type HoldMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	create_time           *time.Time
+	update_time           *time.Time
+	amount                *money.Amount
+	addamount             *money.Amount
+	status                *hold.Status
+	expires_at            *time.Time
+	captured_amount       *money.Amount
+	addcaptured_amount    *money.Amount
+	clearedFields         map[string]struct{}
+	account               *int
+	clearedaccount        bool
+	operation_type        *int
+	clearedoperation_type bool
+	transaction           *int
+	clearedtransaction    bool
+	done                  bool
+	oldValue              func(context.Context) (*Hold, error)
+	predicates            []predicate.Hold
+}
+
+var _ ent.Mutation = (*HoldMutation)(nil)
+
+// holdOption allows management of the mutation configuration using functional options.
+type holdOption func(*HoldMutation)
+
+// newHoldMutation creates new mutation for the Hold entity.
+func newHoldMutation(c config, op Op, opts ...holdOption) *HoldMutation {
+	m := &HoldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHold,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHoldID sets the ID field of the mutation.
+func withHoldID(id int) holdOption {
+	return func(m *HoldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Hold
+		)
+		m.oldValue = func(ctx context.Context) (*Hold, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Hold.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHold sets the old Hold of the mutation.
+func withHold(node *Hold) holdOption {
+	return func(m *HoldMutation) {
+		m.oldValue = func(context.Context) (*Hold, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HoldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HoldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Hold entities.
+func (m *HoldMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HoldMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HoldMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Hold.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *HoldMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *HoldMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *HoldMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *HoldMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *HoldMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *HoldMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *HoldMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *HoldMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *HoldMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetOperationTypeID sets the "operation_type_id" field.
+func (m *HoldMutation) SetOperationTypeID(i int) {
+	m.operation_type = &i
+}
+
+// OperationTypeID returns the value of the "operation_type_id" field in the mutation.
+func (m *HoldMutation) OperationTypeID() (r int, exists bool) {
+	v := m.operation_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperationTypeID returns the old "operation_type_id" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldOperationTypeID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperationTypeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperationTypeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperationTypeID: %w", err)
+	}
+	return oldValue.OperationTypeID, nil
+}
+
+// ResetOperationTypeID resets all changes to the "operation_type_id" field.
+func (m *HoldMutation) ResetOperationTypeID() {
+	m.operation_type = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *HoldMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *HoldMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *HoldMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *HoldMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *HoldMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *HoldMutation) SetStatus(h hold.Status) {
+	m.status = &h
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *HoldMutation) Status() (r hold.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldStatus(ctx context.Context) (v hold.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *HoldMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *HoldMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *HoldMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *HoldMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (m *HoldMutation) SetCapturedAmount(value money.Amount) {
+	m.captured_amount = &value
+	m.addcaptured_amount = nil
+}
+
+// CapturedAmount returns the value of the "captured_amount" field in the mutation.
+func (m *HoldMutation) CapturedAmount() (r money.Amount, exists bool) {
+	v := m.captured_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapturedAmount returns the old "captured_amount" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldCapturedAmount(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapturedAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapturedAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapturedAmount: %w", err)
+	}
+	return oldValue.CapturedAmount, nil
+}
+
+// AddCapturedAmount adds value to the "captured_amount" field.
+func (m *HoldMutation) AddCapturedAmount(value money.Amount) {
+	if m.addcaptured_amount != nil {
+		*m.addcaptured_amount += value
+	} else {
+		m.addcaptured_amount = &value
+	}
+}
+
+// AddedCapturedAmount returns the value that was added to the "captured_amount" field in this mutation.
+func (m *HoldMutation) AddedCapturedAmount() (r money.Amount, exists bool) {
+	v := m.addcaptured_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCapturedAmount clears the value of the "captured_amount" field.
+func (m *HoldMutation) ClearCapturedAmount() {
+	m.captured_amount = nil
+	m.addcaptured_amount = nil
+	m.clearedFields[hold.FieldCapturedAmount] = struct{}{}
+}
+
+// CapturedAmountCleared returns if the "captured_amount" field was cleared in this mutation.
+func (m *HoldMutation) CapturedAmountCleared() bool {
+	_, ok := m.clearedFields[hold.FieldCapturedAmount]
+	return ok
+}
+
+// ResetCapturedAmount resets all changes to the "captured_amount" field.
+func (m *HoldMutation) ResetCapturedAmount() {
+	m.captured_amount = nil
+	m.addcaptured_amount = nil
+	delete(m.clearedFields, hold.FieldCapturedAmount)
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *HoldMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *HoldMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the Hold entity.
+// If the Hold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HoldMutation) OldTransactionID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (m *HoldMutation) ClearTransactionID() {
+	m.transaction = nil
+	m.clearedFields[hold.FieldTransactionID] = struct{}{}
+}
+
+// TransactionIDCleared returns if the "transaction_id" field was cleared in this mutation.
+func (m *HoldMutation) TransactionIDCleared() bool {
+	_, ok := m.clearedFields[hold.FieldTransactionID]
+	return ok
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *HoldMutation) ResetTransactionID() {
+	m.transaction = nil
+	delete(m.clearedFields, hold.FieldTransactionID)
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *HoldMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[hold.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *HoldMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *HoldMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *HoldMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearOperationType clears the "operation_type" edge to the OperationType entity.
+func (m *HoldMutation) ClearOperationType() {
+	m.clearedoperation_type = true
+	m.clearedFields[hold.FieldOperationTypeID] = struct{}{}
+}
+
+// OperationTypeCleared reports if the "operation_type" edge to the OperationType entity was cleared.
+func (m *HoldMutation) OperationTypeCleared() bool {
+	return m.clearedoperation_type
+}
+
+// OperationTypeIDs returns the "operation_type" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OperationTypeID instead. It exists only for internal usage by the builders.
+func (m *HoldMutation) OperationTypeIDs() (ids []int) {
+	if id := m.operation_type; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOperationType resets all changes to the "operation_type" edge.
+func (m *HoldMutation) ResetOperationType() {
+	m.operation_type = nil
+	m.clearedoperation_type = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *HoldMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[hold.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *HoldMutation) TransactionCleared() bool {
+	return m.TransactionIDCleared() || m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *HoldMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *HoldMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// Where appends a list predicates to the HoldMutation builder.
+func (m *HoldMutation) Where(ps ...predicate.Hold) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HoldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HoldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Hold, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HoldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HoldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Hold).
+func (m *HoldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HoldMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, hold.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, hold.FieldUpdateTime)
+	}
+	if m.account != nil {
+		fields = append(fields, hold.FieldAccountID)
+	}
+	if m.operation_type != nil {
+		fields = append(fields, hold.FieldOperationTypeID)
+	}
+	if m.amount != nil {
+		fields = append(fields, hold.FieldAmount)
+	}
+	if m.status != nil {
+		fields = append(fields, hold.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, hold.FieldExpiresAt)
+	}
+	if m.captured_amount != nil {
+		fields = append(fields, hold.FieldCapturedAmount)
+	}
+	if m.transaction != nil {
+		fields = append(fields, hold.FieldTransactionID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HoldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hold.FieldCreateTime:
+		return m.CreateTime()
+	case hold.FieldUpdateTime:
+		return m.UpdateTime()
+	case hold.FieldAccountID:
+		return m.AccountID()
+	case hold.FieldOperationTypeID:
+		return m.OperationTypeID()
+	case hold.FieldAmount:
+		return m.Amount()
+	case hold.FieldStatus:
+		return m.Status()
+	case hold.FieldExpiresAt:
+		return m.ExpiresAt()
+	case hold.FieldCapturedAmount:
+		return m.CapturedAmount()
+	case hold.FieldTransactionID:
+		return m.TransactionID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HoldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hold.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case hold.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case hold.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case hold.FieldOperationTypeID:
+		return m.OldOperationTypeID(ctx)
+	case hold.FieldAmount:
+		return m.OldAmount(ctx)
+	case hold.FieldStatus:
+		return m.OldStatus(ctx)
+	case hold.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case hold.FieldCapturedAmount:
+		return m.OldCapturedAmount(ctx)
+	case hold.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Hold field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HoldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hold.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case hold.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case hold.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case hold.FieldOperationTypeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperationTypeID(v)
+		return nil
+	case hold.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case hold.FieldStatus:
+		v, ok := value.(hold.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case hold.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case hold.FieldCapturedAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapturedAmount(v)
+		return nil
+	case hold.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hold field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HoldMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, hold.FieldAmount)
+	}
+	if m.addcaptured_amount != nil {
+		fields = append(fields, hold.FieldCapturedAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HoldMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hold.FieldAmount:
+		return m.AddedAmount()
+	case hold.FieldCapturedAmount:
+		return m.AddedCapturedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HoldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hold.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case hold.FieldCapturedAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapturedAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hold numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HoldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hold.FieldCapturedAmount) {
+		fields = append(fields, hold.FieldCapturedAmount)
+	}
+	if m.FieldCleared(hold.FieldTransactionID) {
+		fields = append(fields, hold.FieldTransactionID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HoldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HoldMutation) ClearField(name string) error {
+	switch name {
+	case hold.FieldCapturedAmount:
+		m.ClearCapturedAmount()
+		return nil
+	case hold.FieldTransactionID:
+		m.ClearTransactionID()
+		return nil
+	}
+	return fmt.Errorf("unknown Hold nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HoldMutation) ResetField(name string) error {
+	switch name {
+	case hold.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case hold.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case hold.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case hold.FieldOperationTypeID:
+		m.ResetOperationTypeID()
+		return nil
+	case hold.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case hold.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case hold.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case hold.FieldCapturedAmount:
+		m.ResetCapturedAmount()
+		return nil
+	case hold.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	}
+	return fmt.Errorf("unknown Hold field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HoldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.account != nil {
+		edges = append(edges, hold.EdgeAccount)
+	}
+	if m.operation_type != nil {
+		edges = append(edges, hold.EdgeOperationType)
+	}
+	if m.transaction != nil {
+		edges = append(edges, hold.EdgeTransaction)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HoldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case hold.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case hold.EdgeOperationType:
+		if id := m.operation_type; id != nil {
+			return []ent.Value{*id}
+		}
+	case hold.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HoldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HoldMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HoldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedaccount {
+		edges = append(edges, hold.EdgeAccount)
+	}
+	if m.clearedoperation_type {
+		edges = append(edges, hold.EdgeOperationType)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, hold.EdgeTransaction)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HoldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case hold.EdgeAccount:
+		return m.clearedaccount
+	case hold.EdgeOperationType:
+		return m.clearedoperation_type
+	case hold.EdgeTransaction:
+		return m.clearedtransaction
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HoldMutation) ClearEdge(name string) error {
+	switch name {
+	case hold.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case hold.EdgeOperationType:
+		m.ClearOperationType()
+		return nil
+	case hold.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown Hold unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HoldMutation) ResetEdge(name string) error {
+	switch name {
+	case hold.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case hold.EdgeOperationType:
+		m.ResetOperationType()
+		return nil
+	case hold.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown Hold edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	scope              *string
+	key                *string
+	request_hash       *string
+	response_status    *int
+	addresponse_status *int
+	response_body      *[]byte
+	locked_until       *time.Time
+	expires_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*IdempotencyKey, error)
+	predicates         []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id int) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *IdempotencyKeyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *IdempotencyKeyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *IdempotencyKeyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *IdempotencyKeyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *IdempotencyKeyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *IdempotencyKeyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetScope sets the "scope" field.
+func (m *IdempotencyKeyMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *IdempotencyKeyMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *IdempotencyKeyMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetKey sets the "key" field.
+func (m *IdempotencyKeyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *IdempotencyKeyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *IdempotencyKeyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetRequestHash sets the "request_hash" field.
+func (m *IdempotencyKeyMutation) SetRequestHash(s string) {
+	m.request_hash = &s
+}
+
+// RequestHash returns the value of the "request_hash" field in the mutation.
+func (m *IdempotencyKeyMutation) RequestHash() (r string, exists bool) {
+	v := m.request_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHash returns the old "request_hash" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldRequestHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHash: %w", err)
+	}
+	return oldValue.RequestHash, nil
+}
+
+// ResetRequestHash resets all changes to the "request_hash" field.
+func (m *IdempotencyKeyMutation) ResetRequestHash() {
+	m.request_hash = nil
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *IdempotencyKeyMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *IdempotencyKeyMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (m *IdempotencyKeyMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
+}
+
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *IdempotencyKeyMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *IdempotencyKeyMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[idempotencykey.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *IdempotencyKeyMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, idempotencykey.FieldResponseStatus)
+}
+
+// SetResponseBody sets the "response_body" field.
+func (m *IdempotencyKeyMutation) SetResponseBody(b []byte) {
+	m.response_body = &b
+}
+
+// ResponseBody returns the value of the "response_body" field in the mutation.
+func (m *IdempotencyKeyMutation) ResponseBody() (r []byte, exists bool) {
+	v := m.response_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseBody returns the old "response_body" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldResponseBody(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseBody: %w", err)
+	}
+	return oldValue.ResponseBody, nil
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (m *IdempotencyKeyMutation) ClearResponseBody() {
+	m.response_body = nil
+	m.clearedFields[idempotencykey.FieldResponseBody] = struct{}{}
+}
+
+// ResponseBodyCleared returns if the "response_body" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) ResponseBodyCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldResponseBody]
+	return ok
+}
+
+// ResetResponseBody resets all changes to the "response_body" field.
+func (m *IdempotencyKeyMutation) ResetResponseBody() {
+	m.response_body = nil
+	delete(m.clearedFields, idempotencykey.FieldResponseBody)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *IdempotencyKeyMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *IdempotencyKeyMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *IdempotencyKeyMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[idempotencykey.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *IdempotencyKeyMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, idempotencykey.FieldLockedUntil)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, idempotencykey.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, idempotencykey.FieldUpdateTime)
+	}
+	if m.scope != nil {
+		fields = append(fields, idempotencykey.FieldScope)
+	}
+	if m.key != nil {
+		fields = append(fields, idempotencykey.FieldKey)
+	}
+	if m.request_hash != nil {
+		fields = append(fields, idempotencykey.FieldRequestHash)
+	}
+	if m.response_status != nil {
+		fields = append(fields, idempotencykey.FieldResponseStatus)
+	}
+	if m.response_body != nil {
+		fields = append(fields, idempotencykey.FieldResponseBody)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, idempotencykey.FieldLockedUntil)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencykey.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		return m.CreateTime()
+	case idempotencykey.FieldUpdateTime:
+		return m.UpdateTime()
+	case idempotencykey.FieldScope:
+		return m.Scope()
+	case idempotencykey.FieldKey:
+		return m.Key()
+	case idempotencykey.FieldRequestHash:
+		return m.RequestHash()
+	case idempotencykey.FieldResponseStatus:
+		return m.ResponseStatus()
+	case idempotencykey.FieldResponseBody:
+		return m.ResponseBody()
+	case idempotencykey.FieldLockedUntil:
+		return m.LockedUntil()
+	case idempotencykey.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case idempotencykey.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case idempotencykey.FieldScope:
+		return m.OldScope(ctx)
+	case idempotencykey.FieldKey:
+		return m.OldKey(ctx)
+	case idempotencykey.FieldRequestHash:
+		return m.OldRequestHash(ctx)
+	case idempotencykey.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case idempotencykey.FieldResponseBody:
+		return m.OldResponseBody(ctx)
+	case idempotencykey.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case idempotencykey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case idempotencykey.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case idempotencykey.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case idempotencykey.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case idempotencykey.FieldRequestHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHash(v)
+		return nil
+	case idempotencykey.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case idempotencykey.FieldResponseBody:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseBody(v)
+		return nil
+	case idempotencykey.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addresponse_status != nil {
+		fields = append(fields, idempotencykey.FieldResponseStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldResponseStatus) {
+		fields = append(fields, idempotencykey.FieldResponseStatus)
+	}
+	if m.FieldCleared(idempotencykey.FieldResponseBody) {
+		fields = append(fields, idempotencykey.FieldResponseBody)
+	}
+	if m.FieldCleared(idempotencykey.FieldLockedUntil) {
+		fields = append(fields, idempotencykey.FieldLockedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case idempotencykey.FieldResponseBody:
+		m.ClearResponseBody()
+		return nil
+	case idempotencykey.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case idempotencykey.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case idempotencykey.FieldScope:
+		m.ResetScope()
+		return nil
+	case idempotencykey.FieldKey:
+		m.ResetKey()
+		return nil
+	case idempotencykey.FieldRequestHash:
+		m.ResetRequestHash()
+		return nil
+	case idempotencykey.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case idempotencykey.FieldResponseBody:
+		m.ResetResponseBody()
+		return nil
+	case idempotencykey.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// InvoiceMutation represents an operation that mutates the Invoice nodes in the graph.
+type InvoiceMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	period_start       *time.Time
+	period_end         *time.Time
+	total_due          *money.Amount
+	addtotal_due       *money.Amount
+	minimum_payment    *money.Amount
+	addminimum_payment *money.Amount
+	due_date           *time.Time
+	paid_amount        *money.Amount
+	addpaid_amount     *money.Amount
+	status             *invoice.Status
+	clearedFields      map[string]struct{}
+	account            *int
+	clearedaccount     bool
+	late_fee           *int
+	clearedlate_fee    bool
+	payments           map[int]struct{}
+	removedpayments    map[int]struct{}
+	clearedpayments    bool
+	done               bool
+	oldValue           func(context.Context) (*Invoice, error)
+	predicates         []predicate.Invoice
+}
+
+var _ ent.Mutation = (*InvoiceMutation)(nil)
+
+// invoiceOption allows management of the mutation configuration using functional options.
+type invoiceOption func(*InvoiceMutation)
+
+// newInvoiceMutation creates new mutation for the Invoice entity.
+func newInvoiceMutation(c config, op Op, opts ...invoiceOption) *InvoiceMutation {
+	m := &InvoiceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvoice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInvoiceID sets the ID field of the mutation.
+func withInvoiceID(id int) invoiceOption {
+	return func(m *InvoiceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Invoice
+		)
+		m.oldValue = func(ctx context.Context) (*Invoice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Invoice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvoice sets the old Invoice of the mutation.
+func withInvoice(node *Invoice) invoiceOption {
+	return func(m *InvoiceMutation) {
+		m.oldValue = func(context.Context) (*Invoice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvoiceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvoiceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Invoice entities.
+func (m *InvoiceMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InvoiceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InvoiceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Invoice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *InvoiceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *InvoiceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *InvoiceMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *InvoiceMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *InvoiceMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *InvoiceMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *InvoiceMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *InvoiceMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *InvoiceMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (m *InvoiceMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
+}
+
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *InvoiceMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriodStart returns the old "period_start" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
+	}
+	return oldValue.PeriodStart, nil
+}
+
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *InvoiceMutation) ResetPeriodStart() {
+	m.period_start = nil
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (m *InvoiceMutation) SetPeriodEnd(t time.Time) {
+	m.period_end = &t
+}
+
+// PeriodEnd returns the value of the "period_end" field in the mutation.
+func (m *InvoiceMutation) PeriodEnd() (r time.Time, exists bool) {
+	v := m.period_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriodEnd returns the old "period_end" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldPeriodEnd(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriodEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriodEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriodEnd: %w", err)
+	}
+	return oldValue.PeriodEnd, nil
+}
+
+// ResetPeriodEnd resets all changes to the "period_end" field.
+func (m *InvoiceMutation) ResetPeriodEnd() {
+	m.period_end = nil
+}
+
+// SetTotalDue sets the "total_due" field.
+func (m *InvoiceMutation) SetTotalDue(value money.Amount) {
+	m.total_due = &value
+	m.addtotal_due = nil
+}
+
+// TotalDue returns the value of the "total_due" field in the mutation.
+func (m *InvoiceMutation) TotalDue() (r money.Amount, exists bool) {
+	v := m.total_due
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalDue returns the old "total_due" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldTotalDue(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalDue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalDue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalDue: %w", err)
+	}
+	return oldValue.TotalDue, nil
+}
+
+// AddTotalDue adds value to the "total_due" field.
+func (m *InvoiceMutation) AddTotalDue(value money.Amount) {
+	if m.addtotal_due != nil {
+		*m.addtotal_due += value
+	} else {
+		m.addtotal_due = &value
+	}
+}
+
+// AddedTotalDue returns the value that was added to the "total_due" field in this mutation.
+func (m *InvoiceMutation) AddedTotalDue() (r money.Amount, exists bool) {
+	v := m.addtotal_due
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalDue resets all changes to the "total_due" field.
+func (m *InvoiceMutation) ResetTotalDue() {
+	m.total_due = nil
+	m.addtotal_due = nil
+}
+
+// SetMinimumPayment sets the "minimum_payment" field.
+func (m *InvoiceMutation) SetMinimumPayment(value money.Amount) {
+	m.minimum_payment = &value
+	m.addminimum_payment = nil
+}
+
+// MinimumPayment returns the value of the "minimum_payment" field in the mutation.
+func (m *InvoiceMutation) MinimumPayment() (r money.Amount, exists bool) {
+	v := m.minimum_payment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinimumPayment returns the old "minimum_payment" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldMinimumPayment(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinimumPayment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinimumPayment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinimumPayment: %w", err)
+	}
+	return oldValue.MinimumPayment, nil
+}
+
+// AddMinimumPayment adds value to the "minimum_payment" field.
+func (m *InvoiceMutation) AddMinimumPayment(value money.Amount) {
+	if m.addminimum_payment != nil {
+		*m.addminimum_payment += value
+	} else {
+		m.addminimum_payment = &value
+	}
+}
+
+// AddedMinimumPayment returns the value that was added to the "minimum_payment" field in this mutation.
+func (m *InvoiceMutation) AddedMinimumPayment() (r money.Amount, exists bool) {
+	v := m.addminimum_payment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinimumPayment resets all changes to the "minimum_payment" field.
+func (m *InvoiceMutation) ResetMinimumPayment() {
+	m.minimum_payment = nil
+	m.addminimum_payment = nil
+}
+
+// SetDueDate sets the "due_date" field.
+func (m *InvoiceMutation) SetDueDate(t time.Time) {
+	m.due_date = &t
+}
+
+// DueDate returns the value of the "due_date" field in the mutation.
+func (m *InvoiceMutation) DueDate() (r time.Time, exists bool) {
+	v := m.due_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDate returns the old "due_date" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldDueDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+	}
+	return oldValue.DueDate, nil
+}
+
+// ResetDueDate resets all changes to the "due_date" field.
+func (m *InvoiceMutation) ResetDueDate() {
+	m.due_date = nil
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (m *InvoiceMutation) SetPaidAmount(value money.Amount) {
+	m.paid_amount = &value
+	m.addpaid_amount = nil
+}
+
+// PaidAmount returns the value of the "paid_amount" field in the mutation.
+func (m *InvoiceMutation) PaidAmount() (r money.Amount, exists bool) {
+	v := m.paid_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaidAmount returns the old "paid_amount" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldPaidAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaidAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaidAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaidAmount: %w", err)
+	}
+	return oldValue.PaidAmount, nil
+}
+
+// AddPaidAmount adds value to the "paid_amount" field.
+func (m *InvoiceMutation) AddPaidAmount(value money.Amount) {
+	if m.addpaid_amount != nil {
+		*m.addpaid_amount += value
+	} else {
+		m.addpaid_amount = &value
+	}
+}
+
+// AddedPaidAmount returns the value that was added to the "paid_amount" field in this mutation.
+func (m *InvoiceMutation) AddedPaidAmount() (r money.Amount, exists bool) {
+	v := m.addpaid_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPaidAmount resets all changes to the "paid_amount" field.
+func (m *InvoiceMutation) ResetPaidAmount() {
+	m.paid_amount = nil
+	m.addpaid_amount = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *InvoiceMutation) SetStatus(i invoice.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *InvoiceMutation) Status() (r invoice.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Invoice entity.
+// If the Invoice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoiceMutation) OldStatus(ctx context.Context) (v invoice.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *InvoiceMutation) ResetStatus() {
+	m.status = nil
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *InvoiceMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[invoice.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *InvoiceMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *InvoiceMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *InvoiceMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// SetLateFeeID sets the "late_fee" edge to the Accrual entity by id.
+func (m *InvoiceMutation) SetLateFeeID(id int) {
+	m.late_fee = &id
+}
+
+// ClearLateFee clears the "late_fee" edge to the Accrual entity.
+func (m *InvoiceMutation) ClearLateFee() {
+	m.clearedlate_fee = true
+}
+
+// LateFeeCleared reports if the "late_fee" edge to the Accrual entity was cleared.
+func (m *InvoiceMutation) LateFeeCleared() bool {
+	return m.clearedlate_fee
+}
+
+// LateFeeID returns the "late_fee" edge ID in the mutation.
+func (m *InvoiceMutation) LateFeeID() (id int, exists bool) {
+	if m.late_fee != nil {
+		return *m.late_fee, true
+	}
+	return
+}
+
+// LateFeeIDs returns the "late_fee" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LateFeeID instead. It exists only for internal usage by the builders.
+func (m *InvoiceMutation) LateFeeIDs() (ids []int) {
+	if id := m.late_fee; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLateFee resets all changes to the "late_fee" edge.
+func (m *InvoiceMutation) ResetLateFee() {
+	m.late_fee = nil
+	m.clearedlate_fee = false
+}
+
+// AddPaymentIDs adds the "payments" edge to the InvoicePayment entity by ids.
+func (m *InvoiceMutation) AddPaymentIDs(ids ...int) {
+	if m.payments == nil {
+		m.payments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.payments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPayments clears the "payments" edge to the InvoicePayment entity.
+func (m *InvoiceMutation) ClearPayments() {
+	m.clearedpayments = true
+}
+
+// PaymentsCleared reports if the "payments" edge to the InvoicePayment entity was cleared.
+func (m *InvoiceMutation) PaymentsCleared() bool {
+	return m.clearedpayments
+}
+
+// RemovePaymentIDs removes the "payments" edge to the InvoicePayment entity by IDs.
+func (m *InvoiceMutation) RemovePaymentIDs(ids ...int) {
+	if m.removedpayments == nil {
+		m.removedpayments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.payments, ids[i])
+		m.removedpayments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPayments returns the removed IDs of the "payments" edge to the InvoicePayment entity.
+func (m *InvoiceMutation) RemovedPaymentsIDs() (ids []int) {
+	for id := range m.removedpayments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PaymentsIDs returns the "payments" edge IDs in the mutation.
+func (m *InvoiceMutation) PaymentsIDs() (ids []int) {
+	for id := range m.payments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPayments resets all changes to the "payments" edge.
+func (m *InvoiceMutation) ResetPayments() {
+	m.payments = nil
+	m.clearedpayments = false
+	m.removedpayments = nil
+}
+
+// Where appends a list predicates to the InvoiceMutation builder.
+func (m *InvoiceMutation) Where(ps ...predicate.Invoice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvoiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invoice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvoiceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InvoiceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Invoice).
+func (m *InvoiceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvoiceMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.create_time != nil {
+		fields = append(fields, invoice.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, invoice.FieldUpdateTime)
+	}
+	if m.account != nil {
+		fields = append(fields, invoice.FieldAccountID)
+	}
+	if m.period_start != nil {
+		fields = append(fields, invoice.FieldPeriodStart)
+	}
+	if m.period_end != nil {
+		fields = append(fields, invoice.FieldPeriodEnd)
+	}
+	if m.total_due != nil {
+		fields = append(fields, invoice.FieldTotalDue)
+	}
+	if m.minimum_payment != nil {
+		fields = append(fields, invoice.FieldMinimumPayment)
+	}
+	if m.due_date != nil {
+		fields = append(fields, invoice.FieldDueDate)
+	}
+	if m.paid_amount != nil {
+		fields = append(fields, invoice.FieldPaidAmount)
+	}
+	if m.status != nil {
+		fields = append(fields, invoice.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldCreateTime:
+		return m.CreateTime()
+	case invoice.FieldUpdateTime:
+		return m.UpdateTime()
+	case invoice.FieldAccountID:
+		return m.AccountID()
+	case invoice.FieldPeriodStart:
+		return m.PeriodStart()
+	case invoice.FieldPeriodEnd:
+		return m.PeriodEnd()
+	case invoice.FieldTotalDue:
+		return m.TotalDue()
+	case invoice.FieldMinimumPayment:
+		return m.MinimumPayment()
+	case invoice.FieldDueDate:
+		return m.DueDate()
+	case invoice.FieldPaidAmount:
+		return m.PaidAmount()
+	case invoice.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvoiceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invoice.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case invoice.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case invoice.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case invoice.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case invoice.FieldPeriodEnd:
+		return m.OldPeriodEnd(ctx)
+	case invoice.FieldTotalDue:
+		return m.OldTotalDue(ctx)
+	case invoice.FieldMinimumPayment:
+		return m.OldMinimumPayment(ctx)
+	case invoice.FieldDueDate:
+		return m.OldDueDate(ctx)
+	case invoice.FieldPaidAmount:
+		return m.OldPaidAmount(ctx)
+	case invoice.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case invoice.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case invoice.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case invoice.FieldPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodStart(v)
+		return nil
+	case invoice.FieldPeriodEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodEnd(v)
+		return nil
+	case invoice.FieldTotalDue:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalDue(v)
+		return nil
+	case invoice.FieldMinimumPayment:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinimumPayment(v)
+		return nil
+	case invoice.FieldDueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDate(v)
+		return nil
+	case invoice.FieldPaidAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaidAmount(v)
+		return nil
+	case invoice.FieldStatus:
+		v, ok := value.(invoice.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_due != nil {
+		fields = append(fields, invoice.FieldTotalDue)
+	}
+	if m.addminimum_payment != nil {
+		fields = append(fields, invoice.FieldMinimumPayment)
+	}
+	if m.addpaid_amount != nil {
+		fields = append(fields, invoice.FieldPaidAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldTotalDue:
+		return m.AddedTotalDue()
+	case invoice.FieldMinimumPayment:
+		return m.AddedMinimumPayment()
+	case invoice.FieldPaidAmount:
+		return m.AddedPaidAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldTotalDue:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalDue(v)
+		return nil
+	case invoice.FieldMinimumPayment:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinimumPayment(v)
+		return nil
+	case invoice.FieldPaidAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPaidAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvoiceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvoiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Invoice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvoiceMutation) ResetField(name string) error {
+	switch name {
+	case invoice.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case invoice.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case invoice.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case invoice.FieldPeriodStart:
+		m.ResetPeriodStart()
+		return nil
+	case invoice.FieldPeriodEnd:
+		m.ResetPeriodEnd()
+		return nil
+	case invoice.FieldTotalDue:
+		m.ResetTotalDue()
+		return nil
+	case invoice.FieldMinimumPayment:
+		m.ResetMinimumPayment()
+		return nil
+	case invoice.FieldDueDate:
+		m.ResetDueDate()
+		return nil
+	case invoice.FieldPaidAmount:
+		m.ResetPaidAmount()
+		return nil
+	case invoice.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.account != nil {
+		edges = append(edges, invoice.EdgeAccount)
+	}
+	if m.late_fee != nil {
+		edges = append(edges, invoice.EdgeLateFee)
+	}
+	if m.payments != nil {
+		edges = append(edges, invoice.EdgePayments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvoiceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invoice.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case invoice.EdgeLateFee:
+		if id := m.late_fee; id != nil {
+			return []ent.Value{*id}
+		}
+	case invoice.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.payments))
+		for id := range m.payments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedpayments != nil {
+		edges = append(edges, invoice.EdgePayments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvoiceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case invoice.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.removedpayments))
+		for id := range m.removedpayments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedaccount {
+		edges = append(edges, invoice.EdgeAccount)
+	}
+	if m.clearedlate_fee {
+		edges = append(edges, invoice.EdgeLateFee)
+	}
+	if m.clearedpayments {
+		edges = append(edges, invoice.EdgePayments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvoiceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case invoice.EdgeAccount:
+		return m.clearedaccount
+	case invoice.EdgeLateFee:
+		return m.clearedlate_fee
+	case invoice.EdgePayments:
+		return m.clearedpayments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvoiceMutation) ClearEdge(name string) error {
+	switch name {
+	case invoice.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case invoice.EdgeLateFee:
+		m.ClearLateFee()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvoiceMutation) ResetEdge(name string) error {
+	switch name {
+	case invoice.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case invoice.EdgeLateFee:
+		m.ResetLateFee()
+		return nil
+	case invoice.EdgePayments:
+		m.ResetPayments()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice edge %s", name)
+}
+
+// InvoicePaymentMutation represents an operation that mutates the InvoicePayment nodes in the graph.
+type InvoicePaymentMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	amount             *money.Amount
+	addamount          *money.Amount
+	reversed_at        *time.Time
+	clearedFields      map[string]struct{}
+	invoice            *int
+	clearedinvoice     bool
+	transaction        *int
+	clearedtransaction bool
+	done               bool
+	oldValue           func(context.Context) (*InvoicePayment, error)
+	predicates         []predicate.InvoicePayment
+}
+
+var _ ent.Mutation = (*InvoicePaymentMutation)(nil)
+
+// invoicepaymentOption allows management of the mutation configuration using functional options.
+type invoicepaymentOption func(*InvoicePaymentMutation)
+
+// newInvoicePaymentMutation creates new mutation for the InvoicePayment entity.
+func newInvoicePaymentMutation(c config, op Op, opts ...invoicepaymentOption) *InvoicePaymentMutation {
+	m := &InvoicePaymentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvoicePayment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInvoicePaymentID sets the ID field of the mutation.
+func withInvoicePaymentID(id int) invoicepaymentOption {
+	return func(m *InvoicePaymentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *InvoicePayment
+		)
+		m.oldValue = func(ctx context.Context) (*InvoicePayment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().InvoicePayment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvoicePayment sets the old InvoicePayment of the mutation.
+func withInvoicePayment(node *InvoicePayment) invoicepaymentOption {
+	return func(m *InvoicePaymentMutation) {
+		m.oldValue = func(context.Context) (*InvoicePayment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvoicePaymentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvoicePaymentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of InvoicePayment entities.
+func (m *InvoicePaymentMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InvoicePaymentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InvoicePaymentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().InvoicePayment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *InvoicePaymentMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *InvoicePaymentMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the InvoicePayment entity.
+// If the InvoicePayment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoicePaymentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *InvoicePaymentMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *InvoicePaymentMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *InvoicePaymentMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the InvoicePayment entity.
+// If the InvoicePayment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoicePaymentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *InvoicePaymentMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (m *InvoicePaymentMutation) SetInvoiceID(i int) {
+	m.invoice = &i
+}
+
+// InvoiceID returns the value of the "invoice_id" field in the mutation.
+func (m *InvoicePaymentMutation) InvoiceID() (r int, exists bool) {
+	v := m.invoice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvoiceID returns the old "invoice_id" field's value of the InvoicePayment entity.
+// If the InvoicePayment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoicePaymentMutation) OldInvoiceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvoiceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvoiceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvoiceID: %w", err)
+	}
+	return oldValue.InvoiceID, nil
+}
+
+// ResetInvoiceID resets all changes to the "invoice_id" field.
+func (m *InvoicePaymentMutation) ResetInvoiceID() {
+	m.invoice = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *InvoicePaymentMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *InvoicePaymentMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the InvoicePayment entity.
+// If the InvoicePayment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoicePaymentMutation) OldTransactionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *InvoicePaymentMutation) ResetTransactionID() {
+	m.transaction = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *InvoicePaymentMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *InvoicePaymentMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the InvoicePayment entity.
+// If the InvoicePayment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoicePaymentMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *InvoicePaymentMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *InvoicePaymentMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *InvoicePaymentMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (m *InvoicePaymentMutation) SetReversedAt(t time.Time) {
+	m.reversed_at = &t
+}
+
+// ReversedAt returns the value of the "reversed_at" field in the mutation.
+func (m *InvoicePaymentMutation) ReversedAt() (r time.Time, exists bool) {
+	v := m.reversed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReversedAt returns the old "reversed_at" field's value of the InvoicePayment entity.
+// If the InvoicePayment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvoicePaymentMutation) OldReversedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReversedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReversedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReversedAt: %w", err)
+	}
+	return oldValue.ReversedAt, nil
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (m *InvoicePaymentMutation) ClearReversedAt() {
+	m.reversed_at = nil
+	m.clearedFields[invoicepayment.FieldReversedAt] = struct{}{}
+}
+
+// ReversedAtCleared returns if the "reversed_at" field was cleared in this mutation.
+func (m *InvoicePaymentMutation) ReversedAtCleared() bool {
+	_, ok := m.clearedFields[invoicepayment.FieldReversedAt]
+	return ok
+}
+
+// ResetReversedAt resets all changes to the "reversed_at" field.
+func (m *InvoicePaymentMutation) ResetReversedAt() {
+	m.reversed_at = nil
+	delete(m.clearedFields, invoicepayment.FieldReversedAt)
+}
+
+// ClearInvoice clears the "invoice" edge to the Invoice entity.
+func (m *InvoicePaymentMutation) ClearInvoice() {
+	m.clearedinvoice = true
+	m.clearedFields[invoicepayment.FieldInvoiceID] = struct{}{}
+}
+
+// InvoiceCleared reports if the "invoice" edge to the Invoice entity was cleared.
+func (m *InvoicePaymentMutation) InvoiceCleared() bool {
+	return m.clearedinvoice
+}
+
+// InvoiceIDs returns the "invoice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InvoiceID instead. It exists only for internal usage by the builders.
+func (m *InvoicePaymentMutation) InvoiceIDs() (ids []int) {
+	if id := m.invoice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvoice resets all changes to the "invoice" edge.
+func (m *InvoicePaymentMutation) ResetInvoice() {
+	m.invoice = nil
+	m.clearedinvoice = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *InvoicePaymentMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[invoicepayment.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *InvoicePaymentMutation) TransactionCleared() bool {
+	return m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *InvoicePaymentMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *InvoicePaymentMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// Where appends a list predicates to the InvoicePaymentMutation builder.
+func (m *InvoicePaymentMutation) Where(ps ...predicate.InvoicePayment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvoicePaymentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvoicePaymentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.InvoicePayment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvoicePaymentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InvoicePaymentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (InvoicePayment).
+func (m *InvoicePaymentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvoicePaymentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, invoicepayment.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, invoicepayment.FieldUpdateTime)
+	}
+	if m.invoice != nil {
+		fields = append(fields, invoicepayment.FieldInvoiceID)
+	}
+	if m.transaction != nil {
+		fields = append(fields, invoicepayment.FieldTransactionID)
+	}
+	if m.amount != nil {
+		fields = append(fields, invoicepayment.FieldAmount)
+	}
+	if m.reversed_at != nil {
+		fields = append(fields, invoicepayment.FieldReversedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvoicePaymentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invoicepayment.FieldCreateTime:
+		return m.CreateTime()
+	case invoicepayment.FieldUpdateTime:
+		return m.UpdateTime()
+	case invoicepayment.FieldInvoiceID:
+		return m.InvoiceID()
+	case invoicepayment.FieldTransactionID:
+		return m.TransactionID()
+	case invoicepayment.FieldAmount:
+		return m.Amount()
+	case invoicepayment.FieldReversedAt:
+		return m.ReversedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvoicePaymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invoicepayment.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case invoicepayment.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case invoicepayment.FieldInvoiceID:
+		return m.OldInvoiceID(ctx)
+	case invoicepayment.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case invoicepayment.FieldAmount:
+		return m.OldAmount(ctx)
+	case invoicepayment.FieldReversedAt:
+		return m.OldReversedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown InvoicePayment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoicePaymentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invoicepayment.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case invoicepayment.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case invoicepayment.FieldInvoiceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvoiceID(v)
+		return nil
+	case invoicepayment.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case invoicepayment.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case invoicepayment.FieldReversedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReversedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InvoicePayment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvoicePaymentMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, invoicepayment.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvoicePaymentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invoicepayment.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvoicePaymentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case invoicepayment.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown InvoicePayment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvoicePaymentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invoicepayment.FieldReversedAt) {
+		fields = append(fields, invoicepayment.FieldReversedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvoicePaymentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvoicePaymentMutation) ClearField(name string) error {
+	switch name {
+	case invoicepayment.FieldReversedAt:
+		m.ClearReversedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InvoicePayment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvoicePaymentMutation) ResetField(name string) error {
+	switch name {
+	case invoicepayment.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case invoicepayment.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case invoicepayment.FieldInvoiceID:
+		m.ResetInvoiceID()
+		return nil
+	case invoicepayment.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case invoicepayment.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case invoicepayment.FieldReversedAt:
+		m.ResetReversedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown InvoicePayment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvoicePaymentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.invoice != nil {
+		edges = append(edges, invoicepayment.EdgeInvoice)
+	}
+	if m.transaction != nil {
+		edges = append(edges, invoicepayment.EdgeTransaction)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvoicePaymentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invoicepayment.EdgeInvoice:
+		if id := m.invoice; id != nil {
+			return []ent.Value{*id}
+		}
+	case invoicepayment.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvoicePaymentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvoicePaymentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvoicePaymentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedinvoice {
+		edges = append(edges, invoicepayment.EdgeInvoice)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, invoicepayment.EdgeTransaction)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvoicePaymentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case invoicepayment.EdgeInvoice:
+		return m.clearedinvoice
+	case invoicepayment.EdgeTransaction:
+		return m.clearedtransaction
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvoicePaymentMutation) ClearEdge(name string) error {
+	switch name {
+	case invoicepayment.EdgeInvoice:
+		m.ClearInvoice()
+		return nil
+	case invoicepayment.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown InvoicePayment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvoicePaymentMutation) ResetEdge(name string) error {
+	switch name {
+	case invoicepayment.EdgeInvoice:
+		m.ResetInvoice()
+		return nil
+	case invoicepayment.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	}
+	return fmt.Errorf("unknown InvoicePayment edge %s", name)
+}
+
+// JournalEntryMutation represents an operation that mutates the JournalEntry nodes in the graph.
+type JournalEntryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	create_time        *time.Time
+	update_time        *time.Time
+	ledger             *journalentry.Ledger
+	side               *journalentry.Side
+	amount             *money.Amount
+	addamount          *money.Amount
+	currency           *string
+	timestamp          *time.Time
+	clearedFields      map[string]struct{}
+	transaction        *int
+	clearedtransaction bool
+	account            *int
+	clearedaccount     bool
+	done               bool
+	oldValue           func(context.Context) (*JournalEntry, error)
+	predicates         []predicate.JournalEntry
+}
+
+var _ ent.Mutation = (*JournalEntryMutation)(nil)
+
+// journalentryOption allows management of the mutation configuration using functional options.
+type journalentryOption func(*JournalEntryMutation)
+
+// newJournalEntryMutation creates new mutation for the JournalEntry entity.
+func newJournalEntryMutation(c config, op Op, opts ...journalentryOption) *JournalEntryMutation {
+	m := &JournalEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJournalEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJournalEntryID sets the ID field of the mutation.
+func withJournalEntryID(id int) journalentryOption {
+	return func(m *JournalEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JournalEntry
+		)
+		m.oldValue = func(ctx context.Context) (*JournalEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JournalEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJournalEntry sets the old JournalEntry of the mutation.
+func withJournalEntry(node *JournalEntry) journalentryOption {
+	return func(m *JournalEntryMutation) {
+		m.oldValue = func(context.Context) (*JournalEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JournalEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JournalEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of JournalEntry entities.
+func (m *JournalEntryMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JournalEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JournalEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JournalEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *JournalEntryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *JournalEntryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *JournalEntryMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *JournalEntryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *JournalEntryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *JournalEntryMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *JournalEntryMutation) SetTransactionID(i int) {
+	m.transaction = &i
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *JournalEntryMutation) TransactionID() (r int, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldTransactionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *JournalEntryMutation) ResetTransactionID() {
+	m.transaction = nil
+}
+
+// SetLedger sets the "ledger" field.
+func (m *JournalEntryMutation) SetLedger(j journalentry.Ledger) {
+	m.ledger = &j
+}
+
+// Ledger returns the value of the "ledger" field in the mutation.
+func (m *JournalEntryMutation) Ledger() (r journalentry.Ledger, exists bool) {
+	v := m.ledger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLedger returns the old "ledger" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldLedger(ctx context.Context) (v journalentry.Ledger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLedger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLedger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLedger: %w", err)
+	}
+	return oldValue.Ledger, nil
+}
+
+// ResetLedger resets all changes to the "ledger" field.
+func (m *JournalEntryMutation) ResetLedger() {
+	m.ledger = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *JournalEntryMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *JournalEntryMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldAccountID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (m *JournalEntryMutation) ClearAccountID() {
+	m.account = nil
+	m.clearedFields[journalentry.FieldAccountID] = struct{}{}
+}
+
+// AccountIDCleared returns if the "account_id" field was cleared in this mutation.
+func (m *JournalEntryMutation) AccountIDCleared() bool {
+	_, ok := m.clearedFields[journalentry.FieldAccountID]
+	return ok
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *JournalEntryMutation) ResetAccountID() {
+	m.account = nil
+	delete(m.clearedFields, journalentry.FieldAccountID)
+}
+
+// SetSide sets the "side" field.
+func (m *JournalEntryMutation) SetSide(j journalentry.Side) {
+	m.side = &j
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *JournalEntryMutation) Side() (r journalentry.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldSide(ctx context.Context) (v journalentry.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *JournalEntryMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *JournalEntryMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *JournalEntryMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *JournalEntryMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *JournalEntryMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *JournalEntryMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *JournalEntryMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *JournalEntryMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *JournalEntryMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *JournalEntryMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *JournalEntryMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the JournalEntry entity.
+// If the JournalEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JournalEntryMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *JournalEntryMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *JournalEntryMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[journalentry.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *JournalEntryMutation) TransactionCleared() bool {
+	return m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *JournalEntryMutation) TransactionIDs() (ids []int) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *JournalEntryMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *JournalEntryMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[journalentry.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *JournalEntryMutation) AccountCleared() bool {
+	return m.AccountIDCleared() || m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *JournalEntryMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *JournalEntryMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the JournalEntryMutation builder.
+func (m *JournalEntryMutation) Where(ps ...predicate.JournalEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JournalEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JournalEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JournalEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JournalEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JournalEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JournalEntry).
+func (m *JournalEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JournalEntryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, journalentry.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, journalentry.FieldUpdateTime)
+	}
+	if m.transaction != nil {
+		fields = append(fields, journalentry.FieldTransactionID)
+	}
+	if m.ledger != nil {
+		fields = append(fields, journalentry.FieldLedger)
+	}
+	if m.account != nil {
+		fields = append(fields, journalentry.FieldAccountID)
+	}
+	if m.side != nil {
+		fields = append(fields, journalentry.FieldSide)
+	}
+	if m.amount != nil {
+		fields = append(fields, journalentry.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, journalentry.FieldCurrency)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, journalentry.FieldTimestamp)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JournalEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case journalentry.FieldCreateTime:
+		return m.CreateTime()
+	case journalentry.FieldUpdateTime:
+		return m.UpdateTime()
+	case journalentry.FieldTransactionID:
+		return m.TransactionID()
+	case journalentry.FieldLedger:
+		return m.Ledger()
+	case journalentry.FieldAccountID:
+		return m.AccountID()
+	case journalentry.FieldSide:
+		return m.Side()
+	case journalentry.FieldAmount:
+		return m.Amount()
+	case journalentry.FieldCurrency:
+		return m.Currency()
+	case journalentry.FieldTimestamp:
+		return m.Timestamp()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JournalEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case journalentry.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case journalentry.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case journalentry.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case journalentry.FieldLedger:
+		return m.OldLedger(ctx)
+	case journalentry.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case journalentry.FieldSide:
+		return m.OldSide(ctx)
+	case journalentry.FieldAmount:
+		return m.OldAmount(ctx)
+	case journalentry.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case journalentry.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	}
+	return nil, fmt.Errorf("unknown JournalEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JournalEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case journalentry.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case journalentry.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case journalentry.FieldTransactionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case journalentry.FieldLedger:
+		v, ok := value.(journalentry.Ledger)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLedger(v)
+		return nil
+	case journalentry.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case journalentry.FieldSide:
+		v, ok := value.(journalentry.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case journalentry.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case journalentry.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case journalentry.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JournalEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, journalentry.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JournalEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case journalentry.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JournalEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case journalentry.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JournalEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(journalentry.FieldAccountID) {
+		fields = append(fields, journalentry.FieldAccountID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JournalEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JournalEntryMutation) ClearField(name string) error {
+	switch name {
+	case journalentry.FieldAccountID:
+		m.ClearAccountID()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JournalEntryMutation) ResetField(name string) error {
+	switch name {
+	case journalentry.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case journalentry.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case journalentry.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case journalentry.FieldLedger:
+		m.ResetLedger()
+		return nil
+	case journalentry.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case journalentry.FieldSide:
+		m.ResetSide()
+		return nil
+	case journalentry.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case journalentry.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case journalentry.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JournalEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.transaction != nil {
+		edges = append(edges, journalentry.EdgeTransaction)
+	}
+	if m.account != nil {
+		edges = append(edges, journalentry.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JournalEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case journalentry.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	case journalentry.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JournalEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JournalEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JournalEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtransaction {
+		edges = append(edges, journalentry.EdgeTransaction)
+	}
+	if m.clearedaccount {
+		edges = append(edges, journalentry.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JournalEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case journalentry.EdgeTransaction:
+		return m.clearedtransaction
+	case journalentry.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JournalEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case journalentry.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	case journalentry.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JournalEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case journalentry.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	case journalentry.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown JournalEntry edge %s", name)
+}
+
+// OperationTypeMutation represents an operation that mutates the OperationType nodes in the graph.
+type OperationTypeMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	create_time         *time.Time
+	update_time         *time.Time
+	description         *string
+	is_debit            *bool
+	clearedFields       map[string]struct{}
+	transactions        map[int]struct{}
+	removedtransactions map[int]struct{}
+	clearedtransactions bool
+	holds               map[int]struct{}
+	removedholds        map[int]struct{}
+	clearedholds        bool
+	done                bool
+	oldValue            func(context.Context) (*OperationType, error)
+	predicates          []predicate.OperationType
+}
+
+var _ ent.Mutation = (*OperationTypeMutation)(nil)
+
+// operationtypeOption allows management of the mutation configuration using functional options.
+type operationtypeOption func(*OperationTypeMutation)
+
+// newOperationTypeMutation creates new mutation for the OperationType entity.
+func newOperationTypeMutation(c config, op Op, opts ...operationtypeOption) *OperationTypeMutation {
+	m := &OperationTypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOperationType,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOperationTypeID sets the ID field of the mutation.
+func withOperationTypeID(id int) operationtypeOption {
+	return func(m *OperationTypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OperationType
+		)
+		m.oldValue = func(ctx context.Context) (*OperationType, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OperationType.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOperationType sets the old OperationType of the mutation.
+func withOperationType(node *OperationType) operationtypeOption {
+	return func(m *OperationTypeMutation) {
+		m.oldValue = func(context.Context) (*OperationType, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OperationTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OperationTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OperationType entities.
+func (m *OperationTypeMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OperationTypeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OperationTypeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OperationType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OperationTypeMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OperationTypeMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the OperationType entity.
+// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationTypeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OperationTypeMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OperationTypeMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OperationTypeMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the OperationType entity.
+// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationTypeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OperationTypeMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *OperationTypeMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *OperationTypeMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the OperationType entity.
+// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationTypeMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *OperationTypeMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetIsDebit sets the "is_debit" field.
+func (m *OperationTypeMutation) SetIsDebit(b bool) {
+	m.is_debit = &b
+}
+
+// IsDebit returns the value of the "is_debit" field in the mutation.
+func (m *OperationTypeMutation) IsDebit() (r bool, exists bool) {
+	v := m.is_debit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDebit returns the old "is_debit" field's value of the OperationType entity.
+// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationTypeMutation) OldIsDebit(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDebit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDebit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDebit: %w", err)
+	}
+	return oldValue.IsDebit, nil
+}
+
+// ResetIsDebit resets all changes to the "is_debit" field.
+func (m *OperationTypeMutation) ResetIsDebit() {
+	m.is_debit = nil
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *OperationTypeMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *OperationTypeMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *OperationTypeMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *OperationTypeMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *OperationTypeMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *OperationTypeMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *OperationTypeMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// AddHoldIDs adds the "holds" edge to the Hold entity by ids.
+func (m *OperationTypeMutation) AddHoldIDs(ids ...int) {
+	if m.holds == nil {
+		m.holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.holds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHolds clears the "holds" edge to the Hold entity.
+func (m *OperationTypeMutation) ClearHolds() {
+	m.clearedholds = true
+}
+
+// HoldsCleared reports if the "holds" edge to the Hold entity was cleared.
+func (m *OperationTypeMutation) HoldsCleared() bool {
+	return m.clearedholds
+}
+
+// RemoveHoldIDs removes the "holds" edge to the Hold entity by IDs.
+func (m *OperationTypeMutation) RemoveHoldIDs(ids ...int) {
+	if m.removedholds == nil {
+		m.removedholds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.holds, ids[i])
+		m.removedholds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHolds returns the removed IDs of the "holds" edge to the Hold entity.
+func (m *OperationTypeMutation) RemovedHoldsIDs() (ids []int) {
+	for id := range m.removedholds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HoldsIDs returns the "holds" edge IDs in the mutation.
+func (m *OperationTypeMutation) HoldsIDs() (ids []int) {
+	for id := range m.holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHolds resets all changes to the "holds" edge.
+func (m *OperationTypeMutation) ResetHolds() {
+	m.holds = nil
+	m.clearedholds = false
+	m.removedholds = nil
+}
+
+// Where appends a list predicates to the OperationTypeMutation builder.
+func (m *OperationTypeMutation) Where(ps ...predicate.OperationType) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OperationTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OperationTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OperationType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OperationTypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OperationTypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OperationType).
+func (m *OperationTypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OperationTypeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, operationtype.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, operationtype.FieldUpdateTime)
+	}
+	if m.description != nil {
+		fields = append(fields, operationtype.FieldDescription)
+	}
+	if m.is_debit != nil {
+		fields = append(fields, operationtype.FieldIsDebit)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OperationTypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case operationtype.FieldCreateTime:
+		return m.CreateTime()
+	case operationtype.FieldUpdateTime:
+		return m.UpdateTime()
+	case operationtype.FieldDescription:
+		return m.Description()
+	case operationtype.FieldIsDebit:
+		return m.IsDebit()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OperationTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case operationtype.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case operationtype.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case operationtype.FieldDescription:
+		return m.OldDescription(ctx)
+	case operationtype.FieldIsDebit:
+		return m.OldIsDebit(ctx)
+	}
+	return nil, fmt.Errorf("unknown OperationType field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperationTypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case operationtype.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case operationtype.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case operationtype.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case operationtype.FieldIsDebit:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDebit(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OperationType field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OperationTypeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OperationTypeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperationTypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OperationType numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OperationTypeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OperationTypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OperationTypeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OperationType nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OperationTypeMutation) ResetField(name string) error {
+	switch name {
+	case operationtype.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case operationtype.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case operationtype.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case operationtype.FieldIsDebit:
+		m.ResetIsDebit()
+		return nil
+	}
+	return fmt.Errorf("unknown OperationType field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OperationTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.transactions != nil {
+		edges = append(edges, operationtype.EdgeTransactions)
+	}
+	if m.holds != nil {
+		edges = append(edges, operationtype.EdgeHolds)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OperationTypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case operationtype.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.transactions))
+		for id := range m.transactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case operationtype.EdgeHolds:
+		ids := make([]ent.Value, 0, len(m.holds))
+		for id := range m.holds {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OperationTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtransactions != nil {
+		edges = append(edges, operationtype.EdgeTransactions)
+	}
+	if m.removedholds != nil {
+		edges = append(edges, operationtype.EdgeHolds)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OperationTypeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case operationtype.EdgeTransactions:
+		ids := make([]ent.Value, 0, len(m.removedtransactions))
+		for id := range m.removedtransactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case operationtype.EdgeHolds:
+		ids := make([]ent.Value, 0, len(m.removedholds))
+		for id := range m.removedholds {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OperationTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtransactions {
+		edges = append(edges, operationtype.EdgeTransactions)
+	}
+	if m.clearedholds {
+		edges = append(edges, operationtype.EdgeHolds)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OperationTypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case operationtype.EdgeTransactions:
+		return m.clearedtransactions
+	case operationtype.EdgeHolds:
+		return m.clearedholds
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OperationTypeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OperationType unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OperationTypeMutation) ResetEdge(name string) error {
+	switch name {
+	case operationtype.EdgeTransactions:
+		m.ResetTransactions()
+		return nil
+	case operationtype.EdgeHolds:
+		m.ResetHolds()
+		return nil
+	}
+	return fmt.Errorf("unknown OperationType edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	create_time               *time.Time
+	update_time               *time.Time
+	_type                     *string
+	aggregate_id              *int
+	addaggregate_id           *int
+	payload                   *json.RawMessage
+	appendpayload             json.RawMessage
+	published_at              *time.Time
+	attempts                  *int
+	addattempts               *int
+	last_error                *string
+	clearedFields             map[string]struct{}
+	account                   *int
+	clearedaccount            bool
+	webhook_deliveries        map[int]struct{}
+	removedwebhook_deliveries map[int]struct{}
+	clearedwebhook_deliveries bool
+	done                      bool
+	oldValue                  func(context.Context) (*OutboxEvent, error)
+	predicates                []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id int) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OutboxEvent entities.
+func (m *OutboxEventMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OutboxEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OutboxEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OutboxEventMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OutboxEventMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OutboxEventMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OutboxEventMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetType sets the "type" field.
+func (m *OutboxEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *OutboxEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *OutboxEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *OutboxEventMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *OutboxEventMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *OutboxEventMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (m *OutboxEventMutation) SetAggregateID(i int) {
+	m.aggregate_id = &i
+	m.addaggregate_id = nil
+}
+
+// AggregateID returns the value of the "aggregate_id" field in the mutation.
+func (m *OutboxEventMutation) AggregateID() (r int, exists bool) {
+	v := m.aggregate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateID returns the old "aggregate_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateID: %w", err)
+	}
+	return oldValue.AggregateID, nil
+}
+
+// AddAggregateID adds i to the "aggregate_id" field.
+func (m *OutboxEventMutation) AddAggregateID(i int) {
+	if m.addaggregate_id != nil {
+		*m.addaggregate_id += i
+	} else {
+		m.addaggregate_id = &i
+	}
+}
+
+// AddedAggregateID returns the value that was added to the "aggregate_id" field in this mutation.
+func (m *OutboxEventMutation) AddedAggregateID() (r int, exists bool) {
+	v := m.addaggregate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAggregateID resets all changes to the "aggregate_id" field.
+func (m *OutboxEventMutation) ResetAggregateID() {
+	m.aggregate_id = nil
+	m.addaggregate_id = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds jm to the "payload" field.
+func (m *OutboxEventMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *OutboxEventMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *OutboxEventMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *OutboxEventMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *OutboxEventMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[outboxevent.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *OutboxEventMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, outboxevent.FieldPublishedAt)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEventMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxevent.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEventMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxevent.FieldLastError)
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *OutboxEventMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[outboxevent.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *OutboxEventMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *OutboxEventMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *OutboxEventMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by ids.
+func (m *OutboxEventMutation) AddWebhookDeliveryIDs(ids ...int) {
+	if m.webhook_deliveries == nil {
+		m.webhook_deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.webhook_deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhookDeliveries clears the "webhook_deliveries" edge to the WebhookDelivery entity.
+func (m *OutboxEventMutation) ClearWebhookDeliveries() {
+	m.clearedwebhook_deliveries = true
+}
+
+// WebhookDeliveriesCleared reports if the "webhook_deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *OutboxEventMutation) WebhookDeliveriesCleared() bool {
+	return m.clearedwebhook_deliveries
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *OutboxEventMutation) RemoveWebhookDeliveryIDs(ids ...int) {
+	if m.removedwebhook_deliveries == nil {
+		m.removedwebhook_deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_deliveries, ids[i])
+		m.removedwebhook_deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhookDeliveries returns the removed IDs of the "webhook_deliveries" edge to the WebhookDelivery entity.
+func (m *OutboxEventMutation) RemovedWebhookDeliveriesIDs() (ids []int) {
+	for id := range m.removedwebhook_deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhookDeliveriesIDs returns the "webhook_deliveries" edge IDs in the mutation.
+func (m *OutboxEventMutation) WebhookDeliveriesIDs() (ids []int) {
+	for id := range m.webhook_deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhookDeliveries resets all changes to the "webhook_deliveries" edge.
+func (m *OutboxEventMutation) ResetWebhookDeliveries() {
+	m.webhook_deliveries = nil
+	m.clearedwebhook_deliveries = false
+	m.removedwebhook_deliveries = nil
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, outboxevent.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, outboxevent.FieldUpdateTime)
+	}
+	if m._type != nil {
+		fields = append(fields, outboxevent.FieldType)
+	}
+	if m.account != nil {
+		fields = append(fields, outboxevent.FieldAccountID)
+	}
+	if m.aggregate_id != nil {
+		fields = append(fields, outboxevent.FieldAggregateID)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.published_at != nil {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldCreateTime:
+		return m.CreateTime()
+	case outboxevent.FieldUpdateTime:
+		return m.UpdateTime()
+	case outboxevent.FieldType:
+		return m.GetType()
+	case outboxevent.FieldAccountID:
+		return m.AccountID()
+	case outboxevent.FieldAggregateID:
+		return m.AggregateID()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldPublishedAt:
+		return m.PublishedAt()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case outboxevent.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case outboxevent.FieldType:
+		return m.OldType(ctx)
+	case outboxevent.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case outboxevent.FieldAggregateID:
+		return m.OldAggregateID(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case outboxevent.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case outboxevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case outboxevent.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case outboxevent.FieldAggregateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateID(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addaggregate_id != nil {
+		fields = append(fields, outboxevent.FieldAggregateID)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAggregateID:
+		return m.AddedAggregateID()
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAggregateID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAggregateID(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldPublishedAt) {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.FieldCleared(outboxevent.FieldLastError) {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case outboxevent.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case outboxevent.FieldType:
+		m.ResetType()
+		return nil
+	case outboxevent.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case outboxevent.FieldAggregateID:
+		m.ResetAggregateID()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.account != nil {
+		edges = append(edges, outboxevent.EdgeAccount)
+	}
+	if m.webhook_deliveries != nil {
+		edges = append(edges, outboxevent.EdgeWebhookDeliveries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case outboxevent.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case outboxevent.EdgeWebhookDeliveries:
+		ids := make([]ent.Value, 0, len(m.webhook_deliveries))
+		for id := range m.webhook_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedwebhook_deliveries != nil {
+		edges = append(edges, outboxevent.EdgeWebhookDeliveries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case outboxevent.EdgeWebhookDeliveries:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_deliveries))
+		for id := range m.removedwebhook_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedaccount {
+		edges = append(edges, outboxevent.EdgeAccount)
+	}
+	if m.clearedwebhook_deliveries {
+		edges = append(edges, outboxevent.EdgeWebhookDeliveries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case outboxevent.EdgeAccount:
+		return m.clearedaccount
+	case outboxevent.EdgeWebhookDeliveries:
+		return m.clearedwebhook_deliveries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	switch name {
+	case outboxevent.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	switch name {
+	case outboxevent.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case outboxevent.EdgeWebhookDeliveries:
+		m.ResetWebhookDeliveries()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// SettlementMutation represents an operation that mutates the Settlement nodes in the graph.
+type SettlementMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	amount        *money.Amount
+	addamount     *money.Amount
+	timestamp     *time.Time
+	reversed_at   *time.Time
+	clearedFields map[string]struct{}
+	credit        *int
+	clearedcredit bool
+	debit         *int
+	cleareddebit  bool
+	done          bool
+	oldValue      func(context.Context) (*Settlement, error)
+	predicates    []predicate.Settlement
+}
+
+var _ ent.Mutation = (*SettlementMutation)(nil)
+
+// settlementOption allows management of the mutation configuration using functional options.
+type settlementOption func(*SettlementMutation)
+
+// newSettlementMutation creates new mutation for the Settlement entity.
+func newSettlementMutation(c config, op Op, opts ...settlementOption) *SettlementMutation {
+	m := &SettlementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettlement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettlementID sets the ID field of the mutation.
+func withSettlementID(id int) settlementOption {
+	return func(m *SettlementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settlement
+		)
+		m.oldValue = func(ctx context.Context) (*Settlement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settlement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettlement sets the old Settlement of the mutation.
+func withSettlement(node *Settlement) settlementOption {
+	return func(m *SettlementMutation) {
+		m.oldValue = func(context.Context) (*Settlement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettlementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettlementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Settlement entities.
+func (m *SettlementMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettlementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettlementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settlement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *SettlementMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *SettlementMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *SettlementMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *SettlementMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *SettlementMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *SettlementMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetCreditTxnID sets the "credit_txn_id" field.
+func (m *SettlementMutation) SetCreditTxnID(i int) {
+	m.credit = &i
+}
+
+// CreditTxnID returns the value of the "credit_txn_id" field in the mutation.
+func (m *SettlementMutation) CreditTxnID() (r int, exists bool) {
+	v := m.credit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditTxnID returns the old "credit_txn_id" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldCreditTxnID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditTxnID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditTxnID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditTxnID: %w", err)
+	}
+	return oldValue.CreditTxnID, nil
+}
+
+// ResetCreditTxnID resets all changes to the "credit_txn_id" field.
+func (m *SettlementMutation) ResetCreditTxnID() {
+	m.credit = nil
+}
+
+// SetDebitTxnID sets the "debit_txn_id" field.
+func (m *SettlementMutation) SetDebitTxnID(i int) {
+	m.debit = &i
+}
+
+// DebitTxnID returns the value of the "debit_txn_id" field in the mutation.
+func (m *SettlementMutation) DebitTxnID() (r int, exists bool) {
+	v := m.debit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDebitTxnID returns the old "debit_txn_id" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldDebitTxnID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDebitTxnID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDebitTxnID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDebitTxnID: %w", err)
+	}
+	return oldValue.DebitTxnID, nil
+}
+
+// ResetDebitTxnID resets all changes to the "debit_txn_id" field.
+func (m *SettlementMutation) ResetDebitTxnID() {
+	m.debit = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *SettlementMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *SettlementMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *SettlementMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *SettlementMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *SettlementMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *SettlementMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *SettlementMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *SettlementMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (m *SettlementMutation) SetReversedAt(t time.Time) {
+	m.reversed_at = &t
+}
+
+// ReversedAt returns the value of the "reversed_at" field in the mutation.
+func (m *SettlementMutation) ReversedAt() (r time.Time, exists bool) {
+	v := m.reversed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReversedAt returns the old "reversed_at" field's value of the Settlement entity.
+// If the Settlement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettlementMutation) OldReversedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReversedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReversedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReversedAt: %w", err)
+	}
+	return oldValue.ReversedAt, nil
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (m *SettlementMutation) ClearReversedAt() {
+	m.reversed_at = nil
+	m.clearedFields[settlement.FieldReversedAt] = struct{}{}
+}
+
+// ReversedAtCleared returns if the "reversed_at" field was cleared in this mutation.
+func (m *SettlementMutation) ReversedAtCleared() bool {
+	_, ok := m.clearedFields[settlement.FieldReversedAt]
+	return ok
+}
+
+// ResetReversedAt resets all changes to the "reversed_at" field.
+func (m *SettlementMutation) ResetReversedAt() {
+	m.reversed_at = nil
+	delete(m.clearedFields, settlement.FieldReversedAt)
+}
+
+// SetCreditID sets the "credit" edge to the Transaction entity by id.
+func (m *SettlementMutation) SetCreditID(id int) {
+	m.credit = &id
+}
+
+// ClearCredit clears the "credit" edge to the Transaction entity.
+func (m *SettlementMutation) ClearCredit() {
+	m.clearedcredit = true
+	m.clearedFields[settlement.FieldCreditTxnID] = struct{}{}
+}
+
+// CreditCleared reports if the "credit" edge to the Transaction entity was cleared.
+func (m *SettlementMutation) CreditCleared() bool {
+	return m.clearedcredit
+}
+
+// CreditID returns the "credit" edge ID in the mutation.
+func (m *SettlementMutation) CreditID() (id int, exists bool) {
+	if m.credit != nil {
+		return *m.credit, true
+	}
+	return
+}
+
+// CreditIDs returns the "credit" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreditID instead. It exists only for internal usage by the builders.
+func (m *SettlementMutation) CreditIDs() (ids []int) {
+	if id := m.credit; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCredit resets all changes to the "credit" edge.
+func (m *SettlementMutation) ResetCredit() {
+	m.credit = nil
+	m.clearedcredit = false
+}
+
+// SetDebitID sets the "debit" edge to the Transaction entity by id.
+func (m *SettlementMutation) SetDebitID(id int) {
+	m.debit = &id
+}
+
+// ClearDebit clears the "debit" edge to the Transaction entity.
+func (m *SettlementMutation) ClearDebit() {
+	m.cleareddebit = true
+	m.clearedFields[settlement.FieldDebitTxnID] = struct{}{}
+}
+
+// DebitCleared reports if the "debit" edge to the Transaction entity was cleared.
+func (m *SettlementMutation) DebitCleared() bool {
+	return m.cleareddebit
+}
+
+// DebitID returns the "debit" edge ID in the mutation.
+func (m *SettlementMutation) DebitID() (id int, exists bool) {
+	if m.debit != nil {
+		return *m.debit, true
+	}
+	return
+}
+
+// DebitIDs returns the "debit" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DebitID instead. It exists only for internal usage by the builders.
+func (m *SettlementMutation) DebitIDs() (ids []int) {
+	if id := m.debit; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDebit resets all changes to the "debit" edge.
+func (m *SettlementMutation) ResetDebit() {
+	m.debit = nil
+	m.cleareddebit = false
+}
+
+// Where appends a list predicates to the SettlementMutation builder.
+func (m *SettlementMutation) Where(ps ...predicate.Settlement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SettlementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SettlementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Settlement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SettlementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SettlementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Settlement).
+func (m *SettlementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SettlementMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, settlement.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, settlement.FieldUpdateTime)
+	}
+	if m.credit != nil {
+		fields = append(fields, settlement.FieldCreditTxnID)
+	}
+	if m.debit != nil {
+		fields = append(fields, settlement.FieldDebitTxnID)
+	}
+	if m.amount != nil {
+		fields = append(fields, settlement.FieldAmount)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, settlement.FieldTimestamp)
+	}
+	if m.reversed_at != nil {
+		fields = append(fields, settlement.FieldReversedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SettlementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case settlement.FieldCreateTime:
+		return m.CreateTime()
+	case settlement.FieldUpdateTime:
+		return m.UpdateTime()
+	case settlement.FieldCreditTxnID:
+		return m.CreditTxnID()
+	case settlement.FieldDebitTxnID:
+		return m.DebitTxnID()
+	case settlement.FieldAmount:
+		return m.Amount()
+	case settlement.FieldTimestamp:
+		return m.Timestamp()
+	case settlement.FieldReversedAt:
+		return m.ReversedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SettlementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case settlement.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case settlement.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case settlement.FieldCreditTxnID:
+		return m.OldCreditTxnID(ctx)
+	case settlement.FieldDebitTxnID:
+		return m.OldDebitTxnID(ctx)
+	case settlement.FieldAmount:
+		return m.OldAmount(ctx)
+	case settlement.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case settlement.FieldReversedAt:
+		return m.OldReversedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettlementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case settlement.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case settlement.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case settlement.FieldCreditTxnID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditTxnID(v)
+		return nil
+	case settlement.FieldDebitTxnID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDebitTxnID(v)
+		return nil
+	case settlement.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case settlement.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case settlement.FieldReversedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReversedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SettlementMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, settlement.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SettlementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case settlement.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SettlementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case settlement.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SettlementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(settlement.FieldReversedAt) {
+		fields = append(fields, settlement.FieldReversedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SettlementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SettlementMutation) ClearField(name string) error {
+	switch name {
+	case settlement.FieldReversedAt:
+		m.ClearReversedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SettlementMutation) ResetField(name string) error {
+	switch name {
+	case settlement.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case settlement.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case settlement.FieldCreditTxnID:
+		m.ResetCreditTxnID()
+		return nil
+	case settlement.FieldDebitTxnID:
+		m.ResetDebitTxnID()
+		return nil
+	case settlement.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case settlement.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case settlement.FieldReversedAt:
+		m.ResetReversedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettlementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.credit != nil {
+		edges = append(edges, settlement.EdgeCredit)
+	}
+	if m.debit != nil {
+		edges = append(edges, settlement.EdgeDebit)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SettlementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case settlement.EdgeCredit:
+		if id := m.credit; id != nil {
+			return []ent.Value{*id}
+		}
+	case settlement.EdgeDebit:
+		if id := m.debit; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SettlementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SettlementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SettlementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcredit {
+		edges = append(edges, settlement.EdgeCredit)
+	}
+	if m.cleareddebit {
+		edges = append(edges, settlement.EdgeDebit)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SettlementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case settlement.EdgeCredit:
+		return m.clearedcredit
+	case settlement.EdgeDebit:
+		return m.cleareddebit
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SettlementMutation) ClearEdge(name string) error {
+	switch name {
+	case settlement.EdgeCredit:
+		m.ClearCredit()
+		return nil
+	case settlement.EdgeDebit:
+		m.ClearDebit()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SettlementMutation) ResetEdge(name string) error {
+	switch name {
+	case settlement.EdgeCredit:
+		m.ResetCredit()
+		return nil
+	case settlement.EdgeDebit:
+		m.ResetDebit()
+		return nil
+	}
+	return fmt.Errorf("unknown Settlement edge %s", name)
+}
+
+// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
+type TransactionMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	create_time               *time.Time
+	update_time               *time.Time
+	amount                    *money.Amount
+	addamount                 *money.Amount
+	balance                   *money.Amount
+	addbalance                *money.Amount
+	timestamp                 *time.Time
+	installment_number        *int
+	addinstallment_number     *int
+	due_date                  *time.Time
+	status                    *transaction.Status
+	description               *string
+	merchant_name             *string
+	mcc                       *string
+	external_reference        *string
+	metadata                  *map[string]string
+	currency                  *string
+	original_amount           *money.Amount
+	addoriginal_amount        *money.Amount
+	original_currency         *string
+	fx_rate                   *string
+	clearedFields             map[string]struct{}
+	account                   *int
+	clearedaccount            bool
+	operation_type            *int
+	clearedoperation_type     bool
+	parent                    *int
+	clearedparent             bool
+	installments              map[int]struct{}
+	removedinstallments       map[int]struct{}
+	clearedinstallments       bool
+	reversal_of               *int
+	clearedreversal_of        bool
+	reversal                  map[int]struct{}
+	removedreversal           map[int]struct{}
+	clearedreversal           bool
+	transfer                  *int
+	clearedtransfer           bool
+	hold                      *int
+	clearedhold               bool
+	accrual                   *int
+	clearedaccrual            bool
+	credit_settlements        map[int]struct{}
+	removedcredit_settlements map[int]struct{}
+	clearedcredit_settlements bool
+	debit_settlements         map[int]struct{}
+	removeddebit_settlements  map[int]struct{}
+	cleareddebit_settlements  bool
+	invoice_payment           *int
+	clearedinvoice_payment    bool
+	journal_entries           map[int]struct{}
+	removedjournal_entries    map[int]struct{}
+	clearedjournal_entries    bool
+	done                      bool
+	oldValue                  func(context.Context) (*Transaction, error)
+	predicates                []predicate.Transaction
+}
+
+var _ ent.Mutation = (*TransactionMutation)(nil)
+
+// transactionOption allows management of the mutation configuration using functional options.
+type transactionOption func(*TransactionMutation)
+
+// newTransactionMutation creates new mutation for the Transaction entity.
+func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
+	m := &TransactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransactionID sets the ID field of the mutation.
+func withTransactionID(id int) transactionOption {
+	return func(m *TransactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transaction
+		)
+		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransaction sets the old Transaction of the mutation.
+func withTransaction(node *Transaction) transactionOption {
+	return func(m *TransactionMutation) {
+		m.oldValue = func(context.Context) (*Transaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Transaction entities.
+func (m *TransactionMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TransactionMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TransactionMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TransactionMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TransactionMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TransactionMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TransactionMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *TransactionMutation) SetAccountID(i int) {
+	m.account = &i
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *TransactionMutation) AccountID() (r int, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *TransactionMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *TransactionMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TransactionMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *TransactionMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *TransactionMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TransactionMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetBalance sets the "balance" field.
+func (m *TransactionMutation) SetBalance(value money.Amount) {
+	m.balance = &value
+	m.addbalance = nil
+}
+
+// Balance returns the value of the "balance" field in the mutation.
+func (m *TransactionMutation) Balance() (r money.Amount, exists bool) {
+	v := m.balance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBalance returns the old "balance" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldBalance(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBalance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
+	}
+	return oldValue.Balance, nil
+}
+
+// AddBalance adds value to the "balance" field.
+func (m *TransactionMutation) AddBalance(value money.Amount) {
+	if m.addbalance != nil {
+		*m.addbalance += value
+	} else {
+		m.addbalance = &value
+	}
+}
+
+// AddedBalance returns the value that was added to the "balance" field in this mutation.
+func (m *TransactionMutation) AddedBalance() (r money.Amount, exists bool) {
+	v := m.addbalance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBalance resets all changes to the "balance" field.
+func (m *TransactionMutation) ResetBalance() {
+	m.balance = nil
+	m.addbalance = nil
+}
+
+// SetOperationTypeID sets the "operation_type_id" field.
+func (m *TransactionMutation) SetOperationTypeID(i int) {
+	m.operation_type = &i
+}
+
+// OperationTypeID returns the value of the "operation_type_id" field in the mutation.
+func (m *TransactionMutation) OperationTypeID() (r int, exists bool) {
+	v := m.operation_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperationTypeID returns the old "operation_type_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldOperationTypeID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperationTypeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperationTypeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperationTypeID: %w", err)
+	}
+	return oldValue.OperationTypeID, nil
+}
+
+// ResetOperationTypeID resets all changes to the "operation_type_id" field.
+func (m *TransactionMutation) ResetOperationTypeID() {
+	m.operation_type = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *TransactionMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *TransactionMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *TransactionMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *TransactionMutation) SetParentID(i int) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TransactionMutation) ParentID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldParentID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TransactionMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[transaction.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TransactionMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TransactionMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, transaction.FieldParentID)
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (m *TransactionMutation) SetInstallmentNumber(i int) {
+	m.installment_number = &i
+	m.addinstallment_number = nil
+}
+
+// InstallmentNumber returns the value of the "installment_number" field in the mutation.
+func (m *TransactionMutation) InstallmentNumber() (r int, exists bool) {
+	v := m.installment_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstallmentNumber returns the old "installment_number" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldInstallmentNumber(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstallmentNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstallmentNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstallmentNumber: %w", err)
+	}
+	return oldValue.InstallmentNumber, nil
+}
+
+// AddInstallmentNumber adds i to the "installment_number" field.
+func (m *TransactionMutation) AddInstallmentNumber(i int) {
+	if m.addinstallment_number != nil {
+		*m.addinstallment_number += i
+	} else {
+		m.addinstallment_number = &i
+	}
+}
+
+// AddedInstallmentNumber returns the value that was added to the "installment_number" field in this mutation.
+func (m *TransactionMutation) AddedInstallmentNumber() (r int, exists bool) {
+	v := m.addinstallment_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearInstallmentNumber clears the value of the "installment_number" field.
+func (m *TransactionMutation) ClearInstallmentNumber() {
+	m.installment_number = nil
+	m.addinstallment_number = nil
+	m.clearedFields[transaction.FieldInstallmentNumber] = struct{}{}
+}
+
+// InstallmentNumberCleared returns if the "installment_number" field was cleared in this mutation.
+func (m *TransactionMutation) InstallmentNumberCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldInstallmentNumber]
+	return ok
+}
+
+// ResetInstallmentNumber resets all changes to the "installment_number" field.
+func (m *TransactionMutation) ResetInstallmentNumber() {
+	m.installment_number = nil
+	m.addinstallment_number = nil
+	delete(m.clearedFields, transaction.FieldInstallmentNumber)
+}
+
+// SetDueDate sets the "due_date" field.
+func (m *TransactionMutation) SetDueDate(t time.Time) {
+	m.due_date = &t
+}
+
+// DueDate returns the value of the "due_date" field in the mutation.
+func (m *TransactionMutation) DueDate() (r time.Time, exists bool) {
+	v := m.due_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDate returns the old "due_date" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDueDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+	}
+	return oldValue.DueDate, nil
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (m *TransactionMutation) ClearDueDate() {
+	m.due_date = nil
+	m.clearedFields[transaction.FieldDueDate] = struct{}{}
+}
+
+// DueDateCleared returns if the "due_date" field was cleared in this mutation.
+func (m *TransactionMutation) DueDateCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldDueDate]
+	return ok
+}
+
+// ResetDueDate resets all changes to the "due_date" field.
+func (m *TransactionMutation) ResetDueDate() {
+	m.due_date = nil
+	delete(m.clearedFields, transaction.FieldDueDate)
+}
+
+// SetStatus sets the "status" field.
+func (m *TransactionMutation) SetStatus(t transaction.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TransactionMutation) Status() (r transaction.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldStatus(ctx context.Context) (v transaction.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TransactionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReversalOfID sets the "reversal_of_id" field.
+func (m *TransactionMutation) SetReversalOfID(i int) {
+	m.reversal_of = &i
+}
+
+// ReversalOfID returns the value of the "reversal_of_id" field in the mutation.
+func (m *TransactionMutation) ReversalOfID() (r int, exists bool) {
+	v := m.reversal_of
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReversalOfID returns the old "reversal_of_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldReversalOfID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReversalOfID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReversalOfID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReversalOfID: %w", err)
+	}
+	return oldValue.ReversalOfID, nil
+}
+
+// ClearReversalOfID clears the value of the "reversal_of_id" field.
+func (m *TransactionMutation) ClearReversalOfID() {
+	m.reversal_of = nil
+	m.clearedFields[transaction.FieldReversalOfID] = struct{}{}
+}
+
+// ReversalOfIDCleared returns if the "reversal_of_id" field was cleared in this mutation.
+func (m *TransactionMutation) ReversalOfIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldReversalOfID]
+	return ok
+}
+
+// ResetReversalOfID resets all changes to the "reversal_of_id" field.
+func (m *TransactionMutation) ResetReversalOfID() {
+	m.reversal_of = nil
+	delete(m.clearedFields, transaction.FieldReversalOfID)
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (m *TransactionMutation) SetTransferID(i int) {
+	m.transfer = &i
+}
+
+// TransferID returns the value of the "transfer_id" field in the mutation.
+func (m *TransactionMutation) TransferID() (r int, exists bool) {
+	v := m.transfer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransferID returns the old "transfer_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldTransferID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransferID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransferID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransferID: %w", err)
+	}
+	return oldValue.TransferID, nil
+}
+
+// ClearTransferID clears the value of the "transfer_id" field.
+func (m *TransactionMutation) ClearTransferID() {
+	m.transfer = nil
+	m.clearedFields[transaction.FieldTransferID] = struct{}{}
+}
+
+// TransferIDCleared returns if the "transfer_id" field was cleared in this mutation.
+func (m *TransactionMutation) TransferIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldTransferID]
+	return ok
+}
+
+// ResetTransferID resets all changes to the "transfer_id" field.
+func (m *TransactionMutation) ResetTransferID() {
+	m.transfer = nil
+	delete(m.clearedFields, transaction.FieldTransferID)
+}
+
+// SetDescription sets the "description" field.
+func (m *TransactionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TransactionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TransactionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[transaction.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TransactionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TransactionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, transaction.FieldDescription)
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (m *TransactionMutation) SetMerchantName(s string) {
+	m.merchant_name = &s
+}
+
+// MerchantName returns the value of the "merchant_name" field in the mutation.
+func (m *TransactionMutation) MerchantName() (r string, exists bool) {
+	v := m.merchant_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMerchantName returns the old "merchant_name" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldMerchantName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMerchantName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMerchantName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMerchantName: %w", err)
+	}
+	return oldValue.MerchantName, nil
+}
+
+// ClearMerchantName clears the value of the "merchant_name" field.
+func (m *TransactionMutation) ClearMerchantName() {
+	m.merchant_name = nil
+	m.clearedFields[transaction.FieldMerchantName] = struct{}{}
+}
+
+// MerchantNameCleared returns if the "merchant_name" field was cleared in this mutation.
+func (m *TransactionMutation) MerchantNameCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldMerchantName]
+	return ok
+}
+
+// ResetMerchantName resets all changes to the "merchant_name" field.
+func (m *TransactionMutation) ResetMerchantName() {
+	m.merchant_name = nil
+	delete(m.clearedFields, transaction.FieldMerchantName)
+}
+
+// SetMcc sets the "mcc" field.
+func (m *TransactionMutation) SetMcc(s string) {
+	m.mcc = &s
+}
+
+// Mcc returns the value of the "mcc" field in the mutation.
+func (m *TransactionMutation) Mcc() (r string, exists bool) {
+	v := m.mcc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMcc returns the old "mcc" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldMcc(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMcc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMcc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMcc: %w", err)
+	}
+	return oldValue.Mcc, nil
+}
+
+// ClearMcc clears the value of the "mcc" field.
+func (m *TransactionMutation) ClearMcc() {
+	m.mcc = nil
+	m.clearedFields[transaction.FieldMcc] = struct{}{}
+}
+
+// MccCleared returns if the "mcc" field was cleared in this mutation.
+func (m *TransactionMutation) MccCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldMcc]
+	return ok
+}
+
+// ResetMcc resets all changes to the "mcc" field.
+func (m *TransactionMutation) ResetMcc() {
+	m.mcc = nil
+	delete(m.clearedFields, transaction.FieldMcc)
+}
+
+// SetExternalReference sets the "external_reference" field.
+func (m *TransactionMutation) SetExternalReference(s string) {
+	m.external_reference = &s
+}
+
+// ExternalReference returns the value of the "external_reference" field in the mutation.
+func (m *TransactionMutation) ExternalReference() (r string, exists bool) {
+	v := m.external_reference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalReference returns the old "external_reference" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldExternalReference(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalReference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalReference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalReference: %w", err)
+	}
+	return oldValue.ExternalReference, nil
+}
+
+// ClearExternalReference clears the value of the "external_reference" field.
+func (m *TransactionMutation) ClearExternalReference() {
+	m.external_reference = nil
+	m.clearedFields[transaction.FieldExternalReference] = struct{}{}
+}
+
+// ExternalReferenceCleared returns if the "external_reference" field was cleared in this mutation.
+func (m *TransactionMutation) ExternalReferenceCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldExternalReference]
+	return ok
+}
+
+// ResetExternalReference resets all changes to the "external_reference" field.
+func (m *TransactionMutation) ResetExternalReference() {
+	m.external_reference = nil
+	delete(m.clearedFields, transaction.FieldExternalReference)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *TransactionMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *TransactionMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *TransactionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[transaction.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *TransactionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *TransactionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, transaction.FieldMetadata)
+}
+
+// SetCurrency sets the "currency" field.
+func (m *TransactionMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TransactionMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TransactionMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetOriginalAmount sets the "original_amount" field.
+func (m *TransactionMutation) SetOriginalAmount(value money.Amount) {
+	m.original_amount = &value
+	m.addoriginal_amount = nil
+}
+
+// OriginalAmount returns the value of the "original_amount" field in the mutation.
+func (m *TransactionMutation) OriginalAmount() (r money.Amount, exists bool) {
+	v := m.original_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalAmount returns the old "original_amount" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldOriginalAmount(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalAmount: %w", err)
+	}
+	return oldValue.OriginalAmount, nil
+}
+
+// AddOriginalAmount adds value to the "original_amount" field.
+func (m *TransactionMutation) AddOriginalAmount(value money.Amount) {
+	if m.addoriginal_amount != nil {
+		*m.addoriginal_amount += value
+	} else {
+		m.addoriginal_amount = &value
+	}
+}
+
+// AddedOriginalAmount returns the value that was added to the "original_amount" field in this mutation.
+func (m *TransactionMutation) AddedOriginalAmount() (r money.Amount, exists bool) {
+	v := m.addoriginal_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOriginalAmount clears the value of the "original_amount" field.
+func (m *TransactionMutation) ClearOriginalAmount() {
+	m.original_amount = nil
+	m.addoriginal_amount = nil
+	m.clearedFields[transaction.FieldOriginalAmount] = struct{}{}
+}
+
+// OriginalAmountCleared returns if the "original_amount" field was cleared in this mutation.
+func (m *TransactionMutation) OriginalAmountCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldOriginalAmount]
+	return ok
+}
+
+// ResetOriginalAmount resets all changes to the "original_amount" field.
+func (m *TransactionMutation) ResetOriginalAmount() {
+	m.original_amount = nil
+	m.addoriginal_amount = nil
+	delete(m.clearedFields, transaction.FieldOriginalAmount)
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (m *TransactionMutation) SetOriginalCurrency(s string) {
+	m.original_currency = &s
+}
+
+// OriginalCurrency returns the value of the "original_currency" field in the mutation.
+func (m *TransactionMutation) OriginalCurrency() (r string, exists bool) {
+	v := m.original_currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalCurrency returns the old "original_currency" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldOriginalCurrency(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalCurrency: %w", err)
+	}
+	return oldValue.OriginalCurrency, nil
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (m *TransactionMutation) ClearOriginalCurrency() {
+	m.original_currency = nil
+	m.clearedFields[transaction.FieldOriginalCurrency] = struct{}{}
+}
+
+// OriginalCurrencyCleared returns if the "original_currency" field was cleared in this mutation.
+func (m *TransactionMutation) OriginalCurrencyCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldOriginalCurrency]
+	return ok
+}
+
+// ResetOriginalCurrency resets all changes to the "original_currency" field.
+func (m *TransactionMutation) ResetOriginalCurrency() {
+	m.original_currency = nil
+	delete(m.clearedFields, transaction.FieldOriginalCurrency)
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (m *TransactionMutation) SetFxRate(s string) {
+	m.fx_rate = &s
+}
+
+// FxRate returns the value of the "fx_rate" field in the mutation.
+func (m *TransactionMutation) FxRate() (r string, exists bool) {
+	v := m.fx_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFxRate returns the old "fx_rate" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldFxRate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFxRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFxRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFxRate: %w", err)
+	}
+	return oldValue.FxRate, nil
+}
+
+// ClearFxRate clears the value of the "fx_rate" field.
+func (m *TransactionMutation) ClearFxRate() {
+	m.fx_rate = nil
+	m.clearedFields[transaction.FieldFxRate] = struct{}{}
+}
+
+// FxRateCleared returns if the "fx_rate" field was cleared in this mutation.
+func (m *TransactionMutation) FxRateCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldFxRate]
+	return ok
+}
+
+// ResetFxRate resets all changes to the "fx_rate" field.
+func (m *TransactionMutation) ResetFxRate() {
+	m.fx_rate = nil
+	delete(m.clearedFields, transaction.FieldFxRate)
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *TransactionMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[transaction.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *TransactionMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) AccountIDs() (ids []int) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *TransactionMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearOperationType clears the "operation_type" edge to the OperationType entity.
+func (m *TransactionMutation) ClearOperationType() {
+	m.clearedoperation_type = true
+	m.clearedFields[transaction.FieldOperationTypeID] = struct{}{}
+}
+
+// OperationTypeCleared reports if the "operation_type" edge to the OperationType entity was cleared.
+func (m *TransactionMutation) OperationTypeCleared() bool {
+	return m.clearedoperation_type
+}
+
+// OperationTypeIDs returns the "operation_type" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OperationTypeID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) OperationTypeIDs() (ids []int) {
+	if id := m.operation_type; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOperationType resets all changes to the "operation_type" edge.
+func (m *TransactionMutation) ResetOperationType() {
+	m.operation_type = nil
+	m.clearedoperation_type = false
+}
+
+// ClearParent clears the "parent" edge to the Transaction entity.
+func (m *TransactionMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[transaction.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Transaction entity was cleared.
+func (m *TransactionMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TransactionMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddInstallmentIDs adds the "installments" edge to the Transaction entity by ids.
+func (m *TransactionMutation) AddInstallmentIDs(ids ...int) {
+	if m.installments == nil {
+		m.installments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.installments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInstallments clears the "installments" edge to the Transaction entity.
+func (m *TransactionMutation) ClearInstallments() {
+	m.clearedinstallments = true
+}
+
+// InstallmentsCleared reports if the "installments" edge to the Transaction entity was cleared.
+func (m *TransactionMutation) InstallmentsCleared() bool {
+	return m.clearedinstallments
+}
+
+// RemoveInstallmentIDs removes the "installments" edge to the Transaction entity by IDs.
+func (m *TransactionMutation) RemoveInstallmentIDs(ids ...int) {
+	if m.removedinstallments == nil {
+		m.removedinstallments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.installments, ids[i])
+		m.removedinstallments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInstallments returns the removed IDs of the "installments" edge to the Transaction entity.
+func (m *TransactionMutation) RemovedInstallmentsIDs() (ids []int) {
+	for id := range m.removedinstallments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InstallmentsIDs returns the "installments" edge IDs in the mutation.
+func (m *TransactionMutation) InstallmentsIDs() (ids []int) {
+	for id := range m.installments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInstallments resets all changes to the "installments" edge.
+func (m *TransactionMutation) ResetInstallments() {
+	m.installments = nil
+	m.clearedinstallments = false
+	m.removedinstallments = nil
+}
+
+// ClearReversalOf clears the "reversal_of" edge to the Transaction entity.
+func (m *TransactionMutation) ClearReversalOf() {
+	m.clearedreversal_of = true
+	m.clearedFields[transaction.FieldReversalOfID] = struct{}{}
+}
+
+// ReversalOfCleared reports if the "reversal_of" edge to the Transaction entity was cleared.
+func (m *TransactionMutation) ReversalOfCleared() bool {
+	return m.ReversalOfIDCleared() || m.clearedreversal_of
+}
+
+// ReversalOfIDs returns the "reversal_of" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReversalOfID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) ReversalOfIDs() (ids []int) {
+	if id := m.reversal_of; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReversalOf resets all changes to the "reversal_of" edge.
+func (m *TransactionMutation) ResetReversalOf() {
+	m.reversal_of = nil
+	m.clearedreversal_of = false
+}
+
+// AddReversalIDs adds the "reversal" edge to the Transaction entity by ids.
+func (m *TransactionMutation) AddReversalIDs(ids ...int) {
+	if m.reversal == nil {
+		m.reversal = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reversal[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReversal clears the "reversal" edge to the Transaction entity.
+func (m *TransactionMutation) ClearReversal() {
+	m.clearedreversal = true
+}
+
+// ReversalCleared reports if the "reversal" edge to the Transaction entity was cleared.
+func (m *TransactionMutation) ReversalCleared() bool {
+	return m.clearedreversal
+}
+
+// RemoveReversalIDs removes the "reversal" edge to the Transaction entity by IDs.
+func (m *TransactionMutation) RemoveReversalIDs(ids ...int) {
+	if m.removedreversal == nil {
+		m.removedreversal = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reversal, ids[i])
+		m.removedreversal[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReversal returns the removed IDs of the "reversal" edge to the Transaction entity.
+func (m *TransactionMutation) RemovedReversalIDs() (ids []int) {
+	for id := range m.removedreversal {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReversalIDs returns the "reversal" edge IDs in the mutation.
+func (m *TransactionMutation) ReversalIDs() (ids []int) {
+	for id := range m.reversal {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReversal resets all changes to the "reversal" edge.
+func (m *TransactionMutation) ResetReversal() {
+	m.reversal = nil
+	m.clearedreversal = false
+	m.removedreversal = nil
+}
+
+// ClearTransfer clears the "transfer" edge to the Transfer entity.
+func (m *TransactionMutation) ClearTransfer() {
+	m.clearedtransfer = true
+	m.clearedFields[transaction.FieldTransferID] = struct{}{}
+}
+
+// TransferCleared reports if the "transfer" edge to the Transfer entity was cleared.
+func (m *TransactionMutation) TransferCleared() bool {
+	return m.TransferIDCleared() || m.clearedtransfer
+}
+
+// TransferIDs returns the "transfer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransferID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) TransferIDs() (ids []int) {
+	if id := m.transfer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransfer resets all changes to the "transfer" edge.
+func (m *TransactionMutation) ResetTransfer() {
+	m.transfer = nil
+	m.clearedtransfer = false
+}
+
+// SetHoldID sets the "hold" edge to the Hold entity by id.
+func (m *TransactionMutation) SetHoldID(id int) {
+	m.hold = &id
+}
+
+// ClearHold clears the "hold" edge to the Hold entity.
+func (m *TransactionMutation) ClearHold() {
+	m.clearedhold = true
+}
+
+// HoldCleared reports if the "hold" edge to the Hold entity was cleared.
+func (m *TransactionMutation) HoldCleared() bool {
+	return m.clearedhold
+}
+
+// HoldID returns the "hold" edge ID in the mutation.
+func (m *TransactionMutation) HoldID() (id int, exists bool) {
+	if m.hold != nil {
+		return *m.hold, true
+	}
+	return
+}
+
+// HoldIDs returns the "hold" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HoldID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) HoldIDs() (ids []int) {
+	if id := m.hold; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHold resets all changes to the "hold" edge.
+func (m *TransactionMutation) ResetHold() {
+	m.hold = nil
+	m.clearedhold = false
+}
+
+// SetAccrualID sets the "accrual" edge to the Accrual entity by id.
+func (m *TransactionMutation) SetAccrualID(id int) {
+	m.accrual = &id
+}
+
+// ClearAccrual clears the "accrual" edge to the Accrual entity.
+func (m *TransactionMutation) ClearAccrual() {
+	m.clearedaccrual = true
+}
+
+// AccrualCleared reports if the "accrual" edge to the Accrual entity was cleared.
+func (m *TransactionMutation) AccrualCleared() bool {
+	return m.clearedaccrual
+}
+
+// AccrualID returns the "accrual" edge ID in the mutation.
+func (m *TransactionMutation) AccrualID() (id int, exists bool) {
+	if m.accrual != nil {
+		return *m.accrual, true
+	}
+	return
+}
+
+// AccrualIDs returns the "accrual" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccrualID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) AccrualIDs() (ids []int) {
+	if id := m.accrual; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccrual resets all changes to the "accrual" edge.
+func (m *TransactionMutation) ResetAccrual() {
+	m.accrual = nil
+	m.clearedaccrual = false
+}
+
+// AddCreditSettlementIDs adds the "credit_settlements" edge to the Settlement entity by ids.
+func (m *TransactionMutation) AddCreditSettlementIDs(ids ...int) {
+	if m.credit_settlements == nil {
+		m.credit_settlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.credit_settlements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreditSettlements clears the "credit_settlements" edge to the Settlement entity.
+func (m *TransactionMutation) ClearCreditSettlements() {
+	m.clearedcredit_settlements = true
+}
+
+// CreditSettlementsCleared reports if the "credit_settlements" edge to the Settlement entity was cleared.
+func (m *TransactionMutation) CreditSettlementsCleared() bool {
+	return m.clearedcredit_settlements
+}
+
+// RemoveCreditSettlementIDs removes the "credit_settlements" edge to the Settlement entity by IDs.
+func (m *TransactionMutation) RemoveCreditSettlementIDs(ids ...int) {
+	if m.removedcredit_settlements == nil {
+		m.removedcredit_settlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.credit_settlements, ids[i])
+		m.removedcredit_settlements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreditSettlements returns the removed IDs of the "credit_settlements" edge to the Settlement entity.
+func (m *TransactionMutation) RemovedCreditSettlementsIDs() (ids []int) {
+	for id := range m.removedcredit_settlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreditSettlementsIDs returns the "credit_settlements" edge IDs in the mutation.
+func (m *TransactionMutation) CreditSettlementsIDs() (ids []int) {
+	for id := range m.credit_settlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreditSettlements resets all changes to the "credit_settlements" edge.
+func (m *TransactionMutation) ResetCreditSettlements() {
+	m.credit_settlements = nil
+	m.clearedcredit_settlements = false
+	m.removedcredit_settlements = nil
+}
+
+// AddDebitSettlementIDs adds the "debit_settlements" edge to the Settlement entity by ids.
+func (m *TransactionMutation) AddDebitSettlementIDs(ids ...int) {
+	if m.debit_settlements == nil {
+		m.debit_settlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.debit_settlements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDebitSettlements clears the "debit_settlements" edge to the Settlement entity.
+func (m *TransactionMutation) ClearDebitSettlements() {
+	m.cleareddebit_settlements = true
+}
+
+// DebitSettlementsCleared reports if the "debit_settlements" edge to the Settlement entity was cleared.
+func (m *TransactionMutation) DebitSettlementsCleared() bool {
+	return m.cleareddebit_settlements
+}
+
+// RemoveDebitSettlementIDs removes the "debit_settlements" edge to the Settlement entity by IDs.
+func (m *TransactionMutation) RemoveDebitSettlementIDs(ids ...int) {
+	if m.removeddebit_settlements == nil {
+		m.removeddebit_settlements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.debit_settlements, ids[i])
+		m.removeddebit_settlements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDebitSettlements returns the removed IDs of the "debit_settlements" edge to the Settlement entity.
+func (m *TransactionMutation) RemovedDebitSettlementsIDs() (ids []int) {
+	for id := range m.removeddebit_settlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DebitSettlementsIDs returns the "debit_settlements" edge IDs in the mutation.
+func (m *TransactionMutation) DebitSettlementsIDs() (ids []int) {
+	for id := range m.debit_settlements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDebitSettlements resets all changes to the "debit_settlements" edge.
+func (m *TransactionMutation) ResetDebitSettlements() {
+	m.debit_settlements = nil
+	m.cleareddebit_settlements = false
+	m.removeddebit_settlements = nil
+}
+
+// SetInvoicePaymentID sets the "invoice_payment" edge to the InvoicePayment entity by id.
+func (m *TransactionMutation) SetInvoicePaymentID(id int) {
+	m.invoice_payment = &id
+}
+
+// ClearInvoicePayment clears the "invoice_payment" edge to the InvoicePayment entity.
+func (m *TransactionMutation) ClearInvoicePayment() {
+	m.clearedinvoice_payment = true
+}
+
+// InvoicePaymentCleared reports if the "invoice_payment" edge to the InvoicePayment entity was cleared.
+func (m *TransactionMutation) InvoicePaymentCleared() bool {
+	return m.clearedinvoice_payment
+}
+
+// InvoicePaymentID returns the "invoice_payment" edge ID in the mutation.
+func (m *TransactionMutation) InvoicePaymentID() (id int, exists bool) {
+	if m.invoice_payment != nil {
+		return *m.invoice_payment, true
+	}
+	return
+}
+
+// InvoicePaymentIDs returns the "invoice_payment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InvoicePaymentID instead. It exists only for internal usage by the builders.
+func (m *TransactionMutation) InvoicePaymentIDs() (ids []int) {
+	if id := m.invoice_payment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvoicePayment resets all changes to the "invoice_payment" edge.
+func (m *TransactionMutation) ResetInvoicePayment() {
+	m.invoice_payment = nil
+	m.clearedinvoice_payment = false
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by ids.
+func (m *TransactionMutation) AddJournalEntryIDs(ids ...int) {
+	if m.journal_entries == nil {
+		m.journal_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.journal_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJournalEntries clears the "journal_entries" edge to the JournalEntry entity.
+func (m *TransactionMutation) ClearJournalEntries() {
+	m.clearedjournal_entries = true
+}
+
+// JournalEntriesCleared reports if the "journal_entries" edge to the JournalEntry entity was cleared.
+func (m *TransactionMutation) JournalEntriesCleared() bool {
+	return m.clearedjournal_entries
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to the JournalEntry entity by IDs.
+func (m *TransactionMutation) RemoveJournalEntryIDs(ids ...int) {
+	if m.removedjournal_entries == nil {
+		m.removedjournal_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.journal_entries, ids[i])
+		m.removedjournal_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJournalEntries returns the removed IDs of the "journal_entries" edge to the JournalEntry entity.
+func (m *TransactionMutation) RemovedJournalEntriesIDs() (ids []int) {
+	for id := range m.removedjournal_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JournalEntriesIDs returns the "journal_entries" edge IDs in the mutation.
+func (m *TransactionMutation) JournalEntriesIDs() (ids []int) {
+	for id := range m.journal_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJournalEntries resets all changes to the "journal_entries" edge.
+func (m *TransactionMutation) ResetJournalEntries() {
+	m.journal_entries = nil
+	m.clearedjournal_entries = false
+	m.removedjournal_entries = nil
+}
+
+// Where appends a list predicates to the TransactionMutation builder.
+func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transaction).
+func (m *TransactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransactionMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.create_time != nil {
+		fields = append(fields, transaction.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, transaction.FieldUpdateTime)
+	}
+	if m.account != nil {
+		fields = append(fields, transaction.FieldAccountID)
+	}
+	if m.amount != nil {
+		fields = append(fields, transaction.FieldAmount)
+	}
+	if m.balance != nil {
+		fields = append(fields, transaction.FieldBalance)
+	}
+	if m.operation_type != nil {
+		fields = append(fields, transaction.FieldOperationTypeID)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, transaction.FieldTimestamp)
+	}
+	if m.parent != nil {
+		fields = append(fields, transaction.FieldParentID)
+	}
+	if m.installment_number != nil {
+		fields = append(fields, transaction.FieldInstallmentNumber)
+	}
+	if m.due_date != nil {
+		fields = append(fields, transaction.FieldDueDate)
+	}
+	if m.status != nil {
+		fields = append(fields, transaction.FieldStatus)
+	}
+	if m.reversal_of != nil {
+		fields = append(fields, transaction.FieldReversalOfID)
+	}
+	if m.transfer != nil {
+		fields = append(fields, transaction.FieldTransferID)
+	}
+	if m.description != nil {
+		fields = append(fields, transaction.FieldDescription)
+	}
+	if m.merchant_name != nil {
+		fields = append(fields, transaction.FieldMerchantName)
+	}
+	if m.mcc != nil {
+		fields = append(fields, transaction.FieldMcc)
+	}
+	if m.external_reference != nil {
+		fields = append(fields, transaction.FieldExternalReference)
+	}
+	if m.metadata != nil {
+		fields = append(fields, transaction.FieldMetadata)
+	}
+	if m.currency != nil {
+		fields = append(fields, transaction.FieldCurrency)
+	}
+	if m.original_amount != nil {
+		fields = append(fields, transaction.FieldOriginalAmount)
+	}
+	if m.original_currency != nil {
+		fields = append(fields, transaction.FieldOriginalCurrency)
+	}
+	if m.fx_rate != nil {
+		fields = append(fields, transaction.FieldFxRate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transaction.FieldCreateTime:
+		return m.CreateTime()
+	case transaction.FieldUpdateTime:
+		return m.UpdateTime()
+	case transaction.FieldAccountID:
+		return m.AccountID()
+	case transaction.FieldAmount:
+		return m.Amount()
+	case transaction.FieldBalance:
+		return m.Balance()
+	case transaction.FieldOperationTypeID:
+		return m.OperationTypeID()
+	case transaction.FieldTimestamp:
+		return m.Timestamp()
+	case transaction.FieldParentID:
+		return m.ParentID()
+	case transaction.FieldInstallmentNumber:
+		return m.InstallmentNumber()
+	case transaction.FieldDueDate:
+		return m.DueDate()
+	case transaction.FieldStatus:
+		return m.Status()
+	case transaction.FieldReversalOfID:
+		return m.ReversalOfID()
+	case transaction.FieldTransferID:
+		return m.TransferID()
+	case transaction.FieldDescription:
+		return m.Description()
+	case transaction.FieldMerchantName:
+		return m.MerchantName()
+	case transaction.FieldMcc:
+		return m.Mcc()
+	case transaction.FieldExternalReference:
+		return m.ExternalReference()
+	case transaction.FieldMetadata:
+		return m.Metadata()
+	case transaction.FieldCurrency:
+		return m.Currency()
+	case transaction.FieldOriginalAmount:
+		return m.OriginalAmount()
+	case transaction.FieldOriginalCurrency:
+		return m.OriginalCurrency()
+	case transaction.FieldFxRate:
+		return m.FxRate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transaction.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case transaction.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case transaction.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case transaction.FieldAmount:
+		return m.OldAmount(ctx)
+	case transaction.FieldBalance:
+		return m.OldBalance(ctx)
+	case transaction.FieldOperationTypeID:
+		return m.OldOperationTypeID(ctx)
+	case transaction.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case transaction.FieldParentID:
+		return m.OldParentID(ctx)
+	case transaction.FieldInstallmentNumber:
+		return m.OldInstallmentNumber(ctx)
+	case transaction.FieldDueDate:
+		return m.OldDueDate(ctx)
+	case transaction.FieldStatus:
+		return m.OldStatus(ctx)
+	case transaction.FieldReversalOfID:
+		return m.OldReversalOfID(ctx)
+	case transaction.FieldTransferID:
+		return m.OldTransferID(ctx)
+	case transaction.FieldDescription:
+		return m.OldDescription(ctx)
+	case transaction.FieldMerchantName:
+		return m.OldMerchantName(ctx)
+	case transaction.FieldMcc:
+		return m.OldMcc(ctx)
+	case transaction.FieldExternalReference:
+		return m.OldExternalReference(ctx)
+	case transaction.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case transaction.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case transaction.FieldOriginalAmount:
+		return m.OldOriginalAmount(ctx)
+	case transaction.FieldOriginalCurrency:
+		return m.OldOriginalCurrency(ctx)
+	case transaction.FieldFxRate:
+		return m.OldFxRate(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transaction.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case transaction.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case transaction.FieldAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case transaction.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case transaction.FieldBalance:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBalance(v)
+		return nil
+	case transaction.FieldOperationTypeID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperationTypeID(v)
+		return nil
+	case transaction.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case transaction.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case transaction.FieldInstallmentNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstallmentNumber(v)
+		return nil
+	case transaction.FieldDueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDate(v)
+		return nil
+	case transaction.FieldStatus:
+		v, ok := value.(transaction.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case transaction.FieldReversalOfID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReversalOfID(v)
+		return nil
+	case transaction.FieldTransferID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransferID(v)
+		return nil
+	case transaction.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case transaction.FieldMerchantName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantName(v)
+		return nil
+	case transaction.FieldMcc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMcc(v)
+		return nil
+	case transaction.FieldExternalReference:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalReference(v)
+		return nil
+	case transaction.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case transaction.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case transaction.FieldOriginalAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalAmount(v)
+		return nil
+	case transaction.FieldOriginalCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalCurrency(v)
+		return nil
+	case transaction.FieldFxRate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFxRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransactionMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, transaction.FieldAmount)
+	}
+	if m.addbalance != nil {
+		fields = append(fields, transaction.FieldBalance)
+	}
+	if m.addinstallment_number != nil {
+		fields = append(fields, transaction.FieldInstallmentNumber)
+	}
+	if m.addoriginal_amount != nil {
+		fields = append(fields, transaction.FieldOriginalAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case transaction.FieldAmount:
+		return m.AddedAmount()
+	case transaction.FieldBalance:
+		return m.AddedBalance()
+	case transaction.FieldInstallmentNumber:
+		return m.AddedInstallmentNumber()
+	case transaction.FieldOriginalAmount:
+		return m.AddedOriginalAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case transaction.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case transaction.FieldBalance:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBalance(v)
+		return nil
+	case transaction.FieldInstallmentNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInstallmentNumber(v)
+		return nil
+	case transaction.FieldOriginalAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOriginalAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransactionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transaction.FieldParentID) {
+		fields = append(fields, transaction.FieldParentID)
+	}
+	if m.FieldCleared(transaction.FieldInstallmentNumber) {
+		fields = append(fields, transaction.FieldInstallmentNumber)
+	}
+	if m.FieldCleared(transaction.FieldDueDate) {
+		fields = append(fields, transaction.FieldDueDate)
+	}
+	if m.FieldCleared(transaction.FieldReversalOfID) {
+		fields = append(fields, transaction.FieldReversalOfID)
+	}
+	if m.FieldCleared(transaction.FieldTransferID) {
+		fields = append(fields, transaction.FieldTransferID)
+	}
+	if m.FieldCleared(transaction.FieldDescription) {
+		fields = append(fields, transaction.FieldDescription)
+	}
+	if m.FieldCleared(transaction.FieldMerchantName) {
+		fields = append(fields, transaction.FieldMerchantName)
+	}
+	if m.FieldCleared(transaction.FieldMcc) {
+		fields = append(fields, transaction.FieldMcc)
+	}
+	if m.FieldCleared(transaction.FieldExternalReference) {
+		fields = append(fields, transaction.FieldExternalReference)
+	}
+	if m.FieldCleared(transaction.FieldMetadata) {
+		fields = append(fields, transaction.FieldMetadata)
+	}
+	if m.FieldCleared(transaction.FieldOriginalAmount) {
+		fields = append(fields, transaction.FieldOriginalAmount)
+	}
+	if m.FieldCleared(transaction.FieldOriginalCurrency) {
+		fields = append(fields, transaction.FieldOriginalCurrency)
+	}
+	if m.FieldCleared(transaction.FieldFxRate) {
+		fields = append(fields, transaction.FieldFxRate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransactionMutation) ClearField(name string) error {
+	switch name {
+	case transaction.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case transaction.FieldInstallmentNumber:
+		m.ClearInstallmentNumber()
+		return nil
+	case transaction.FieldDueDate:
+		m.ClearDueDate()
+		return nil
+	case transaction.FieldReversalOfID:
+		m.ClearReversalOfID()
+		return nil
+	case transaction.FieldTransferID:
+		m.ClearTransferID()
+		return nil
+	case transaction.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case transaction.FieldMerchantName:
+		m.ClearMerchantName()
+		return nil
+	case transaction.FieldMcc:
+		m.ClearMcc()
+		return nil
+	case transaction.FieldExternalReference:
+		m.ClearExternalReference()
+		return nil
+	case transaction.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case transaction.FieldOriginalAmount:
+		m.ClearOriginalAmount()
+		return nil
+	case transaction.FieldOriginalCurrency:
+		m.ClearOriginalCurrency()
+		return nil
+	case transaction.FieldFxRate:
+		m.ClearFxRate()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransactionMutation) ResetField(name string) error {
+	switch name {
+	case transaction.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case transaction.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case transaction.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case transaction.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case transaction.FieldBalance:
+		m.ResetBalance()
+		return nil
+	case transaction.FieldOperationTypeID:
+		m.ResetOperationTypeID()
+		return nil
+	case transaction.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case transaction.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case transaction.FieldInstallmentNumber:
+		m.ResetInstallmentNumber()
+		return nil
+	case transaction.FieldDueDate:
+		m.ResetDueDate()
+		return nil
+	case transaction.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case transaction.FieldReversalOfID:
+		m.ResetReversalOfID()
+		return nil
+	case transaction.FieldTransferID:
+		m.ResetTransferID()
+		return nil
+	case transaction.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case transaction.FieldMerchantName:
+		m.ResetMerchantName()
+		return nil
+	case transaction.FieldMcc:
+		m.ResetMcc()
+		return nil
+	case transaction.FieldExternalReference:
+		m.ResetExternalReference()
+		return nil
+	case transaction.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case transaction.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case transaction.FieldOriginalAmount:
+		m.ResetOriginalAmount()
+		return nil
+	case transaction.FieldOriginalCurrency:
+		m.ResetOriginalCurrency()
+		return nil
+	case transaction.FieldFxRate:
+		m.ResetFxRate()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.account != nil {
+		edges = append(edges, transaction.EdgeAccount)
+	}
+	if m.operation_type != nil {
+		edges = append(edges, transaction.EdgeOperationType)
+	}
+	if m.parent != nil {
+		edges = append(edges, transaction.EdgeParent)
+	}
+	if m.installments != nil {
+		edges = append(edges, transaction.EdgeInstallments)
+	}
+	if m.reversal_of != nil {
+		edges = append(edges, transaction.EdgeReversalOf)
+	}
+	if m.reversal != nil {
+		edges = append(edges, transaction.EdgeReversal)
+	}
+	if m.transfer != nil {
+		edges = append(edges, transaction.EdgeTransfer)
+	}
+	if m.hold != nil {
+		edges = append(edges, transaction.EdgeHold)
+	}
+	if m.accrual != nil {
+		edges = append(edges, transaction.EdgeAccrual)
+	}
+	if m.credit_settlements != nil {
+		edges = append(edges, transaction.EdgeCreditSettlements)
+	}
+	if m.debit_settlements != nil {
+		edges = append(edges, transaction.EdgeDebitSettlements)
+	}
+	if m.invoice_payment != nil {
+		edges = append(edges, transaction.EdgeInvoicePayment)
+	}
+	if m.journal_entries != nil {
+		edges = append(edges, transaction.EdgeJournalEntries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transaction.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeOperationType:
+		if id := m.operation_type; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeInstallments:
+		ids := make([]ent.Value, 0, len(m.installments))
+		for id := range m.installments {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeReversalOf:
+		if id := m.reversal_of; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeReversal:
+		ids := make([]ent.Value, 0, len(m.reversal))
+		for id := range m.reversal {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeTransfer:
+		if id := m.transfer; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeHold:
+		if id := m.hold; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeAccrual:
+		if id := m.accrual; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeCreditSettlements:
+		ids := make([]ent.Value, 0, len(m.credit_settlements))
+		for id := range m.credit_settlements {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeDebitSettlements:
+		ids := make([]ent.Value, 0, len(m.debit_settlements))
+		for id := range m.debit_settlements {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeInvoicePayment:
+		if id := m.invoice_payment; id != nil {
+			return []ent.Value{*id}
+		}
+	case transaction.EdgeJournalEntries:
+		ids := make([]ent.Value, 0, len(m.journal_entries))
+		for id := range m.journal_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.removedinstallments != nil {
+		edges = append(edges, transaction.EdgeInstallments)
+	}
+	if m.removedreversal != nil {
+		edges = append(edges, transaction.EdgeReversal)
+	}
+	if m.removedcredit_settlements != nil {
+		edges = append(edges, transaction.EdgeCreditSettlements)
+	}
+	if m.removeddebit_settlements != nil {
+		edges = append(edges, transaction.EdgeDebitSettlements)
+	}
+	if m.removedjournal_entries != nil {
+		edges = append(edges, transaction.EdgeJournalEntries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case transaction.EdgeInstallments:
+		ids := make([]ent.Value, 0, len(m.removedinstallments))
+		for id := range m.removedinstallments {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeReversal:
+		ids := make([]ent.Value, 0, len(m.removedreversal))
+		for id := range m.removedreversal {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeCreditSettlements:
+		ids := make([]ent.Value, 0, len(m.removedcredit_settlements))
+		for id := range m.removedcredit_settlements {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeDebitSettlements:
+		ids := make([]ent.Value, 0, len(m.removeddebit_settlements))
+		for id := range m.removeddebit_settlements {
+			ids = append(ids, id)
+		}
+		return ids
+	case transaction.EdgeJournalEntries:
+		ids := make([]ent.Value, 0, len(m.removedjournal_entries))
+		for id := range m.removedjournal_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 13)
+	if m.clearedaccount {
+		edges = append(edges, transaction.EdgeAccount)
+	}
+	if m.clearedoperation_type {
+		edges = append(edges, transaction.EdgeOperationType)
+	}
+	if m.clearedparent {
+		edges = append(edges, transaction.EdgeParent)
+	}
+	if m.clearedinstallments {
+		edges = append(edges, transaction.EdgeInstallments)
+	}
+	if m.clearedreversal_of {
+		edges = append(edges, transaction.EdgeReversalOf)
+	}
+	if m.clearedreversal {
+		edges = append(edges, transaction.EdgeReversal)
+	}
+	if m.clearedtransfer {
+		edges = append(edges, transaction.EdgeTransfer)
+	}
+	if m.clearedhold {
+		edges = append(edges, transaction.EdgeHold)
+	}
+	if m.clearedaccrual {
+		edges = append(edges, transaction.EdgeAccrual)
+	}
+	if m.clearedcredit_settlements {
+		edges = append(edges, transaction.EdgeCreditSettlements)
+	}
+	if m.cleareddebit_settlements {
+		edges = append(edges, transaction.EdgeDebitSettlements)
+	}
+	if m.clearedinvoice_payment {
+		edges = append(edges, transaction.EdgeInvoicePayment)
+	}
+	if m.clearedjournal_entries {
+		edges = append(edges, transaction.EdgeJournalEntries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transaction.EdgeAccount:
+		return m.clearedaccount
+	case transaction.EdgeOperationType:
+		return m.clearedoperation_type
+	case transaction.EdgeParent:
+		return m.clearedparent
+	case transaction.EdgeInstallments:
+		return m.clearedinstallments
+	case transaction.EdgeReversalOf:
+		return m.clearedreversal_of
+	case transaction.EdgeReversal:
+		return m.clearedreversal
+	case transaction.EdgeTransfer:
+		return m.clearedtransfer
+	case transaction.EdgeHold:
+		return m.clearedhold
+	case transaction.EdgeAccrual:
+		return m.clearedaccrual
+	case transaction.EdgeCreditSettlements:
+		return m.clearedcredit_settlements
+	case transaction.EdgeDebitSettlements:
+		return m.cleareddebit_settlements
+	case transaction.EdgeInvoicePayment:
+		return m.clearedinvoice_payment
+	case transaction.EdgeJournalEntries:
+		return m.clearedjournal_entries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransactionMutation) ClearEdge(name string) error {
+	switch name {
+	case transaction.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case transaction.EdgeOperationType:
+		m.ClearOperationType()
+		return nil
+	case transaction.EdgeParent:
+		m.ClearParent()
+		return nil
+	case transaction.EdgeReversalOf:
+		m.ClearReversalOf()
+		return nil
+	case transaction.EdgeTransfer:
+		m.ClearTransfer()
+		return nil
+	case transaction.EdgeHold:
+		m.ClearHold()
+		return nil
+	case transaction.EdgeAccrual:
+		m.ClearAccrual()
+		return nil
+	case transaction.EdgeInvoicePayment:
+		m.ClearInvoicePayment()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransactionMutation) ResetEdge(name string) error {
+	switch name {
+	case transaction.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case transaction.EdgeOperationType:
+		m.ResetOperationType()
+		return nil
+	case transaction.EdgeParent:
+		m.ResetParent()
+		return nil
+	case transaction.EdgeInstallments:
+		m.ResetInstallments()
+		return nil
+	case transaction.EdgeReversalOf:
+		m.ResetReversalOf()
+		return nil
+	case transaction.EdgeReversal:
+		m.ResetReversal()
+		return nil
+	case transaction.EdgeTransfer:
+		m.ResetTransfer()
+		return nil
+	case transaction.EdgeHold:
+		m.ResetHold()
+		return nil
+	case transaction.EdgeAccrual:
+		m.ResetAccrual()
+		return nil
+	case transaction.EdgeCreditSettlements:
+		m.ResetCreditSettlements()
+		return nil
+	case transaction.EdgeDebitSettlements:
+		m.ResetDebitSettlements()
+		return nil
+	case transaction.EdgeInvoicePayment:
+		m.ResetInvoicePayment()
+		return nil
+	case transaction.EdgeJournalEntries:
+		m.ResetJournalEntries()
+		return nil
+	}
+	return fmt.Errorf("unknown Transaction edge %s", name)
+}
+
+// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
+type TransferMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	create_time                *time.Time
+	update_time                *time.Time
+	amount                     *money.Amount
+	addamount                  *money.Amount
+	timestamp                  *time.Time
+	clearedFields              map[string]struct{}
+	source_account             *int
+	clearedsource_account      bool
+	destination_account        *int
+	cleareddestination_account bool
+	transactions               map[int]struct{}
+	removedtransactions        map[int]struct{}
+	clearedtransactions        bool
+	done                       bool
+	oldValue                   func(context.Context) (*Transfer, error)
+	predicates                 []predicate.Transfer
+}
+
+var _ ent.Mutation = (*TransferMutation)(nil)
+
+// transferOption allows management of the mutation configuration using functional options.
+type transferOption func(*TransferMutation)
+
+// newTransferMutation creates new mutation for the Transfer entity.
+func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
+	m := &TransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTransferID sets the ID field of the mutation.
+func withTransferID(id int) transferOption {
+	return func(m *TransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Transfer
+		)
+		m.oldValue = func(ctx context.Context) (*Transfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Transfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTransfer sets the old Transfer of the mutation.
+func withTransfer(node *Transfer) transferOption {
+	return func(m *TransferMutation) {
+		m.oldValue = func(context.Context) (*Transfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Transfer entities.
+func (m *TransferMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TransferMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransferMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TransferMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TransferMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TransferMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TransferMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TransferMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TransferMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetSourceAccountID sets the "source_account_id" field.
+func (m *TransferMutation) SetSourceAccountID(i int) {
+	m.source_account = &i
+}
+
+// SourceAccountID returns the value of the "source_account_id" field in the mutation.
+func (m *TransferMutation) SourceAccountID() (r int, exists bool) {
+	v := m.source_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceAccountID returns the old "source_account_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldSourceAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceAccountID: %w", err)
+	}
+	return oldValue.SourceAccountID, nil
+}
+
+// ResetSourceAccountID resets all changes to the "source_account_id" field.
+func (m *TransferMutation) ResetSourceAccountID() {
+	m.source_account = nil
+}
+
+// SetDestinationAccountID sets the "destination_account_id" field.
+func (m *TransferMutation) SetDestinationAccountID(i int) {
+	m.destination_account = &i
+}
+
+// DestinationAccountID returns the value of the "destination_account_id" field in the mutation.
+func (m *TransferMutation) DestinationAccountID() (r int, exists bool) {
+	v := m.destination_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestinationAccountID returns the old "destination_account_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldDestinationAccountID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestinationAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestinationAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestinationAccountID: %w", err)
+	}
+	return oldValue.DestinationAccountID, nil
+}
+
+// ResetDestinationAccountID resets all changes to the "destination_account_id" field.
+func (m *TransferMutation) ResetDestinationAccountID() {
+	m.destination_account = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *TransferMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *TransferMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *TransferMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *TransferMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *TransferMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *TransferMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *TransferMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *TransferMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// ClearSourceAccount clears the "source_account" edge to the Account entity.
+func (m *TransferMutation) ClearSourceAccount() {
+	m.clearedsource_account = true
+	m.clearedFields[transfer.FieldSourceAccountID] = struct{}{}
+}
+
+// SourceAccountCleared reports if the "source_account" edge to the Account entity was cleared.
+func (m *TransferMutation) SourceAccountCleared() bool {
+	return m.clearedsource_account
+}
+
+// SourceAccountIDs returns the "source_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceAccountID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) SourceAccountIDs() (ids []int) {
+	if id := m.source_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSourceAccount resets all changes to the "source_account" edge.
+func (m *TransferMutation) ResetSourceAccount() {
+	m.source_account = nil
+	m.clearedsource_account = false
+}
+
+// ClearDestinationAccount clears the "destination_account" edge to the Account entity.
+func (m *TransferMutation) ClearDestinationAccount() {
+	m.cleareddestination_account = true
+	m.clearedFields[transfer.FieldDestinationAccountID] = struct{}{}
+}
+
+// DestinationAccountCleared reports if the "destination_account" edge to the Account entity was cleared.
+func (m *TransferMutation) DestinationAccountCleared() bool {
+	return m.cleareddestination_account
+}
+
+// DestinationAccountIDs returns the "destination_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DestinationAccountID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) DestinationAccountIDs() (ids []int) {
+	if id := m.destination_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDestinationAccount resets all changes to the "destination_account" edge.
+func (m *TransferMutation) ResetDestinationAccount() {
+	m.destination_account = nil
+	m.cleareddestination_account = false
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
+func (m *TransferMutation) AddTransactionIDs(ids ...int) {
+	if m.transactions == nil {
+		m.transactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.transactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransactions clears the "transactions" edge to the Transaction entity.
+func (m *TransferMutation) ClearTransactions() {
+	m.clearedtransactions = true
+}
+
+// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
+func (m *TransferMutation) TransactionsCleared() bool {
+	return m.clearedtransactions
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
+func (m *TransferMutation) RemoveTransactionIDs(ids ...int) {
+	if m.removedtransactions == nil {
+		m.removedtransactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.transactions, ids[i])
+		m.removedtransactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
+func (m *TransferMutation) RemovedTransactionsIDs() (ids []int) {
+	for id := range m.removedtransactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransactionsIDs returns the "transactions" edge IDs in the mutation.
+func (m *TransferMutation) TransactionsIDs() (ids []int) {
+	for id := range m.transactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransactions resets all changes to the "transactions" edge.
+func (m *TransferMutation) ResetTransactions() {
+	m.transactions = nil
+	m.clearedtransactions = false
+	m.removedtransactions = nil
+}
+
+// Where appends a list predicates to the TransferMutation builder.
+func (m *TransferMutation) Where(ps ...predicate.Transfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Transfer).
+func (m *TransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransferMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.create_time != nil {
+		fields = append(fields, transfer.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, transfer.FieldUpdateTime)
+	}
+	if m.source_account != nil {
+		fields = append(fields, transfer.FieldSourceAccountID)
+	}
+	if m.destination_account != nil {
+		fields = append(fields, transfer.FieldDestinationAccountID)
+	}
+	if m.amount != nil {
+		fields = append(fields, transfer.FieldAmount)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, transfer.FieldTimestamp)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transfer.FieldCreateTime:
+		return m.CreateTime()
+	case transfer.FieldUpdateTime:
+		return m.UpdateTime()
+	case transfer.FieldSourceAccountID:
+		return m.SourceAccountID()
+	case transfer.FieldDestinationAccountID:
+		return m.DestinationAccountID()
+	case transfer.FieldAmount:
+		return m.Amount()
+	case transfer.FieldTimestamp:
+		return m.Timestamp()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transfer.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case transfer.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case transfer.FieldSourceAccountID:
+		return m.OldSourceAccountID(ctx)
+	case transfer.FieldDestinationAccountID:
+		return m.OldDestinationAccountID(ctx)
+	case transfer.FieldAmount:
+		return m.OldAmount(ctx)
+	case transfer.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transfer.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case transfer.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case transfer.FieldSourceAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceAccountID(v)
+		return nil
+	case transfer.FieldDestinationAccountID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestinationAccountID(v)
+		return nil
+	case transfer.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case transfer.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransferMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, transfer.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case transfer.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AccountMutation) AddField(name string, value ent.Value) error {
+func (m *TransferMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case transfer.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Account numeric field %s", name)
+	return fmt.Errorf("unknown Transfer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AccountMutation) ClearedFields() []string {
+func (m *TransferMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AccountMutation) FieldCleared(name string) bool {
+func (m *TransferMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AccountMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Account nullable field %s", name)
+func (m *TransferMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Transfer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AccountMutation) ResetField(name string) error {
+func (m *TransferMutation) ResetField(name string) error {
 	switch name {
-	case account.FieldCreateTime:
+	case transfer.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case account.FieldUpdateTime:
+	case transfer.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case account.FieldName:
-		m.ResetName()
+	case transfer.FieldSourceAccountID:
+		m.ResetSourceAccountID()
 		return nil
-	case account.FieldDocumentNumber:
-		m.ResetDocumentNumber()
+	case transfer.FieldDestinationAccountID:
+		m.ResetDestinationAccountID()
+		return nil
+	case transfer.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case transfer.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown Account field %s", name)
+	return fmt.Errorf("unknown Transfer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.source_account != nil {
+		edges = append(edges, transfer.EdgeSourceAccount)
+	}
+	if m.destination_account != nil {
+		edges = append(edges, transfer.EdgeDestinationAccount)
+	}
 	if m.transactions != nil {
-		edges = append(edges, account.EdgeTransactions)
+		edges = append(edges, transfer.EdgeTransactions)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AccountMutation) AddedIDs(name string) []ent.Value {
+func (m *TransferMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case account.EdgeTransactions:
+	case transfer.EdgeSourceAccount:
+		if id := m.source_account; id != nil {
+			return []ent.Value{*id}
+		}
+	case transfer.EdgeDestinationAccount:
+		if id := m.destination_account; id != nil {
+			return []ent.Value{*id}
+		}
+	case transfer.EdgeTransactions:
 		ids := make([]ent.Value, 0, len(m.transactions))
 		for id := range m.transactions {
 			ids = append(ids, id)
@@ -558,19 +13714,19 @@ func (m *AccountMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.removedtransactions != nil {
-		edges = append(edges, account.EdgeTransactions)
+		edges = append(edges, transfer.EdgeTransactions)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
+func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case account.EdgeTransactions:
+	case transfer.EdgeTransactions:
 		ids := make([]ent.Value, 0, len(m.removedtransactions))
 		for id := range m.removedtransactions {
 			ids = append(ids, id)
@@ -581,19 +13737,29 @@ func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedsource_account {
+		edges = append(edges, transfer.EdgeSourceAccount)
+	}
+	if m.cleareddestination_account {
+		edges = append(edges, transfer.EdgeDestinationAccount)
+	}
 	if m.clearedtransactions {
-		edges = append(edges, account.EdgeTransactions)
+		edges = append(edges, transfer.EdgeTransactions)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AccountMutation) EdgeCleared(name string) bool {
+func (m *TransferMutation) EdgeCleared(name string) bool {
 	switch name {
-	case account.EdgeTransactions:
+	case transfer.EdgeSourceAccount:
+		return m.clearedsource_account
+	case transfer.EdgeDestinationAccount:
+		return m.cleareddestination_account
+	case transfer.EdgeTransactions:
 		return m.clearedtransactions
 	}
 	return false
@@ -601,53 +13767,72 @@ func (m *AccountMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AccountMutation) ClearEdge(name string) error {
+func (m *TransferMutation) ClearEdge(name string) error {
 	switch name {
+	case transfer.EdgeSourceAccount:
+		m.ClearSourceAccount()
+		return nil
+	case transfer.EdgeDestinationAccount:
+		m.ClearDestinationAccount()
+		return nil
 	}
-	return fmt.Errorf("unknown Account unique edge %s", name)
+	return fmt.Errorf("unknown Transfer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AccountMutation) ResetEdge(name string) error {
+func (m *TransferMutation) ResetEdge(name string) error {
 	switch name {
-	case account.EdgeTransactions:
+	case transfer.EdgeSourceAccount:
+		m.ResetSourceAccount()
+		return nil
+	case transfer.EdgeDestinationAccount:
+		m.ResetDestinationAccount()
+		return nil
+	case transfer.EdgeTransactions:
 		m.ResetTransactions()
 		return nil
 	}
-	return fmt.Errorf("unknown Account edge %s", name)
+	return fmt.Errorf("unknown Transfer edge %s", name)
 }
 
-// OperationTypeMutation represents an operation that mutates the OperationType nodes in the graph.
-type OperationTypeMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
 	op                  Op
 	typ                 string
 	id                  *int
 	create_time         *time.Time
 	update_time         *time.Time
-	description         *string
-	is_debit            *bool
+	status              *webhookdelivery.Status
+	attempts            *int
+	addattempts         *int
+	next_attempt_at     *time.Time
+	response_status     *int
+	addresponse_status  *int
+	last_error          *string
+	delivered_at        *time.Time
 	clearedFields       map[string]struct{}
-	transactions        map[int]struct{}
-	removedtransactions map[int]struct{}
-	clearedtransactions bool
+	subscription        *int
+	clearedsubscription bool
+	event               *int
+	clearedevent        bool
 	done                bool
-	oldValue            func(context.Context) (*OperationType, error)
-	predicates          []predicate.OperationType
+	oldValue            func(context.Context) (*WebhookDelivery, error)
+	predicates          []predicate.WebhookDelivery
 }
 
-var _ ent.Mutation = (*OperationTypeMutation)(nil)
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
 
-// operationtypeOption allows management of the mutation configuration using functional options.
-type operationtypeOption func(*OperationTypeMutation)
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
 
-// newOperationTypeMutation creates new mutation for the OperationType entity.
-func newOperationTypeMutation(c config, op Op, opts ...operationtypeOption) *OperationTypeMutation {
-	m := &OperationTypeMutation{
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOperationType,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -656,20 +13841,20 @@ func newOperationTypeMutation(c config, op Op, opts ...operationtypeOption) *Ope
 	return m
 }
 
-// withOperationTypeID sets the ID field of the mutation.
-func withOperationTypeID(id int) operationtypeOption {
-	return func(m *OperationTypeMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id int) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OperationType
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*OperationType, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OperationType.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -678,10 +13863,10 @@ func withOperationTypeID(id int) operationtypeOption {
 	}
 }
 
-// withOperationType sets the old OperationType of the mutation.
-func withOperationType(node *OperationType) operationtypeOption {
-	return func(m *OperationTypeMutation) {
-		m.oldValue = func(context.Context) (*OperationType, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -690,7 +13875,7 @@ func withOperationType(node *OperationType) operationtypeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OperationTypeMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -698,256 +13883,552 @@ func (m OperationTypeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OperationTypeMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookDeliveryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookDeliveryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookDeliveryMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookDeliveryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookDeliveryMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *WebhookDeliveryMutation) SetSubscriptionID(i int) {
+	m.subscription = &i
+}
+
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *WebhookDeliveryMutation) SubscriptionID() (r int, exists bool) {
+	v := m.subscription
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubscriptionID returns the old "subscription_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldSubscriptionID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
+	}
+	return oldValue.SubscriptionID, nil
+}
+
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *WebhookDeliveryMutation) ResetSubscriptionID() {
+	m.subscription = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(i int) {
+	m.event = &i
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r int, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OperationType entities.
-func (m *OperationTypeMutation) SetID(id int) {
-	m.id = &id
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *OperationTypeMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *OperationTypeMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OperationType.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *OperationTypeMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *OperationTypeMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the OperationType entity.
-// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OperationTypeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *OperationTypeMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *OperationTypeMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *OperationTypeMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the OperationType entity.
-// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OperationTypeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.ResponseStatus, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *OperationTypeMutation) ResetUpdateTime() {
-	m.update_time = nil
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *OperationTypeMutation) SetDescription(s string) {
-	m.description = &s
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *OperationTypeMutation) Description() (r string, exists bool) {
-	v := m.description
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the OperationType entity.
-// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OperationTypeMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.LastError, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *OperationTypeMutation) ResetDescription() {
-	m.description = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// SetIsDebit sets the "is_debit" field.
-func (m *OperationTypeMutation) SetIsDebit(b bool) {
-	m.is_debit = &b
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
 }
 
-// IsDebit returns the value of the "is_debit" field in the mutation.
-func (m *OperationTypeMutation) IsDebit() (r bool, exists bool) {
-	v := m.is_debit
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsDebit returns the old "is_debit" field's value of the OperationType entity.
-// If the OperationType object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OperationTypeMutation) OldIsDebit(ctx context.Context) (v bool, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDebit is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDebit requires an ID field in the mutation")
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDebit: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.IsDebit, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// ResetIsDebit resets all changes to the "is_debit" field.
-func (m *OperationTypeMutation) ResetIsDebit() {
-	m.is_debit = nil
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// AddTransactionIDs adds the "transactions" edge to the Transaction entity by ids.
-func (m *OperationTypeMutation) AddTransactionIDs(ids ...int) {
-	if m.transactions == nil {
-		m.transactions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.transactions[ids[i]] = struct{}{}
-	}
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// ClearTransactions clears the "transactions" edge to the Transaction entity.
-func (m *OperationTypeMutation) ClearTransactions() {
-	m.clearedtransactions = true
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// TransactionsCleared reports if the "transactions" edge to the Transaction entity was cleared.
-func (m *OperationTypeMutation) TransactionsCleared() bool {
-	return m.clearedtransactions
+// ClearSubscription clears the "subscription" edge to the WebhookSubscription entity.
+func (m *WebhookDeliveryMutation) ClearSubscription() {
+	m.clearedsubscription = true
+	m.clearedFields[webhookdelivery.FieldSubscriptionID] = struct{}{}
 }
 
-// RemoveTransactionIDs removes the "transactions" edge to the Transaction entity by IDs.
-func (m *OperationTypeMutation) RemoveTransactionIDs(ids ...int) {
-	if m.removedtransactions == nil {
-		m.removedtransactions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.transactions, ids[i])
-		m.removedtransactions[ids[i]] = struct{}{}
-	}
+// SubscriptionCleared reports if the "subscription" edge to the WebhookSubscription entity was cleared.
+func (m *WebhookDeliveryMutation) SubscriptionCleared() bool {
+	return m.clearedsubscription
 }
 
-// RemovedTransactions returns the removed IDs of the "transactions" edge to the Transaction entity.
-func (m *OperationTypeMutation) RemovedTransactionsIDs() (ids []int) {
-	for id := range m.removedtransactions {
-		ids = append(ids, id)
+// SubscriptionIDs returns the "subscription" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubscriptionID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) SubscriptionIDs() (ids []int) {
+	if id := m.subscription; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// TransactionsIDs returns the "transactions" edge IDs in the mutation.
-func (m *OperationTypeMutation) TransactionsIDs() (ids []int) {
-	for id := range m.transactions {
-		ids = append(ids, id)
+// ResetSubscription resets all changes to the "subscription" edge.
+func (m *WebhookDeliveryMutation) ResetSubscription() {
+	m.subscription = nil
+	m.clearedsubscription = false
+}
+
+// ClearEvent clears the "event" edge to the OutboxEvent entity.
+func (m *WebhookDeliveryMutation) ClearEvent() {
+	m.clearedevent = true
+	m.clearedFields[webhookdelivery.FieldEventID] = struct{}{}
+}
+
+// EventCleared reports if the "event" edge to the OutboxEvent entity was cleared.
+func (m *WebhookDeliveryMutation) EventCleared() bool {
+	return m.clearedevent
+}
+
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) EventIDs() (ids []int) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTransactions resets all changes to the "transactions" edge.
-func (m *OperationTypeMutation) ResetTransactions() {
-	m.transactions = nil
-	m.clearedtransactions = false
-	m.removedtransactions = nil
+// ResetEvent resets all changes to the "event" edge.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
 }
 
-// Where appends a list predicates to the OperationTypeMutation builder.
-func (m *OperationTypeMutation) Where(ps ...predicate.OperationType) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OperationTypeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OperationTypeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OperationType, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -955,36 +14436,54 @@ func (m *OperationTypeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OperationTypeMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OperationTypeMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OperationType).
-func (m *OperationTypeMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OperationTypeMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
-		fields = append(fields, operationtype.FieldCreateTime)
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, operationtype.FieldUpdateTime)
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
-	if m.description != nil {
-		fields = append(fields, operationtype.FieldDescription)
+	if m.subscription != nil {
+		fields = append(fields, webhookdelivery.FieldSubscriptionID)
 	}
-	if m.is_debit != nil {
-		fields = append(fields, operationtype.FieldIsDebit)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -992,16 +14491,28 @@ func (m *OperationTypeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OperationTypeMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case operationtype.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.CreateTime()
-	case operationtype.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.UpdateTime()
-	case operationtype.FieldDescription:
-		return m.Description()
-	case operationtype.FieldIsDebit:
-		return m.IsDebit()
+	case webhookdelivery.FieldSubscriptionID:
+		return m.SubscriptionID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -1009,236 +14520,362 @@ func (m *OperationTypeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OperationTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case operationtype.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case operationtype.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case operationtype.FieldDescription:
-		return m.OldDescription(ctx)
-	case operationtype.FieldIsDebit:
-		return m.OldIsDebit(ctx)
-	}
-	return nil, fmt.Errorf("unknown OperationType field %s", name)
+	case webhookdelivery.FieldSubscriptionID:
+		return m.OldSubscriptionID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OperationTypeMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case operationtype.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case operationtype.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case operationtype.FieldDescription:
+	case webhookdelivery.FieldSubscriptionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionID(v)
+		return nil
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetLastError(v)
 		return nil
-	case operationtype.FieldIsDebit:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDebit(v)
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OperationType field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OperationTypeMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OperationTypeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OperationTypeMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
 	}
-	return fmt.Errorf("unknown OperationType numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OperationTypeMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OperationTypeMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OperationTypeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown OperationType nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OperationTypeMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case operationtype.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case operationtype.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case operationtype.FieldDescription:
-		m.ResetDescription()
+	case webhookdelivery.FieldSubscriptionID:
+		m.ResetSubscriptionID()
 		return nil
-	case operationtype.FieldIsDebit:
-		m.ResetIsDebit()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown OperationType field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OperationTypeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.transactions != nil {
-		edges = append(edges, operationtype.EdgeTransactions)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.subscription != nil {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
+	}
+	if m.event != nil {
+		edges = append(edges, webhookdelivery.EdgeEvent)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OperationTypeMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case operationtype.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.transactions))
-		for id := range m.transactions {
-			ids = append(ids, id)
+	case webhookdelivery.EdgeSubscription:
+		if id := m.subscription; id != nil {
+			return []ent.Value{*id}
+		}
+	case webhookdelivery.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OperationTypeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedtransactions != nil {
-		edges = append(edges, operationtype.EdgeTransactions)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OperationTypeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case operationtype.EdgeTransactions:
-		ids := make([]ent.Value, 0, len(m.removedtransactions))
-		for id := range m.removedtransactions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OperationTypeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtransactions {
-		edges = append(edges, operationtype.EdgeTransactions)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedsubscription {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
+	}
+	if m.clearedevent {
+		edges = append(edges, webhookdelivery.EdgeEvent)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OperationTypeMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case operationtype.EdgeTransactions:
-		return m.clearedtransactions
+	case webhookdelivery.EdgeSubscription:
+		return m.clearedsubscription
+	case webhookdelivery.EdgeEvent:
+		return m.clearedevent
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OperationTypeMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeSubscription:
+		m.ClearSubscription()
+		return nil
+	case webhookdelivery.EdgeEvent:
+		m.ClearEvent()
+		return nil
 	}
-	return fmt.Errorf("unknown OperationType unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OperationTypeMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case operationtype.EdgeTransactions:
-		m.ResetTransactions()
+	case webhookdelivery.EdgeSubscription:
+		m.ResetSubscription()
+		return nil
+	case webhookdelivery.EdgeEvent:
+		m.ResetEvent()
 		return nil
 	}
-	return fmt.Errorf("unknown OperationType edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
 
-// TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
-type TransactionMutation struct {
+// WebhookSubscriptionMutation represents an operation that mutates the WebhookSubscription nodes in the graph.
+type WebhookSubscriptionMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	create_time           *time.Time
-	update_time           *time.Time
-	amount                *float64
-	addamount             *float64
-	balance               *float64
-	addbalance            *float64
-	timestamp             *time.Time
-	clearedFields         map[string]struct{}
-	account               *int
-	clearedaccount        bool
-	operation_type        *int
-	clearedoperation_type bool
-	done                  bool
-	oldValue              func(context.Context) (*Transaction, error)
-	predicates            []predicate.Transaction
-}
-
-var _ ent.Mutation = (*TransactionMutation)(nil)
-
-// transactionOption allows management of the mutation configuration using functional options.
-type transactionOption func(*TransactionMutation)
-
-// newTransactionMutation creates new mutation for the Transaction entity.
-func newTransactionMutation(c config, op Op, opts ...transactionOption) *TransactionMutation {
-	m := &TransactionMutation{
+	op                Op
+	typ               string
+	id                *int
+	create_time       *time.Time
+	update_time       *time.Time
+	url               *string
+	event_types       *[]string
+	appendevent_types []string
+	secret            *string
+	clearedFields     map[string]struct{}
+	deliveries        map[int]struct{}
+	removeddeliveries map[int]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*WebhookSubscription, error)
+	predicates        []predicate.WebhookSubscription
+}
+
+var _ ent.Mutation = (*WebhookSubscriptionMutation)(nil)
+
+// webhooksubscriptionOption allows management of the mutation configuration using functional options.
+type webhooksubscriptionOption func(*WebhookSubscriptionMutation)
+
+// newWebhookSubscriptionMutation creates new mutation for the WebhookSubscription entity.
+func newWebhookSubscriptionMutation(c config, op Op, opts ...webhooksubscriptionOption) *WebhookSubscriptionMutation {
+	m := &WebhookSubscriptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTransaction,
+		typ:           TypeWebhookSubscription,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1247,20 +14884,20 @@ func newTransactionMutation(c config, op Op, opts ...transactionOption) *Transac
 	return m
 }
 
-// withTransactionID sets the ID field of the mutation.
-func withTransactionID(id int) transactionOption {
-	return func(m *TransactionMutation) {
+// withWebhookSubscriptionID sets the ID field of the mutation.
+func withWebhookSubscriptionID(id int) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Transaction
+			value *WebhookSubscription
 		)
-		m.oldValue = func(ctx context.Context) (*Transaction, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookSubscription, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Transaction.Get(ctx, id)
+					value, err = m.Client().WebhookSubscription.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1269,10 +14906,10 @@ func withTransactionID(id int) transactionOption {
 	}
 }
 
-// withTransaction sets the old Transaction of the mutation.
-func withTransaction(node *Transaction) transactionOption {
-	return func(m *TransactionMutation) {
-		m.oldValue = func(context.Context) (*Transaction, error) {
+// withWebhookSubscription sets the old WebhookSubscription of the mutation.
+func withWebhookSubscription(node *WebhookSubscription) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*WebhookSubscription, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1281,7 +14918,7 @@ func withTransaction(node *Transaction) transactionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TransactionMutation) Client() *Client {
+func (m WebhookSubscriptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1289,7 +14926,7 @@ func (m TransactionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TransactionMutation) Tx() (*Tx, error) {
+func (m WebhookSubscriptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1299,14 +14936,14 @@ func (m TransactionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Transaction entities.
-func (m *TransactionMutation) SetID(id int) {
+// operation is only accepted on creation of WebhookSubscription entities.
+func (m *WebhookSubscriptionMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TransactionMutation) ID() (id int, exists bool) {
+func (m *WebhookSubscriptionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1317,7 +14954,7 @@ func (m *TransactionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WebhookSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1326,19 +14963,19 @@ func (m *TransactionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Transaction.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookSubscription.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TransactionMutation) SetCreateTime(t time.Time) {
+func (m *WebhookSubscriptionMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TransactionMutation) CreateTime() (r time.Time, exists bool) {
+func (m *WebhookSubscriptionMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1346,10 +14983,10 @@ func (m *TransactionMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1364,17 +15001,17 @@ func (m *TransactionMutation) OldCreateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TransactionMutation) ResetCreateTime() {
+func (m *WebhookSubscriptionMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TransactionMutation) SetUpdateTime(t time.Time) {
+func (m *WebhookSubscriptionMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TransactionMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *WebhookSubscriptionMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1382,10 +15019,10 @@ func (m *TransactionMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1400,293 +15037,196 @@ func (m *TransactionMutation) OldUpdateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TransactionMutation) ResetUpdateTime() {
+func (m *WebhookSubscriptionMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetAccountID sets the "account_id" field.
-func (m *TransactionMutation) SetAccountID(i int) {
-	m.account = &i
-}
-
-// AccountID returns the value of the "account_id" field in the mutation.
-func (m *TransactionMutation) AccountID() (r int, exists bool) {
-	v := m.account
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAccountID returns the old "account_id" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldAccountID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
-	}
-	return oldValue.AccountID, nil
-}
-
-// ResetAccountID resets all changes to the "account_id" field.
-func (m *TransactionMutation) ResetAccountID() {
-	m.account = nil
-}
-
-// SetAmount sets the "amount" field.
-func (m *TransactionMutation) SetAmount(f float64) {
-	m.amount = &f
-	m.addamount = nil
+// SetURL sets the "url" field.
+func (m *WebhookSubscriptionMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *TransactionMutation) Amount() (r float64, exists bool) {
-	v := m.amount
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookSubscriptionMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmount returns the old "amount" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldAmount(ctx context.Context) (v float64, err error) {
+func (m *WebhookSubscriptionMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
-	}
-	return oldValue.Amount, nil
-}
-
-// AddAmount adds f to the "amount" field.
-func (m *TransactionMutation) AddAmount(f float64) {
-	if m.addamount != nil {
-		*m.addamount += f
-	} else {
-		m.addamount = &f
-	}
-}
-
-// AddedAmount returns the value that was added to the "amount" field in this mutation.
-func (m *TransactionMutation) AddedAmount() (r float64, exists bool) {
-	v := m.addamount
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return *v, true
+	return oldValue.URL, nil
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *TransactionMutation) ResetAmount() {
-	m.amount = nil
-	m.addamount = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookSubscriptionMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetBalance sets the "balance" field.
-func (m *TransactionMutation) SetBalance(f float64) {
-	m.balance = &f
-	m.addbalance = nil
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookSubscriptionMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
 }
 
-// Balance returns the value of the "balance" field in the mutation.
-func (m *TransactionMutation) Balance() (r float64, exists bool) {
-	v := m.balance
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookSubscriptionMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBalance returns the old "balance" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldEventTypes returns the old "event_types" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldBalance(ctx context.Context) (v float64, err error) {
+func (m *WebhookSubscriptionMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBalance requires an ID field in the mutation")
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
-	}
-	return oldValue.Balance, nil
-}
-
-// AddBalance adds f to the "balance" field.
-func (m *TransactionMutation) AddBalance(f float64) {
-	if m.addbalance != nil {
-		*m.addbalance += f
-	} else {
-		m.addbalance = &f
-	}
-}
-
-// AddedBalance returns the value that was added to the "balance" field in this mutation.
-func (m *TransactionMutation) AddedBalance() (r float64, exists bool) {
-	v := m.addbalance
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetBalance resets all changes to the "balance" field.
-func (m *TransactionMutation) ResetBalance() {
-	m.balance = nil
-	m.addbalance = nil
-}
-
-// SetOperationTypeID sets the "operation_type_id" field.
-func (m *TransactionMutation) SetOperationTypeID(i int) {
-	m.operation_type = &i
-}
-
-// OperationTypeID returns the value of the "operation_type_id" field in the mutation.
-func (m *TransactionMutation) OperationTypeID() (r int, exists bool) {
-	v := m.operation_type
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
-	return *v, true
+	return oldValue.EventTypes, nil
 }
 
-// OldOperationTypeID returns the old "operation_type_id" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldOperationTypeID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperationTypeID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperationTypeID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperationTypeID: %w", err)
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookSubscriptionMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
+}
+
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookSubscriptionMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
 	}
-	return oldValue.OperationTypeID, nil
+	return m.appendevent_types, true
 }
 
-// ResetOperationTypeID resets all changes to the "operation_type_id" field.
-func (m *TransactionMutation) ResetOperationTypeID() {
-	m.operation_type = nil
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookSubscriptionMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *TransactionMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetSecret sets the "secret" field.
+func (m *WebhookSubscriptionMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *TransactionMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookSubscriptionMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the Transaction entity.
-// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.Timestamp, nil
-}
-
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *TransactionMutation) ResetTimestamp() {
-	m.timestamp = nil
+	return oldValue.Secret, nil
 }
 
-// ClearAccount clears the "account" edge to the Account entity.
-func (m *TransactionMutation) ClearAccount() {
-	m.clearedaccount = true
-	m.clearedFields[transaction.FieldAccountID] = struct{}{}
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookSubscriptionMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// AccountCleared reports if the "account" edge to the Account entity was cleared.
-func (m *TransactionMutation) AccountCleared() bool {
-	return m.clearedaccount
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookSubscriptionMutation) AddDeliveryIDs(ids ...int) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
 }
 
-// AccountIDs returns the "account" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AccountID instead. It exists only for internal usage by the builders.
-func (m *TransactionMutation) AccountIDs() (ids []int) {
-	if id := m.account; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// ResetAccount resets all changes to the "account" edge.
-func (m *TransactionMutation) ResetAccount() {
-	m.account = nil
-	m.clearedaccount = false
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookSubscriptionMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// ClearOperationType clears the "operation_type" edge to the OperationType entity.
-func (m *TransactionMutation) ClearOperationType() {
-	m.clearedoperation_type = true
-	m.clearedFields[transaction.FieldOperationTypeID] = struct{}{}
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookSubscriptionMutation) RemoveDeliveryIDs(ids ...int) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
 }
 
-// OperationTypeCleared reports if the "operation_type" edge to the OperationType entity was cleared.
-func (m *TransactionMutation) OperationTypeCleared() bool {
-	return m.clearedoperation_type
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) RemovedDeliveriesIDs() (ids []int) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// OperationTypeIDs returns the "operation_type" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OperationTypeID instead. It exists only for internal usage by the builders.
-func (m *TransactionMutation) OperationTypeIDs() (ids []int) {
-	if id := m.operation_type; id != nil {
-		ids = append(ids, *id)
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookSubscriptionMutation) DeliveriesIDs() (ids []int) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOperationType resets all changes to the "operation_type" edge.
-func (m *TransactionMutation) ResetOperationType() {
-	m.operation_type = nil
-	m.clearedoperation_type = false
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookSubscriptionMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the TransactionMutation builder.
-func (m *TransactionMutation) Where(ps ...predicate.Transaction) {
+// Where appends a list predicates to the WebhookSubscriptionMutation builder.
+func (m *WebhookSubscriptionMutation) Where(ps ...predicate.WebhookSubscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TransactionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookSubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Transaction, len(ps))
+func (m *WebhookSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookSubscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1694,45 +15234,39 @@ func (m *TransactionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TransactionMutation) Op() Op {
+func (m *WebhookSubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TransactionMutation) SetOp(op Op) {
+func (m *WebhookSubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Transaction).
-func (m *TransactionMutation) Type() string {
+// Type returns the node type of this mutation (WebhookSubscription).
+func (m *WebhookSubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TransactionMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *WebhookSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
-		fields = append(fields, transaction.FieldCreateTime)
+		fields = append(fields, webhooksubscription.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, transaction.FieldUpdateTime)
-	}
-	if m.account != nil {
-		fields = append(fields, transaction.FieldAccountID)
-	}
-	if m.amount != nil {
-		fields = append(fields, transaction.FieldAmount)
+		fields = append(fields, webhooksubscription.FieldUpdateTime)
 	}
-	if m.balance != nil {
-		fields = append(fields, transaction.FieldBalance)
+	if m.url != nil {
+		fields = append(fields, webhooksubscription.FieldURL)
 	}
-	if m.operation_type != nil {
-		fields = append(fields, transaction.FieldOperationTypeID)
+	if m.event_types != nil {
+		fields = append(fields, webhooksubscription.FieldEventTypes)
 	}
-	if m.timestamp != nil {
-		fields = append(fields, transaction.FieldTimestamp)
+	if m.secret != nil {
+		fields = append(fields, webhooksubscription.FieldSecret)
 	}
 	return fields
 }
@@ -1740,22 +15274,18 @@ func (m *TransactionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookSubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case transaction.FieldCreateTime:
+	case webhooksubscription.FieldCreateTime:
 		return m.CreateTime()
-	case transaction.FieldUpdateTime:
+	case webhooksubscription.FieldUpdateTime:
 		return m.UpdateTime()
-	case transaction.FieldAccountID:
-		return m.AccountID()
-	case transaction.FieldAmount:
-		return m.Amount()
-	case transaction.FieldBalance:
-		return m.Balance()
-	case transaction.FieldOperationTypeID:
-		return m.OperationTypeID()
-	case transaction.FieldTimestamp:
-		return m.Timestamp()
+	case webhooksubscription.FieldURL:
+		return m.URL()
+	case webhooksubscription.FieldEventTypes:
+		return m.EventTypes()
+	case webhooksubscription.FieldSecret:
+		return m.Secret()
 	}
 	return nil, false
 }
@@ -1763,269 +15293,210 @@ func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case transaction.FieldCreateTime:
+	case webhooksubscription.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case transaction.FieldUpdateTime:
+	case webhooksubscription.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case transaction.FieldAccountID:
-		return m.OldAccountID(ctx)
-	case transaction.FieldAmount:
-		return m.OldAmount(ctx)
-	case transaction.FieldBalance:
-		return m.OldBalance(ctx)
-	case transaction.FieldOperationTypeID:
-		return m.OldOperationTypeID(ctx)
-	case transaction.FieldTimestamp:
-		return m.OldTimestamp(ctx)
+	case webhooksubscription.FieldURL:
+		return m.OldURL(ctx)
+	case webhooksubscription.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhooksubscription.FieldSecret:
+		return m.OldSecret(ctx)
 	}
-	return nil, fmt.Errorf("unknown Transaction field %s", name)
+	return nil, fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransactionMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case transaction.FieldCreateTime:
+	case webhooksubscription.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case transaction.FieldUpdateTime:
+	case webhooksubscription.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case transaction.FieldAccountID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccountID(v)
-		return nil
-	case transaction.FieldAmount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAmount(v)
-		return nil
-	case transaction.FieldBalance:
-		v, ok := value.(float64)
+	case webhooksubscription.FieldURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBalance(v)
+		m.SetURL(v)
 		return nil
-	case transaction.FieldOperationTypeID:
-		v, ok := value.(int)
+	case webhooksubscription.FieldEventTypes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOperationTypeID(v)
+		m.SetEventTypes(v)
 		return nil
-	case transaction.FieldTimestamp:
-		v, ok := value.(time.Time)
+	case webhooksubscription.FieldSecret:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetSecret(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TransactionMutation) AddedFields() []string {
-	var fields []string
-	if m.addamount != nil {
-		fields = append(fields, transaction.FieldAmount)
-	}
-	if m.addbalance != nil {
-		fields = append(fields, transaction.FieldBalance)
-	}
-	return fields
+func (m *WebhookSubscriptionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case transaction.FieldAmount:
-		return m.AddedAmount()
-	case transaction.FieldBalance:
-		return m.AddedBalance()
-	}
+func (m *WebhookSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransactionMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case transaction.FieldAmount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAmount(v)
-		return nil
-	case transaction.FieldBalance:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBalance(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Transaction numeric field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TransactionMutation) ClearedFields() []string {
+func (m *WebhookSubscriptionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TransactionMutation) FieldCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TransactionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Transaction nullable field %s", name)
+func (m *WebhookSubscriptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TransactionMutation) ResetField(name string) error {
+func (m *WebhookSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case transaction.FieldCreateTime:
+	case webhooksubscription.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case transaction.FieldUpdateTime:
+	case webhooksubscription.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case transaction.FieldAccountID:
-		m.ResetAccountID()
-		return nil
-	case transaction.FieldAmount:
-		m.ResetAmount()
-		return nil
-	case transaction.FieldBalance:
-		m.ResetBalance()
+	case webhooksubscription.FieldURL:
+		m.ResetURL()
 		return nil
-	case transaction.FieldOperationTypeID:
-		m.ResetOperationTypeID()
+	case webhooksubscription.FieldEventTypes:
+		m.ResetEventTypes()
 		return nil
-	case transaction.FieldTimestamp:
-		m.ResetTimestamp()
+	case webhooksubscription.FieldSecret:
+		m.ResetSecret()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TransactionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.account != nil {
-		edges = append(edges, transaction.EdgeAccount)
-	}
-	if m.operation_type != nil {
-		edges = append(edges, transaction.EdgeOperationType)
+func (m *WebhookSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TransactionMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case transaction.EdgeAccount:
-		if id := m.account; id != nil {
-			return []ent.Value{*id}
-		}
-	case transaction.EdgeOperationType:
-		if id := m.operation_type; id != nil {
-			return []ent.Value{*id}
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TransactionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebhookSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TransactionMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TransactionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedaccount {
-		edges = append(edges, transaction.EdgeAccount)
-	}
-	if m.clearedoperation_type {
-		edges = append(edges, transaction.EdgeOperationType)
+func (m *WebhookSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeliveries {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TransactionMutation) EdgeCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case transaction.EdgeAccount:
-		return m.clearedaccount
-	case transaction.EdgeOperationType:
-		return m.clearedoperation_type
+	case webhooksubscription.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TransactionMutation) ClearEdge(name string) error {
+func (m *WebhookSubscriptionMutation) ClearEdge(name string) error {
 	switch name {
-	case transaction.EdgeAccount:
-		m.ClearAccount()
-		return nil
-	case transaction.EdgeOperationType:
-		m.ClearOperationType()
-		return nil
 	}
-	return fmt.Errorf("unknown Transaction unique edge %s", name)
+	return fmt.Errorf("unknown WebhookSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TransactionMutation) ResetEdge(name string) error {
+func (m *WebhookSubscriptionMutation) ResetEdge(name string) error {
 	switch name {
-	case transaction.EdgeAccount:
-		m.ResetAccount()
-		return nil
-	case transaction.EdgeOperationType:
-		m.ResetOperationType()
+	case webhooksubscription.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Transaction edge %s", name)
+	return fmt.Errorf("unknown WebhookSubscription edge %s", name)
 }
@@ -35,9 +35,11 @@ type OperationType struct {
 type OperationTypeEdges struct {
 	// Transactions holds the value of the transactions edge.
 	Transactions []*Transaction `json:"transactions,omitempty"`
+	// Holds holds the value of the holds edge.
+	Holds []*Hold `json:"holds,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // TransactionsOrErr returns the Transactions value or an error if the edge
@@ -49,6 +51,15 @@ func (e OperationTypeEdges) TransactionsOrErr() ([]*Transaction, error) {
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
+// HoldsOrErr returns the Holds value or an error if the edge
+// was not loaded in eager-loading.
+func (e OperationTypeEdges) HoldsOrErr() ([]*Hold, error) {
+	if e.loadedTypes[1] {
+		return e.Holds, nil
+	}
+	return nil, &NotLoadedError{edge: "holds"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OperationType) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -125,6 +136,11 @@ func (ot *OperationType) QueryTransactions() *TransactionQuery {
 	return NewOperationTypeClient(ot.config).QueryTransactions(ot)
 }
 
+// QueryHolds queries the "holds" edge of the OperationType entity.
+func (ot *OperationType) QueryHolds() *HoldQuery {
+	return NewOperationTypeClient(ot.config).QueryHolds(ot)
+}
+
 // Update returns a builder for updating this OperationType.
 // Note that you need to call OperationType.Unwrap() before calling this method if this OperationType
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"transactor-server/pkg/db/ent/hold"
 	"transactor-server/pkg/db/ent/operationtype"
 	"transactor-server/pkg/db/ent/transaction"
 
@@ -84,6 +85,21 @@ func (otc *OperationTypeCreate) AddTransactions(t ...*Transaction) *OperationTyp
 	return otc.AddTransactionIDs(ids...)
 }
 
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (otc *OperationTypeCreate) AddHoldIDs(ids ...int) *OperationTypeCreate {
+	otc.mutation.AddHoldIDs(ids...)
+	return otc
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (otc *OperationTypeCreate) AddHolds(h ...*Hold) *OperationTypeCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return otc.AddHoldIDs(ids...)
+}
+
 // Mutation returns the OperationTypeMutation object of the builder.
 func (otc *OperationTypeCreate) Mutation() *OperationTypeMutation {
 	return otc.mutation
@@ -208,6 +224,22 @@ func (otc *OperationTypeCreate) createSpec() (*OperationType, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := otc.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   operationtype.HoldsTable,
+			Columns: []string{operationtype.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"transactor-server/pkg/db/ent/hold"
 	"transactor-server/pkg/db/ent/operationtype"
 	"transactor-server/pkg/db/ent/predicate"
 	"transactor-server/pkg/db/ent/transaction"
@@ -25,6 +26,7 @@ type OperationTypeQuery struct {
 	inters           []Interceptor
 	predicates       []predicate.OperationType
 	withTransactions *TransactionQuery
+	withHolds        *HoldQuery
 	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -84,6 +86,28 @@ func (otq *OperationTypeQuery) QueryTransactions() *TransactionQuery {
 	return query
 }
 
+// QueryHolds chains the current query on the "holds" edge.
+func (otq *OperationTypeQuery) QueryHolds() *HoldQuery {
+	query := (&HoldClient{config: otq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := otq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := otq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(operationtype.Table, operationtype.FieldID, selector),
+			sqlgraph.To(hold.Table, hold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, operationtype.HoldsTable, operationtype.HoldsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(otq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first OperationType entity from the query.
 // Returns a *NotFoundError when no OperationType was found.
 func (otq *OperationTypeQuery) First(ctx context.Context) (*OperationType, error) {
@@ -277,6 +301,7 @@ func (otq *OperationTypeQuery) Clone() *OperationTypeQuery {
 		inters:           append([]Interceptor{}, otq.inters...),
 		predicates:       append([]predicate.OperationType{}, otq.predicates...),
 		withTransactions: otq.withTransactions.Clone(),
+		withHolds:        otq.withHolds.Clone(),
 		// clone intermediate query.
 		sql:       otq.sql.Clone(),
 		path:      otq.path,
@@ -295,6 +320,17 @@ func (otq *OperationTypeQuery) WithTransactions(opts ...func(*TransactionQuery))
 	return otq
 }
 
+// WithHolds tells the query-builder to eager-load the nodes that are connected to
+// the "holds" edge. The optional arguments are used to configure the query builder of the edge.
+func (otq *OperationTypeQuery) WithHolds(opts ...func(*HoldQuery)) *OperationTypeQuery {
+	query := (&HoldClient{config: otq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	otq.withHolds = query
+	return otq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +409,9 @@ func (otq *OperationTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*OperationType{}
 		_spec       = otq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			otq.withTransactions != nil,
+			otq.withHolds != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -405,6 +442,13 @@ func (otq *OperationTypeQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := otq.withHolds; query != nil {
+		if err := otq.loadHolds(ctx, query, nodes,
+			func(n *OperationType) { n.Edges.Holds = []*Hold{} },
+			func(n *OperationType, e *Hold) { n.Edges.Holds = append(n.Edges.Holds, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -438,6 +482,36 @@ func (otq *OperationTypeQuery) loadTransactions(ctx context.Context, query *Tran
 	}
 	return nil
 }
+func (otq *OperationTypeQuery) loadHolds(ctx context.Context, query *HoldQuery, nodes []*OperationType, init func(*OperationType), assign func(*OperationType, *Hold)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*OperationType)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(hold.FieldOperationTypeID)
+	}
+	query.Where(predicate.Hold(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(operationtype.HoldsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OperationTypeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "operation_type_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (otq *OperationTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := otq.querySpec()
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"transactor-server/pkg/db/ent/hold"
 	"transactor-server/pkg/db/ent/operationtype"
 	"transactor-server/pkg/db/ent/predicate"
 	"transactor-server/pkg/db/ent/transaction"
@@ -79,6 +80,21 @@ func (otu *OperationTypeUpdate) AddTransactions(t ...*Transaction) *OperationTyp
 	return otu.AddTransactionIDs(ids...)
 }
 
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (otu *OperationTypeUpdate) AddHoldIDs(ids ...int) *OperationTypeUpdate {
+	otu.mutation.AddHoldIDs(ids...)
+	return otu
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (otu *OperationTypeUpdate) AddHolds(h ...*Hold) *OperationTypeUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return otu.AddHoldIDs(ids...)
+}
+
 // Mutation returns the OperationTypeMutation object of the builder.
 func (otu *OperationTypeUpdate) Mutation() *OperationTypeMutation {
 	return otu.mutation
@@ -105,6 +121,27 @@ func (otu *OperationTypeUpdate) RemoveTransactions(t ...*Transaction) *Operation
 	return otu.RemoveTransactionIDs(ids...)
 }
 
+// ClearHolds clears all "holds" edges to the Hold entity.
+func (otu *OperationTypeUpdate) ClearHolds() *OperationTypeUpdate {
+	otu.mutation.ClearHolds()
+	return otu
+}
+
+// RemoveHoldIDs removes the "holds" edge to Hold entities by IDs.
+func (otu *OperationTypeUpdate) RemoveHoldIDs(ids ...int) *OperationTypeUpdate {
+	otu.mutation.RemoveHoldIDs(ids...)
+	return otu
+}
+
+// RemoveHolds removes "holds" edges to Hold entities.
+func (otu *OperationTypeUpdate) RemoveHolds(h ...*Hold) *OperationTypeUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return otu.RemoveHoldIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (otu *OperationTypeUpdate) Save(ctx context.Context) (int, error) {
 	otu.defaults()
@@ -210,6 +247,51 @@ func (otu *OperationTypeUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if otu.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   operationtype.HoldsTable,
+			Columns: []string{operationtype.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := otu.mutation.RemovedHoldsIDs(); len(nodes) > 0 && !otu.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   operationtype.HoldsTable,
+			Columns: []string{operationtype.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := otu.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   operationtype.HoldsTable,
+			Columns: []string{operationtype.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(otu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, otu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -281,6 +363,21 @@ func (otuo *OperationTypeUpdateOne) AddTransactions(t ...*Transaction) *Operatio
 	return otuo.AddTransactionIDs(ids...)
 }
 
+// AddHoldIDs adds the "holds" edge to the Hold entity by IDs.
+func (otuo *OperationTypeUpdateOne) AddHoldIDs(ids ...int) *OperationTypeUpdateOne {
+	otuo.mutation.AddHoldIDs(ids...)
+	return otuo
+}
+
+// AddHolds adds the "holds" edges to the Hold entity.
+func (otuo *OperationTypeUpdateOne) AddHolds(h ...*Hold) *OperationTypeUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return otuo.AddHoldIDs(ids...)
+}
+
 // Mutation returns the OperationTypeMutation object of the builder.
 func (otuo *OperationTypeUpdateOne) Mutation() *OperationTypeMutation {
 	return otuo.mutation
@@ -307,6 +404,27 @@ func (otuo *OperationTypeUpdateOne) RemoveTransactions(t ...*Transaction) *Opera
 	return otuo.RemoveTransactionIDs(ids...)
 }
 
+// ClearHolds clears all "holds" edges to the Hold entity.
+func (otuo *OperationTypeUpdateOne) ClearHolds() *OperationTypeUpdateOne {
+	otuo.mutation.ClearHolds()
+	return otuo
+}
+
+// RemoveHoldIDs removes the "holds" edge to Hold entities by IDs.
+func (otuo *OperationTypeUpdateOne) RemoveHoldIDs(ids ...int) *OperationTypeUpdateOne {
+	otuo.mutation.RemoveHoldIDs(ids...)
+	return otuo
+}
+
+// RemoveHolds removes "holds" edges to Hold entities.
+func (otuo *OperationTypeUpdateOne) RemoveHolds(h ...*Hold) *OperationTypeUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return otuo.RemoveHoldIDs(ids...)
+}
+
 // Where appends a list predicates to the OperationTypeUpdate builder.
 func (otuo *OperationTypeUpdateOne) Where(ps ...predicate.OperationType) *OperationTypeUpdateOne {
 	otuo.mutation.Where(ps...)
@@ -442,6 +560,51 @@ func (otuo *OperationTypeUpdateOne) sqlSave(ctx context.Context) (_node *Operati
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if otuo.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   operationtype.HoldsTable,
+			Columns: []string{operationtype.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := otuo.mutation.RemovedHoldsIDs(); len(nodes) > 0 && !otuo.mutation.HoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   operationtype.HoldsTable,
+			Columns: []string{operationtype.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := otuo.mutation.HoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   operationtype.HoldsTable,
+			Columns: []string{operationtype.HoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(otuo.modifiers...)
 	_node = &OperationType{config: otuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/outboxevent"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OutboxEvent is the model entity for the OutboxEvent schema.
+type OutboxEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID int `json:"account_id,omitempty"`
+	// AggregateID holds the value of the "aggregate_id" field.
+	AggregateID int `json:"aggregate_id,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OutboxEventQuery when eager-loading is set.
+	Edges        OutboxEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OutboxEventEdges holds the relations/edges for other nodes in the graph.
+type OutboxEventEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// WebhookDeliveries holds the value of the webhook_deliveries edge.
+	WebhookDeliveries []*WebhookDelivery `json:"webhook_deliveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OutboxEventEdges) AccountOrErr() (*Account, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// WebhookDeliveriesOrErr returns the WebhookDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e OutboxEventEdges) WebhookDeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[1] {
+		return e.WebhookDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldPayload:
+			values[i] = new([]byte)
+		case outboxevent.FieldID, outboxevent.FieldAccountID, outboxevent.FieldAggregateID, outboxevent.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case outboxevent.FieldType, outboxevent.FieldLastError:
+			values[i] = new(sql.NullString)
+		case outboxevent.FieldCreateTime, outboxevent.FieldUpdateTime, outboxevent.FieldPublishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxEvent fields.
+func (oe *OutboxEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oe.ID = int(value.Int64)
+		case outboxevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				oe.CreateTime = value.Time
+			}
+		case outboxevent.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				oe.UpdateTime = value.Time
+			}
+		case outboxevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				oe.Type = value.String
+			}
+		case outboxevent.FieldAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				oe.AccountID = int(value.Int64)
+			}
+		case outboxevent.FieldAggregateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field aggregate_id", values[i])
+			} else if value.Valid {
+				oe.AggregateID = int(value.Int64)
+			}
+		case outboxevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oe.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case outboxevent.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				oe.PublishedAt = new(time.Time)
+				*oe.PublishedAt = value.Time
+			}
+		case outboxevent.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				oe.Attempts = int(value.Int64)
+			}
+		case outboxevent.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				oe.LastError = new(string)
+				*oe.LastError = value.String
+			}
+		default:
+			oe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OutboxEvent.
+// This includes values selected through modifiers, order, etc.
+func (oe *OutboxEvent) Value(name string) (ent.Value, error) {
+	return oe.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the OutboxEvent entity.
+func (oe *OutboxEvent) QueryAccount() *AccountQuery {
+	return NewOutboxEventClient(oe.config).QueryAccount(oe)
+}
+
+// QueryWebhookDeliveries queries the "webhook_deliveries" edge of the OutboxEvent entity.
+func (oe *OutboxEvent) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	return NewOutboxEventClient(oe.config).QueryWebhookDeliveries(oe)
+}
+
+// Update returns a builder for updating this OutboxEvent.
+// Note that you need to call OutboxEvent.Unwrap() before calling this method if this OutboxEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oe *OutboxEvent) Update() *OutboxEventUpdateOne {
+	return NewOutboxEventClient(oe.config).UpdateOne(oe)
+}
+
+// Unwrap unwraps the OutboxEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oe *OutboxEvent) Unwrap() *OutboxEvent {
+	_tx, ok := oe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OutboxEvent is not a transactional entity")
+	}
+	oe.config.driver = _tx.drv
+	return oe
+}
+
+// String implements the fmt.Stringer.
+func (oe *OutboxEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oe.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(oe.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(oe.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(oe.Type)
+	builder.WriteString(", ")
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", oe.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("aggregate_id=")
+	builder.WriteString(fmt.Sprintf("%v", oe.AggregateID))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Payload))
+	builder.WriteString(", ")
+	if v := oe.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Attempts))
+	builder.WriteString(", ")
+	if v := oe.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxEvents is a parsable slice of OutboxEvent.
+type OutboxEvents []*OutboxEvent
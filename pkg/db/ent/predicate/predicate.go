@@ -9,8 +9,44 @@ import (
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// Accrual is the predicate function for accrual builders.
+type Accrual func(*sql.Selector)
+
+// FxRate is the predicate function for fxrate builders.
+type FxRate func(*sql.Selector)
+
+// Hold is the predicate function for hold builders.
+type Hold func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// InvoicePayment is the predicate function for invoicepayment builders.
+type InvoicePayment func(*sql.Selector)
+
+// JournalEntry is the predicate function for journalentry builders.
+type JournalEntry func(*sql.Selector)
+
 // OperationType is the predicate function for operationtype builders.
 type OperationType func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// Settlement is the predicate function for settlement builders.
+type Settlement func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
+
+// Transfer is the predicate function for transfer builders.
+type Transfer func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
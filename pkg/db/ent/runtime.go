@@ -5,9 +5,22 @@ package ent
 import (
 	"time"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/fxrate"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/idempotencykey"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/journalentry"
 	"transactor-server/pkg/db/ent/operationtype"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/db/ent/settlement"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/db/ent/webhooksubscription"
 	"transactor-server/pkg/db/schema"
+	"transactor-server/pkg/money"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -47,6 +60,169 @@ func init() {
 			return nil
 		}
 	}()
+	// accountDescCycleClosingDay is the schema descriptor for cycle_closing_day field.
+	accountDescCycleClosingDay := accountFields[4].Descriptor()
+	// account.CycleClosingDayValidator is a validator for the "cycle_closing_day" field. It is called by the builders before save.
+	account.CycleClosingDayValidator = accountDescCycleClosingDay.Validators[0].(func(int) error)
+	// accountDescDueDateOffset is the schema descriptor for due_date_offset field.
+	accountDescDueDateOffset := accountFields[5].Descriptor()
+	// account.DefaultDueDateOffset holds the default value on creation for the due_date_offset field.
+	account.DefaultDueDateOffset = accountDescDueDateOffset.Default.(int)
+	// account.DueDateOffsetValidator is a validator for the "due_date_offset" field. It is called by the builders before save.
+	account.DueDateOffsetValidator = accountDescDueDateOffset.Validators[0].(func(int) error)
+	// accountDescCurrency is the schema descriptor for currency field.
+	accountDescCurrency := accountFields[6].Descriptor()
+	// account.DefaultCurrency holds the default value on creation for the currency field.
+	account.DefaultCurrency = accountDescCurrency.Default.(string)
+	// account.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	account.CurrencyValidator = accountDescCurrency.Validators[0].(func(string) error)
+	accrualMixin := schema.Accrual{}.Mixin()
+	accrualMixinFields0 := accrualMixin[0].Fields()
+	_ = accrualMixinFields0
+	accrualFields := schema.Accrual{}.Fields()
+	_ = accrualFields
+	// accrualDescCreateTime is the schema descriptor for create_time field.
+	accrualDescCreateTime := accrualMixinFields0[0].Descriptor()
+	// accrual.DefaultCreateTime holds the default value on creation for the create_time field.
+	accrual.DefaultCreateTime = accrualDescCreateTime.Default.(func() time.Time)
+	// accrualDescUpdateTime is the schema descriptor for update_time field.
+	accrualDescUpdateTime := accrualMixinFields0[1].Descriptor()
+	// accrual.DefaultUpdateTime holds the default value on creation for the update_time field.
+	accrual.DefaultUpdateTime = accrualDescUpdateTime.Default.(func() time.Time)
+	// accrual.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	accrual.UpdateDefaultUpdateTime = accrualDescUpdateTime.UpdateDefault.(func() time.Time)
+	fxrateMixin := schema.FxRate{}.Mixin()
+	fxrateMixinFields0 := fxrateMixin[0].Fields()
+	_ = fxrateMixinFields0
+	fxrateFields := schema.FxRate{}.Fields()
+	_ = fxrateFields
+	// fxrateDescCreateTime is the schema descriptor for create_time field.
+	fxrateDescCreateTime := fxrateMixinFields0[0].Descriptor()
+	// fxrate.DefaultCreateTime holds the default value on creation for the create_time field.
+	fxrate.DefaultCreateTime = fxrateDescCreateTime.Default.(func() time.Time)
+	// fxrateDescUpdateTime is the schema descriptor for update_time field.
+	fxrateDescUpdateTime := fxrateMixinFields0[1].Descriptor()
+	// fxrate.DefaultUpdateTime holds the default value on creation for the update_time field.
+	fxrate.DefaultUpdateTime = fxrateDescUpdateTime.Default.(func() time.Time)
+	// fxrate.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	fxrate.UpdateDefaultUpdateTime = fxrateDescUpdateTime.UpdateDefault.(func() time.Time)
+	// fxrateDescBaseCurrency is the schema descriptor for base_currency field.
+	fxrateDescBaseCurrency := fxrateFields[1].Descriptor()
+	// fxrate.BaseCurrencyValidator is a validator for the "base_currency" field. It is called by the builders before save.
+	fxrate.BaseCurrencyValidator = fxrateDescBaseCurrency.Validators[0].(func(string) error)
+	// fxrateDescQuoteCurrency is the schema descriptor for quote_currency field.
+	fxrateDescQuoteCurrency := fxrateFields[2].Descriptor()
+	// fxrate.QuoteCurrencyValidator is a validator for the "quote_currency" field. It is called by the builders before save.
+	fxrate.QuoteCurrencyValidator = fxrateDescQuoteCurrency.Validators[0].(func(string) error)
+	// fxrateDescRate is the schema descriptor for rate field.
+	fxrateDescRate := fxrateFields[3].Descriptor()
+	// fxrate.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	fxrate.RateValidator = fxrateDescRate.Validators[0].(func(string) error)
+	holdMixin := schema.Hold{}.Mixin()
+	holdMixinFields0 := holdMixin[0].Fields()
+	_ = holdMixinFields0
+	holdFields := schema.Hold{}.Fields()
+	_ = holdFields
+	// holdDescCreateTime is the schema descriptor for create_time field.
+	holdDescCreateTime := holdMixinFields0[0].Descriptor()
+	// hold.DefaultCreateTime holds the default value on creation for the create_time field.
+	hold.DefaultCreateTime = holdDescCreateTime.Default.(func() time.Time)
+	// holdDescUpdateTime is the schema descriptor for update_time field.
+	holdDescUpdateTime := holdMixinFields0[1].Descriptor()
+	// hold.DefaultUpdateTime holds the default value on creation for the update_time field.
+	hold.DefaultUpdateTime = holdDescUpdateTime.Default.(func() time.Time)
+	// hold.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	hold.UpdateDefaultUpdateTime = holdDescUpdateTime.UpdateDefault.(func() time.Time)
+	idempotencykeyMixin := schema.IdempotencyKey{}.Mixin()
+	idempotencykeyMixinFields0 := idempotencykeyMixin[0].Fields()
+	_ = idempotencykeyMixinFields0
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreateTime is the schema descriptor for create_time field.
+	idempotencykeyDescCreateTime := idempotencykeyMixinFields0[0].Descriptor()
+	// idempotencykey.DefaultCreateTime holds the default value on creation for the create_time field.
+	idempotencykey.DefaultCreateTime = idempotencykeyDescCreateTime.Default.(func() time.Time)
+	// idempotencykeyDescUpdateTime is the schema descriptor for update_time field.
+	idempotencykeyDescUpdateTime := idempotencykeyMixinFields0[1].Descriptor()
+	// idempotencykey.DefaultUpdateTime holds the default value on creation for the update_time field.
+	idempotencykey.DefaultUpdateTime = idempotencykeyDescUpdateTime.Default.(func() time.Time)
+	// idempotencykey.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	idempotencykey.UpdateDefaultUpdateTime = idempotencykeyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// idempotencykeyDescScope is the schema descriptor for scope field.
+	idempotencykeyDescScope := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	idempotencykey.ScopeValidator = idempotencykeyDescScope.Validators[0].(func(string) error)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	invoiceMixin := schema.Invoice{}.Mixin()
+	invoiceMixinFields0 := invoiceMixin[0].Fields()
+	_ = invoiceMixinFields0
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescCreateTime is the schema descriptor for create_time field.
+	invoiceDescCreateTime := invoiceMixinFields0[0].Descriptor()
+	// invoice.DefaultCreateTime holds the default value on creation for the create_time field.
+	invoice.DefaultCreateTime = invoiceDescCreateTime.Default.(func() time.Time)
+	// invoiceDescUpdateTime is the schema descriptor for update_time field.
+	invoiceDescUpdateTime := invoiceMixinFields0[1].Descriptor()
+	// invoice.DefaultUpdateTime holds the default value on creation for the update_time field.
+	invoice.DefaultUpdateTime = invoiceDescUpdateTime.Default.(func() time.Time)
+	// invoice.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	invoice.UpdateDefaultUpdateTime = invoiceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// invoiceDescPaidAmount is the schema descriptor for paid_amount field.
+	invoiceDescPaidAmount := invoiceFields[7].Descriptor()
+	// invoice.DefaultPaidAmount holds the default value on creation for the paid_amount field.
+	invoice.DefaultPaidAmount = money.Amount(invoiceDescPaidAmount.Default.(int64))
+	invoicepaymentMixin := schema.InvoicePayment{}.Mixin()
+	invoicepaymentMixinFields0 := invoicepaymentMixin[0].Fields()
+	_ = invoicepaymentMixinFields0
+	invoicepaymentFields := schema.InvoicePayment{}.Fields()
+	_ = invoicepaymentFields
+	// invoicepaymentDescCreateTime is the schema descriptor for create_time field.
+	invoicepaymentDescCreateTime := invoicepaymentMixinFields0[0].Descriptor()
+	// invoicepayment.DefaultCreateTime holds the default value on creation for the create_time field.
+	invoicepayment.DefaultCreateTime = invoicepaymentDescCreateTime.Default.(func() time.Time)
+	// invoicepaymentDescUpdateTime is the schema descriptor for update_time field.
+	invoicepaymentDescUpdateTime := invoicepaymentMixinFields0[1].Descriptor()
+	// invoicepayment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	invoicepayment.DefaultUpdateTime = invoicepaymentDescUpdateTime.Default.(func() time.Time)
+	// invoicepayment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	invoicepayment.UpdateDefaultUpdateTime = invoicepaymentDescUpdateTime.UpdateDefault.(func() time.Time)
+	journalentryMixin := schema.JournalEntry{}.Mixin()
+	journalentryMixinFields0 := journalentryMixin[0].Fields()
+	_ = journalentryMixinFields0
+	journalentryFields := schema.JournalEntry{}.Fields()
+	_ = journalentryFields
+	// journalentryDescCreateTime is the schema descriptor for create_time field.
+	journalentryDescCreateTime := journalentryMixinFields0[0].Descriptor()
+	// journalentry.DefaultCreateTime holds the default value on creation for the create_time field.
+	journalentry.DefaultCreateTime = journalentryDescCreateTime.Default.(func() time.Time)
+	// journalentryDescUpdateTime is the schema descriptor for update_time field.
+	journalentryDescUpdateTime := journalentryMixinFields0[1].Descriptor()
+	// journalentry.DefaultUpdateTime holds the default value on creation for the update_time field.
+	journalentry.DefaultUpdateTime = journalentryDescUpdateTime.Default.(func() time.Time)
+	// journalentry.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	journalentry.UpdateDefaultUpdateTime = journalentryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// journalentryDescCurrency is the schema descriptor for currency field.
+	journalentryDescCurrency := journalentryFields[6].Descriptor()
+	// journalentry.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	journalentry.CurrencyValidator = journalentryDescCurrency.Validators[0].(func(string) error)
 	operationtypeMixin := schema.OperationType{}.Mixin()
 	operationtypeMixinFields0 := operationtypeMixin[0].Fields()
 	_ = operationtypeMixinFields0
@@ -62,6 +238,48 @@ func init() {
 	operationtype.DefaultUpdateTime = operationtypeDescUpdateTime.Default.(func() time.Time)
 	// operationtype.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	operationtype.UpdateDefaultUpdateTime = operationtypeDescUpdateTime.UpdateDefault.(func() time.Time)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreateTime is the schema descriptor for create_time field.
+	outboxeventDescCreateTime := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	outboxevent.DefaultCreateTime = outboxeventDescCreateTime.Default.(func() time.Time)
+	// outboxeventDescUpdateTime is the schema descriptor for update_time field.
+	outboxeventDescUpdateTime := outboxeventMixinFields0[1].Descriptor()
+	// outboxevent.DefaultUpdateTime holds the default value on creation for the update_time field.
+	outboxevent.DefaultUpdateTime = outboxeventDescUpdateTime.Default.(func() time.Time)
+	// outboxevent.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	outboxevent.UpdateDefaultUpdateTime = outboxeventDescUpdateTime.UpdateDefault.(func() time.Time)
+	// outboxeventDescType is the schema descriptor for type field.
+	outboxeventDescType := outboxeventFields[1].Descriptor()
+	// outboxevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	outboxevent.TypeValidator = outboxeventDescType.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescLastError is the schema descriptor for last_error field.
+	outboxeventDescLastError := outboxeventFields[7].Descriptor()
+	// outboxevent.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	outboxevent.LastErrorValidator = outboxeventDescLastError.Validators[0].(func(string) error)
+	settlementMixin := schema.Settlement{}.Mixin()
+	settlementMixinFields0 := settlementMixin[0].Fields()
+	_ = settlementMixinFields0
+	settlementFields := schema.Settlement{}.Fields()
+	_ = settlementFields
+	// settlementDescCreateTime is the schema descriptor for create_time field.
+	settlementDescCreateTime := settlementMixinFields0[0].Descriptor()
+	// settlement.DefaultCreateTime holds the default value on creation for the create_time field.
+	settlement.DefaultCreateTime = settlementDescCreateTime.Default.(func() time.Time)
+	// settlementDescUpdateTime is the schema descriptor for update_time field.
+	settlementDescUpdateTime := settlementMixinFields0[1].Descriptor()
+	// settlement.DefaultUpdateTime holds the default value on creation for the update_time field.
+	settlement.DefaultUpdateTime = settlementDescUpdateTime.Default.(func() time.Time)
+	// settlement.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	settlement.UpdateDefaultUpdateTime = settlementDescUpdateTime.UpdateDefault.(func() time.Time)
 	transactionMixin := schema.Transaction{}.Mixin()
 	transactionMixinFields0 := transactionMixin[0].Fields()
 	_ = transactionMixinFields0
@@ -77,4 +295,99 @@ func init() {
 	transaction.DefaultUpdateTime = transactionDescUpdateTime.Default.(func() time.Time)
 	// transaction.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	transaction.UpdateDefaultUpdateTime = transactionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// transactionDescBalance is the schema descriptor for balance field.
+	transactionDescBalance := transactionFields[3].Descriptor()
+	// transaction.DefaultBalance holds the default value on creation for the balance field.
+	transaction.DefaultBalance = money.Amount(transactionDescBalance.Default.(int64))
+	// transactionDescDescription is the schema descriptor for description field.
+	transactionDescDescription := transactionFields[12].Descriptor()
+	// transaction.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	transaction.DescriptionValidator = transactionDescDescription.Validators[0].(func(string) error)
+	// transactionDescMerchantName is the schema descriptor for merchant_name field.
+	transactionDescMerchantName := transactionFields[13].Descriptor()
+	// transaction.MerchantNameValidator is a validator for the "merchant_name" field. It is called by the builders before save.
+	transaction.MerchantNameValidator = transactionDescMerchantName.Validators[0].(func(string) error)
+	// transactionDescMcc is the schema descriptor for mcc field.
+	transactionDescMcc := transactionFields[14].Descriptor()
+	// transaction.MccValidator is a validator for the "mcc" field. It is called by the builders before save.
+	transaction.MccValidator = transactionDescMcc.Validators[0].(func(string) error)
+	// transactionDescExternalReference is the schema descriptor for external_reference field.
+	transactionDescExternalReference := transactionFields[15].Descriptor()
+	// transaction.ExternalReferenceValidator is a validator for the "external_reference" field. It is called by the builders before save.
+	transaction.ExternalReferenceValidator = transactionDescExternalReference.Validators[0].(func(string) error)
+	// transactionDescCurrency is the schema descriptor for currency field.
+	transactionDescCurrency := transactionFields[17].Descriptor()
+	// transaction.DefaultCurrency holds the default value on creation for the currency field.
+	transaction.DefaultCurrency = transactionDescCurrency.Default.(string)
+	// transaction.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	transaction.CurrencyValidator = transactionDescCurrency.Validators[0].(func(string) error)
+	// transactionDescOriginalCurrency is the schema descriptor for original_currency field.
+	transactionDescOriginalCurrency := transactionFields[19].Descriptor()
+	// transaction.OriginalCurrencyValidator is a validator for the "original_currency" field. It is called by the builders before save.
+	transaction.OriginalCurrencyValidator = transactionDescOriginalCurrency.Validators[0].(func(string) error)
+	// transactionDescFxRate is the schema descriptor for fx_rate field.
+	transactionDescFxRate := transactionFields[20].Descriptor()
+	// transaction.FxRateValidator is a validator for the "fx_rate" field. It is called by the builders before save.
+	transaction.FxRateValidator = transactionDescFxRate.Validators[0].(func(string) error)
+	transferMixin := schema.Transfer{}.Mixin()
+	transferMixinFields0 := transferMixin[0].Fields()
+	_ = transferMixinFields0
+	transferFields := schema.Transfer{}.Fields()
+	_ = transferFields
+	// transferDescCreateTime is the schema descriptor for create_time field.
+	transferDescCreateTime := transferMixinFields0[0].Descriptor()
+	// transfer.DefaultCreateTime holds the default value on creation for the create_time field.
+	transfer.DefaultCreateTime = transferDescCreateTime.Default.(func() time.Time)
+	// transferDescUpdateTime is the schema descriptor for update_time field.
+	transferDescUpdateTime := transferMixinFields0[1].Descriptor()
+	// transfer.DefaultUpdateTime holds the default value on creation for the update_time field.
+	transfer.DefaultUpdateTime = transferDescUpdateTime.Default.(func() time.Time)
+	// transfer.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	transfer.UpdateDefaultUpdateTime = transferDescUpdateTime.UpdateDefault.(func() time.Time)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeliveryDescUpdateTime := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdelivery.DefaultUpdateTime = webhookdeliveryDescUpdateTime.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdelivery.UpdateDefaultUpdateTime = webhookdeliveryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	webhooksubscriptionMixin := schema.WebhookSubscription{}.Mixin()
+	webhooksubscriptionMixinFields0 := webhooksubscriptionMixin[0].Fields()
+	_ = webhooksubscriptionMixinFields0
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescCreateTime is the schema descriptor for create_time field.
+	webhooksubscriptionDescCreateTime := webhooksubscriptionMixinFields0[0].Descriptor()
+	// webhooksubscription.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhooksubscription.DefaultCreateTime = webhooksubscriptionDescCreateTime.Default.(func() time.Time)
+	// webhooksubscriptionDescUpdateTime is the schema descriptor for update_time field.
+	webhooksubscriptionDescUpdateTime := webhooksubscriptionMixinFields0[1].Descriptor()
+	// webhooksubscription.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhooksubscription.DefaultUpdateTime = webhooksubscriptionDescUpdateTime.Default.(func() time.Time)
+	// webhooksubscription.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhooksubscription.UpdateDefaultUpdateTime = webhooksubscriptionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = webhooksubscriptionDescURL.Validators[0].(func(string) error)
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[3].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
 }
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/settlement"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Settlement is the model entity for the Settlement schema.
+type Settlement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// CreditTxnID holds the value of the "credit_txn_id" field.
+	CreditTxnID int `json:"credit_txn_id,omitempty"`
+	// DebitTxnID holds the value of the "debit_txn_id" field.
+	DebitTxnID int `json:"debit_txn_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// ReversedAt holds the value of the "reversed_at" field.
+	ReversedAt *time.Time `json:"reversed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SettlementQuery when eager-loading is set.
+	Edges        SettlementEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SettlementEdges holds the relations/edges for other nodes in the graph.
+type SettlementEdges struct {
+	// Credit holds the value of the credit edge.
+	Credit *Transaction `json:"credit,omitempty"`
+	// Debit holds the value of the debit edge.
+	Debit *Transaction `json:"debit,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CreditOrErr returns the Credit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) CreditOrErr() (*Transaction, error) {
+	if e.Credit != nil {
+		return e.Credit, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "credit"}
+}
+
+// DebitOrErr returns the Debit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SettlementEdges) DebitOrErr() (*Transaction, error) {
+	if e.Debit != nil {
+		return e.Debit, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "debit"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Settlement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlement.FieldID, settlement.FieldCreditTxnID, settlement.FieldDebitTxnID, settlement.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case settlement.FieldCreateTime, settlement.FieldUpdateTime, settlement.FieldTimestamp, settlement.FieldReversedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Settlement fields.
+func (s *Settlement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case settlement.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				s.CreateTime = value.Time
+			}
+		case settlement.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				s.UpdateTime = value.Time
+			}
+		case settlement.FieldCreditTxnID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_txn_id", values[i])
+			} else if value.Valid {
+				s.CreditTxnID = int(value.Int64)
+			}
+		case settlement.FieldDebitTxnID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field debit_txn_id", values[i])
+			} else if value.Valid {
+				s.DebitTxnID = int(value.Int64)
+			}
+		case settlement.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				s.Amount = money.Amount(value.Int64)
+			}
+		case settlement.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				s.Timestamp = value.Time
+			}
+		case settlement.FieldReversedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reversed_at", values[i])
+			} else if value.Valid {
+				s.ReversedAt = new(time.Time)
+				*s.ReversedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Settlement.
+// This includes values selected through modifiers, order, etc.
+func (s *Settlement) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryCredit queries the "credit" edge of the Settlement entity.
+func (s *Settlement) QueryCredit() *TransactionQuery {
+	return NewSettlementClient(s.config).QueryCredit(s)
+}
+
+// QueryDebit queries the "debit" edge of the Settlement entity.
+func (s *Settlement) QueryDebit() *TransactionQuery {
+	return NewSettlementClient(s.config).QueryDebit(s)
+}
+
+// Update returns a builder for updating this Settlement.
+// Note that you need to call Settlement.Unwrap() before calling this method if this Settlement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Settlement) Update() *SettlementUpdateOne {
+	return NewSettlementClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Settlement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Settlement) Unwrap() *Settlement {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Settlement is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Settlement) String() string {
+	var builder strings.Builder
+	builder.WriteString("Settlement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(s.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(s.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("credit_txn_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.CreditTxnID))
+	builder.WriteString(", ")
+	builder.WriteString("debit_txn_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.DebitTxnID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", s.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(s.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.ReversedAt; v != nil {
+		builder.WriteString("reversed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Settlements is a parsable slice of Settlement.
+type Settlements []*Settlement
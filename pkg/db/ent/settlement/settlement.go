@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the settlement type in the database.
+	Label = "settlement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldCreditTxnID holds the string denoting the credit_txn_id field in the database.
+	FieldCreditTxnID = "credit_txn_id"
+	// FieldDebitTxnID holds the string denoting the debit_txn_id field in the database.
+	FieldDebitTxnID = "debit_txn_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldReversedAt holds the string denoting the reversed_at field in the database.
+	FieldReversedAt = "reversed_at"
+	// EdgeCredit holds the string denoting the credit edge name in mutations.
+	EdgeCredit = "credit"
+	// EdgeDebit holds the string denoting the debit edge name in mutations.
+	EdgeDebit = "debit"
+	// Table holds the table name of the settlement in the database.
+	Table = "settlements"
+	// CreditTable is the table that holds the credit relation/edge.
+	CreditTable = "settlements"
+	// CreditInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	CreditInverseTable = "transactions"
+	// CreditColumn is the table column denoting the credit relation/edge.
+	CreditColumn = "credit_txn_id"
+	// DebitTable is the table that holds the debit relation/edge.
+	DebitTable = "settlements"
+	// DebitInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	DebitInverseTable = "transactions"
+	// DebitColumn is the table column denoting the debit relation/edge.
+	DebitColumn = "debit_txn_id"
+)
+
+// Columns holds all SQL columns for settlement fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldCreditTxnID,
+	FieldDebitTxnID,
+	FieldAmount,
+	FieldTimestamp,
+	FieldReversedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the Settlement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByCreditTxnID orders the results by the credit_txn_id field.
+func ByCreditTxnID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditTxnID, opts...).ToFunc()
+}
+
+// ByDebitTxnID orders the results by the debit_txn_id field.
+func ByDebitTxnID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDebitTxnID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByReversedAt orders the results by the reversed_at field.
+func ByReversedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReversedAt, opts...).ToFunc()
+}
+
+// ByCreditField orders the results by credit field.
+func ByCreditField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreditStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDebitField orders the results by debit field.
+func ByDebitField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDebitStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCreditStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreditInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CreditTable, CreditColumn),
+	)
+}
+func newDebitStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DebitInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DebitTable, DebitColumn),
+	)
+}
@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlement
+
+import (
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// CreditTxnID applies equality check predicate on the "credit_txn_id" field. It's identical to CreditTxnIDEQ.
+func CreditTxnID(v int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldCreditTxnID, v))
+}
+
+// DebitTxnID applies equality check predicate on the "debit_txn_id" field. It's identical to DebitTxnIDEQ.
+func DebitTxnID(v int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldDebitTxnID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v money.Amount) predicate.Settlement {
+	vc := int64(v)
+	return predicate.Settlement(sql.FieldEQ(FieldAmount, vc))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// ReversedAt applies equality check predicate on the "reversed_at" field. It's identical to ReversedAtEQ.
+func ReversedAt(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldReversedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// CreditTxnIDEQ applies the EQ predicate on the "credit_txn_id" field.
+func CreditTxnIDEQ(v int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldCreditTxnID, v))
+}
+
+// CreditTxnIDNEQ applies the NEQ predicate on the "credit_txn_id" field.
+func CreditTxnIDNEQ(v int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldCreditTxnID, v))
+}
+
+// CreditTxnIDIn applies the In predicate on the "credit_txn_id" field.
+func CreditTxnIDIn(vs ...int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldCreditTxnID, vs...))
+}
+
+// CreditTxnIDNotIn applies the NotIn predicate on the "credit_txn_id" field.
+func CreditTxnIDNotIn(vs ...int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldCreditTxnID, vs...))
+}
+
+// DebitTxnIDEQ applies the EQ predicate on the "debit_txn_id" field.
+func DebitTxnIDEQ(v int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldDebitTxnID, v))
+}
+
+// DebitTxnIDNEQ applies the NEQ predicate on the "debit_txn_id" field.
+func DebitTxnIDNEQ(v int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldDebitTxnID, v))
+}
+
+// DebitTxnIDIn applies the In predicate on the "debit_txn_id" field.
+func DebitTxnIDIn(vs ...int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldDebitTxnID, vs...))
+}
+
+// DebitTxnIDNotIn applies the NotIn predicate on the "debit_txn_id" field.
+func DebitTxnIDNotIn(vs ...int) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldDebitTxnID, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v money.Amount) predicate.Settlement {
+	vc := int64(v)
+	return predicate.Settlement(sql.FieldEQ(FieldAmount, vc))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v money.Amount) predicate.Settlement {
+	vc := int64(v)
+	return predicate.Settlement(sql.FieldNEQ(FieldAmount, vc))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...money.Amount) predicate.Settlement {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Settlement(sql.FieldIn(FieldAmount, v...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...money.Amount) predicate.Settlement {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Settlement(sql.FieldNotIn(FieldAmount, v...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v money.Amount) predicate.Settlement {
+	vc := int64(v)
+	return predicate.Settlement(sql.FieldGT(FieldAmount, vc))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v money.Amount) predicate.Settlement {
+	vc := int64(v)
+	return predicate.Settlement(sql.FieldGTE(FieldAmount, vc))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v money.Amount) predicate.Settlement {
+	vc := int64(v)
+	return predicate.Settlement(sql.FieldLT(FieldAmount, vc))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v money.Amount) predicate.Settlement {
+	vc := int64(v)
+	return predicate.Settlement(sql.FieldLTE(FieldAmount, vc))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// ReversedAtEQ applies the EQ predicate on the "reversed_at" field.
+func ReversedAtEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldEQ(FieldReversedAt, v))
+}
+
+// ReversedAtNEQ applies the NEQ predicate on the "reversed_at" field.
+func ReversedAtNEQ(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNEQ(FieldReversedAt, v))
+}
+
+// ReversedAtIn applies the In predicate on the "reversed_at" field.
+func ReversedAtIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldIn(FieldReversedAt, vs...))
+}
+
+// ReversedAtNotIn applies the NotIn predicate on the "reversed_at" field.
+func ReversedAtNotIn(vs ...time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotIn(FieldReversedAt, vs...))
+}
+
+// ReversedAtGT applies the GT predicate on the "reversed_at" field.
+func ReversedAtGT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGT(FieldReversedAt, v))
+}
+
+// ReversedAtGTE applies the GTE predicate on the "reversed_at" field.
+func ReversedAtGTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldGTE(FieldReversedAt, v))
+}
+
+// ReversedAtLT applies the LT predicate on the "reversed_at" field.
+func ReversedAtLT(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLT(FieldReversedAt, v))
+}
+
+// ReversedAtLTE applies the LTE predicate on the "reversed_at" field.
+func ReversedAtLTE(v time.Time) predicate.Settlement {
+	return predicate.Settlement(sql.FieldLTE(FieldReversedAt, v))
+}
+
+// ReversedAtIsNil applies the IsNil predicate on the "reversed_at" field.
+func ReversedAtIsNil() predicate.Settlement {
+	return predicate.Settlement(sql.FieldIsNull(FieldReversedAt))
+}
+
+// ReversedAtNotNil applies the NotNil predicate on the "reversed_at" field.
+func ReversedAtNotNil() predicate.Settlement {
+	return predicate.Settlement(sql.FieldNotNull(FieldReversedAt))
+}
+
+// HasCredit applies the HasEdge predicate on the "credit" edge.
+func HasCredit() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreditTable, CreditColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditWith applies the HasEdge predicate on the "credit" edge with a given conditions (other predicates).
+func HasCreditWith(preds ...predicate.Transaction) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newCreditStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDebit applies the HasEdge predicate on the "debit" edge.
+func HasDebit() predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DebitTable, DebitColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDebitWith applies the HasEdge predicate on the "debit" edge with a given conditions (other predicates).
+func HasDebitWith(preds ...predicate.Transaction) predicate.Settlement {
+	return predicate.Settlement(func(s *sql.Selector) {
+		step := newDebitStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Settlement) predicate.Settlement {
+	return predicate.Settlement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Settlement) predicate.Settlement {
+	return predicate.Settlement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Settlement) predicate.Settlement {
+	return predicate.Settlement(sql.NotPredicates(p))
+}
@@ -0,0 +1,744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/settlement"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SettlementCreate is the builder for creating a Settlement entity.
+type SettlementCreate struct {
+	config
+	mutation *SettlementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (sc *SettlementCreate) SetCreateTime(t time.Time) *SettlementCreate {
+	sc.mutation.SetCreateTime(t)
+	return sc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (sc *SettlementCreate) SetNillableCreateTime(t *time.Time) *SettlementCreate {
+	if t != nil {
+		sc.SetCreateTime(*t)
+	}
+	return sc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sc *SettlementCreate) SetUpdateTime(t time.Time) *SettlementCreate {
+	sc.mutation.SetUpdateTime(t)
+	return sc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (sc *SettlementCreate) SetNillableUpdateTime(t *time.Time) *SettlementCreate {
+	if t != nil {
+		sc.SetUpdateTime(*t)
+	}
+	return sc
+}
+
+// SetCreditTxnID sets the "credit_txn_id" field.
+func (sc *SettlementCreate) SetCreditTxnID(i int) *SettlementCreate {
+	sc.mutation.SetCreditTxnID(i)
+	return sc
+}
+
+// SetDebitTxnID sets the "debit_txn_id" field.
+func (sc *SettlementCreate) SetDebitTxnID(i int) *SettlementCreate {
+	sc.mutation.SetDebitTxnID(i)
+	return sc
+}
+
+// SetAmount sets the "amount" field.
+func (sc *SettlementCreate) SetAmount(m money.Amount) *SettlementCreate {
+	sc.mutation.SetAmount(m)
+	return sc
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (sc *SettlementCreate) SetTimestamp(t time.Time) *SettlementCreate {
+	sc.mutation.SetTimestamp(t)
+	return sc
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (sc *SettlementCreate) SetReversedAt(t time.Time) *SettlementCreate {
+	sc.mutation.SetReversedAt(t)
+	return sc
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (sc *SettlementCreate) SetNillableReversedAt(t *time.Time) *SettlementCreate {
+	if t != nil {
+		sc.SetReversedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *SettlementCreate) SetID(i int) *SettlementCreate {
+	sc.mutation.SetID(i)
+	return sc
+}
+
+// SetCreditID sets the "credit" edge to the Transaction entity by ID.
+func (sc *SettlementCreate) SetCreditID(id int) *SettlementCreate {
+	sc.mutation.SetCreditID(id)
+	return sc
+}
+
+// SetCredit sets the "credit" edge to the Transaction entity.
+func (sc *SettlementCreate) SetCredit(t *Transaction) *SettlementCreate {
+	return sc.SetCreditID(t.ID)
+}
+
+// SetDebitID sets the "debit" edge to the Transaction entity by ID.
+func (sc *SettlementCreate) SetDebitID(id int) *SettlementCreate {
+	sc.mutation.SetDebitID(id)
+	return sc
+}
+
+// SetDebit sets the "debit" edge to the Transaction entity.
+func (sc *SettlementCreate) SetDebit(t *Transaction) *SettlementCreate {
+	return sc.SetDebitID(t.ID)
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (sc *SettlementCreate) Mutation() *SettlementMutation {
+	return sc.mutation
+}
+
+// Save creates the Settlement in the database.
+func (sc *SettlementCreate) Save(ctx context.Context) (*Settlement, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SettlementCreate) SaveX(ctx context.Context) *Settlement {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SettlementCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SettlementCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SettlementCreate) defaults() {
+	if _, ok := sc.mutation.CreateTime(); !ok {
+		v := settlement.DefaultCreateTime()
+		sc.mutation.SetCreateTime(v)
+	}
+	if _, ok := sc.mutation.UpdateTime(); !ok {
+		v := settlement.DefaultUpdateTime()
+		sc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SettlementCreate) check() error {
+	if _, ok := sc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Settlement.create_time"`)}
+	}
+	if _, ok := sc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Settlement.update_time"`)}
+	}
+	if _, ok := sc.mutation.CreditTxnID(); !ok {
+		return &ValidationError{Name: "credit_txn_id", err: errors.New(`ent: missing required field "Settlement.credit_txn_id"`)}
+	}
+	if _, ok := sc.mutation.DebitTxnID(); !ok {
+		return &ValidationError{Name: "debit_txn_id", err: errors.New(`ent: missing required field "Settlement.debit_txn_id"`)}
+	}
+	if _, ok := sc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Settlement.amount"`)}
+	}
+	if _, ok := sc.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Settlement.timestamp"`)}
+	}
+	if len(sc.mutation.CreditIDs()) == 0 {
+		return &ValidationError{Name: "credit", err: errors.New(`ent: missing required edge "Settlement.credit"`)}
+	}
+	if len(sc.mutation.DebitIDs()) == 0 {
+		return &ValidationError{Name: "debit", err: errors.New(`ent: missing required edge "Settlement.debit"`)}
+	}
+	return nil
+}
+
+func (sc *SettlementCreate) sqlSave(ctx context.Context) (*Settlement, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SettlementCreate) createSpec() (*Settlement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Settlement{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(settlement.Table, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.CreateTime(); ok {
+		_spec.SetField(settlement.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := sc.mutation.UpdateTime(); ok {
+		_spec.SetField(settlement.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := sc.mutation.Amount(); ok {
+		_spec.SetField(settlement.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := sc.mutation.Timestamp(); ok {
+		_spec.SetField(settlement.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := sc.mutation.ReversedAt(); ok {
+		_spec.SetField(settlement.FieldReversedAt, field.TypeTime, value)
+		_node.ReversedAt = &value
+	}
+	if nodes := sc.mutation.CreditIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.CreditTable,
+			Columns: []string{settlement.CreditColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreditTxnID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.DebitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   settlement.DebitTable,
+			Columns: []string{settlement.DebitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DebitTxnID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Settlement.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *SettlementCreate) OnConflict(opts ...sql.ConflictOption) *SettlementUpsertOne {
+	sc.conflict = opts
+	return &SettlementUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *SettlementCreate) OnConflictColumns(columns ...string) *SettlementUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &SettlementUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// SettlementUpsertOne is the builder for "upsert"-ing
+	//  one Settlement node.
+	SettlementUpsertOne struct {
+		create *SettlementCreate
+	}
+
+	// SettlementUpsert is the "OnConflict" setter.
+	SettlementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SettlementUpsert) SetUpdateTime(v time.Time) *SettlementUpsert {
+	u.Set(settlement.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateUpdateTime() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldUpdateTime)
+	return u
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (u *SettlementUpsert) SetReversedAt(v time.Time) *SettlementUpsert {
+	u.Set(settlement.FieldReversedAt, v)
+	return u
+}
+
+// UpdateReversedAt sets the "reversed_at" field to the value that was provided on create.
+func (u *SettlementUpsert) UpdateReversedAt() *SettlementUpsert {
+	u.SetExcluded(settlement.FieldReversedAt)
+	return u
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (u *SettlementUpsert) ClearReversedAt() *SettlementUpsert {
+	u.SetNull(settlement.FieldReversedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementUpsertOne) UpdateNewValues() *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(settlement.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(settlement.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.CreditTxnID(); exists {
+			s.SetIgnore(settlement.FieldCreditTxnID)
+		}
+		if _, exists := u.create.mutation.DebitTxnID(); exists {
+			s.SetIgnore(settlement.FieldDebitTxnID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(settlement.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Timestamp(); exists {
+			s.SetIgnore(settlement.FieldTimestamp)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SettlementUpsertOne) Ignore() *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementUpsertOne) DoNothing() *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementCreate.OnConflict
+// documentation for more info.
+func (u *SettlementUpsertOne) Update(set func(*SettlementUpsert)) *SettlementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SettlementUpsertOne) SetUpdateTime(v time.Time) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateUpdateTime() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (u *SettlementUpsertOne) SetReversedAt(v time.Time) *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetReversedAt(v)
+	})
+}
+
+// UpdateReversedAt sets the "reversed_at" field to the value that was provided on create.
+func (u *SettlementUpsertOne) UpdateReversedAt() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateReversedAt()
+	})
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (u *SettlementUpsertOne) ClearReversedAt() *SettlementUpsertOne {
+	return u.Update(func(s *SettlementUpsert) {
+		s.ClearReversedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SettlementUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SettlementUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SettlementCreateBulk is the builder for creating many Settlement entities in bulk.
+type SettlementCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Settlement entities in the database.
+func (scb *SettlementCreateBulk) Save(ctx context.Context) ([]*Settlement, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Settlement, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SettlementCreateBulk) SaveX(ctx context.Context) []*Settlement {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SettlementCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SettlementCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Settlement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SettlementUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *SettlementCreateBulk) OnConflict(opts ...sql.ConflictOption) *SettlementUpsertBulk {
+	scb.conflict = opts
+	return &SettlementUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *SettlementCreateBulk) OnConflictColumns(columns ...string) *SettlementUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &SettlementUpsertBulk{
+		create: scb,
+	}
+}
+
+// SettlementUpsertBulk is the builder for "upsert"-ing
+// a bulk of Settlement nodes.
+type SettlementUpsertBulk struct {
+	create *SettlementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(settlement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SettlementUpsertBulk) UpdateNewValues() *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(settlement.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(settlement.FieldCreateTime)
+			}
+			if _, exists := b.mutation.CreditTxnID(); exists {
+				s.SetIgnore(settlement.FieldCreditTxnID)
+			}
+			if _, exists := b.mutation.DebitTxnID(); exists {
+				s.SetIgnore(settlement.FieldDebitTxnID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(settlement.FieldAmount)
+			}
+			if _, exists := b.mutation.Timestamp(); exists {
+				s.SetIgnore(settlement.FieldTimestamp)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Settlement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SettlementUpsertBulk) Ignore() *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SettlementUpsertBulk) DoNothing() *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SettlementCreateBulk.OnConflict
+// documentation for more info.
+func (u *SettlementUpsertBulk) Update(set func(*SettlementUpsert)) *SettlementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SettlementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *SettlementUpsertBulk) SetUpdateTime(v time.Time) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateUpdateTime() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (u *SettlementUpsertBulk) SetReversedAt(v time.Time) *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.SetReversedAt(v)
+	})
+}
+
+// UpdateReversedAt sets the "reversed_at" field to the value that was provided on create.
+func (u *SettlementUpsertBulk) UpdateReversedAt() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.UpdateReversedAt()
+	})
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (u *SettlementUpsertBulk) ClearReversedAt() *SettlementUpsertBulk {
+	return u.Update(func(s *SettlementUpsert) {
+		s.ClearReversedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SettlementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SettlementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SettlementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SettlementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
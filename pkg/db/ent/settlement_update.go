@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/settlement"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SettlementUpdate is the builder for updating Settlement entities.
+type SettlementUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SettlementMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SettlementUpdate builder.
+func (su *SettlementUpdate) Where(ps ...predicate.Settlement) *SettlementUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (su *SettlementUpdate) SetUpdateTime(t time.Time) *SettlementUpdate {
+	su.mutation.SetUpdateTime(t)
+	return su
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (su *SettlementUpdate) SetReversedAt(t time.Time) *SettlementUpdate {
+	su.mutation.SetReversedAt(t)
+	return su
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (su *SettlementUpdate) SetNillableReversedAt(t *time.Time) *SettlementUpdate {
+	if t != nil {
+		su.SetReversedAt(*t)
+	}
+	return su
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (su *SettlementUpdate) ClearReversedAt() *SettlementUpdate {
+	su.mutation.ClearReversedAt()
+	return su
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (su *SettlementUpdate) Mutation() *SettlementMutation {
+	return su.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SettlementUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SettlementUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SettlementUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SettlementUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *SettlementUpdate) defaults() {
+	if _, ok := su.mutation.UpdateTime(); !ok {
+		v := settlement.UpdateDefaultUpdateTime()
+		su.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SettlementUpdate) check() error {
+	if su.mutation.CreditCleared() && len(su.mutation.CreditIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.credit"`)
+	}
+	if su.mutation.DebitCleared() && len(su.mutation.DebitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.debit"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *SettlementUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SettlementUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
+func (su *SettlementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.UpdateTime(); ok {
+		_spec.SetField(settlement.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.ReversedAt(); ok {
+		_spec.SetField(settlement.FieldReversedAt, field.TypeTime, value)
+	}
+	if su.mutation.ReversedAtCleared() {
+		_spec.ClearField(settlement.FieldReversedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(su.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SettlementUpdateOne is the builder for updating a single Settlement entity.
+type SettlementUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SettlementMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (suo *SettlementUpdateOne) SetUpdateTime(t time.Time) *SettlementUpdateOne {
+	suo.mutation.SetUpdateTime(t)
+	return suo
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (suo *SettlementUpdateOne) SetReversedAt(t time.Time) *SettlementUpdateOne {
+	suo.mutation.SetReversedAt(t)
+	return suo
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (suo *SettlementUpdateOne) SetNillableReversedAt(t *time.Time) *SettlementUpdateOne {
+	if t != nil {
+		suo.SetReversedAt(*t)
+	}
+	return suo
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (suo *SettlementUpdateOne) ClearReversedAt() *SettlementUpdateOne {
+	suo.mutation.ClearReversedAt()
+	return suo
+}
+
+// Mutation returns the SettlementMutation object of the builder.
+func (suo *SettlementUpdateOne) Mutation() *SettlementMutation {
+	return suo.mutation
+}
+
+// Where appends a list predicates to the SettlementUpdate builder.
+func (suo *SettlementUpdateOne) Where(ps ...predicate.Settlement) *SettlementUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SettlementUpdateOne) Select(field string, fields ...string) *SettlementUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Settlement entity.
+func (suo *SettlementUpdateOne) Save(ctx context.Context) (*Settlement, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SettlementUpdateOne) SaveX(ctx context.Context) *Settlement {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SettlementUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SettlementUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *SettlementUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdateTime(); !ok {
+		v := settlement.UpdateDefaultUpdateTime()
+		suo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SettlementUpdateOne) check() error {
+	if suo.mutation.CreditCleared() && len(suo.mutation.CreditIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.credit"`)
+	}
+	if suo.mutation.DebitCleared() && len(suo.mutation.DebitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Settlement.debit"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *SettlementUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SettlementUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
+func (suo *SettlementUpdateOne) sqlSave(ctx context.Context) (_node *Settlement, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlement.Table, settlement.Columns, sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Settlement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlement.FieldID)
+		for _, f := range fields {
+			if !settlement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.UpdateTime(); ok {
+		_spec.SetField(settlement.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.ReversedAt(); ok {
+		_spec.SetField(settlement.FieldReversedAt, field.TypeTime, value)
+	}
+	if suo.mutation.ReversedAtCleared() {
+		_spec.ClearField(settlement.FieldReversedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(suo.modifiers...)
+	_node = &Settlement{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
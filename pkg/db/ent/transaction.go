@@ -3,12 +3,18 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/invoicepayment"
 	"transactor-server/pkg/db/ent/operationtype"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -26,13 +32,43 @@ type Transaction struct {
 	// AccountID holds the value of the "account_id" field.
 	AccountID int `json:"account_id,omitempty"`
 	// Amount holds the value of the "amount" field.
-	Amount float64 `json:"amount,omitempty"`
+	Amount money.Amount `json:"amount,omitempty"`
 	// Balance holds the value of the "balance" field.
-	Balance float64 `json:"balance,omitempty"`
+	Balance money.Amount `json:"balance,omitempty"`
 	// OperationTypeID holds the value of the "operation_type_id" field.
 	OperationTypeID int `json:"operation_type_id,omitempty"`
 	// Timestamp holds the value of the "timestamp" field.
 	Timestamp time.Time `json:"timestamp,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *int `json:"parent_id,omitempty"`
+	// InstallmentNumber holds the value of the "installment_number" field.
+	InstallmentNumber *int `json:"installment_number,omitempty"`
+	// DueDate holds the value of the "due_date" field.
+	DueDate *time.Time `json:"due_date,omitempty"`
+	// Status holds the value of the "status" field.
+	Status transaction.Status `json:"status,omitempty"`
+	// ReversalOfID holds the value of the "reversal_of_id" field.
+	ReversalOfID *int `json:"reversal_of_id,omitempty"`
+	// TransferID holds the value of the "transfer_id" field.
+	TransferID *int `json:"transfer_id,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// MerchantName holds the value of the "merchant_name" field.
+	MerchantName *string `json:"merchant_name,omitempty"`
+	// Mcc holds the value of the "mcc" field.
+	Mcc *string `json:"mcc,omitempty"`
+	// ExternalReference holds the value of the "external_reference" field.
+	ExternalReference *string `json:"external_reference,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// OriginalAmount holds the value of the "original_amount" field.
+	OriginalAmount *money.Amount `json:"original_amount,omitempty"`
+	// OriginalCurrency holds the value of the "original_currency" field.
+	OriginalCurrency *string `json:"original_currency,omitempty"`
+	// FxRate holds the value of the "fx_rate" field.
+	FxRate *string `json:"fx_rate,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TransactionQuery when eager-loading is set.
 	Edges        TransactionEdges `json:"edges"`
@@ -45,9 +81,31 @@ type TransactionEdges struct {
 	Account *Account `json:"account,omitempty"`
 	// OperationType holds the value of the operation_type edge.
 	OperationType *OperationType `json:"operation_type,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Transaction `json:"parent,omitempty"`
+	// Installments holds the value of the installments edge.
+	Installments []*Transaction `json:"installments,omitempty"`
+	// ReversalOf holds the value of the reversal_of edge.
+	ReversalOf *Transaction `json:"reversal_of,omitempty"`
+	// Reversal holds the value of the reversal edge.
+	Reversal []*Transaction `json:"reversal,omitempty"`
+	// Transfer holds the value of the transfer edge.
+	Transfer *Transfer `json:"transfer,omitempty"`
+	// Hold holds the value of the hold edge.
+	Hold *Hold `json:"hold,omitempty"`
+	// Accrual holds the value of the accrual edge.
+	Accrual *Accrual `json:"accrual,omitempty"`
+	// CreditSettlements holds the value of the credit_settlements edge.
+	CreditSettlements []*Settlement `json:"credit_settlements,omitempty"`
+	// DebitSettlements holds the value of the debit_settlements edge.
+	DebitSettlements []*Settlement `json:"debit_settlements,omitempty"`
+	// InvoicePayment holds the value of the invoice_payment edge.
+	InvoicePayment *InvoicePayment `json:"invoice_payment,omitempty"`
+	// JournalEntries holds the value of the journal_entries edge.
+	JournalEntries []*JournalEntry `json:"journal_entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [13]bool
 }
 
 // AccountOrErr returns the Account value or an error if the edge
@@ -72,16 +130,129 @@ func (e TransactionEdges) OperationTypeOrErr() (*OperationType, error) {
 	return nil, &NotLoadedError{edge: "operation_type"}
 }
 
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) ParentOrErr() (*Transaction, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// InstallmentsOrErr returns the Installments value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) InstallmentsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[3] {
+		return e.Installments, nil
+	}
+	return nil, &NotLoadedError{edge: "installments"}
+}
+
+// ReversalOfOrErr returns the ReversalOf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) ReversalOfOrErr() (*Transaction, error) {
+	if e.ReversalOf != nil {
+		return e.ReversalOf, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: transaction.Label}
+	}
+	return nil, &NotLoadedError{edge: "reversal_of"}
+}
+
+// ReversalOrErr returns the Reversal value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) ReversalOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[5] {
+		return e.Reversal, nil
+	}
+	return nil, &NotLoadedError{edge: "reversal"}
+}
+
+// TransferOrErr returns the Transfer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) TransferOrErr() (*Transfer, error) {
+	if e.Transfer != nil {
+		return e.Transfer, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: transfer.Label}
+	}
+	return nil, &NotLoadedError{edge: "transfer"}
+}
+
+// HoldOrErr returns the Hold value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) HoldOrErr() (*Hold, error) {
+	if e.Hold != nil {
+		return e.Hold, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: hold.Label}
+	}
+	return nil, &NotLoadedError{edge: "hold"}
+}
+
+// AccrualOrErr returns the Accrual value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) AccrualOrErr() (*Accrual, error) {
+	if e.Accrual != nil {
+		return e.Accrual, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: accrual.Label}
+	}
+	return nil, &NotLoadedError{edge: "accrual"}
+}
+
+// CreditSettlementsOrErr returns the CreditSettlements value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) CreditSettlementsOrErr() ([]*Settlement, error) {
+	if e.loadedTypes[9] {
+		return e.CreditSettlements, nil
+	}
+	return nil, &NotLoadedError{edge: "credit_settlements"}
+}
+
+// DebitSettlementsOrErr returns the DebitSettlements value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) DebitSettlementsOrErr() ([]*Settlement, error) {
+	if e.loadedTypes[10] {
+		return e.DebitSettlements, nil
+	}
+	return nil, &NotLoadedError{edge: "debit_settlements"}
+}
+
+// InvoicePaymentOrErr returns the InvoicePayment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransactionEdges) InvoicePaymentOrErr() (*InvoicePayment, error) {
+	if e.InvoicePayment != nil {
+		return e.InvoicePayment, nil
+	} else if e.loadedTypes[11] {
+		return nil, &NotFoundError{label: invoicepayment.Label}
+	}
+	return nil, &NotLoadedError{edge: "invoice_payment"}
+}
+
+// JournalEntriesOrErr returns the JournalEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransactionEdges) JournalEntriesOrErr() ([]*JournalEntry, error) {
+	if e.loadedTypes[12] {
+		return e.JournalEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "journal_entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transaction.FieldAmount, transaction.FieldBalance:
-			values[i] = new(sql.NullFloat64)
-		case transaction.FieldID, transaction.FieldAccountID, transaction.FieldOperationTypeID:
+		case transaction.FieldMetadata:
+			values[i] = new([]byte)
+		case transaction.FieldID, transaction.FieldAccountID, transaction.FieldAmount, transaction.FieldBalance, transaction.FieldOperationTypeID, transaction.FieldParentID, transaction.FieldInstallmentNumber, transaction.FieldReversalOfID, transaction.FieldTransferID, transaction.FieldOriginalAmount:
 			values[i] = new(sql.NullInt64)
-		case transaction.FieldCreateTime, transaction.FieldUpdateTime, transaction.FieldTimestamp:
+		case transaction.FieldStatus, transaction.FieldDescription, transaction.FieldMerchantName, transaction.FieldMcc, transaction.FieldExternalReference, transaction.FieldCurrency, transaction.FieldOriginalCurrency, transaction.FieldFxRate:
+			values[i] = new(sql.NullString)
+		case transaction.FieldCreateTime, transaction.FieldUpdateTime, transaction.FieldTimestamp, transaction.FieldDueDate:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -123,16 +294,16 @@ func (t *Transaction) assignValues(columns []string, values []any) error {
 				t.AccountID = int(value.Int64)
 			}
 		case transaction.FieldAmount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount", values[i])
 			} else if value.Valid {
-				t.Amount = value.Float64
+				t.Amount = money.Amount(value.Int64)
 			}
 		case transaction.FieldBalance:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field balance", values[i])
 			} else if value.Valid {
-				t.Balance = value.Float64
+				t.Balance = money.Amount(value.Int64)
 			}
 		case transaction.FieldOperationTypeID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -146,6 +317,110 @@ func (t *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Timestamp = value.Time
 			}
+		case transaction.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				t.ParentID = new(int)
+				*t.ParentID = int(value.Int64)
+			}
+		case transaction.FieldInstallmentNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field installment_number", values[i])
+			} else if value.Valid {
+				t.InstallmentNumber = new(int)
+				*t.InstallmentNumber = int(value.Int64)
+			}
+		case transaction.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				t.DueDate = new(time.Time)
+				*t.DueDate = value.Time
+			}
+		case transaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				t.Status = transaction.Status(value.String)
+			}
+		case transaction.FieldReversalOfID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reversal_of_id", values[i])
+			} else if value.Valid {
+				t.ReversalOfID = new(int)
+				*t.ReversalOfID = int(value.Int64)
+			}
+		case transaction.FieldTransferID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_id", values[i])
+			} else if value.Valid {
+				t.TransferID = new(int)
+				*t.TransferID = int(value.Int64)
+			}
+		case transaction.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				t.Description = new(string)
+				*t.Description = value.String
+			}
+		case transaction.FieldMerchantName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant_name", values[i])
+			} else if value.Valid {
+				t.MerchantName = new(string)
+				*t.MerchantName = value.String
+			}
+		case transaction.FieldMcc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mcc", values[i])
+			} else if value.Valid {
+				t.Mcc = new(string)
+				*t.Mcc = value.String
+			}
+		case transaction.FieldExternalReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_reference", values[i])
+			} else if value.Valid {
+				t.ExternalReference = new(string)
+				*t.ExternalReference = value.String
+			}
+		case transaction.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case transaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				t.Currency = value.String
+			}
+		case transaction.FieldOriginalAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_amount", values[i])
+			} else if value.Valid {
+				t.OriginalAmount = new(money.Amount)
+				*t.OriginalAmount = money.Amount(value.Int64)
+			}
+		case transaction.FieldOriginalCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_currency", values[i])
+			} else if value.Valid {
+				t.OriginalCurrency = new(string)
+				*t.OriginalCurrency = value.String
+			}
+		case transaction.FieldFxRate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_rate", values[i])
+			} else if value.Valid {
+				t.FxRate = new(string)
+				*t.FxRate = value.String
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -169,6 +444,61 @@ func (t *Transaction) QueryOperationType() *OperationTypeQuery {
 	return NewTransactionClient(t.config).QueryOperationType(t)
 }
 
+// QueryParent queries the "parent" edge of the Transaction entity.
+func (t *Transaction) QueryParent() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryParent(t)
+}
+
+// QueryInstallments queries the "installments" edge of the Transaction entity.
+func (t *Transaction) QueryInstallments() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryInstallments(t)
+}
+
+// QueryReversalOf queries the "reversal_of" edge of the Transaction entity.
+func (t *Transaction) QueryReversalOf() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryReversalOf(t)
+}
+
+// QueryReversal queries the "reversal" edge of the Transaction entity.
+func (t *Transaction) QueryReversal() *TransactionQuery {
+	return NewTransactionClient(t.config).QueryReversal(t)
+}
+
+// QueryTransfer queries the "transfer" edge of the Transaction entity.
+func (t *Transaction) QueryTransfer() *TransferQuery {
+	return NewTransactionClient(t.config).QueryTransfer(t)
+}
+
+// QueryHold queries the "hold" edge of the Transaction entity.
+func (t *Transaction) QueryHold() *HoldQuery {
+	return NewTransactionClient(t.config).QueryHold(t)
+}
+
+// QueryAccrual queries the "accrual" edge of the Transaction entity.
+func (t *Transaction) QueryAccrual() *AccrualQuery {
+	return NewTransactionClient(t.config).QueryAccrual(t)
+}
+
+// QueryCreditSettlements queries the "credit_settlements" edge of the Transaction entity.
+func (t *Transaction) QueryCreditSettlements() *SettlementQuery {
+	return NewTransactionClient(t.config).QueryCreditSettlements(t)
+}
+
+// QueryDebitSettlements queries the "debit_settlements" edge of the Transaction entity.
+func (t *Transaction) QueryDebitSettlements() *SettlementQuery {
+	return NewTransactionClient(t.config).QueryDebitSettlements(t)
+}
+
+// QueryInvoicePayment queries the "invoice_payment" edge of the Transaction entity.
+func (t *Transaction) QueryInvoicePayment() *InvoicePaymentQuery {
+	return NewTransactionClient(t.config).QueryInvoicePayment(t)
+}
+
+// QueryJournalEntries queries the "journal_entries" edge of the Transaction entity.
+func (t *Transaction) QueryJournalEntries() *JournalEntryQuery {
+	return NewTransactionClient(t.config).QueryJournalEntries(t)
+}
+
 // Update returns a builder for updating this Transaction.
 // Note that you need to call Transaction.Unwrap() before calling this method if this Transaction
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -212,6 +542,75 @@ func (t *Transaction) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("timestamp=")
 	builder.WriteString(t.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := t.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.InstallmentNumber; v != nil {
+		builder.WriteString("installment_number=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.DueDate; v != nil {
+		builder.WriteString("due_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", t.Status))
+	builder.WriteString(", ")
+	if v := t.ReversalOfID; v != nil {
+		builder.WriteString("reversal_of_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.TransferID; v != nil {
+		builder.WriteString("transfer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.MerchantName; v != nil {
+		builder.WriteString("merchant_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.Mcc; v != nil {
+		builder.WriteString("mcc=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.ExternalReference; v != nil {
+		builder.WriteString("external_reference=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", t.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(t.Currency)
+	builder.WriteString(", ")
+	if v := t.OriginalAmount; v != nil {
+		builder.WriteString("original_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := t.OriginalCurrency; v != nil {
+		builder.WriteString("original_currency=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.FxRate; v != nil {
+		builder.WriteString("fx_rate=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
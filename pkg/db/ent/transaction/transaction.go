@@ -3,7 +3,9 @@
 package transaction
 
 import (
+	"fmt"
 	"time"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -28,10 +30,62 @@ const (
 	FieldOperationTypeID = "operation_type_id"
 	// FieldTimestamp holds the string denoting the timestamp field in the database.
 	FieldTimestamp = "timestamp"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldInstallmentNumber holds the string denoting the installment_number field in the database.
+	FieldInstallmentNumber = "installment_number"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReversalOfID holds the string denoting the reversal_of_id field in the database.
+	FieldReversalOfID = "reversal_of_id"
+	// FieldTransferID holds the string denoting the transfer_id field in the database.
+	FieldTransferID = "transfer_id"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldMerchantName holds the string denoting the merchant_name field in the database.
+	FieldMerchantName = "merchant_name"
+	// FieldMcc holds the string denoting the mcc field in the database.
+	FieldMcc = "mcc"
+	// FieldExternalReference holds the string denoting the external_reference field in the database.
+	FieldExternalReference = "external_reference"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldOriginalAmount holds the string denoting the original_amount field in the database.
+	FieldOriginalAmount = "original_amount"
+	// FieldOriginalCurrency holds the string denoting the original_currency field in the database.
+	FieldOriginalCurrency = "original_currency"
+	// FieldFxRate holds the string denoting the fx_rate field in the database.
+	FieldFxRate = "fx_rate"
 	// EdgeAccount holds the string denoting the account edge name in mutations.
 	EdgeAccount = "account"
 	// EdgeOperationType holds the string denoting the operation_type edge name in mutations.
 	EdgeOperationType = "operation_type"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeInstallments holds the string denoting the installments edge name in mutations.
+	EdgeInstallments = "installments"
+	// EdgeReversalOf holds the string denoting the reversal_of edge name in mutations.
+	EdgeReversalOf = "reversal_of"
+	// EdgeReversal holds the string denoting the reversal edge name in mutations.
+	EdgeReversal = "reversal"
+	// EdgeTransfer holds the string denoting the transfer edge name in mutations.
+	EdgeTransfer = "transfer"
+	// EdgeHold holds the string denoting the hold edge name in mutations.
+	EdgeHold = "hold"
+	// EdgeAccrual holds the string denoting the accrual edge name in mutations.
+	EdgeAccrual = "accrual"
+	// EdgeCreditSettlements holds the string denoting the credit_settlements edge name in mutations.
+	EdgeCreditSettlements = "credit_settlements"
+	// EdgeDebitSettlements holds the string denoting the debit_settlements edge name in mutations.
+	EdgeDebitSettlements = "debit_settlements"
+	// EdgeInvoicePayment holds the string denoting the invoice_payment edge name in mutations.
+	EdgeInvoicePayment = "invoice_payment"
+	// EdgeJournalEntries holds the string denoting the journal_entries edge name in mutations.
+	EdgeJournalEntries = "journal_entries"
 	// Table holds the table name of the transaction in the database.
 	Table = "transactions"
 	// AccountTable is the table that holds the account relation/edge.
@@ -48,6 +102,71 @@ const (
 	OperationTypeInverseTable = "operation_types"
 	// OperationTypeColumn is the table column denoting the operation_type relation/edge.
 	OperationTypeColumn = "operation_type_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "transactions"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// InstallmentsTable is the table that holds the installments relation/edge.
+	InstallmentsTable = "transactions"
+	// InstallmentsColumn is the table column denoting the installments relation/edge.
+	InstallmentsColumn = "parent_id"
+	// ReversalOfTable is the table that holds the reversal_of relation/edge.
+	ReversalOfTable = "transactions"
+	// ReversalOfColumn is the table column denoting the reversal_of relation/edge.
+	ReversalOfColumn = "reversal_of_id"
+	// ReversalTable is the table that holds the reversal relation/edge.
+	ReversalTable = "transactions"
+	// ReversalColumn is the table column denoting the reversal relation/edge.
+	ReversalColumn = "reversal_of_id"
+	// TransferTable is the table that holds the transfer relation/edge.
+	TransferTable = "transactions"
+	// TransferInverseTable is the table name for the Transfer entity.
+	// It exists in this package in order to avoid circular dependency with the "transfer" package.
+	TransferInverseTable = "transfers"
+	// TransferColumn is the table column denoting the transfer relation/edge.
+	TransferColumn = "transfer_id"
+	// HoldTable is the table that holds the hold relation/edge.
+	HoldTable = "holds"
+	// HoldInverseTable is the table name for the Hold entity.
+	// It exists in this package in order to avoid circular dependency with the "hold" package.
+	HoldInverseTable = "holds"
+	// HoldColumn is the table column denoting the hold relation/edge.
+	HoldColumn = "transaction_id"
+	// AccrualTable is the table that holds the accrual relation/edge.
+	AccrualTable = "accruals"
+	// AccrualInverseTable is the table name for the Accrual entity.
+	// It exists in this package in order to avoid circular dependency with the "accrual" package.
+	AccrualInverseTable = "accruals"
+	// AccrualColumn is the table column denoting the accrual relation/edge.
+	AccrualColumn = "transaction_id"
+	// CreditSettlementsTable is the table that holds the credit_settlements relation/edge.
+	CreditSettlementsTable = "settlements"
+	// CreditSettlementsInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	CreditSettlementsInverseTable = "settlements"
+	// CreditSettlementsColumn is the table column denoting the credit_settlements relation/edge.
+	CreditSettlementsColumn = "credit_txn_id"
+	// DebitSettlementsTable is the table that holds the debit_settlements relation/edge.
+	DebitSettlementsTable = "settlements"
+	// DebitSettlementsInverseTable is the table name for the Settlement entity.
+	// It exists in this package in order to avoid circular dependency with the "settlement" package.
+	DebitSettlementsInverseTable = "settlements"
+	// DebitSettlementsColumn is the table column denoting the debit_settlements relation/edge.
+	DebitSettlementsColumn = "debit_txn_id"
+	// InvoicePaymentTable is the table that holds the invoice_payment relation/edge.
+	InvoicePaymentTable = "invoice_payments"
+	// InvoicePaymentInverseTable is the table name for the InvoicePayment entity.
+	// It exists in this package in order to avoid circular dependency with the "invoicepayment" package.
+	InvoicePaymentInverseTable = "invoice_payments"
+	// InvoicePaymentColumn is the table column denoting the invoice_payment relation/edge.
+	InvoicePaymentColumn = "transaction_id"
+	// JournalEntriesTable is the table that holds the journal_entries relation/edge.
+	JournalEntriesTable = "journal_entries"
+	// JournalEntriesInverseTable is the table name for the JournalEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "journalentry" package.
+	JournalEntriesInverseTable = "journal_entries"
+	// JournalEntriesColumn is the table column denoting the journal_entries relation/edge.
+	JournalEntriesColumn = "transaction_id"
 )
 
 // Columns holds all SQL columns for transaction fields.
@@ -60,6 +179,21 @@ var Columns = []string{
 	FieldBalance,
 	FieldOperationTypeID,
 	FieldTimestamp,
+	FieldParentID,
+	FieldInstallmentNumber,
+	FieldDueDate,
+	FieldStatus,
+	FieldReversalOfID,
+	FieldTransferID,
+	FieldDescription,
+	FieldMerchantName,
+	FieldMcc,
+	FieldExternalReference,
+	FieldMetadata,
+	FieldCurrency,
+	FieldOriginalAmount,
+	FieldOriginalCurrency,
+	FieldFxRate,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -79,8 +213,52 @@ var (
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultBalance holds the default value on creation for the "balance" field.
+	DefaultBalance money.Amount
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// MerchantNameValidator is a validator for the "merchant_name" field. It is called by the builders before save.
+	MerchantNameValidator func(string) error
+	// MccValidator is a validator for the "mcc" field. It is called by the builders before save.
+	MccValidator func(string) error
+	// ExternalReferenceValidator is a validator for the "external_reference" field. It is called by the builders before save.
+	ExternalReferenceValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// OriginalCurrencyValidator is a validator for the "original_currency" field. It is called by the builders before save.
+	OriginalCurrencyValidator func(string) error
+	// FxRateValidator is a validator for the "fx_rate" field. It is called by the builders before save.
+	FxRateValidator func(string) error
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPosted is the default value of the Status enum.
+const DefaultStatus = StatusPosted
+
+// Status values.
+const (
+	StatusPosted   Status = "posted"
+	StatusReversed Status = "reversed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPosted, StatusReversed:
+		return nil
+	default:
+		return fmt.Errorf("transaction: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Transaction queries.
 type OrderOption func(*sql.Selector)
 
@@ -124,6 +302,76 @@ func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
 }
 
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// ByInstallmentNumber orders the results by the installment_number field.
+func ByInstallmentNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstallmentNumber, opts...).ToFunc()
+}
+
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReversalOfID orders the results by the reversal_of_id field.
+func ByReversalOfID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReversalOfID, opts...).ToFunc()
+}
+
+// ByTransferID orders the results by the transfer_id field.
+func ByTransferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferID, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByMerchantName orders the results by the merchant_name field.
+func ByMerchantName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMerchantName, opts...).ToFunc()
+}
+
+// ByMcc orders the results by the mcc field.
+func ByMcc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMcc, opts...).ToFunc()
+}
+
+// ByExternalReference orders the results by the external_reference field.
+func ByExternalReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalReference, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByOriginalAmount orders the results by the original_amount field.
+func ByOriginalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalAmount, opts...).ToFunc()
+}
+
+// ByOriginalCurrency orders the results by the original_currency field.
+func ByOriginalCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalCurrency, opts...).ToFunc()
+}
+
+// ByFxRate orders the results by the fx_rate field.
+func ByFxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxRate, opts...).ToFunc()
+}
+
 // ByAccountField orders the results by account field.
 func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -137,6 +385,118 @@ func ByOperationTypeField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newOperationTypeStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInstallmentsCount orders the results by installments count.
+func ByInstallmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInstallmentsStep(), opts...)
+	}
+}
+
+// ByInstallments orders the results by installments terms.
+func ByInstallments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInstallmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReversalOfField orders the results by reversal_of field.
+func ByReversalOfField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReversalOfStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReversalCount orders the results by reversal count.
+func ByReversalCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReversalStep(), opts...)
+	}
+}
+
+// ByReversal orders the results by reversal terms.
+func ByReversal(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReversalStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTransferField orders the results by transfer field.
+func ByTransferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransferStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHoldField orders the results by hold field.
+func ByHoldField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHoldStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAccrualField orders the results by accrual field.
+func ByAccrualField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccrualStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCreditSettlementsCount orders the results by credit_settlements count.
+func ByCreditSettlementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCreditSettlementsStep(), opts...)
+	}
+}
+
+// ByCreditSettlements orders the results by credit_settlements terms.
+func ByCreditSettlements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCreditSettlementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDebitSettlementsCount orders the results by debit_settlements count.
+func ByDebitSettlementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDebitSettlementsStep(), opts...)
+	}
+}
+
+// ByDebitSettlements orders the results by debit_settlements terms.
+func ByDebitSettlements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDebitSettlementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvoicePaymentField orders the results by invoice_payment field.
+func ByInvoicePaymentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvoicePaymentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByJournalEntriesCount orders the results by journal_entries count.
+func ByJournalEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJournalEntriesStep(), opts...)
+	}
+}
+
+// ByJournalEntries orders the results by journal_entries terms.
+func ByJournalEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJournalEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAccountStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -151,3 +511,80 @@ func newOperationTypeStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, OperationTypeTable, OperationTypeColumn),
 	)
 }
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newInstallmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InstallmentsTable, InstallmentsColumn),
+	)
+}
+func newReversalOfStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReversalOfTable, ReversalOfColumn),
+	)
+}
+func newReversalStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReversalTable, ReversalColumn),
+	)
+}
+func newTransferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TransferTable, TransferColumn),
+	)
+}
+func newHoldStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HoldInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, HoldTable, HoldColumn),
+	)
+}
+func newAccrualStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccrualInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AccrualTable, AccrualColumn),
+	)
+}
+func newCreditSettlementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CreditSettlementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CreditSettlementsTable, CreditSettlementsColumn),
+	)
+}
+func newDebitSettlementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DebitSettlementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DebitSettlementsTable, DebitSettlementsColumn),
+	)
+}
+func newInvoicePaymentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvoicePaymentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, InvoicePaymentTable, InvoicePaymentColumn),
+	)
+}
+func newJournalEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JournalEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JournalEntriesTable, JournalEntriesColumn),
+	)
+}
@@ -5,6 +5,7 @@ package transaction
 import (
 	"time"
 	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -71,13 +72,15 @@ func AccountID(v int) predicate.Transaction {
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
-func Amount(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
+func Amount(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldEQ(FieldAmount, vc))
 }
 
 // Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
-func Balance(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEQ(FieldBalance, v))
+func Balance(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldEQ(FieldBalance, vc))
 }
 
 // OperationTypeID applies equality check predicate on the "operation_type_id" field. It's identical to OperationTypeIDEQ.
@@ -90,6 +93,72 @@ func Timestamp(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldTimestamp, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldParentID, v))
+}
+
+// InstallmentNumber applies equality check predicate on the "installment_number" field. It's identical to InstallmentNumberEQ.
+func InstallmentNumber(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInstallmentNumber, v))
+}
+
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDueDate, v))
+}
+
+// ReversalOfID applies equality check predicate on the "reversal_of_id" field. It's identical to ReversalOfIDEQ.
+func ReversalOfID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldReversalOfID, v))
+}
+
+// TransferID applies equality check predicate on the "transfer_id" field. It's identical to TransferIDEQ.
+func TransferID(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// MerchantName applies equality check predicate on the "merchant_name" field. It's identical to MerchantNameEQ.
+func MerchantName(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMerchantName, v))
+}
+
+// Mcc applies equality check predicate on the "mcc" field. It's identical to MccEQ.
+func Mcc(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMcc, v))
+}
+
+// ExternalReference applies equality check predicate on the "external_reference" field. It's identical to ExternalReferenceEQ.
+func ExternalReference(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalReference, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// OriginalAmount applies equality check predicate on the "original_amount" field. It's identical to OriginalAmountEQ.
+func OriginalAmount(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalAmount, vc))
+}
+
+// OriginalCurrency applies equality check predicate on the "original_currency" field. It's identical to OriginalCurrencyEQ.
+func OriginalCurrency(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalCurrency, v))
+}
+
+// FxRate applies equality check predicate on the "fx_rate" field. It's identical to FxRateEQ.
+func FxRate(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFxRate, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreateTime, v))
@@ -191,83 +260,111 @@ func AccountIDNotIn(vs ...int) predicate.Transaction {
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
-func AmountEQ(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
+func AmountEQ(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldEQ(FieldAmount, vc))
 }
 
 // AmountNEQ applies the NEQ predicate on the "amount" field.
-func AmountNEQ(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNEQ(FieldAmount, v))
+func AmountNEQ(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldNEQ(FieldAmount, vc))
 }
 
 // AmountIn applies the In predicate on the "amount" field.
-func AmountIn(vs ...float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldIn(FieldAmount, vs...))
+func AmountIn(vs ...money.Amount) predicate.Transaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transaction(sql.FieldIn(FieldAmount, v...))
 }
 
 // AmountNotIn applies the NotIn predicate on the "amount" field.
-func AmountNotIn(vs ...float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNotIn(FieldAmount, vs...))
+func AmountNotIn(vs ...money.Amount) predicate.Transaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transaction(sql.FieldNotIn(FieldAmount, v...))
 }
 
 // AmountGT applies the GT predicate on the "amount" field.
-func AmountGT(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGT(FieldAmount, v))
+func AmountGT(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldGT(FieldAmount, vc))
 }
 
 // AmountGTE applies the GTE predicate on the "amount" field.
-func AmountGTE(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGTE(FieldAmount, v))
+func AmountGTE(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldGTE(FieldAmount, vc))
 }
 
 // AmountLT applies the LT predicate on the "amount" field.
-func AmountLT(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLT(FieldAmount, v))
+func AmountLT(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldLT(FieldAmount, vc))
 }
 
 // AmountLTE applies the LTE predicate on the "amount" field.
-func AmountLTE(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLTE(FieldAmount, v))
+func AmountLTE(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldLTE(FieldAmount, vc))
 }
 
 // BalanceEQ applies the EQ predicate on the "balance" field.
-func BalanceEQ(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEQ(FieldBalance, v))
+func BalanceEQ(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldEQ(FieldBalance, vc))
 }
 
 // BalanceNEQ applies the NEQ predicate on the "balance" field.
-func BalanceNEQ(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNEQ(FieldBalance, v))
+func BalanceNEQ(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldNEQ(FieldBalance, vc))
 }
 
 // BalanceIn applies the In predicate on the "balance" field.
-func BalanceIn(vs ...float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldIn(FieldBalance, vs...))
+func BalanceIn(vs ...money.Amount) predicate.Transaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transaction(sql.FieldIn(FieldBalance, v...))
 }
 
 // BalanceNotIn applies the NotIn predicate on the "balance" field.
-func BalanceNotIn(vs ...float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNotIn(FieldBalance, vs...))
+func BalanceNotIn(vs ...money.Amount) predicate.Transaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transaction(sql.FieldNotIn(FieldBalance, v...))
 }
 
 // BalanceGT applies the GT predicate on the "balance" field.
-func BalanceGT(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGT(FieldBalance, v))
+func BalanceGT(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldGT(FieldBalance, vc))
 }
 
 // BalanceGTE applies the GTE predicate on the "balance" field.
-func BalanceGTE(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGTE(FieldBalance, v))
+func BalanceGTE(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldGTE(FieldBalance, vc))
 }
 
 // BalanceLT applies the LT predicate on the "balance" field.
-func BalanceLT(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLT(FieldBalance, v))
+func BalanceLT(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldLT(FieldBalance, vc))
 }
 
 // BalanceLTE applies the LTE predicate on the "balance" field.
-func BalanceLTE(v float64) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLTE(FieldBalance, v))
+func BalanceLTE(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldLTE(FieldBalance, vc))
 }
 
 // OperationTypeIDEQ applies the EQ predicate on the "operation_type_id" field.
@@ -330,44 +427,1096 @@ func TimestampLTE(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldTimestamp, v))
 }
 
-// HasAccount applies the HasEdge predicate on the "account" edge.
-func HasAccount() predicate.Transaction {
-	return predicate.Transaction(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldParentID, v))
 }
 
-// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
-func HasAccountWith(preds ...predicate.Account) predicate.Transaction {
-	return predicate.Transaction(func(s *sql.Selector) {
-		step := newAccountStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldParentID, v))
 }
 
-// HasOperationType applies the HasEdge predicate on the "operation_type" edge.
-func HasOperationType() predicate.Transaction {
-	return predicate.Transaction(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, OperationTypeTable, OperationTypeColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldParentID, vs...))
 }
 
-// HasOperationTypeWith applies the HasEdge predicate on the "operation_type" edge with a given conditions (other predicates).
-func HasOperationTypeWith(preds ...predicate.OperationType) predicate.Transaction {
-	return predicate.Transaction(func(s *sql.Selector) {
-		step := newOperationTypeStep()
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldParentID))
+}
+
+// InstallmentNumberEQ applies the EQ predicate on the "installment_number" field.
+func InstallmentNumberEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberNEQ applies the NEQ predicate on the "installment_number" field.
+func InstallmentNumberNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberIn applies the In predicate on the "installment_number" field.
+func InstallmentNumberIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldInstallmentNumber, vs...))
+}
+
+// InstallmentNumberNotIn applies the NotIn predicate on the "installment_number" field.
+func InstallmentNumberNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldInstallmentNumber, vs...))
+}
+
+// InstallmentNumberGT applies the GT predicate on the "installment_number" field.
+func InstallmentNumberGT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberGTE applies the GTE predicate on the "installment_number" field.
+func InstallmentNumberGTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberLT applies the LT predicate on the "installment_number" field.
+func InstallmentNumberLT(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberLTE applies the LTE predicate on the "installment_number" field.
+func InstallmentNumberLTE(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldInstallmentNumber, v))
+}
+
+// InstallmentNumberIsNil applies the IsNil predicate on the "installment_number" field.
+func InstallmentNumberIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldInstallmentNumber))
+}
+
+// InstallmentNumberNotNil applies the NotNil predicate on the "installment_number" field.
+func InstallmentNumberNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldInstallmentNumber))
+}
+
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldDueDate, v))
+}
+
+// DueDateIsNil applies the IsNil predicate on the "due_date" field.
+func DueDateIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldDueDate))
+}
+
+// DueDateNotNil applies the NotNil predicate on the "due_date" field.
+func DueDateNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldDueDate))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReversalOfIDEQ applies the EQ predicate on the "reversal_of_id" field.
+func ReversalOfIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldReversalOfID, v))
+}
+
+// ReversalOfIDNEQ applies the NEQ predicate on the "reversal_of_id" field.
+func ReversalOfIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldReversalOfID, v))
+}
+
+// ReversalOfIDIn applies the In predicate on the "reversal_of_id" field.
+func ReversalOfIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldReversalOfID, vs...))
+}
+
+// ReversalOfIDNotIn applies the NotIn predicate on the "reversal_of_id" field.
+func ReversalOfIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldReversalOfID, vs...))
+}
+
+// ReversalOfIDIsNil applies the IsNil predicate on the "reversal_of_id" field.
+func ReversalOfIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldReversalOfID))
+}
+
+// ReversalOfIDNotNil applies the NotNil predicate on the "reversal_of_id" field.
+func ReversalOfIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldReversalOfID))
+}
+
+// TransferIDEQ applies the EQ predicate on the "transfer_id" field.
+func TransferIDEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferID, v))
+}
+
+// TransferIDNEQ applies the NEQ predicate on the "transfer_id" field.
+func TransferIDNEQ(v int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldTransferID, v))
+}
+
+// TransferIDIn applies the In predicate on the "transfer_id" field.
+func TransferIDIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldTransferID, vs...))
+}
+
+// TransferIDNotIn applies the NotIn predicate on the "transfer_id" field.
+func TransferIDNotIn(vs ...int) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldTransferID, vs...))
+}
+
+// TransferIDIsNil applies the IsNil predicate on the "transfer_id" field.
+func TransferIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldTransferID))
+}
+
+// TransferIDNotNil applies the NotNil predicate on the "transfer_id" field.
+func TransferIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldTransferID))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// MerchantNameEQ applies the EQ predicate on the "merchant_name" field.
+func MerchantNameEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMerchantName, v))
+}
+
+// MerchantNameNEQ applies the NEQ predicate on the "merchant_name" field.
+func MerchantNameNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldMerchantName, v))
+}
+
+// MerchantNameIn applies the In predicate on the "merchant_name" field.
+func MerchantNameIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldMerchantName, vs...))
+}
+
+// MerchantNameNotIn applies the NotIn predicate on the "merchant_name" field.
+func MerchantNameNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldMerchantName, vs...))
+}
+
+// MerchantNameGT applies the GT predicate on the "merchant_name" field.
+func MerchantNameGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldMerchantName, v))
+}
+
+// MerchantNameGTE applies the GTE predicate on the "merchant_name" field.
+func MerchantNameGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldMerchantName, v))
+}
+
+// MerchantNameLT applies the LT predicate on the "merchant_name" field.
+func MerchantNameLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldMerchantName, v))
+}
+
+// MerchantNameLTE applies the LTE predicate on the "merchant_name" field.
+func MerchantNameLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldMerchantName, v))
+}
+
+// MerchantNameContains applies the Contains predicate on the "merchant_name" field.
+func MerchantNameContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldMerchantName, v))
+}
+
+// MerchantNameHasPrefix applies the HasPrefix predicate on the "merchant_name" field.
+func MerchantNameHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldMerchantName, v))
+}
+
+// MerchantNameHasSuffix applies the HasSuffix predicate on the "merchant_name" field.
+func MerchantNameHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldMerchantName, v))
+}
+
+// MerchantNameIsNil applies the IsNil predicate on the "merchant_name" field.
+func MerchantNameIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMerchantName))
+}
+
+// MerchantNameNotNil applies the NotNil predicate on the "merchant_name" field.
+func MerchantNameNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMerchantName))
+}
+
+// MerchantNameEqualFold applies the EqualFold predicate on the "merchant_name" field.
+func MerchantNameEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldMerchantName, v))
+}
+
+// MerchantNameContainsFold applies the ContainsFold predicate on the "merchant_name" field.
+func MerchantNameContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldMerchantName, v))
+}
+
+// MccEQ applies the EQ predicate on the "mcc" field.
+func MccEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldMcc, v))
+}
+
+// MccNEQ applies the NEQ predicate on the "mcc" field.
+func MccNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldMcc, v))
+}
+
+// MccIn applies the In predicate on the "mcc" field.
+func MccIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldMcc, vs...))
+}
+
+// MccNotIn applies the NotIn predicate on the "mcc" field.
+func MccNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldMcc, vs...))
+}
+
+// MccGT applies the GT predicate on the "mcc" field.
+func MccGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldMcc, v))
+}
+
+// MccGTE applies the GTE predicate on the "mcc" field.
+func MccGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldMcc, v))
+}
+
+// MccLT applies the LT predicate on the "mcc" field.
+func MccLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldMcc, v))
+}
+
+// MccLTE applies the LTE predicate on the "mcc" field.
+func MccLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldMcc, v))
+}
+
+// MccContains applies the Contains predicate on the "mcc" field.
+func MccContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldMcc, v))
+}
+
+// MccHasPrefix applies the HasPrefix predicate on the "mcc" field.
+func MccHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldMcc, v))
+}
+
+// MccHasSuffix applies the HasSuffix predicate on the "mcc" field.
+func MccHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldMcc, v))
+}
+
+// MccIsNil applies the IsNil predicate on the "mcc" field.
+func MccIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMcc))
+}
+
+// MccNotNil applies the NotNil predicate on the "mcc" field.
+func MccNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMcc))
+}
+
+// MccEqualFold applies the EqualFold predicate on the "mcc" field.
+func MccEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldMcc, v))
+}
+
+// MccContainsFold applies the ContainsFold predicate on the "mcc" field.
+func MccContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldMcc, v))
+}
+
+// ExternalReferenceEQ applies the EQ predicate on the "external_reference" field.
+func ExternalReferenceEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalReference, v))
+}
+
+// ExternalReferenceNEQ applies the NEQ predicate on the "external_reference" field.
+func ExternalReferenceNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExternalReference, v))
+}
+
+// ExternalReferenceIn applies the In predicate on the "external_reference" field.
+func ExternalReferenceIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExternalReference, vs...))
+}
+
+// ExternalReferenceNotIn applies the NotIn predicate on the "external_reference" field.
+func ExternalReferenceNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExternalReference, vs...))
+}
+
+// ExternalReferenceGT applies the GT predicate on the "external_reference" field.
+func ExternalReferenceGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExternalReference, v))
+}
+
+// ExternalReferenceGTE applies the GTE predicate on the "external_reference" field.
+func ExternalReferenceGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExternalReference, v))
+}
+
+// ExternalReferenceLT applies the LT predicate on the "external_reference" field.
+func ExternalReferenceLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExternalReference, v))
+}
+
+// ExternalReferenceLTE applies the LTE predicate on the "external_reference" field.
+func ExternalReferenceLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExternalReference, v))
+}
+
+// ExternalReferenceContains applies the Contains predicate on the "external_reference" field.
+func ExternalReferenceContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldExternalReference, v))
+}
+
+// ExternalReferenceHasPrefix applies the HasPrefix predicate on the "external_reference" field.
+func ExternalReferenceHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldExternalReference, v))
+}
+
+// ExternalReferenceHasSuffix applies the HasSuffix predicate on the "external_reference" field.
+func ExternalReferenceHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldExternalReference, v))
+}
+
+// ExternalReferenceIsNil applies the IsNil predicate on the "external_reference" field.
+func ExternalReferenceIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldExternalReference))
+}
+
+// ExternalReferenceNotNil applies the NotNil predicate on the "external_reference" field.
+func ExternalReferenceNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldExternalReference))
+}
+
+// ExternalReferenceEqualFold applies the EqualFold predicate on the "external_reference" field.
+func ExternalReferenceEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldExternalReference, v))
+}
+
+// ExternalReferenceContainsFold applies the ContainsFold predicate on the "external_reference" field.
+func ExternalReferenceContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldExternalReference, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldMetadata))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// OriginalAmountEQ applies the EQ predicate on the "original_amount" field.
+func OriginalAmountEQ(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalAmount, vc))
+}
+
+// OriginalAmountNEQ applies the NEQ predicate on the "original_amount" field.
+func OriginalAmountNEQ(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldNEQ(FieldOriginalAmount, vc))
+}
+
+// OriginalAmountIn applies the In predicate on the "original_amount" field.
+func OriginalAmountIn(vs ...money.Amount) predicate.Transaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transaction(sql.FieldIn(FieldOriginalAmount, v...))
+}
+
+// OriginalAmountNotIn applies the NotIn predicate on the "original_amount" field.
+func OriginalAmountNotIn(vs ...money.Amount) predicate.Transaction {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transaction(sql.FieldNotIn(FieldOriginalAmount, v...))
+}
+
+// OriginalAmountGT applies the GT predicate on the "original_amount" field.
+func OriginalAmountGT(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldGT(FieldOriginalAmount, vc))
+}
+
+// OriginalAmountGTE applies the GTE predicate on the "original_amount" field.
+func OriginalAmountGTE(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldGTE(FieldOriginalAmount, vc))
+}
+
+// OriginalAmountLT applies the LT predicate on the "original_amount" field.
+func OriginalAmountLT(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldLT(FieldOriginalAmount, vc))
+}
+
+// OriginalAmountLTE applies the LTE predicate on the "original_amount" field.
+func OriginalAmountLTE(v money.Amount) predicate.Transaction {
+	vc := int64(v)
+	return predicate.Transaction(sql.FieldLTE(FieldOriginalAmount, vc))
+}
+
+// OriginalAmountIsNil applies the IsNil predicate on the "original_amount" field.
+func OriginalAmountIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldOriginalAmount))
+}
+
+// OriginalAmountNotNil applies the NotNil predicate on the "original_amount" field.
+func OriginalAmountNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldOriginalAmount))
+}
+
+// OriginalCurrencyEQ applies the EQ predicate on the "original_currency" field.
+func OriginalCurrencyEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyNEQ applies the NEQ predicate on the "original_currency" field.
+func OriginalCurrencyNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyIn applies the In predicate on the "original_currency" field.
+func OriginalCurrencyIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldOriginalCurrency, vs...))
+}
+
+// OriginalCurrencyNotIn applies the NotIn predicate on the "original_currency" field.
+func OriginalCurrencyNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldOriginalCurrency, vs...))
+}
+
+// OriginalCurrencyGT applies the GT predicate on the "original_currency" field.
+func OriginalCurrencyGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyGTE applies the GTE predicate on the "original_currency" field.
+func OriginalCurrencyGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyLT applies the LT predicate on the "original_currency" field.
+func OriginalCurrencyLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyLTE applies the LTE predicate on the "original_currency" field.
+func OriginalCurrencyLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyContains applies the Contains predicate on the "original_currency" field.
+func OriginalCurrencyContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyHasPrefix applies the HasPrefix predicate on the "original_currency" field.
+func OriginalCurrencyHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyHasSuffix applies the HasSuffix predicate on the "original_currency" field.
+func OriginalCurrencyHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyIsNil applies the IsNil predicate on the "original_currency" field.
+func OriginalCurrencyIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldOriginalCurrency))
+}
+
+// OriginalCurrencyNotNil applies the NotNil predicate on the "original_currency" field.
+func OriginalCurrencyNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldOriginalCurrency))
+}
+
+// OriginalCurrencyEqualFold applies the EqualFold predicate on the "original_currency" field.
+func OriginalCurrencyEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyContainsFold applies the ContainsFold predicate on the "original_currency" field.
+func OriginalCurrencyContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldOriginalCurrency, v))
+}
+
+// FxRateEQ applies the EQ predicate on the "fx_rate" field.
+func FxRateEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FxRateNEQ applies the NEQ predicate on the "fx_rate" field.
+func FxRateNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldFxRate, v))
+}
+
+// FxRateIn applies the In predicate on the "fx_rate" field.
+func FxRateIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldFxRate, vs...))
+}
+
+// FxRateNotIn applies the NotIn predicate on the "fx_rate" field.
+func FxRateNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldFxRate, vs...))
+}
+
+// FxRateGT applies the GT predicate on the "fx_rate" field.
+func FxRateGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldFxRate, v))
+}
+
+// FxRateGTE applies the GTE predicate on the "fx_rate" field.
+func FxRateGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldFxRate, v))
+}
+
+// FxRateLT applies the LT predicate on the "fx_rate" field.
+func FxRateLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldFxRate, v))
+}
+
+// FxRateLTE applies the LTE predicate on the "fx_rate" field.
+func FxRateLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldFxRate, v))
+}
+
+// FxRateContains applies the Contains predicate on the "fx_rate" field.
+func FxRateContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldFxRate, v))
+}
+
+// FxRateHasPrefix applies the HasPrefix predicate on the "fx_rate" field.
+func FxRateHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldFxRate, v))
+}
+
+// FxRateHasSuffix applies the HasSuffix predicate on the "fx_rate" field.
+func FxRateHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldFxRate, v))
+}
+
+// FxRateIsNil applies the IsNil predicate on the "fx_rate" field.
+func FxRateIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldFxRate))
+}
+
+// FxRateNotNil applies the NotNil predicate on the "fx_rate" field.
+func FxRateNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldFxRate))
+}
+
+// FxRateEqualFold applies the EqualFold predicate on the "fx_rate" field.
+func FxRateEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldFxRate, v))
+}
+
+// FxRateContainsFold applies the ContainsFold predicate on the "fx_rate" field.
+func FxRateContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldFxRate, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOperationType applies the HasEdge predicate on the "operation_type" edge.
+func HasOperationType() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OperationTypeTable, OperationTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOperationTypeWith applies the HasEdge predicate on the "operation_type" edge with a given conditions (other predicates).
+func HasOperationTypeWith(preds ...predicate.OperationType) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newOperationTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInstallments applies the HasEdge predicate on the "installments" edge.
+func HasInstallments() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InstallmentsTable, InstallmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInstallmentsWith applies the HasEdge predicate on the "installments" edge with a given conditions (other predicates).
+func HasInstallmentsWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newInstallmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReversalOf applies the HasEdge predicate on the "reversal_of" edge.
+func HasReversalOf() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReversalOfTable, ReversalOfColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReversalOfWith applies the HasEdge predicate on the "reversal_of" edge with a given conditions (other predicates).
+func HasReversalOfWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newReversalOfStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReversal applies the HasEdge predicate on the "reversal" edge.
+func HasReversal() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReversalTable, ReversalColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReversalWith applies the HasEdge predicate on the "reversal" edge with a given conditions (other predicates).
+func HasReversalWith(preds ...predicate.Transaction) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newReversalStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransfer applies the HasEdge predicate on the "transfer" edge.
+func HasTransfer() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TransferTable, TransferColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransferWith applies the HasEdge predicate on the "transfer" edge with a given conditions (other predicates).
+func HasTransferWith(preds ...predicate.Transfer) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newTransferStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHold applies the HasEdge predicate on the "hold" edge.
+func HasHold() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, HoldTable, HoldColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHoldWith applies the HasEdge predicate on the "hold" edge with a given conditions (other predicates).
+func HasHoldWith(preds ...predicate.Hold) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newHoldStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccrual applies the HasEdge predicate on the "accrual" edge.
+func HasAccrual() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AccrualTable, AccrualColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccrualWith applies the HasEdge predicate on the "accrual" edge with a given conditions (other predicates).
+func HasAccrualWith(preds ...predicate.Accrual) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newAccrualStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreditSettlements applies the HasEdge predicate on the "credit_settlements" edge.
+func HasCreditSettlements() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CreditSettlementsTable, CreditSettlementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreditSettlementsWith applies the HasEdge predicate on the "credit_settlements" edge with a given conditions (other predicates).
+func HasCreditSettlementsWith(preds ...predicate.Settlement) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newCreditSettlementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDebitSettlements applies the HasEdge predicate on the "debit_settlements" edge.
+func HasDebitSettlements() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DebitSettlementsTable, DebitSettlementsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDebitSettlementsWith applies the HasEdge predicate on the "debit_settlements" edge with a given conditions (other predicates).
+func HasDebitSettlementsWith(preds ...predicate.Settlement) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newDebitSettlementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoicePayment applies the HasEdge predicate on the "invoice_payment" edge.
+func HasInvoicePayment() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, InvoicePaymentTable, InvoicePaymentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoicePaymentWith applies the HasEdge predicate on the "invoice_payment" edge with a given conditions (other predicates).
+func HasInvoicePaymentWith(preds ...predicate.InvoicePayment) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newInvoicePaymentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJournalEntries applies the HasEdge predicate on the "journal_entries" edge.
+func HasJournalEntries() predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JournalEntriesTable, JournalEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJournalEntriesWith applies the HasEdge predicate on the "journal_entries" edge with a given conditions (other predicates).
+func HasJournalEntriesWith(preds ...predicate.JournalEntry) predicate.Transaction {
+	return predicate.Transaction(func(s *sql.Selector) {
+		step := newJournalEntriesStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -8,8 +8,15 @@ import (
 	"fmt"
 	"time"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/journalentry"
 	"transactor-server/pkg/db/ent/operationtype"
+	"transactor-server/pkg/db/ent/settlement"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -59,14 +66,22 @@ func (tc *TransactionCreate) SetAccountID(i int) *TransactionCreate {
 }
 
 // SetAmount sets the "amount" field.
-func (tc *TransactionCreate) SetAmount(f float64) *TransactionCreate {
-	tc.mutation.SetAmount(f)
+func (tc *TransactionCreate) SetAmount(m money.Amount) *TransactionCreate {
+	tc.mutation.SetAmount(m)
 	return tc
 }
 
 // SetBalance sets the "balance" field.
-func (tc *TransactionCreate) SetBalance(f float64) *TransactionCreate {
-	tc.mutation.SetBalance(f)
+func (tc *TransactionCreate) SetBalance(m money.Amount) *TransactionCreate {
+	tc.mutation.SetBalance(m)
+	return tc
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableBalance(m *money.Amount) *TransactionCreate {
+	if m != nil {
+		tc.SetBalance(*m)
+	}
 	return tc
 }
 
@@ -82,6 +97,208 @@ func (tc *TransactionCreate) SetTimestamp(t time.Time) *TransactionCreate {
 	return tc
 }
 
+// SetParentID sets the "parent_id" field.
+func (tc *TransactionCreate) SetParentID(i int) *TransactionCreate {
+	tc.mutation.SetParentID(i)
+	return tc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableParentID(i *int) *TransactionCreate {
+	if i != nil {
+		tc.SetParentID(*i)
+	}
+	return tc
+}
+
+// SetInstallmentNumber sets the "installment_number" field.
+func (tc *TransactionCreate) SetInstallmentNumber(i int) *TransactionCreate {
+	tc.mutation.SetInstallmentNumber(i)
+	return tc
+}
+
+// SetNillableInstallmentNumber sets the "installment_number" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableInstallmentNumber(i *int) *TransactionCreate {
+	if i != nil {
+		tc.SetInstallmentNumber(*i)
+	}
+	return tc
+}
+
+// SetDueDate sets the "due_date" field.
+func (tc *TransactionCreate) SetDueDate(t time.Time) *TransactionCreate {
+	tc.mutation.SetDueDate(t)
+	return tc
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableDueDate(t *time.Time) *TransactionCreate {
+	if t != nil {
+		tc.SetDueDate(*t)
+	}
+	return tc
+}
+
+// SetStatus sets the "status" field.
+func (tc *TransactionCreate) SetStatus(t transaction.Status) *TransactionCreate {
+	tc.mutation.SetStatus(t)
+	return tc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableStatus(t *transaction.Status) *TransactionCreate {
+	if t != nil {
+		tc.SetStatus(*t)
+	}
+	return tc
+}
+
+// SetReversalOfID sets the "reversal_of_id" field.
+func (tc *TransactionCreate) SetReversalOfID(i int) *TransactionCreate {
+	tc.mutation.SetReversalOfID(i)
+	return tc
+}
+
+// SetNillableReversalOfID sets the "reversal_of_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableReversalOfID(i *int) *TransactionCreate {
+	if i != nil {
+		tc.SetReversalOfID(*i)
+	}
+	return tc
+}
+
+// SetTransferID sets the "transfer_id" field.
+func (tc *TransactionCreate) SetTransferID(i int) *TransactionCreate {
+	tc.mutation.SetTransferID(i)
+	return tc
+}
+
+// SetNillableTransferID sets the "transfer_id" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableTransferID(i *int) *TransactionCreate {
+	if i != nil {
+		tc.SetTransferID(*i)
+	}
+	return tc
+}
+
+// SetDescription sets the "description" field.
+func (tc *TransactionCreate) SetDescription(s string) *TransactionCreate {
+	tc.mutation.SetDescription(s)
+	return tc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableDescription(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetDescription(*s)
+	}
+	return tc
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (tc *TransactionCreate) SetMerchantName(s string) *TransactionCreate {
+	tc.mutation.SetMerchantName(s)
+	return tc
+}
+
+// SetNillableMerchantName sets the "merchant_name" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableMerchantName(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetMerchantName(*s)
+	}
+	return tc
+}
+
+// SetMcc sets the "mcc" field.
+func (tc *TransactionCreate) SetMcc(s string) *TransactionCreate {
+	tc.mutation.SetMcc(s)
+	return tc
+}
+
+// SetNillableMcc sets the "mcc" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableMcc(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetMcc(*s)
+	}
+	return tc
+}
+
+// SetExternalReference sets the "external_reference" field.
+func (tc *TransactionCreate) SetExternalReference(s string) *TransactionCreate {
+	tc.mutation.SetExternalReference(s)
+	return tc
+}
+
+// SetNillableExternalReference sets the "external_reference" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableExternalReference(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetExternalReference(*s)
+	}
+	return tc
+}
+
+// SetMetadata sets the "metadata" field.
+func (tc *TransactionCreate) SetMetadata(m map[string]string) *TransactionCreate {
+	tc.mutation.SetMetadata(m)
+	return tc
+}
+
+// SetCurrency sets the "currency" field.
+func (tc *TransactionCreate) SetCurrency(s string) *TransactionCreate {
+	tc.mutation.SetCurrency(s)
+	return tc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableCurrency(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetCurrency(*s)
+	}
+	return tc
+}
+
+// SetOriginalAmount sets the "original_amount" field.
+func (tc *TransactionCreate) SetOriginalAmount(m money.Amount) *TransactionCreate {
+	tc.mutation.SetOriginalAmount(m)
+	return tc
+}
+
+// SetNillableOriginalAmount sets the "original_amount" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableOriginalAmount(m *money.Amount) *TransactionCreate {
+	if m != nil {
+		tc.SetOriginalAmount(*m)
+	}
+	return tc
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (tc *TransactionCreate) SetOriginalCurrency(s string) *TransactionCreate {
+	tc.mutation.SetOriginalCurrency(s)
+	return tc
+}
+
+// SetNillableOriginalCurrency sets the "original_currency" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableOriginalCurrency(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetOriginalCurrency(*s)
+	}
+	return tc
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (tc *TransactionCreate) SetFxRate(s string) *TransactionCreate {
+	tc.mutation.SetFxRate(s)
+	return tc
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (tc *TransactionCreate) SetNillableFxRate(s *string) *TransactionCreate {
+	if s != nil {
+		tc.SetFxRate(*s)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TransactionCreate) SetID(i int) *TransactionCreate {
 	tc.mutation.SetID(i)
@@ -98,6 +315,153 @@ func (tc *TransactionCreate) SetOperationType(o *OperationType) *TransactionCrea
 	return tc.SetOperationTypeID(o.ID)
 }
 
+// SetParent sets the "parent" edge to the Transaction entity.
+func (tc *TransactionCreate) SetParent(t *Transaction) *TransactionCreate {
+	return tc.SetParentID(t.ID)
+}
+
+// AddInstallmentIDs adds the "installments" edge to the Transaction entity by IDs.
+func (tc *TransactionCreate) AddInstallmentIDs(ids ...int) *TransactionCreate {
+	tc.mutation.AddInstallmentIDs(ids...)
+	return tc
+}
+
+// AddInstallments adds the "installments" edges to the Transaction entity.
+func (tc *TransactionCreate) AddInstallments(t ...*Transaction) *TransactionCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddInstallmentIDs(ids...)
+}
+
+// SetReversalOf sets the "reversal_of" edge to the Transaction entity.
+func (tc *TransactionCreate) SetReversalOf(t *Transaction) *TransactionCreate {
+	return tc.SetReversalOfID(t.ID)
+}
+
+// AddReversalIDs adds the "reversal" edge to the Transaction entity by IDs.
+func (tc *TransactionCreate) AddReversalIDs(ids ...int) *TransactionCreate {
+	tc.mutation.AddReversalIDs(ids...)
+	return tc
+}
+
+// AddReversal adds the "reversal" edges to the Transaction entity.
+func (tc *TransactionCreate) AddReversal(t ...*Transaction) *TransactionCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddReversalIDs(ids...)
+}
+
+// SetTransfer sets the "transfer" edge to the Transfer entity.
+func (tc *TransactionCreate) SetTransfer(t *Transfer) *TransactionCreate {
+	return tc.SetTransferID(t.ID)
+}
+
+// SetHoldID sets the "hold" edge to the Hold entity by ID.
+func (tc *TransactionCreate) SetHoldID(id int) *TransactionCreate {
+	tc.mutation.SetHoldID(id)
+	return tc
+}
+
+// SetNillableHoldID sets the "hold" edge to the Hold entity by ID if the given value is not nil.
+func (tc *TransactionCreate) SetNillableHoldID(id *int) *TransactionCreate {
+	if id != nil {
+		tc = tc.SetHoldID(*id)
+	}
+	return tc
+}
+
+// SetHold sets the "hold" edge to the Hold entity.
+func (tc *TransactionCreate) SetHold(h *Hold) *TransactionCreate {
+	return tc.SetHoldID(h.ID)
+}
+
+// SetAccrualID sets the "accrual" edge to the Accrual entity by ID.
+func (tc *TransactionCreate) SetAccrualID(id int) *TransactionCreate {
+	tc.mutation.SetAccrualID(id)
+	return tc
+}
+
+// SetNillableAccrualID sets the "accrual" edge to the Accrual entity by ID if the given value is not nil.
+func (tc *TransactionCreate) SetNillableAccrualID(id *int) *TransactionCreate {
+	if id != nil {
+		tc = tc.SetAccrualID(*id)
+	}
+	return tc
+}
+
+// SetAccrual sets the "accrual" edge to the Accrual entity.
+func (tc *TransactionCreate) SetAccrual(a *Accrual) *TransactionCreate {
+	return tc.SetAccrualID(a.ID)
+}
+
+// AddCreditSettlementIDs adds the "credit_settlements" edge to the Settlement entity by IDs.
+func (tc *TransactionCreate) AddCreditSettlementIDs(ids ...int) *TransactionCreate {
+	tc.mutation.AddCreditSettlementIDs(ids...)
+	return tc
+}
+
+// AddCreditSettlements adds the "credit_settlements" edges to the Settlement entity.
+func (tc *TransactionCreate) AddCreditSettlements(s ...*Settlement) *TransactionCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tc.AddCreditSettlementIDs(ids...)
+}
+
+// AddDebitSettlementIDs adds the "debit_settlements" edge to the Settlement entity by IDs.
+func (tc *TransactionCreate) AddDebitSettlementIDs(ids ...int) *TransactionCreate {
+	tc.mutation.AddDebitSettlementIDs(ids...)
+	return tc
+}
+
+// AddDebitSettlements adds the "debit_settlements" edges to the Settlement entity.
+func (tc *TransactionCreate) AddDebitSettlements(s ...*Settlement) *TransactionCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tc.AddDebitSettlementIDs(ids...)
+}
+
+// SetInvoicePaymentID sets the "invoice_payment" edge to the InvoicePayment entity by ID.
+func (tc *TransactionCreate) SetInvoicePaymentID(id int) *TransactionCreate {
+	tc.mutation.SetInvoicePaymentID(id)
+	return tc
+}
+
+// SetNillableInvoicePaymentID sets the "invoice_payment" edge to the InvoicePayment entity by ID if the given value is not nil.
+func (tc *TransactionCreate) SetNillableInvoicePaymentID(id *int) *TransactionCreate {
+	if id != nil {
+		tc = tc.SetInvoicePaymentID(*id)
+	}
+	return tc
+}
+
+// SetInvoicePayment sets the "invoice_payment" edge to the InvoicePayment entity.
+func (tc *TransactionCreate) SetInvoicePayment(i *InvoicePayment) *TransactionCreate {
+	return tc.SetInvoicePaymentID(i.ID)
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
+func (tc *TransactionCreate) AddJournalEntryIDs(ids ...int) *TransactionCreate {
+	tc.mutation.AddJournalEntryIDs(ids...)
+	return tc
+}
+
+// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
+func (tc *TransactionCreate) AddJournalEntries(j ...*JournalEntry) *TransactionCreate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return tc.AddJournalEntryIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tc *TransactionCreate) Mutation() *TransactionMutation {
 	return tc.mutation
@@ -141,6 +505,18 @@ func (tc *TransactionCreate) defaults() {
 		v := transaction.DefaultUpdateTime()
 		tc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := tc.mutation.Balance(); !ok {
+		v := transaction.DefaultBalance
+		tc.mutation.SetBalance(v)
+	}
+	if _, ok := tc.mutation.Status(); !ok {
+		v := transaction.DefaultStatus
+		tc.mutation.SetStatus(v)
+	}
+	if _, ok := tc.mutation.Currency(); !ok {
+		v := transaction.DefaultCurrency
+		tc.mutation.SetCurrency(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -166,6 +542,52 @@ func (tc *TransactionCreate) check() error {
 	if _, ok := tc.mutation.Timestamp(); !ok {
 		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Transaction.timestamp"`)}
 	}
+	if _, ok := tc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Transaction.status"`)}
+	}
+	if v, ok := tc.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.Description(); ok {
+		if err := transaction.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Transaction.description": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.MerchantName(); ok {
+		if err := transaction.MerchantNameValidator(v); err != nil {
+			return &ValidationError{Name: "merchant_name", err: fmt.Errorf(`ent: validator failed for field "Transaction.merchant_name": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.Mcc(); ok {
+		if err := transaction.MccValidator(v); err != nil {
+			return &ValidationError{Name: "mcc", err: fmt.Errorf(`ent: validator failed for field "Transaction.mcc": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.ExternalReference(); ok {
+		if err := transaction.ExternalReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "external_reference", err: fmt.Errorf(`ent: validator failed for field "Transaction.external_reference": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Transaction.currency"`)}
+	}
+	if v, ok := tc.mutation.Currency(); ok {
+		if err := transaction.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.currency": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.OriginalCurrency(); ok {
+		if err := transaction.OriginalCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "original_currency", err: fmt.Errorf(`ent: validator failed for field "Transaction.original_currency": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.FxRate(); ok {
+		if err := transaction.FxRateValidator(v); err != nil {
+			return &ValidationError{Name: "fx_rate", err: fmt.Errorf(`ent: validator failed for field "Transaction.fx_rate": %w`, err)}
+		}
+	}
 	if len(tc.mutation.AccountIDs()) == 0 {
 		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "Transaction.account"`)}
 	}
@@ -214,17 +636,65 @@ func (tc *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.UpdateTime = value
 	}
 	if value, ok := tc.mutation.Amount(); ok {
-		_spec.SetField(transaction.FieldAmount, field.TypeFloat64, value)
+		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
 	if value, ok := tc.mutation.Balance(); ok {
-		_spec.SetField(transaction.FieldBalance, field.TypeFloat64, value)
+		_spec.SetField(transaction.FieldBalance, field.TypeInt64, value)
 		_node.Balance = value
 	}
 	if value, ok := tc.mutation.Timestamp(); ok {
 		_spec.SetField(transaction.FieldTimestamp, field.TypeTime, value)
 		_node.Timestamp = value
 	}
+	if value, ok := tc.mutation.InstallmentNumber(); ok {
+		_spec.SetField(transaction.FieldInstallmentNumber, field.TypeInt, value)
+		_node.InstallmentNumber = &value
+	}
+	if value, ok := tc.mutation.DueDate(); ok {
+		_spec.SetField(transaction.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = &value
+	}
+	if value, ok := tc.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tc.mutation.Description(); ok {
+		_spec.SetField(transaction.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := tc.mutation.MerchantName(); ok {
+		_spec.SetField(transaction.FieldMerchantName, field.TypeString, value)
+		_node.MerchantName = &value
+	}
+	if value, ok := tc.mutation.Mcc(); ok {
+		_spec.SetField(transaction.FieldMcc, field.TypeString, value)
+		_node.Mcc = &value
+	}
+	if value, ok := tc.mutation.ExternalReference(); ok {
+		_spec.SetField(transaction.FieldExternalReference, field.TypeString, value)
+		_node.ExternalReference = &value
+	}
+	if value, ok := tc.mutation.Metadata(); ok {
+		_spec.SetField(transaction.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := tc.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := tc.mutation.OriginalAmount(); ok {
+		_spec.SetField(transaction.FieldOriginalAmount, field.TypeInt64, value)
+		_node.OriginalAmount = &value
+	}
+	if value, ok := tc.mutation.OriginalCurrency(); ok {
+		_spec.SetField(transaction.FieldOriginalCurrency, field.TypeString, value)
+		_node.OriginalCurrency = &value
+	}
+	if value, ok := tc.mutation.FxRate(); ok {
+		_spec.SetField(transaction.FieldFxRate, field.TypeString, value)
+		_node.FxRate = &value
+	}
 	if nodes := tc.mutation.AccountIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -259,6 +729,185 @@ func (tc *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.OperationTypeID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ParentTable,
+			Columns: []string{transaction.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.InstallmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.InstallmentsTable,
+			Columns: []string{transaction.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ReversalOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.ReversalOfTable,
+			Columns: []string{transaction.ReversalOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReversalOfID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ReversalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalTable,
+			Columns: []string{transaction.ReversalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.TransferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transaction.TransferTable,
+			Columns: []string{transaction.TransferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TransferID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.HoldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.HoldTable,
+			Columns: []string{transaction.HoldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.AccrualIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.AccrualTable,
+			Columns: []string{transaction.AccrualColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.CreditSettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.CreditSettlementsTable,
+			Columns: []string{transaction.CreditSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.DebitSettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.DebitSettlementsTable,
+			Columns: []string{transaction.DebitSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.InvoicePaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.InvoicePaymentTable,
+			Columns: []string{transaction.InvoicePaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.JournalEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.JournalEntriesTable,
+			Columns: []string{transaction.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -324,7 +973,7 @@ func (u *TransactionUpsert) UpdateUpdateTime() *TransactionUpsert {
 }
 
 // SetBalance sets the "balance" field.
-func (u *TransactionUpsert) SetBalance(v float64) *TransactionUpsert {
+func (u *TransactionUpsert) SetBalance(v money.Amount) *TransactionUpsert {
 	u.Set(transaction.FieldBalance, v)
 	return u
 }
@@ -336,11 +985,23 @@ func (u *TransactionUpsert) UpdateBalance() *TransactionUpsert {
 }
 
 // AddBalance adds v to the "balance" field.
-func (u *TransactionUpsert) AddBalance(v float64) *TransactionUpsert {
+func (u *TransactionUpsert) AddBalance(v money.Amount) *TransactionUpsert {
 	u.Add(transaction.FieldBalance, v)
 	return u
 }
 
+// SetStatus sets the "status" field.
+func (u *TransactionUpsert) SetStatus(v transaction.Status) *TransactionUpsert {
+	u.Set(transaction.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateStatus() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldStatus)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -373,6 +1034,48 @@ func (u *TransactionUpsertOne) UpdateNewValues() *TransactionUpsertOne {
 		if _, exists := u.create.mutation.Timestamp(); exists {
 			s.SetIgnore(transaction.FieldTimestamp)
 		}
+		if _, exists := u.create.mutation.ParentID(); exists {
+			s.SetIgnore(transaction.FieldParentID)
+		}
+		if _, exists := u.create.mutation.InstallmentNumber(); exists {
+			s.SetIgnore(transaction.FieldInstallmentNumber)
+		}
+		if _, exists := u.create.mutation.DueDate(); exists {
+			s.SetIgnore(transaction.FieldDueDate)
+		}
+		if _, exists := u.create.mutation.ReversalOfID(); exists {
+			s.SetIgnore(transaction.FieldReversalOfID)
+		}
+		if _, exists := u.create.mutation.TransferID(); exists {
+			s.SetIgnore(transaction.FieldTransferID)
+		}
+		if _, exists := u.create.mutation.Description(); exists {
+			s.SetIgnore(transaction.FieldDescription)
+		}
+		if _, exists := u.create.mutation.MerchantName(); exists {
+			s.SetIgnore(transaction.FieldMerchantName)
+		}
+		if _, exists := u.create.mutation.Mcc(); exists {
+			s.SetIgnore(transaction.FieldMcc)
+		}
+		if _, exists := u.create.mutation.ExternalReference(); exists {
+			s.SetIgnore(transaction.FieldExternalReference)
+		}
+		if _, exists := u.create.mutation.Metadata(); exists {
+			s.SetIgnore(transaction.FieldMetadata)
+		}
+		if _, exists := u.create.mutation.Currency(); exists {
+			s.SetIgnore(transaction.FieldCurrency)
+		}
+		if _, exists := u.create.mutation.OriginalAmount(); exists {
+			s.SetIgnore(transaction.FieldOriginalAmount)
+		}
+		if _, exists := u.create.mutation.OriginalCurrency(); exists {
+			s.SetIgnore(transaction.FieldOriginalCurrency)
+		}
+		if _, exists := u.create.mutation.FxRate(); exists {
+			s.SetIgnore(transaction.FieldFxRate)
+		}
 	}))
 	return u
 }
@@ -419,14 +1122,14 @@ func (u *TransactionUpsertOne) UpdateUpdateTime() *TransactionUpsertOne {
 }
 
 // SetBalance sets the "balance" field.
-func (u *TransactionUpsertOne) SetBalance(v float64) *TransactionUpsertOne {
+func (u *TransactionUpsertOne) SetBalance(v money.Amount) *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
 		s.SetBalance(v)
 	})
 }
 
 // AddBalance adds v to the "balance" field.
-func (u *TransactionUpsertOne) AddBalance(v float64) *TransactionUpsertOne {
+func (u *TransactionUpsertOne) AddBalance(v money.Amount) *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
 		s.AddBalance(v)
 	})
@@ -439,6 +1142,20 @@ func (u *TransactionUpsertOne) UpdateBalance() *TransactionUpsertOne {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertOne) SetStatus(v transaction.Status) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateStatus() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *TransactionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -636,6 +1353,48 @@ func (u *TransactionUpsertBulk) UpdateNewValues() *TransactionUpsertBulk {
 			if _, exists := b.mutation.Timestamp(); exists {
 				s.SetIgnore(transaction.FieldTimestamp)
 			}
+			if _, exists := b.mutation.ParentID(); exists {
+				s.SetIgnore(transaction.FieldParentID)
+			}
+			if _, exists := b.mutation.InstallmentNumber(); exists {
+				s.SetIgnore(transaction.FieldInstallmentNumber)
+			}
+			if _, exists := b.mutation.DueDate(); exists {
+				s.SetIgnore(transaction.FieldDueDate)
+			}
+			if _, exists := b.mutation.ReversalOfID(); exists {
+				s.SetIgnore(transaction.FieldReversalOfID)
+			}
+			if _, exists := b.mutation.TransferID(); exists {
+				s.SetIgnore(transaction.FieldTransferID)
+			}
+			if _, exists := b.mutation.Description(); exists {
+				s.SetIgnore(transaction.FieldDescription)
+			}
+			if _, exists := b.mutation.MerchantName(); exists {
+				s.SetIgnore(transaction.FieldMerchantName)
+			}
+			if _, exists := b.mutation.Mcc(); exists {
+				s.SetIgnore(transaction.FieldMcc)
+			}
+			if _, exists := b.mutation.ExternalReference(); exists {
+				s.SetIgnore(transaction.FieldExternalReference)
+			}
+			if _, exists := b.mutation.Metadata(); exists {
+				s.SetIgnore(transaction.FieldMetadata)
+			}
+			if _, exists := b.mutation.Currency(); exists {
+				s.SetIgnore(transaction.FieldCurrency)
+			}
+			if _, exists := b.mutation.OriginalAmount(); exists {
+				s.SetIgnore(transaction.FieldOriginalAmount)
+			}
+			if _, exists := b.mutation.OriginalCurrency(); exists {
+				s.SetIgnore(transaction.FieldOriginalCurrency)
+			}
+			if _, exists := b.mutation.FxRate(); exists {
+				s.SetIgnore(transaction.FieldFxRate)
+			}
 		}
 	}))
 	return u
@@ -683,14 +1442,14 @@ func (u *TransactionUpsertBulk) UpdateUpdateTime() *TransactionUpsertBulk {
 }
 
 // SetBalance sets the "balance" field.
-func (u *TransactionUpsertBulk) SetBalance(v float64) *TransactionUpsertBulk {
+func (u *TransactionUpsertBulk) SetBalance(v money.Amount) *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
 		s.SetBalance(v)
 	})
 }
 
 // AddBalance adds v to the "balance" field.
-func (u *TransactionUpsertBulk) AddBalance(v float64) *TransactionUpsertBulk {
+func (u *TransactionUpsertBulk) AddBalance(v money.Amount) *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
 		s.AddBalance(v)
 	})
@@ -703,6 +1462,20 @@ func (u *TransactionUpsertBulk) UpdateBalance() *TransactionUpsertBulk {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *TransactionUpsertBulk) SetStatus(v transaction.Status) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateStatus() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // Exec executes the query.
 func (u *TransactionUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
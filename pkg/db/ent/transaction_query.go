@@ -4,12 +4,19 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/journalentry"
 	"transactor-server/pkg/db/ent/operationtype"
 	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/settlement"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,13 +27,24 @@ import (
 // TransactionQuery is the builder for querying Transaction entities.
 type TransactionQuery struct {
 	config
-	ctx               *QueryContext
-	order             []transaction.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Transaction
-	withAccount       *AccountQuery
-	withOperationType *OperationTypeQuery
-	modifiers         []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []transaction.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Transaction
+	withAccount           *AccountQuery
+	withOperationType     *OperationTypeQuery
+	withParent            *TransactionQuery
+	withInstallments      *TransactionQuery
+	withReversalOf        *TransactionQuery
+	withReversal          *TransactionQuery
+	withTransfer          *TransferQuery
+	withHold              *HoldQuery
+	withAccrual           *AccrualQuery
+	withCreditSettlements *SettlementQuery
+	withDebitSettlements  *SettlementQuery
+	withInvoicePayment    *InvoicePaymentQuery
+	withJournalEntries    *JournalEntryQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +125,248 @@ func (tq *TransactionQuery) QueryOperationType() *OperationTypeQuery {
 	return query
 }
 
+// QueryParent chains the current query on the "parent" edge.
+func (tq *TransactionQuery) QueryParent() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.ParentTable, transaction.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInstallments chains the current query on the "installments" edge.
+func (tq *TransactionQuery) QueryInstallments() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.InstallmentsTable, transaction.InstallmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReversalOf chains the current query on the "reversal_of" edge.
+func (tq *TransactionQuery) QueryReversalOf() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.ReversalOfTable, transaction.ReversalOfColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReversal chains the current query on the "reversal" edge.
+func (tq *TransactionQuery) QueryReversal() *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transaction.Table, transaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.ReversalTable, transaction.ReversalColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTransfer chains the current query on the "transfer" edge.
+func (tq *TransactionQuery) QueryTransfer() *TransferQuery {
+	query := (&TransferClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, transaction.TransferTable, transaction.TransferColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHold chains the current query on the "hold" edge.
+func (tq *TransactionQuery) QueryHold() *HoldQuery {
+	query := (&HoldClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(hold.Table, hold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, transaction.HoldTable, transaction.HoldColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccrual chains the current query on the "accrual" edge.
+func (tq *TransactionQuery) QueryAccrual() *AccrualQuery {
+	query := (&AccrualClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(accrual.Table, accrual.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, transaction.AccrualTable, transaction.AccrualColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreditSettlements chains the current query on the "credit_settlements" edge.
+func (tq *TransactionQuery) QueryCreditSettlements() *SettlementQuery {
+	query := (&SettlementClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.CreditSettlementsTable, transaction.CreditSettlementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDebitSettlements chains the current query on the "debit_settlements" edge.
+func (tq *TransactionQuery) QueryDebitSettlements() *SettlementQuery {
+	query := (&SettlementClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(settlement.Table, settlement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.DebitSettlementsTable, transaction.DebitSettlementsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoicePayment chains the current query on the "invoice_payment" edge.
+func (tq *TransactionQuery) QueryInvoicePayment() *InvoicePaymentQuery {
+	query := (&InvoicePaymentClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(invoicepayment.Table, invoicepayment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, transaction.InvoicePaymentTable, transaction.InvoicePaymentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryJournalEntries chains the current query on the "journal_entries" edge.
+func (tq *TransactionQuery) QueryJournalEntries() *JournalEntryQuery {
+	query := (&JournalEntryClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transaction.Table, transaction.FieldID, selector),
+			sqlgraph.To(journalentry.Table, journalentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, transaction.JournalEntriesTable, transaction.JournalEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Transaction entity from the query.
 // Returns a *NotFoundError when no Transaction was found.
 func (tq *TransactionQuery) First(ctx context.Context) (*Transaction, error) {
@@ -294,13 +554,24 @@ func (tq *TransactionQuery) Clone() *TransactionQuery {
 		return nil
 	}
 	return &TransactionQuery{
-		config:            tq.config,
-		ctx:               tq.ctx.Clone(),
-		order:             append([]transaction.OrderOption{}, tq.order...),
-		inters:            append([]Interceptor{}, tq.inters...),
-		predicates:        append([]predicate.Transaction{}, tq.predicates...),
-		withAccount:       tq.withAccount.Clone(),
-		withOperationType: tq.withOperationType.Clone(),
+		config:                tq.config,
+		ctx:                   tq.ctx.Clone(),
+		order:                 append([]transaction.OrderOption{}, tq.order...),
+		inters:                append([]Interceptor{}, tq.inters...),
+		predicates:            append([]predicate.Transaction{}, tq.predicates...),
+		withAccount:           tq.withAccount.Clone(),
+		withOperationType:     tq.withOperationType.Clone(),
+		withParent:            tq.withParent.Clone(),
+		withInstallments:      tq.withInstallments.Clone(),
+		withReversalOf:        tq.withReversalOf.Clone(),
+		withReversal:          tq.withReversal.Clone(),
+		withTransfer:          tq.withTransfer.Clone(),
+		withHold:              tq.withHold.Clone(),
+		withAccrual:           tq.withAccrual.Clone(),
+		withCreditSettlements: tq.withCreditSettlements.Clone(),
+		withDebitSettlements:  tq.withDebitSettlements.Clone(),
+		withInvoicePayment:    tq.withInvoicePayment.Clone(),
+		withJournalEntries:    tq.withJournalEntries.Clone(),
 		// clone intermediate query.
 		sql:       tq.sql.Clone(),
 		path:      tq.path,
@@ -330,6 +601,127 @@ func (tq *TransactionQuery) WithOperationType(opts ...func(*OperationTypeQuery))
 	return tq
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithParent(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withParent = query
+	return tq
+}
+
+// WithInstallments tells the query-builder to eager-load the nodes that are connected to
+// the "installments" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithInstallments(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withInstallments = query
+	return tq
+}
+
+// WithReversalOf tells the query-builder to eager-load the nodes that are connected to
+// the "reversal_of" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithReversalOf(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withReversalOf = query
+	return tq
+}
+
+// WithReversal tells the query-builder to eager-load the nodes that are connected to
+// the "reversal" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithReversal(opts ...func(*TransactionQuery)) *TransactionQuery {
+	query := (&TransactionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withReversal = query
+	return tq
+}
+
+// WithTransfer tells the query-builder to eager-load the nodes that are connected to
+// the "transfer" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithTransfer(opts ...func(*TransferQuery)) *TransactionQuery {
+	query := (&TransferClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTransfer = query
+	return tq
+}
+
+// WithHold tells the query-builder to eager-load the nodes that are connected to
+// the "hold" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithHold(opts ...func(*HoldQuery)) *TransactionQuery {
+	query := (&HoldClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withHold = query
+	return tq
+}
+
+// WithAccrual tells the query-builder to eager-load the nodes that are connected to
+// the "accrual" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithAccrual(opts ...func(*AccrualQuery)) *TransactionQuery {
+	query := (&AccrualClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withAccrual = query
+	return tq
+}
+
+// WithCreditSettlements tells the query-builder to eager-load the nodes that are connected to
+// the "credit_settlements" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithCreditSettlements(opts ...func(*SettlementQuery)) *TransactionQuery {
+	query := (&SettlementClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withCreditSettlements = query
+	return tq
+}
+
+// WithDebitSettlements tells the query-builder to eager-load the nodes that are connected to
+// the "debit_settlements" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithDebitSettlements(opts ...func(*SettlementQuery)) *TransactionQuery {
+	query := (&SettlementClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withDebitSettlements = query
+	return tq
+}
+
+// WithInvoicePayment tells the query-builder to eager-load the nodes that are connected to
+// the "invoice_payment" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithInvoicePayment(opts ...func(*InvoicePaymentQuery)) *TransactionQuery {
+	query := (&InvoicePaymentClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withInvoicePayment = query
+	return tq
+}
+
+// WithJournalEntries tells the query-builder to eager-load the nodes that are connected to
+// the "journal_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TransactionQuery) WithJournalEntries(opts ...func(*JournalEntryQuery)) *TransactionQuery {
+	query := (&JournalEntryClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withJournalEntries = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +800,20 @@ func (tq *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Transaction{}
 		_spec       = tq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [13]bool{
 			tq.withAccount != nil,
 			tq.withOperationType != nil,
+			tq.withParent != nil,
+			tq.withInstallments != nil,
+			tq.withReversalOf != nil,
+			tq.withReversal != nil,
+			tq.withTransfer != nil,
+			tq.withHold != nil,
+			tq.withAccrual != nil,
+			tq.withCreditSettlements != nil,
+			tq.withDebitSettlements != nil,
+			tq.withInvoicePayment != nil,
+			tq.withJournalEntries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -446,6 +849,77 @@ func (tq *TransactionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := tq.withParent; query != nil {
+		if err := tq.loadParent(ctx, query, nodes, nil,
+			func(n *Transaction, e *Transaction) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withInstallments; query != nil {
+		if err := tq.loadInstallments(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Installments = []*Transaction{} },
+			func(n *Transaction, e *Transaction) { n.Edges.Installments = append(n.Edges.Installments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withReversalOf; query != nil {
+		if err := tq.loadReversalOf(ctx, query, nodes, nil,
+			func(n *Transaction, e *Transaction) { n.Edges.ReversalOf = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withReversal; query != nil {
+		if err := tq.loadReversal(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.Reversal = []*Transaction{} },
+			func(n *Transaction, e *Transaction) { n.Edges.Reversal = append(n.Edges.Reversal, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withTransfer; query != nil {
+		if err := tq.loadTransfer(ctx, query, nodes, nil,
+			func(n *Transaction, e *Transfer) { n.Edges.Transfer = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withHold; query != nil {
+		if err := tq.loadHold(ctx, query, nodes, nil,
+			func(n *Transaction, e *Hold) { n.Edges.Hold = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withAccrual; query != nil {
+		if err := tq.loadAccrual(ctx, query, nodes, nil,
+			func(n *Transaction, e *Accrual) { n.Edges.Accrual = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withCreditSettlements; query != nil {
+		if err := tq.loadCreditSettlements(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.CreditSettlements = []*Settlement{} },
+			func(n *Transaction, e *Settlement) { n.Edges.CreditSettlements = append(n.Edges.CreditSettlements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withDebitSettlements; query != nil {
+		if err := tq.loadDebitSettlements(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.DebitSettlements = []*Settlement{} },
+			func(n *Transaction, e *Settlement) { n.Edges.DebitSettlements = append(n.Edges.DebitSettlements, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withInvoicePayment; query != nil {
+		if err := tq.loadInvoicePayment(ctx, query, nodes, nil,
+			func(n *Transaction, e *InvoicePayment) { n.Edges.InvoicePayment = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withJournalEntries; query != nil {
+		if err := tq.loadJournalEntries(ctx, query, nodes,
+			func(n *Transaction) { n.Edges.JournalEntries = []*JournalEntry{} },
+			func(n *Transaction, e *JournalEntry) { n.Edges.JournalEntries = append(n.Edges.JournalEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +981,345 @@ func (tq *TransactionQuery) loadOperationType(ctx context.Context, query *Operat
 	}
 	return nil
 }
+func (tq *TransactionQuery) loadParent(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadInstallments(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transaction.FieldParentID)
+	}
+	query.Where(predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.InstallmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadReversalOf(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].ReversalOfID == nil {
+			continue
+		}
+		fk := *nodes[i].ReversalOfID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transaction.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reversal_of_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadReversal(ctx context.Context, query *TransactionQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(transaction.FieldReversalOfID)
+	}
+	query.Where(predicate.Transaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.ReversalColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReversalOfID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reversal_of_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reversal_of_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadTransfer(ctx context.Context, query *TransferQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Transfer)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Transaction)
+	for i := range nodes {
+		if nodes[i].TransferID == nil {
+			continue
+		}
+		fk := *nodes[i].TransferID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(transfer.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "transfer_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadHold(ctx context.Context, query *HoldQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Hold)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(hold.FieldTransactionID)
+	}
+	query.Where(predicate.Hold(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.HoldColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TransactionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadAccrual(ctx context.Context, query *AccrualQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Accrual)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accrual.FieldTransactionID)
+	}
+	query.Where(predicate.Accrual(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.AccrualColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TransactionID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "transaction_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadCreditSettlements(ctx context.Context, query *SettlementQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Settlement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(settlement.FieldCreditTxnID)
+	}
+	query.Where(predicate.Settlement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.CreditSettlementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreditTxnID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "credit_txn_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadDebitSettlements(ctx context.Context, query *SettlementQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *Settlement)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(settlement.FieldDebitTxnID)
+	}
+	query.Where(predicate.Settlement(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.DebitSettlementsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DebitTxnID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "debit_txn_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadInvoicePayment(ctx context.Context, query *InvoicePaymentQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *InvoicePayment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invoicepayment.FieldTransactionID)
+	}
+	query.Where(predicate.InvoicePayment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.InvoicePaymentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TransactionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TransactionQuery) loadJournalEntries(ctx context.Context, query *JournalEntryQuery, nodes []*Transaction, init func(*Transaction), assign func(*Transaction, *JournalEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Transaction)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(journalentry.FieldTransactionID)
+	}
+	query.Where(predicate.JournalEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(transaction.JournalEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TransactionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "transaction_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tq *TransactionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
@@ -542,6 +1355,15 @@ func (tq *TransactionQuery) querySpec() *sqlgraph.QuerySpec {
 		if tq.withOperationType != nil {
 			_spec.Node.AddColumnOnce(transaction.FieldOperationTypeID)
 		}
+		if tq.withParent != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldParentID)
+		}
+		if tq.withReversalOf != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldReversalOfID)
+		}
+		if tq.withTransfer != nil {
+			_spec.Node.AddColumnOnce(transaction.FieldTransferID)
+		}
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,8 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"transactor-server/pkg/db/ent/accrual"
+	"transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/db/ent/invoicepayment"
+	"transactor-server/pkg/db/ent/journalentry"
 	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/settlement"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -36,31 +42,300 @@ func (tu *TransactionUpdate) SetUpdateTime(t time.Time) *TransactionUpdate {
 }
 
 // SetBalance sets the "balance" field.
-func (tu *TransactionUpdate) SetBalance(f float64) *TransactionUpdate {
+func (tu *TransactionUpdate) SetBalance(m money.Amount) *TransactionUpdate {
 	tu.mutation.ResetBalance()
-	tu.mutation.SetBalance(f)
+	tu.mutation.SetBalance(m)
 	return tu
 }
 
 // SetNillableBalance sets the "balance" field if the given value is not nil.
-func (tu *TransactionUpdate) SetNillableBalance(f *float64) *TransactionUpdate {
-	if f != nil {
-		tu.SetBalance(*f)
+func (tu *TransactionUpdate) SetNillableBalance(m *money.Amount) *TransactionUpdate {
+	if m != nil {
+		tu.SetBalance(*m)
 	}
 	return tu
 }
 
-// AddBalance adds f to the "balance" field.
-func (tu *TransactionUpdate) AddBalance(f float64) *TransactionUpdate {
-	tu.mutation.AddBalance(f)
+// AddBalance adds m to the "balance" field.
+func (tu *TransactionUpdate) AddBalance(m money.Amount) *TransactionUpdate {
+	tu.mutation.AddBalance(m)
 	return tu
 }
 
+// SetStatus sets the "status" field.
+func (tu *TransactionUpdate) SetStatus(t transaction.Status) *TransactionUpdate {
+	tu.mutation.SetStatus(t)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableStatus(t *transaction.Status) *TransactionUpdate {
+	if t != nil {
+		tu.SetStatus(*t)
+	}
+	return tu
+}
+
+// AddInstallmentIDs adds the "installments" edge to the Transaction entity by IDs.
+func (tu *TransactionUpdate) AddInstallmentIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.AddInstallmentIDs(ids...)
+	return tu
+}
+
+// AddInstallments adds the "installments" edges to the Transaction entity.
+func (tu *TransactionUpdate) AddInstallments(t ...*Transaction) *TransactionUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddInstallmentIDs(ids...)
+}
+
+// AddReversalIDs adds the "reversal" edge to the Transaction entity by IDs.
+func (tu *TransactionUpdate) AddReversalIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.AddReversalIDs(ids...)
+	return tu
+}
+
+// AddReversal adds the "reversal" edges to the Transaction entity.
+func (tu *TransactionUpdate) AddReversal(t ...*Transaction) *TransactionUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddReversalIDs(ids...)
+}
+
+// SetHoldID sets the "hold" edge to the Hold entity by ID.
+func (tu *TransactionUpdate) SetHoldID(id int) *TransactionUpdate {
+	tu.mutation.SetHoldID(id)
+	return tu
+}
+
+// SetNillableHoldID sets the "hold" edge to the Hold entity by ID if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableHoldID(id *int) *TransactionUpdate {
+	if id != nil {
+		tu = tu.SetHoldID(*id)
+	}
+	return tu
+}
+
+// SetHold sets the "hold" edge to the Hold entity.
+func (tu *TransactionUpdate) SetHold(h *Hold) *TransactionUpdate {
+	return tu.SetHoldID(h.ID)
+}
+
+// SetAccrualID sets the "accrual" edge to the Accrual entity by ID.
+func (tu *TransactionUpdate) SetAccrualID(id int) *TransactionUpdate {
+	tu.mutation.SetAccrualID(id)
+	return tu
+}
+
+// SetNillableAccrualID sets the "accrual" edge to the Accrual entity by ID if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableAccrualID(id *int) *TransactionUpdate {
+	if id != nil {
+		tu = tu.SetAccrualID(*id)
+	}
+	return tu
+}
+
+// SetAccrual sets the "accrual" edge to the Accrual entity.
+func (tu *TransactionUpdate) SetAccrual(a *Accrual) *TransactionUpdate {
+	return tu.SetAccrualID(a.ID)
+}
+
+// AddCreditSettlementIDs adds the "credit_settlements" edge to the Settlement entity by IDs.
+func (tu *TransactionUpdate) AddCreditSettlementIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.AddCreditSettlementIDs(ids...)
+	return tu
+}
+
+// AddCreditSettlements adds the "credit_settlements" edges to the Settlement entity.
+func (tu *TransactionUpdate) AddCreditSettlements(s ...*Settlement) *TransactionUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.AddCreditSettlementIDs(ids...)
+}
+
+// AddDebitSettlementIDs adds the "debit_settlements" edge to the Settlement entity by IDs.
+func (tu *TransactionUpdate) AddDebitSettlementIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.AddDebitSettlementIDs(ids...)
+	return tu
+}
+
+// AddDebitSettlements adds the "debit_settlements" edges to the Settlement entity.
+func (tu *TransactionUpdate) AddDebitSettlements(s ...*Settlement) *TransactionUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.AddDebitSettlementIDs(ids...)
+}
+
+// SetInvoicePaymentID sets the "invoice_payment" edge to the InvoicePayment entity by ID.
+func (tu *TransactionUpdate) SetInvoicePaymentID(id int) *TransactionUpdate {
+	tu.mutation.SetInvoicePaymentID(id)
+	return tu
+}
+
+// SetNillableInvoicePaymentID sets the "invoice_payment" edge to the InvoicePayment entity by ID if the given value is not nil.
+func (tu *TransactionUpdate) SetNillableInvoicePaymentID(id *int) *TransactionUpdate {
+	if id != nil {
+		tu = tu.SetInvoicePaymentID(*id)
+	}
+	return tu
+}
+
+// SetInvoicePayment sets the "invoice_payment" edge to the InvoicePayment entity.
+func (tu *TransactionUpdate) SetInvoicePayment(i *InvoicePayment) *TransactionUpdate {
+	return tu.SetInvoicePaymentID(i.ID)
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
+func (tu *TransactionUpdate) AddJournalEntryIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.AddJournalEntryIDs(ids...)
+	return tu
+}
+
+// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
+func (tu *TransactionUpdate) AddJournalEntries(j ...*JournalEntry) *TransactionUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return tu.AddJournalEntryIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tu *TransactionUpdate) Mutation() *TransactionMutation {
 	return tu.mutation
 }
 
+// ClearInstallments clears all "installments" edges to the Transaction entity.
+func (tu *TransactionUpdate) ClearInstallments() *TransactionUpdate {
+	tu.mutation.ClearInstallments()
+	return tu
+}
+
+// RemoveInstallmentIDs removes the "installments" edge to Transaction entities by IDs.
+func (tu *TransactionUpdate) RemoveInstallmentIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.RemoveInstallmentIDs(ids...)
+	return tu
+}
+
+// RemoveInstallments removes "installments" edges to Transaction entities.
+func (tu *TransactionUpdate) RemoveInstallments(t ...*Transaction) *TransactionUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveInstallmentIDs(ids...)
+}
+
+// ClearReversal clears all "reversal" edges to the Transaction entity.
+func (tu *TransactionUpdate) ClearReversal() *TransactionUpdate {
+	tu.mutation.ClearReversal()
+	return tu
+}
+
+// RemoveReversalIDs removes the "reversal" edge to Transaction entities by IDs.
+func (tu *TransactionUpdate) RemoveReversalIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.RemoveReversalIDs(ids...)
+	return tu
+}
+
+// RemoveReversal removes "reversal" edges to Transaction entities.
+func (tu *TransactionUpdate) RemoveReversal(t ...*Transaction) *TransactionUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveReversalIDs(ids...)
+}
+
+// ClearHold clears the "hold" edge to the Hold entity.
+func (tu *TransactionUpdate) ClearHold() *TransactionUpdate {
+	tu.mutation.ClearHold()
+	return tu
+}
+
+// ClearAccrual clears the "accrual" edge to the Accrual entity.
+func (tu *TransactionUpdate) ClearAccrual() *TransactionUpdate {
+	tu.mutation.ClearAccrual()
+	return tu
+}
+
+// ClearCreditSettlements clears all "credit_settlements" edges to the Settlement entity.
+func (tu *TransactionUpdate) ClearCreditSettlements() *TransactionUpdate {
+	tu.mutation.ClearCreditSettlements()
+	return tu
+}
+
+// RemoveCreditSettlementIDs removes the "credit_settlements" edge to Settlement entities by IDs.
+func (tu *TransactionUpdate) RemoveCreditSettlementIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.RemoveCreditSettlementIDs(ids...)
+	return tu
+}
+
+// RemoveCreditSettlements removes "credit_settlements" edges to Settlement entities.
+func (tu *TransactionUpdate) RemoveCreditSettlements(s ...*Settlement) *TransactionUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.RemoveCreditSettlementIDs(ids...)
+}
+
+// ClearDebitSettlements clears all "debit_settlements" edges to the Settlement entity.
+func (tu *TransactionUpdate) ClearDebitSettlements() *TransactionUpdate {
+	tu.mutation.ClearDebitSettlements()
+	return tu
+}
+
+// RemoveDebitSettlementIDs removes the "debit_settlements" edge to Settlement entities by IDs.
+func (tu *TransactionUpdate) RemoveDebitSettlementIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.RemoveDebitSettlementIDs(ids...)
+	return tu
+}
+
+// RemoveDebitSettlements removes "debit_settlements" edges to Settlement entities.
+func (tu *TransactionUpdate) RemoveDebitSettlements(s ...*Settlement) *TransactionUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.RemoveDebitSettlementIDs(ids...)
+}
+
+// ClearInvoicePayment clears the "invoice_payment" edge to the InvoicePayment entity.
+func (tu *TransactionUpdate) ClearInvoicePayment() *TransactionUpdate {
+	tu.mutation.ClearInvoicePayment()
+	return tu
+}
+
+// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
+func (tu *TransactionUpdate) ClearJournalEntries() *TransactionUpdate {
+	tu.mutation.ClearJournalEntries()
+	return tu
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
+func (tu *TransactionUpdate) RemoveJournalEntryIDs(ids ...int) *TransactionUpdate {
+	tu.mutation.RemoveJournalEntryIDs(ids...)
+	return tu
+}
+
+// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
+func (tu *TransactionUpdate) RemoveJournalEntries(j ...*JournalEntry) *TransactionUpdate {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return tu.RemoveJournalEntryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TransactionUpdate) Save(ctx context.Context) (int, error) {
 	tu.defaults()
@@ -99,6 +374,11 @@ func (tu *TransactionUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (tu *TransactionUpdate) check() error {
+	if v, ok := tu.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
 	if tu.mutation.AccountCleared() && len(tu.mutation.AccountIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.account"`)
 	}
@@ -130,10 +410,355 @@ func (tu *TransactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		_spec.SetField(transaction.FieldUpdateTime, field.TypeTime, value)
 	}
 	if value, ok := tu.mutation.Balance(); ok {
-		_spec.SetField(transaction.FieldBalance, field.TypeFloat64, value)
+		_spec.SetField(transaction.FieldBalance, field.TypeInt64, value)
 	}
 	if value, ok := tu.mutation.AddedBalance(); ok {
-		_spec.AddField(transaction.FieldBalance, field.TypeFloat64, value)
+		_spec.AddField(transaction.FieldBalance, field.TypeInt64, value)
+	}
+	if tu.mutation.InstallmentNumberCleared() {
+		_spec.ClearField(transaction.FieldInstallmentNumber, field.TypeInt)
+	}
+	if tu.mutation.DueDateCleared() {
+		_spec.ClearField(transaction.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+	}
+	if tu.mutation.DescriptionCleared() {
+		_spec.ClearField(transaction.FieldDescription, field.TypeString)
+	}
+	if tu.mutation.MerchantNameCleared() {
+		_spec.ClearField(transaction.FieldMerchantName, field.TypeString)
+	}
+	if tu.mutation.MccCleared() {
+		_spec.ClearField(transaction.FieldMcc, field.TypeString)
+	}
+	if tu.mutation.ExternalReferenceCleared() {
+		_spec.ClearField(transaction.FieldExternalReference, field.TypeString)
+	}
+	if tu.mutation.MetadataCleared() {
+		_spec.ClearField(transaction.FieldMetadata, field.TypeJSON)
+	}
+	if tu.mutation.OriginalAmountCleared() {
+		_spec.ClearField(transaction.FieldOriginalAmount, field.TypeInt64)
+	}
+	if tu.mutation.OriginalCurrencyCleared() {
+		_spec.ClearField(transaction.FieldOriginalCurrency, field.TypeString)
+	}
+	if tu.mutation.FxRateCleared() {
+		_spec.ClearField(transaction.FieldFxRate, field.TypeString)
+	}
+	if tu.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.InstallmentsTable,
+			Columns: []string{transaction.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedInstallmentsIDs(); len(nodes) > 0 && !tu.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.InstallmentsTable,
+			Columns: []string{transaction.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.InstallmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.InstallmentsTable,
+			Columns: []string{transaction.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ReversalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalTable,
+			Columns: []string{transaction.ReversalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedReversalIDs(); len(nodes) > 0 && !tu.mutation.ReversalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalTable,
+			Columns: []string{transaction.ReversalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ReversalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalTable,
+			Columns: []string{transaction.ReversalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.HoldCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.HoldTable,
+			Columns: []string{transaction.HoldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.HoldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.HoldTable,
+			Columns: []string{transaction.HoldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AccrualCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.AccrualTable,
+			Columns: []string{transaction.AccrualColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AccrualIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.AccrualTable,
+			Columns: []string{transaction.AccrualColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CreditSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.CreditSettlementsTable,
+			Columns: []string{transaction.CreditSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedCreditSettlementsIDs(); len(nodes) > 0 && !tu.mutation.CreditSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.CreditSettlementsTable,
+			Columns: []string{transaction.CreditSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CreditSettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.CreditSettlementsTable,
+			Columns: []string{transaction.CreditSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.DebitSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.DebitSettlementsTable,
+			Columns: []string{transaction.DebitSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedDebitSettlementsIDs(); len(nodes) > 0 && !tu.mutation.DebitSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.DebitSettlementsTable,
+			Columns: []string{transaction.DebitSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.DebitSettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.DebitSettlementsTable,
+			Columns: []string{transaction.DebitSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.InvoicePaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.InvoicePaymentTable,
+			Columns: []string{transaction.InvoicePaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.InvoicePaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.InvoicePaymentTable,
+			Columns: []string{transaction.InvoicePaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.JournalEntriesTable,
+			Columns: []string{transaction.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedJournalEntriesIDs(); len(nodes) > 0 && !tu.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.JournalEntriesTable,
+			Columns: []string{transaction.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.JournalEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.JournalEntriesTable,
+			Columns: []string{transaction.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
@@ -164,31 +789,300 @@ func (tuo *TransactionUpdateOne) SetUpdateTime(t time.Time) *TransactionUpdateOn
 }
 
 // SetBalance sets the "balance" field.
-func (tuo *TransactionUpdateOne) SetBalance(f float64) *TransactionUpdateOne {
+func (tuo *TransactionUpdateOne) SetBalance(m money.Amount) *TransactionUpdateOne {
 	tuo.mutation.ResetBalance()
-	tuo.mutation.SetBalance(f)
+	tuo.mutation.SetBalance(m)
 	return tuo
 }
 
 // SetNillableBalance sets the "balance" field if the given value is not nil.
-func (tuo *TransactionUpdateOne) SetNillableBalance(f *float64) *TransactionUpdateOne {
-	if f != nil {
-		tuo.SetBalance(*f)
+func (tuo *TransactionUpdateOne) SetNillableBalance(m *money.Amount) *TransactionUpdateOne {
+	if m != nil {
+		tuo.SetBalance(*m)
 	}
 	return tuo
 }
 
-// AddBalance adds f to the "balance" field.
-func (tuo *TransactionUpdateOne) AddBalance(f float64) *TransactionUpdateOne {
-	tuo.mutation.AddBalance(f)
+// AddBalance adds m to the "balance" field.
+func (tuo *TransactionUpdateOne) AddBalance(m money.Amount) *TransactionUpdateOne {
+	tuo.mutation.AddBalance(m)
 	return tuo
 }
 
+// SetStatus sets the "status" field.
+func (tuo *TransactionUpdateOne) SetStatus(t transaction.Status) *TransactionUpdateOne {
+	tuo.mutation.SetStatus(t)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableStatus(t *transaction.Status) *TransactionUpdateOne {
+	if t != nil {
+		tuo.SetStatus(*t)
+	}
+	return tuo
+}
+
+// AddInstallmentIDs adds the "installments" edge to the Transaction entity by IDs.
+func (tuo *TransactionUpdateOne) AddInstallmentIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.AddInstallmentIDs(ids...)
+	return tuo
+}
+
+// AddInstallments adds the "installments" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) AddInstallments(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddInstallmentIDs(ids...)
+}
+
+// AddReversalIDs adds the "reversal" edge to the Transaction entity by IDs.
+func (tuo *TransactionUpdateOne) AddReversalIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.AddReversalIDs(ids...)
+	return tuo
+}
+
+// AddReversal adds the "reversal" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) AddReversal(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddReversalIDs(ids...)
+}
+
+// SetHoldID sets the "hold" edge to the Hold entity by ID.
+func (tuo *TransactionUpdateOne) SetHoldID(id int) *TransactionUpdateOne {
+	tuo.mutation.SetHoldID(id)
+	return tuo
+}
+
+// SetNillableHoldID sets the "hold" edge to the Hold entity by ID if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableHoldID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		tuo = tuo.SetHoldID(*id)
+	}
+	return tuo
+}
+
+// SetHold sets the "hold" edge to the Hold entity.
+func (tuo *TransactionUpdateOne) SetHold(h *Hold) *TransactionUpdateOne {
+	return tuo.SetHoldID(h.ID)
+}
+
+// SetAccrualID sets the "accrual" edge to the Accrual entity by ID.
+func (tuo *TransactionUpdateOne) SetAccrualID(id int) *TransactionUpdateOne {
+	tuo.mutation.SetAccrualID(id)
+	return tuo
+}
+
+// SetNillableAccrualID sets the "accrual" edge to the Accrual entity by ID if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableAccrualID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		tuo = tuo.SetAccrualID(*id)
+	}
+	return tuo
+}
+
+// SetAccrual sets the "accrual" edge to the Accrual entity.
+func (tuo *TransactionUpdateOne) SetAccrual(a *Accrual) *TransactionUpdateOne {
+	return tuo.SetAccrualID(a.ID)
+}
+
+// AddCreditSettlementIDs adds the "credit_settlements" edge to the Settlement entity by IDs.
+func (tuo *TransactionUpdateOne) AddCreditSettlementIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.AddCreditSettlementIDs(ids...)
+	return tuo
+}
+
+// AddCreditSettlements adds the "credit_settlements" edges to the Settlement entity.
+func (tuo *TransactionUpdateOne) AddCreditSettlements(s ...*Settlement) *TransactionUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.AddCreditSettlementIDs(ids...)
+}
+
+// AddDebitSettlementIDs adds the "debit_settlements" edge to the Settlement entity by IDs.
+func (tuo *TransactionUpdateOne) AddDebitSettlementIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.AddDebitSettlementIDs(ids...)
+	return tuo
+}
+
+// AddDebitSettlements adds the "debit_settlements" edges to the Settlement entity.
+func (tuo *TransactionUpdateOne) AddDebitSettlements(s ...*Settlement) *TransactionUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.AddDebitSettlementIDs(ids...)
+}
+
+// SetInvoicePaymentID sets the "invoice_payment" edge to the InvoicePayment entity by ID.
+func (tuo *TransactionUpdateOne) SetInvoicePaymentID(id int) *TransactionUpdateOne {
+	tuo.mutation.SetInvoicePaymentID(id)
+	return tuo
+}
+
+// SetNillableInvoicePaymentID sets the "invoice_payment" edge to the InvoicePayment entity by ID if the given value is not nil.
+func (tuo *TransactionUpdateOne) SetNillableInvoicePaymentID(id *int) *TransactionUpdateOne {
+	if id != nil {
+		tuo = tuo.SetInvoicePaymentID(*id)
+	}
+	return tuo
+}
+
+// SetInvoicePayment sets the "invoice_payment" edge to the InvoicePayment entity.
+func (tuo *TransactionUpdateOne) SetInvoicePayment(i *InvoicePayment) *TransactionUpdateOne {
+	return tuo.SetInvoicePaymentID(i.ID)
+}
+
+// AddJournalEntryIDs adds the "journal_entries" edge to the JournalEntry entity by IDs.
+func (tuo *TransactionUpdateOne) AddJournalEntryIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.AddJournalEntryIDs(ids...)
+	return tuo
+}
+
+// AddJournalEntries adds the "journal_entries" edges to the JournalEntry entity.
+func (tuo *TransactionUpdateOne) AddJournalEntries(j ...*JournalEntry) *TransactionUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return tuo.AddJournalEntryIDs(ids...)
+}
+
 // Mutation returns the TransactionMutation object of the builder.
 func (tuo *TransactionUpdateOne) Mutation() *TransactionMutation {
 	return tuo.mutation
 }
 
+// ClearInstallments clears all "installments" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) ClearInstallments() *TransactionUpdateOne {
+	tuo.mutation.ClearInstallments()
+	return tuo
+}
+
+// RemoveInstallmentIDs removes the "installments" edge to Transaction entities by IDs.
+func (tuo *TransactionUpdateOne) RemoveInstallmentIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.RemoveInstallmentIDs(ids...)
+	return tuo
+}
+
+// RemoveInstallments removes "installments" edges to Transaction entities.
+func (tuo *TransactionUpdateOne) RemoveInstallments(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveInstallmentIDs(ids...)
+}
+
+// ClearReversal clears all "reversal" edges to the Transaction entity.
+func (tuo *TransactionUpdateOne) ClearReversal() *TransactionUpdateOne {
+	tuo.mutation.ClearReversal()
+	return tuo
+}
+
+// RemoveReversalIDs removes the "reversal" edge to Transaction entities by IDs.
+func (tuo *TransactionUpdateOne) RemoveReversalIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.RemoveReversalIDs(ids...)
+	return tuo
+}
+
+// RemoveReversal removes "reversal" edges to Transaction entities.
+func (tuo *TransactionUpdateOne) RemoveReversal(t ...*Transaction) *TransactionUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveReversalIDs(ids...)
+}
+
+// ClearHold clears the "hold" edge to the Hold entity.
+func (tuo *TransactionUpdateOne) ClearHold() *TransactionUpdateOne {
+	tuo.mutation.ClearHold()
+	return tuo
+}
+
+// ClearAccrual clears the "accrual" edge to the Accrual entity.
+func (tuo *TransactionUpdateOne) ClearAccrual() *TransactionUpdateOne {
+	tuo.mutation.ClearAccrual()
+	return tuo
+}
+
+// ClearCreditSettlements clears all "credit_settlements" edges to the Settlement entity.
+func (tuo *TransactionUpdateOne) ClearCreditSettlements() *TransactionUpdateOne {
+	tuo.mutation.ClearCreditSettlements()
+	return tuo
+}
+
+// RemoveCreditSettlementIDs removes the "credit_settlements" edge to Settlement entities by IDs.
+func (tuo *TransactionUpdateOne) RemoveCreditSettlementIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.RemoveCreditSettlementIDs(ids...)
+	return tuo
+}
+
+// RemoveCreditSettlements removes "credit_settlements" edges to Settlement entities.
+func (tuo *TransactionUpdateOne) RemoveCreditSettlements(s ...*Settlement) *TransactionUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.RemoveCreditSettlementIDs(ids...)
+}
+
+// ClearDebitSettlements clears all "debit_settlements" edges to the Settlement entity.
+func (tuo *TransactionUpdateOne) ClearDebitSettlements() *TransactionUpdateOne {
+	tuo.mutation.ClearDebitSettlements()
+	return tuo
+}
+
+// RemoveDebitSettlementIDs removes the "debit_settlements" edge to Settlement entities by IDs.
+func (tuo *TransactionUpdateOne) RemoveDebitSettlementIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.RemoveDebitSettlementIDs(ids...)
+	return tuo
+}
+
+// RemoveDebitSettlements removes "debit_settlements" edges to Settlement entities.
+func (tuo *TransactionUpdateOne) RemoveDebitSettlements(s ...*Settlement) *TransactionUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.RemoveDebitSettlementIDs(ids...)
+}
+
+// ClearInvoicePayment clears the "invoice_payment" edge to the InvoicePayment entity.
+func (tuo *TransactionUpdateOne) ClearInvoicePayment() *TransactionUpdateOne {
+	tuo.mutation.ClearInvoicePayment()
+	return tuo
+}
+
+// ClearJournalEntries clears all "journal_entries" edges to the JournalEntry entity.
+func (tuo *TransactionUpdateOne) ClearJournalEntries() *TransactionUpdateOne {
+	tuo.mutation.ClearJournalEntries()
+	return tuo
+}
+
+// RemoveJournalEntryIDs removes the "journal_entries" edge to JournalEntry entities by IDs.
+func (tuo *TransactionUpdateOne) RemoveJournalEntryIDs(ids ...int) *TransactionUpdateOne {
+	tuo.mutation.RemoveJournalEntryIDs(ids...)
+	return tuo
+}
+
+// RemoveJournalEntries removes "journal_entries" edges to JournalEntry entities.
+func (tuo *TransactionUpdateOne) RemoveJournalEntries(j ...*JournalEntry) *TransactionUpdateOne {
+	ids := make([]int, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return tuo.RemoveJournalEntryIDs(ids...)
+}
+
 // Where appends a list predicates to the TransactionUpdate builder.
 func (tuo *TransactionUpdateOne) Where(ps ...predicate.Transaction) *TransactionUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -240,6 +1134,11 @@ func (tuo *TransactionUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (tuo *TransactionUpdateOne) check() error {
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := transaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Transaction.status": %w`, err)}
+		}
+	}
 	if tuo.mutation.AccountCleared() && len(tuo.mutation.AccountIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Transaction.account"`)
 	}
@@ -288,10 +1187,355 @@ func (tuo *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transactio
 		_spec.SetField(transaction.FieldUpdateTime, field.TypeTime, value)
 	}
 	if value, ok := tuo.mutation.Balance(); ok {
-		_spec.SetField(transaction.FieldBalance, field.TypeFloat64, value)
+		_spec.SetField(transaction.FieldBalance, field.TypeInt64, value)
 	}
 	if value, ok := tuo.mutation.AddedBalance(); ok {
-		_spec.AddField(transaction.FieldBalance, field.TypeFloat64, value)
+		_spec.AddField(transaction.FieldBalance, field.TypeInt64, value)
+	}
+	if tuo.mutation.InstallmentNumberCleared() {
+		_spec.ClearField(transaction.FieldInstallmentNumber, field.TypeInt)
+	}
+	if tuo.mutation.DueDateCleared() {
+		_spec.ClearField(transaction.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(transaction.FieldStatus, field.TypeEnum, value)
+	}
+	if tuo.mutation.DescriptionCleared() {
+		_spec.ClearField(transaction.FieldDescription, field.TypeString)
+	}
+	if tuo.mutation.MerchantNameCleared() {
+		_spec.ClearField(transaction.FieldMerchantName, field.TypeString)
+	}
+	if tuo.mutation.MccCleared() {
+		_spec.ClearField(transaction.FieldMcc, field.TypeString)
+	}
+	if tuo.mutation.ExternalReferenceCleared() {
+		_spec.ClearField(transaction.FieldExternalReference, field.TypeString)
+	}
+	if tuo.mutation.MetadataCleared() {
+		_spec.ClearField(transaction.FieldMetadata, field.TypeJSON)
+	}
+	if tuo.mutation.OriginalAmountCleared() {
+		_spec.ClearField(transaction.FieldOriginalAmount, field.TypeInt64)
+	}
+	if tuo.mutation.OriginalCurrencyCleared() {
+		_spec.ClearField(transaction.FieldOriginalCurrency, field.TypeString)
+	}
+	if tuo.mutation.FxRateCleared() {
+		_spec.ClearField(transaction.FieldFxRate, field.TypeString)
+	}
+	if tuo.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.InstallmentsTable,
+			Columns: []string{transaction.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedInstallmentsIDs(); len(nodes) > 0 && !tuo.mutation.InstallmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.InstallmentsTable,
+			Columns: []string{transaction.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.InstallmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.InstallmentsTable,
+			Columns: []string{transaction.InstallmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ReversalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalTable,
+			Columns: []string{transaction.ReversalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedReversalIDs(); len(nodes) > 0 && !tuo.mutation.ReversalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalTable,
+			Columns: []string{transaction.ReversalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ReversalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.ReversalTable,
+			Columns: []string{transaction.ReversalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.HoldCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.HoldTable,
+			Columns: []string{transaction.HoldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.HoldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.HoldTable,
+			Columns: []string{transaction.HoldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AccrualCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.AccrualTable,
+			Columns: []string{transaction.AccrualColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AccrualIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.AccrualTable,
+			Columns: []string{transaction.AccrualColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accrual.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.CreditSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.CreditSettlementsTable,
+			Columns: []string{transaction.CreditSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedCreditSettlementsIDs(); len(nodes) > 0 && !tuo.mutation.CreditSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.CreditSettlementsTable,
+			Columns: []string{transaction.CreditSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CreditSettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.CreditSettlementsTable,
+			Columns: []string{transaction.CreditSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.DebitSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.DebitSettlementsTable,
+			Columns: []string{transaction.DebitSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedDebitSettlementsIDs(); len(nodes) > 0 && !tuo.mutation.DebitSettlementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.DebitSettlementsTable,
+			Columns: []string{transaction.DebitSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.DebitSettlementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.DebitSettlementsTable,
+			Columns: []string{transaction.DebitSettlementsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(settlement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.InvoicePaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.InvoicePaymentTable,
+			Columns: []string{transaction.InvoicePaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.InvoicePaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   transaction.InvoicePaymentTable,
+			Columns: []string{transaction.InvoicePaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoicepayment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.JournalEntriesTable,
+			Columns: []string{transaction.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedJournalEntriesIDs(); len(nodes) > 0 && !tuo.mutation.JournalEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.JournalEntriesTable,
+			Columns: []string{transaction.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.JournalEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transaction.JournalEntriesTable,
+			Columns: []string{transaction.JournalEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(journalentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Transaction{config: tuo.config}
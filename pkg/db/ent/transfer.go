@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Transfer is the model entity for the Transfer schema.
+type Transfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// SourceAccountID holds the value of the "source_account_id" field.
+	SourceAccountID int `json:"source_account_id,omitempty"`
+	// DestinationAccountID holds the value of the "destination_account_id" field.
+	DestinationAccountID int `json:"destination_account_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TransferQuery when eager-loading is set.
+	Edges        TransferEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TransferEdges holds the relations/edges for other nodes in the graph.
+type TransferEdges struct {
+	// SourceAccount holds the value of the source_account edge.
+	SourceAccount *Account `json:"source_account,omitempty"`
+	// DestinationAccount holds the value of the destination_account edge.
+	DestinationAccount *Account `json:"destination_account,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*Transaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// SourceAccountOrErr returns the SourceAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferEdges) SourceAccountOrErr() (*Account, error) {
+	if e.SourceAccount != nil {
+		return e.SourceAccount, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "source_account"}
+}
+
+// DestinationAccountOrErr returns the DestinationAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferEdges) DestinationAccountOrErr() (*Account, error) {
+	if e.DestinationAccount != nil {
+		return e.DestinationAccount, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "destination_account"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TransferEdges) TransactionsOrErr() ([]*Transaction, error) {
+	if e.loadedTypes[2] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Transfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldID, transfer.FieldSourceAccountID, transfer.FieldDestinationAccountID, transfer.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case transfer.FieldCreateTime, transfer.FieldUpdateTime, transfer.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Transfer fields.
+func (t *Transfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case transfer.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				t.CreateTime = value.Time
+			}
+		case transfer.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				t.UpdateTime = value.Time
+			}
+		case transfer.FieldSourceAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_account_id", values[i])
+			} else if value.Valid {
+				t.SourceAccountID = int(value.Int64)
+			}
+		case transfer.FieldDestinationAccountID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field destination_account_id", values[i])
+			} else if value.Valid {
+				t.DestinationAccountID = int(value.Int64)
+			}
+		case transfer.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				t.Amount = money.Amount(value.Int64)
+			}
+		case transfer.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				t.Timestamp = value.Time
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Transfer.
+// This includes values selected through modifiers, order, etc.
+func (t *Transfer) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// QuerySourceAccount queries the "source_account" edge of the Transfer entity.
+func (t *Transfer) QuerySourceAccount() *AccountQuery {
+	return NewTransferClient(t.config).QuerySourceAccount(t)
+}
+
+// QueryDestinationAccount queries the "destination_account" edge of the Transfer entity.
+func (t *Transfer) QueryDestinationAccount() *AccountQuery {
+	return NewTransferClient(t.config).QueryDestinationAccount(t)
+}
+
+// QueryTransactions queries the "transactions" edge of the Transfer entity.
+func (t *Transfer) QueryTransactions() *TransactionQuery {
+	return NewTransferClient(t.config).QueryTransactions(t)
+}
+
+// Update returns a builder for updating this Transfer.
+// Note that you need to call Transfer.Unwrap() before calling this method if this Transfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Transfer) Update() *TransferUpdateOne {
+	return NewTransferClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Transfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Transfer) Unwrap() *Transfer {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Transfer is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Transfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Transfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(t.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(t.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.SourceAccountID))
+	builder.WriteString(", ")
+	builder.WriteString("destination_account_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.DestinationAccountID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", t.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(t.Timestamp.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Transfers is a parsable slice of Transfer.
+type Transfers []*Transfer
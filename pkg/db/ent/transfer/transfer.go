@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the transfer type in the database.
+	Label = "transfer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldSourceAccountID holds the string denoting the source_account_id field in the database.
+	FieldSourceAccountID = "source_account_id"
+	// FieldDestinationAccountID holds the string denoting the destination_account_id field in the database.
+	FieldDestinationAccountID = "destination_account_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// EdgeSourceAccount holds the string denoting the source_account edge name in mutations.
+	EdgeSourceAccount = "source_account"
+	// EdgeDestinationAccount holds the string denoting the destination_account edge name in mutations.
+	EdgeDestinationAccount = "destination_account"
+	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
+	EdgeTransactions = "transactions"
+	// Table holds the table name of the transfer in the database.
+	Table = "transfers"
+	// SourceAccountTable is the table that holds the source_account relation/edge.
+	SourceAccountTable = "transfers"
+	// SourceAccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	SourceAccountInverseTable = "accounts"
+	// SourceAccountColumn is the table column denoting the source_account relation/edge.
+	SourceAccountColumn = "source_account_id"
+	// DestinationAccountTable is the table that holds the destination_account relation/edge.
+	DestinationAccountTable = "transfers"
+	// DestinationAccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	DestinationAccountInverseTable = "accounts"
+	// DestinationAccountColumn is the table column denoting the destination_account relation/edge.
+	DestinationAccountColumn = "destination_account_id"
+	// TransactionsTable is the table that holds the transactions relation/edge.
+	TransactionsTable = "transactions"
+	// TransactionsInverseTable is the table name for the Transaction entity.
+	// It exists in this package in order to avoid circular dependency with the "transaction" package.
+	TransactionsInverseTable = "transactions"
+	// TransactionsColumn is the table column denoting the transactions relation/edge.
+	TransactionsColumn = "transfer_id"
+)
+
+// Columns holds all SQL columns for transfer fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldSourceAccountID,
+	FieldDestinationAccountID,
+	FieldAmount,
+	FieldTimestamp,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+)
+
+// OrderOption defines the ordering options for the Transfer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// BySourceAccountID orders the results by the source_account_id field.
+func BySourceAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceAccountID, opts...).ToFunc()
+}
+
+// ByDestinationAccountID orders the results by the destination_account_id field.
+func ByDestinationAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestinationAccountID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// BySourceAccountField orders the results by source_account field.
+func BySourceAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourceAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDestinationAccountField orders the results by destination_account field.
+func ByDestinationAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDestinationAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTransactionsCount orders the results by transactions count.
+func ByTransactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTransactionsStep(), opts...)
+	}
+}
+
+// ByTransactions orders the results by transactions terms.
+func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSourceAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourceAccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SourceAccountTable, SourceAccountColumn),
+	)
+}
+func newDestinationAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DestinationAccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DestinationAccountTable, DestinationAccountColumn),
+	)
+}
+func newTransactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TransactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+	)
+}
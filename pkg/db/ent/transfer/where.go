@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transfer
+
+import (
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// SourceAccountID applies equality check predicate on the "source_account_id" field. It's identical to SourceAccountIDEQ.
+func SourceAccountID(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldSourceAccountID, v))
+}
+
+// DestinationAccountID applies equality check predicate on the "destination_account_id" field. It's identical to DestinationAccountIDEQ.
+func DestinationAccountID(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldDestinationAccountID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v money.Amount) predicate.Transfer {
+	vc := int64(v)
+	return predicate.Transfer(sql.FieldEQ(FieldAmount, vc))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// SourceAccountIDEQ applies the EQ predicate on the "source_account_id" field.
+func SourceAccountIDEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldSourceAccountID, v))
+}
+
+// SourceAccountIDNEQ applies the NEQ predicate on the "source_account_id" field.
+func SourceAccountIDNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldSourceAccountID, v))
+}
+
+// SourceAccountIDIn applies the In predicate on the "source_account_id" field.
+func SourceAccountIDIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldSourceAccountID, vs...))
+}
+
+// SourceAccountIDNotIn applies the NotIn predicate on the "source_account_id" field.
+func SourceAccountIDNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldSourceAccountID, vs...))
+}
+
+// DestinationAccountIDEQ applies the EQ predicate on the "destination_account_id" field.
+func DestinationAccountIDEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldDestinationAccountID, v))
+}
+
+// DestinationAccountIDNEQ applies the NEQ predicate on the "destination_account_id" field.
+func DestinationAccountIDNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldDestinationAccountID, v))
+}
+
+// DestinationAccountIDIn applies the In predicate on the "destination_account_id" field.
+func DestinationAccountIDIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldDestinationAccountID, vs...))
+}
+
+// DestinationAccountIDNotIn applies the NotIn predicate on the "destination_account_id" field.
+func DestinationAccountIDNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldDestinationAccountID, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v money.Amount) predicate.Transfer {
+	vc := int64(v)
+	return predicate.Transfer(sql.FieldEQ(FieldAmount, vc))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v money.Amount) predicate.Transfer {
+	vc := int64(v)
+	return predicate.Transfer(sql.FieldNEQ(FieldAmount, vc))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...money.Amount) predicate.Transfer {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transfer(sql.FieldIn(FieldAmount, v...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...money.Amount) predicate.Transfer {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Transfer(sql.FieldNotIn(FieldAmount, v...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v money.Amount) predicate.Transfer {
+	vc := int64(v)
+	return predicate.Transfer(sql.FieldGT(FieldAmount, vc))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v money.Amount) predicate.Transfer {
+	vc := int64(v)
+	return predicate.Transfer(sql.FieldGTE(FieldAmount, vc))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v money.Amount) predicate.Transfer {
+	vc := int64(v)
+	return predicate.Transfer(sql.FieldLT(FieldAmount, vc))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v money.Amount) predicate.Transfer {
+	vc := int64(v)
+	return predicate.Transfer(sql.FieldLTE(FieldAmount, vc))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// HasSourceAccount applies the HasEdge predicate on the "source_account" edge.
+func HasSourceAccount() predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SourceAccountTable, SourceAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSourceAccountWith applies the HasEdge predicate on the "source_account" edge with a given conditions (other predicates).
+func HasSourceAccountWith(preds ...predicate.Account) predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := newSourceAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDestinationAccount applies the HasEdge predicate on the "destination_account" edge.
+func HasDestinationAccount() predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DestinationAccountTable, DestinationAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDestinationAccountWith applies the HasEdge predicate on the "destination_account" edge with a given conditions (other predicates).
+func HasDestinationAccountWith(preds ...predicate.Account) predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := newDestinationAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.Transaction) predicate.Transfer {
+	return predicate.Transfer(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.NotPredicates(p))
+}
@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/account"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferCreate is the builder for creating a Transfer entity.
+type TransferCreate struct {
+	config
+	mutation *TransferMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (tc *TransferCreate) SetCreateTime(t time.Time) *TransferCreate {
+	tc.mutation.SetCreateTime(t)
+	return tc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableCreateTime(t *time.Time) *TransferCreate {
+	if t != nil {
+		tc.SetCreateTime(*t)
+	}
+	return tc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tc *TransferCreate) SetUpdateTime(t time.Time) *TransferCreate {
+	tc.mutation.SetUpdateTime(t)
+	return tc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableUpdateTime(t *time.Time) *TransferCreate {
+	if t != nil {
+		tc.SetUpdateTime(*t)
+	}
+	return tc
+}
+
+// SetSourceAccountID sets the "source_account_id" field.
+func (tc *TransferCreate) SetSourceAccountID(i int) *TransferCreate {
+	tc.mutation.SetSourceAccountID(i)
+	return tc
+}
+
+// SetDestinationAccountID sets the "destination_account_id" field.
+func (tc *TransferCreate) SetDestinationAccountID(i int) *TransferCreate {
+	tc.mutation.SetDestinationAccountID(i)
+	return tc
+}
+
+// SetAmount sets the "amount" field.
+func (tc *TransferCreate) SetAmount(m money.Amount) *TransferCreate {
+	tc.mutation.SetAmount(m)
+	return tc
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (tc *TransferCreate) SetTimestamp(t time.Time) *TransferCreate {
+	tc.mutation.SetTimestamp(t)
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TransferCreate) SetID(i int) *TransferCreate {
+	tc.mutation.SetID(i)
+	return tc
+}
+
+// SetSourceAccount sets the "source_account" edge to the Account entity.
+func (tc *TransferCreate) SetSourceAccount(a *Account) *TransferCreate {
+	return tc.SetSourceAccountID(a.ID)
+}
+
+// SetDestinationAccount sets the "destination_account" edge to the Account entity.
+func (tc *TransferCreate) SetDestinationAccount(a *Account) *TransferCreate {
+	return tc.SetDestinationAccountID(a.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (tc *TransferCreate) AddTransactionIDs(ids ...int) *TransferCreate {
+	tc.mutation.AddTransactionIDs(ids...)
+	return tc
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (tc *TransferCreate) AddTransactions(t ...*Transaction) *TransferCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (tc *TransferCreate) Mutation() *TransferMutation {
+	return tc.mutation
+}
+
+// Save creates the Transfer in the database.
+func (tc *TransferCreate) Save(ctx context.Context) (*Transfer, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TransferCreate) SaveX(ctx context.Context) *Transfer {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TransferCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TransferCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TransferCreate) defaults() {
+	if _, ok := tc.mutation.CreateTime(); !ok {
+		v := transfer.DefaultCreateTime()
+		tc.mutation.SetCreateTime(v)
+	}
+	if _, ok := tc.mutation.UpdateTime(); !ok {
+		v := transfer.DefaultUpdateTime()
+		tc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TransferCreate) check() error {
+	if _, ok := tc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Transfer.create_time"`)}
+	}
+	if _, ok := tc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Transfer.update_time"`)}
+	}
+	if _, ok := tc.mutation.SourceAccountID(); !ok {
+		return &ValidationError{Name: "source_account_id", err: errors.New(`ent: missing required field "Transfer.source_account_id"`)}
+	}
+	if _, ok := tc.mutation.DestinationAccountID(); !ok {
+		return &ValidationError{Name: "destination_account_id", err: errors.New(`ent: missing required field "Transfer.destination_account_id"`)}
+	}
+	if _, ok := tc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Transfer.amount"`)}
+	}
+	if _, ok := tc.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Transfer.timestamp"`)}
+	}
+	if len(tc.mutation.SourceAccountIDs()) == 0 {
+		return &ValidationError{Name: "source_account", err: errors.New(`ent: missing required edge "Transfer.source_account"`)}
+	}
+	if len(tc.mutation.DestinationAccountIDs()) == 0 {
+		return &ValidationError{Name: "destination_account", err: errors.New(`ent: missing required edge "Transfer.destination_account"`)}
+	}
+	return nil
+}
+
+func (tc *TransferCreate) sqlSave(ctx context.Context) (*Transfer, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Transfer{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(transfer.Table, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tc.conflict
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tc.mutation.CreateTime(); ok {
+		_spec.SetField(transfer.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := tc.mutation.UpdateTime(); ok {
+		_spec.SetField(transfer.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := tc.mutation.Amount(); ok {
+		_spec.SetField(transfer.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := tc.mutation.Timestamp(); ok {
+		_spec.SetField(transfer.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if nodes := tc.mutation.SourceAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.SourceAccountTable,
+			Columns: []string{transfer.SourceAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SourceAccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.DestinationAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   transfer.DestinationAccountTable,
+			Columns: []string{transfer.DestinationAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DestinationAccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transfer.TransactionsTable,
+			Columns: []string{transfer.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transfer.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransferUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TransferCreate) OnConflict(opts ...sql.ConflictOption) *TransferUpsertOne {
+	tc.conflict = opts
+	return &TransferUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TransferCreate) OnConflictColumns(columns ...string) *TransferUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TransferUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TransferUpsertOne is the builder for "upsert"-ing
+	//  one Transfer node.
+	TransferUpsertOne struct {
+		create *TransferCreate
+	}
+
+	// TransferUpsert is the "OnConflict" setter.
+	TransferUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TransferUpsert) SetUpdateTime(v time.Time) *TransferUpsert {
+	u.Set(transfer.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateUpdateTime() *TransferUpsert {
+	u.SetExcluded(transfer.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transfer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransferUpsertOne) UpdateNewValues() *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transfer.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(transfer.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.SourceAccountID(); exists {
+			s.SetIgnore(transfer.FieldSourceAccountID)
+		}
+		if _, exists := u.create.mutation.DestinationAccountID(); exists {
+			s.SetIgnore(transfer.FieldDestinationAccountID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(transfer.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Timestamp(); exists {
+			s.SetIgnore(transfer.FieldTimestamp)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransferUpsertOne) Ignore() *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransferUpsertOne) DoNothing() *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransferCreate.OnConflict
+// documentation for more info.
+func (u *TransferUpsertOne) Update(set func(*TransferUpsert)) *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TransferUpsertOne) SetUpdateTime(v time.Time) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateUpdateTime() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TransferUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransferCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransferUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransferUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransferUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransferCreateBulk is the builder for creating many Transfer entities in bulk.
+type TransferCreateBulk struct {
+	config
+	err      error
+	builders []*TransferCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Transfer entities in the database.
+func (tcb *TransferCreateBulk) Save(ctx context.Context) ([]*Transfer, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Transfer, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TransferCreateBulk) SaveX(ctx context.Context) []*Transfer {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TransferCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transfer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransferUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TransferCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransferUpsertBulk {
+	tcb.conflict = opts
+	return &TransferUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TransferCreateBulk) OnConflictColumns(columns ...string) *TransferUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TransferUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TransferUpsertBulk is the builder for "upsert"-ing
+// a bulk of Transfer nodes.
+type TransferUpsertBulk struct {
+	create *TransferCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transfer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransferUpsertBulk) UpdateNewValues() *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transfer.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(transfer.FieldCreateTime)
+			}
+			if _, exists := b.mutation.SourceAccountID(); exists {
+				s.SetIgnore(transfer.FieldSourceAccountID)
+			}
+			if _, exists := b.mutation.DestinationAccountID(); exists {
+				s.SetIgnore(transfer.FieldDestinationAccountID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(transfer.FieldAmount)
+			}
+			if _, exists := b.mutation.Timestamp(); exists {
+				s.SetIgnore(transfer.FieldTimestamp)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransferUpsertBulk) Ignore() *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransferUpsertBulk) DoNothing() *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransferCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransferUpsertBulk) Update(set func(*TransferUpsert)) *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TransferUpsertBulk) SetUpdateTime(v time.Time) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateUpdateTime() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TransferUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransferCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransferCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransferUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
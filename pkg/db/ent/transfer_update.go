@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/db/ent/transfer"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferUpdate is the builder for updating Transfer entities.
+type TransferUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransferUpdate builder.
+func (tu *TransferUpdate) Where(ps ...predicate.Transfer) *TransferUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tu *TransferUpdate) SetUpdateTime(t time.Time) *TransferUpdate {
+	tu.mutation.SetUpdateTime(t)
+	return tu
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (tu *TransferUpdate) AddTransactionIDs(ids ...int) *TransferUpdate {
+	tu.mutation.AddTransactionIDs(ids...)
+	return tu
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (tu *TransferUpdate) AddTransactions(t ...*Transaction) *TransferUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (tu *TransferUpdate) Mutation() *TransferMutation {
+	return tu.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (tu *TransferUpdate) ClearTransactions() *TransferUpdate {
+	tu.mutation.ClearTransactions()
+	return tu
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (tu *TransferUpdate) RemoveTransactionIDs(ids ...int) *TransferUpdate {
+	tu.mutation.RemoveTransactionIDs(ids...)
+	return tu
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (tu *TransferUpdate) RemoveTransactions(t ...*Transaction) *TransferUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TransferUpdate) Save(ctx context.Context) (int, error) {
+	tu.defaults()
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TransferUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TransferUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tu *TransferUpdate) defaults() {
+	if _, ok := tu.mutation.UpdateTime(); !ok {
+		v := transfer.UpdateDefaultUpdateTime()
+		tu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TransferUpdate) check() error {
+	if tu.mutation.SourceAccountCleared() && len(tu.mutation.SourceAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.source_account"`)
+	}
+	if tu.mutation.DestinationAccountCleared() && len(tu.mutation.DestinationAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.destination_account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tu *TransferUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferUpdate {
+	tu.modifiers = append(tu.modifiers, modifiers...)
+	return tu
+}
+
+func (tu *TransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transfer.Table, transfer.Columns, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.UpdateTime(); ok {
+		_spec.SetField(transfer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if tu.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transfer.TransactionsTable,
+			Columns: []string{transfer.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !tu.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transfer.TransactionsTable,
+			Columns: []string{transfer.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transfer.TransactionsTable,
+			Columns: []string{transfer.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TransferUpdateOne is the builder for updating a single Transfer entity.
+type TransferUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransferMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tuo *TransferUpdateOne) SetUpdateTime(t time.Time) *TransferUpdateOne {
+	tuo.mutation.SetUpdateTime(t)
+	return tuo
+}
+
+// AddTransactionIDs adds the "transactions" edge to the Transaction entity by IDs.
+func (tuo *TransferUpdateOne) AddTransactionIDs(ids ...int) *TransferUpdateOne {
+	tuo.mutation.AddTransactionIDs(ids...)
+	return tuo
+}
+
+// AddTransactions adds the "transactions" edges to the Transaction entity.
+func (tuo *TransferUpdateOne) AddTransactions(t ...*Transaction) *TransferUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (tuo *TransferUpdateOne) Mutation() *TransferMutation {
+	return tuo.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the Transaction entity.
+func (tuo *TransferUpdateOne) ClearTransactions() *TransferUpdateOne {
+	tuo.mutation.ClearTransactions()
+	return tuo
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to Transaction entities by IDs.
+func (tuo *TransferUpdateOne) RemoveTransactionIDs(ids ...int) *TransferUpdateOne {
+	tuo.mutation.RemoveTransactionIDs(ids...)
+	return tuo
+}
+
+// RemoveTransactions removes "transactions" edges to Transaction entities.
+func (tuo *TransferUpdateOne) RemoveTransactions(t ...*Transaction) *TransferUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the TransferUpdate builder.
+func (tuo *TransferUpdateOne) Where(ps ...predicate.Transfer) *TransferUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TransferUpdateOne) Select(field string, fields ...string) *TransferUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Transfer entity.
+func (tuo *TransferUpdateOne) Save(ctx context.Context) (*Transfer, error) {
+	tuo.defaults()
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TransferUpdateOne) SaveX(ctx context.Context) *Transfer {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TransferUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tuo *TransferUpdateOne) defaults() {
+	if _, ok := tuo.mutation.UpdateTime(); !ok {
+		v := transfer.UpdateDefaultUpdateTime()
+		tuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TransferUpdateOne) check() error {
+	if tuo.mutation.SourceAccountCleared() && len(tuo.mutation.SourceAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.source_account"`)
+	}
+	if tuo.mutation.DestinationAccountCleared() && len(tuo.mutation.DestinationAccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Transfer.destination_account"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuo *TransferUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransferUpdateOne {
+	tuo.modifiers = append(tuo.modifiers, modifiers...)
+	return tuo
+}
+
+func (tuo *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transfer.Table, transfer.Columns, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Transfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transfer.FieldID)
+		for _, f := range fields {
+			if !transfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.UpdateTime(); ok {
+		_spec.SetField(transfer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if tuo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transfer.TransactionsTable,
+			Columns: []string{transfer.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !tuo.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transfer.TransactionsTable,
+			Columns: []string{transfer.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   transfer.TransactionsTable,
+			Columns: []string{transfer.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(transaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tuo.modifiers...)
+	_node = &Transfer{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/db/ent/webhooksubscription"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID int `json:"subscription_id,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID int `json:"event_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status webhookdelivery.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// ResponseStatus holds the value of the "response_status" field.
+	ResponseStatus *int `json:"response_status,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliveryQuery when eager-loading is set.
+	Edges        WebhookDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookDeliveryEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliveryEdges struct {
+	// Subscription holds the value of the subscription edge.
+	Subscription *WebhookSubscription `json:"subscription,omitempty"`
+	// Event holds the value of the event edge.
+	Event *OutboxEvent `json:"event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) SubscriptionOrErr() (*WebhookSubscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: webhooksubscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) EventOrErr() (*OutboxEvent, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: outboxevent.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID, webhookdelivery.FieldSubscriptionID, webhookdelivery.FieldEventID, webhookdelivery.FieldAttempts, webhookdelivery.FieldResponseStatus:
+			values[i] = new(sql.NullInt64)
+		case webhookdelivery.FieldStatus, webhookdelivery.FieldLastError:
+			values[i] = new(sql.NullString)
+		case webhookdelivery.FieldCreateTime, webhookdelivery.FieldUpdateTime, webhookdelivery.FieldNextAttemptAt, webhookdelivery.FieldDeliveredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (wd *WebhookDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wd.ID = int(value.Int64)
+		case webhookdelivery.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				wd.CreateTime = value.Time
+			}
+		case webhookdelivery.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				wd.UpdateTime = value.Time
+			}
+		case webhookdelivery.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				wd.SubscriptionID = int(value.Int64)
+			}
+		case webhookdelivery.FieldEventID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				wd.EventID = int(value.Int64)
+			}
+		case webhookdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wd.Status = webhookdelivery.Status(value.String)
+			}
+		case webhookdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				wd.Attempts = int(value.Int64)
+			}
+		case webhookdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				wd.NextAttemptAt = value.Time
+			}
+		case webhookdelivery.FieldResponseStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status", values[i])
+			} else if value.Valid {
+				wd.ResponseStatus = new(int)
+				*wd.ResponseStatus = int(value.Int64)
+			}
+		case webhookdelivery.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				wd.LastError = new(string)
+				*wd.LastError = value.String
+			}
+		case webhookdelivery.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				wd.DeliveredAt = new(time.Time)
+				*wd.DeliveredAt = value.Time
+			}
+		default:
+			wd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDelivery.
+// This includes values selected through modifiers, order, etc.
+func (wd *WebhookDelivery) Value(name string) (ent.Value, error) {
+	return wd.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QuerySubscription() *WebhookSubscriptionQuery {
+	return NewWebhookDeliveryClient(wd.config).QuerySubscription(wd)
+}
+
+// QueryEvent queries the "event" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QueryEvent() *OutboxEventQuery {
+	return NewWebhookDeliveryClient(wd.config).QueryEvent(wd)
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that you need to call WebhookDelivery.Unwrap() before calling this method if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return NewWebhookDeliveryClient(wd.config).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WebhookDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WebhookDelivery) Unwrap() *WebhookDelivery {
+	_tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDelivery is not a transactional entity")
+	}
+	wd.config.driver = _tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wd.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(wd.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(wd.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.SubscriptionID))
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(wd.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := wd.ResponseStatus; v != nil {
+		builder.WriteString("response_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := wd.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := wd.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
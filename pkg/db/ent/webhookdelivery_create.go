@@ -0,0 +1,1083 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/db/ent/webhooksubscription"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (wdc *WebhookDeliveryCreate) SetCreateTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreateTime(t)
+	return wdc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreateTime(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreateTime(*t)
+	}
+	return wdc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wdc *WebhookDeliveryCreate) SetUpdateTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdateTime(t)
+	return wdc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdateTime(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetUpdateTime(*t)
+	}
+	return wdc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (wdc *WebhookDeliveryCreate) SetSubscriptionID(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetSubscriptionID(i)
+	return wdc
+}
+
+// SetEventID sets the "event_id" field.
+func (wdc *WebhookDeliveryCreate) SetEventID(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventID(i)
+	return wdc
+}
+
+// SetStatus sets the "status" field.
+func (wdc *WebhookDeliveryCreate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatus(w)
+	return wdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryCreate {
+	if w != nil {
+		wdc.SetStatus(*w)
+	}
+	return wdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdc *WebhookDeliveryCreate) SetAttempts(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempts(i)
+	return wdc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttempts(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttempts(*i)
+	}
+	return wdc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdc *WebhookDeliveryCreate) SetNextAttemptAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetNextAttemptAt(t)
+	return wdc
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdc *WebhookDeliveryCreate) SetResponseStatus(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseStatus(i)
+	return wdc
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseStatus(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseStatus(*i)
+	}
+	return wdc
+}
+
+// SetLastError sets the "last_error" field.
+func (wdc *WebhookDeliveryCreate) SetLastError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetLastError(s)
+	return wdc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableLastError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetLastError(*s)
+	}
+	return wdc
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wdc *WebhookDeliveryCreate) SetDeliveredAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetDeliveredAt(t)
+	return wdc
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetDeliveredAt(*t)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDeliveryCreate) SetID(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(i)
+	return wdc
+}
+
+// SetSubscription sets the "subscription" edge to the WebhookSubscription entity.
+func (wdc *WebhookDeliveryCreate) SetSubscription(w *WebhookSubscription) *WebhookDeliveryCreate {
+	return wdc.SetSubscriptionID(w.ID)
+}
+
+// SetEvent sets the "event" edge to the OutboxEvent entity.
+func (wdc *WebhookDeliveryCreate) SetEvent(o *OutboxEvent) *WebhookDeliveryCreate {
+	return wdc.SetEventID(o.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wdc.defaults()
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() {
+	if _, ok := wdc.mutation.CreateTime(); !ok {
+		v := webhookdelivery.DefaultCreateTime()
+		wdc.mutation.SetCreateTime(v)
+	}
+	if _, ok := wdc.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.DefaultUpdateTime()
+		wdc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		v := webhookdelivery.DefaultStatus
+		wdc.mutation.SetStatus(v)
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		v := webhookdelivery.DefaultAttempts
+		wdc.mutation.SetAttempts(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "WebhookDelivery.create_time"`)}
+	}
+	if _, ok := wdc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "WebhookDelivery.update_time"`)}
+	}
+	if _, ok := wdc.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`ent: missing required field "WebhookDelivery.subscription_id"`)}
+	}
+	if _, ok := wdc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "WebhookDelivery.event_id"`)}
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WebhookDelivery.status"`)}
+	}
+	if v, ok := wdc.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "WebhookDelivery.attempts"`)}
+	}
+	if _, ok := wdc.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "WebhookDelivery.next_attempt_at"`)}
+	}
+	if v, ok := wdc.mutation.LastError(); ok {
+		if err := webhookdelivery.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.last_error": %w`, err)}
+		}
+	}
+	if len(wdc.mutation.SubscriptionIDs()) == 0 {
+		return &ValidationError{Name: "subscription", err: errors.New(`ent: missing required edge "WebhookDelivery.subscription"`)}
+	}
+	if len(wdc.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required edge "WebhookDelivery.event"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = wdc.conflict
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wdc.mutation.CreateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := wdc.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := wdc.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wdc.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := wdc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := wdc.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+		_node.ResponseStatus = &value
+	}
+	if value, ok := wdc.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := wdc.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if nodes := wdc.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.SubscriptionTable,
+			Columns: []string{webhookdelivery.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubscriptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wdc.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.EventTable,
+			Columns: []string{webhookdelivery.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EventID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertOne {
+	wdc.conflict = opts
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertOne {
+	wdc.conflict = append(wdc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+type (
+	// WebhookDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one WebhookDelivery node.
+	WebhookDeliveryUpsertOne struct {
+		create *WebhookDeliveryCreate
+	}
+
+	// WebhookDeliveryUpsert is the "OnConflict" setter.
+	WebhookDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookDeliveryUpsert) SetUpdateTime(v time.Time) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateUpdateTime() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldUpdateTime)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsert) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateStatus() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsert) SetAttempts(v int) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateAttempts() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsert) AddAttempts(v int) *WebhookDeliveryUpsert {
+	u.Add(webhookdelivery.FieldAttempts, v)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsert) SetNextAttemptAt(v time.Time) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateNextAttemptAt() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliveryUpsert) SetResponseStatus(v int) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldResponseStatus, v)
+	return u
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateResponseStatus() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldResponseStatus)
+	return u
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliveryUpsert) AddResponseStatus(v int) *WebhookDeliveryUpsert {
+	u.Add(webhookdelivery.FieldResponseStatus, v)
+	return u
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *WebhookDeliveryUpsert) ClearResponseStatus() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldResponseStatus)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *WebhookDeliveryUpsert) SetLastError(v string) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateLastError() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *WebhookDeliveryUpsert) ClearLastError() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldLastError)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *WebhookDeliveryUpsert) SetDeliveredAt(v time.Time) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateDeliveredAt() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldDeliveredAt)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *WebhookDeliveryUpsert) ClearDeliveredAt() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldDeliveredAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) UpdateNewValues() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(webhookdelivery.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.SubscriptionID(); exists {
+			s.SetIgnore(webhookdelivery.FieldSubscriptionID)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(webhookdelivery.FieldEventID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) Ignore() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertOne) DoNothing() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertOne) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookDeliveryUpsertOne) SetUpdateTime(v time.Time) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateUpdateTime() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsertOne) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateStatus() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsertOne) SetAttempts(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsertOne) AddAttempts(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateAttempts() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsertOne) SetNextAttemptAt(v time.Time) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateNextAttemptAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliveryUpsertOne) SetResponseStatus(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliveryUpsertOne) AddResponseStatus(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateResponseStatus() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *WebhookDeliveryUpsertOne) ClearResponseStatus() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *WebhookDeliveryUpsertOne) SetLastError(v string) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateLastError() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *WebhookDeliveryUpsertOne) ClearLastError() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *WebhookDeliveryUpsertOne) SetDeliveredAt(v time.Time) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateDeliveredAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *WebhookDeliveryUpsertOne) ClearDeliveredAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookDeliveryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = opts
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = append(wdcb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// WebhookDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookDelivery nodes.
+type WebhookDeliveryUpsertBulk struct {
+	create *WebhookDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) UpdateNewValues() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookdelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(webhookdelivery.FieldCreateTime)
+			}
+			if _, exists := b.mutation.SubscriptionID(); exists {
+				s.SetIgnore(webhookdelivery.FieldSubscriptionID)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(webhookdelivery.FieldEventID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) Ignore() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertBulk) DoNothing() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertBulk) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookDeliveryUpsertBulk) SetUpdateTime(v time.Time) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateUpdateTime() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsertBulk) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateStatus() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsertBulk) SetAttempts(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsertBulk) AddAttempts(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateAttempts() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsertBulk) SetNextAttemptAt(v time.Time) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateNextAttemptAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliveryUpsertBulk) SetResponseStatus(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliveryUpsertBulk) AddResponseStatus(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateResponseStatus() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *WebhookDeliveryUpsertBulk) ClearResponseStatus() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseStatus()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *WebhookDeliveryUpsertBulk) SetLastError(v string) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateLastError() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *WebhookDeliveryUpsertBulk) ClearLastError() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *WebhookDeliveryUpsertBulk) SetDeliveredAt(v time.Time) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateDeliveredAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *WebhookDeliveryUpsertBulk) ClearDeliveredAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebhookDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookDeliveryDelete is the builder for deleting a WebhookDelivery entity.
+type WebhookDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryDelete builder.
+func (wdd *WebhookDeliveryDelete) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryDelete {
+	wdd.mutation.Where(ps...)
+	return wdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wdd *WebhookDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wdd.sqlExec, wdd.mutation, wdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdd *WebhookDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := wdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wdd *WebhookDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := wdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wdd.mutation.done = true
+	return affected, err
+}
+
+// WebhookDeliveryDeleteOne is the builder for deleting a single WebhookDelivery entity.
+type WebhookDeliveryDeleteOne struct {
+	wdd *WebhookDeliveryDelete
+}
+
+// Where appends a list predicates to the WebhookDeliveryDelete builder.
+func (wddo *WebhookDeliveryDeleteOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryDeleteOne {
+	wddo.wdd.mutation.Where(ps...)
+	return wddo
+}
+
+// Exec executes the deletion query.
+func (wddo *WebhookDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := wddo.wdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webhookdelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wddo *WebhookDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := wddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdateTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdateTime(t)
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(w)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdate {
+	if w != nil {
+		wdu.SetStatus(*w)
+	}
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptAt(t)
+	return wdu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptAt(*t)
+	}
+	return wdu
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseStatus()
+	wdu.mutation.SetResponseStatus(i)
+	return wdu
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseStatus(*i)
+	}
+	return wdu
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) AddResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseStatus(i)
+	return wdu
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) ClearResponseStatus() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearResponseStatus()
+	return wdu
+}
+
+// SetLastError sets the "last_error" field.
+func (wdu *WebhookDeliveryUpdate) SetLastError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetLastError(s)
+	return wdu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetLastError(*s)
+	}
+	return wdu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (wdu *WebhookDeliveryUpdate) ClearLastError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearLastError()
+	return wdu
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeliveredAt(t)
+	return wdu
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetDeliveredAt(*t)
+	}
+	return wdu
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearDeliveredAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearDeliveredAt()
+	return wdu
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	wdu.defaults()
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wdu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if v, ok := wdu.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := wdu.mutation.LastError(); ok {
+		if err := webhookdelivery.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.last_error": %w`, err)}
+		}
+	}
+	if wdu.mutation.SubscriptionCleared() && len(wdu.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.subscription"`)
+	}
+	if wdu.mutation.EventCleared() && len(wdu.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.event"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdu *WebhookDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdate {
+	wdu.modifiers = append(wdu.modifiers, modifiers...)
+	return wdu
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if wdu.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if wdu.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := wdu.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if wdu.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(wdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdateTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdateTime(t)
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(w)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	if w != nil {
+		wduo.SetStatus(*w)
+	}
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptAt(t)
+	return wduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptAt(*t)
+	}
+	return wduo
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseStatus()
+	wduo.mutation.SetResponseStatus(i)
+	return wduo
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseStatus(*i)
+	}
+	return wduo
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseStatus(i)
+	return wduo
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearResponseStatus() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearResponseStatus()
+	return wduo
+}
+
+// SetLastError sets the "last_error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetLastError(s)
+	return wduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetLastError(*s)
+	}
+	return wduo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearLastError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearLastError()
+	return wduo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeliveredAt(t)
+	return wduo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetDeliveredAt(*t)
+	}
+	return wduo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearDeliveredAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearDeliveredAt()
+	return wduo
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wduo.defaults()
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wduo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := wduo.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := wduo.mutation.LastError(); ok {
+		if err := webhookdelivery.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.last_error": %w`, err)}
+		}
+	}
+	if wduo.mutation.SubscriptionCleared() && len(wduo.mutation.SubscriptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.subscription"`)
+	}
+	if wduo.mutation.EventCleared() && len(wduo.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.event"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wduo *WebhookDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdateOne {
+	wduo.modifiers = append(wduo.modifiers, modifiers...)
+	return wduo
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if wduo.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if wduo.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := wduo.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if wduo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	_spec.AddModifiers(wduo.modifiers...)
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/db/ent/webhooksubscription"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookSubscriptionUpdate is the builder for updating WebhookSubscription entities.
+type WebhookSubscriptionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookSubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookSubscriptionUpdate builder.
+func (wsu *WebhookSubscriptionUpdate) Where(ps ...predicate.WebhookSubscription) *WebhookSubscriptionUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wsu *WebhookSubscriptionUpdate) SetUpdateTime(t time.Time) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetUpdateTime(t)
+	return wsu
+}
+
+// SetURL sets the "url" field.
+func (wsu *WebhookSubscriptionUpdate) SetURL(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetURL(s)
+	return wsu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableURL(s *string) *WebhookSubscriptionUpdate {
+	if s != nil {
+		wsu.SetURL(*s)
+	}
+	return wsu
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wsu *WebhookSubscriptionUpdate) SetEventTypes(s []string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetEventTypes(s)
+	return wsu
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wsu *WebhookSubscriptionUpdate) AppendEventTypes(s []string) *WebhookSubscriptionUpdate {
+	wsu.mutation.AppendEventTypes(s)
+	return wsu
+}
+
+// SetSecret sets the "secret" field.
+func (wsu *WebhookSubscriptionUpdate) SetSecret(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetSecret(s)
+	return wsu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableSecret(s *string) *WebhookSubscriptionUpdate {
+	if s != nil {
+		wsu.SetSecret(*s)
+	}
+	return wsu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wsu *WebhookSubscriptionUpdate) AddDeliveryIDs(ids ...int) *WebhookSubscriptionUpdate {
+	wsu.mutation.AddDeliveryIDs(ids...)
+	return wsu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wsu *WebhookSubscriptionUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsu *WebhookSubscriptionUpdate) Mutation() *WebhookSubscriptionMutation {
+	return wsu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wsu *WebhookSubscriptionUpdate) ClearDeliveries() *WebhookSubscriptionUpdate {
+	wsu.mutation.ClearDeliveries()
+	return wsu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wsu *WebhookSubscriptionUpdate) RemoveDeliveryIDs(ids ...int) *WebhookSubscriptionUpdate {
+	wsu.mutation.RemoveDeliveryIDs(ids...)
+	return wsu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wsu *WebhookSubscriptionUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WebhookSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	wsu.defaults()
+	return withHooks(ctx, wsu.sqlSave, wsu.mutation, wsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WebhookSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WebhookSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WebhookSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsu *WebhookSubscriptionUpdate) defaults() {
+	if _, ok := wsu.mutation.UpdateTime(); !ok {
+		v := webhooksubscription.UpdateDefaultUpdateTime()
+		wsu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WebhookSubscriptionUpdate) check() error {
+	if v, ok := wsu.mutation.URL(); ok {
+		if err := webhooksubscription.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.url": %w`, err)}
+		}
+	}
+	if v, ok := wsu.mutation.Secret(); ok {
+		if err := webhooksubscription.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsu *WebhookSubscriptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookSubscriptionUpdate {
+	wsu.modifiers = append(wsu.modifiers, modifiers...)
+	return wsu
+}
+
+func (wsu *WebhookSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhooksubscription.Table, webhooksubscription.Columns, sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt))
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.UpdateTime(); ok {
+		_spec.SetField(webhooksubscription.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wsu.mutation.URL(); ok {
+		_spec.SetField(webhooksubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wsu.mutation.EventTypes(); ok {
+		_spec.SetField(webhooksubscription.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wsu.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhooksubscription.FieldEventTypes, value)
+		})
+	}
+	if value, ok := wsu.mutation.Secret(); ok {
+		_spec.SetField(webhooksubscription.FieldSecret, field.TypeString, value)
+	}
+	if wsu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wsu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhooksubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wsu.mutation.done = true
+	return n, nil
+}
+
+// WebhookSubscriptionUpdateOne is the builder for updating a single WebhookSubscription entity.
+type WebhookSubscriptionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookSubscriptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetUpdateTime(t time.Time) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetUpdateTime(t)
+	return wsuo
+}
+
+// SetURL sets the "url" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetURL(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetURL(s)
+	return wsuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableURL(s *string) *WebhookSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetURL(*s)
+	}
+	return wsuo
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetEventTypes(s []string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetEventTypes(s)
+	return wsuo
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wsuo *WebhookSubscriptionUpdateOne) AppendEventTypes(s []string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.AppendEventTypes(s)
+	return wsuo
+}
+
+// SetSecret sets the "secret" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetSecret(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetSecret(s)
+	return wsuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableSecret(s *string) *WebhookSubscriptionUpdateOne {
+	if s != nil {
+		wsuo.SetSecret(*s)
+	}
+	return wsuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wsuo *WebhookSubscriptionUpdateOne) AddDeliveryIDs(ids ...int) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.AddDeliveryIDs(ids...)
+	return wsuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wsuo *WebhookSubscriptionUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsuo *WebhookSubscriptionUpdateOne) Mutation() *WebhookSubscriptionMutation {
+	return wsuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wsuo *WebhookSubscriptionUpdateOne) ClearDeliveries() *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.ClearDeliveries()
+	return wsuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wsuo *WebhookSubscriptionUpdateOne) RemoveDeliveryIDs(ids ...int) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.RemoveDeliveryIDs(ids...)
+	return wsuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wsuo *WebhookSubscriptionUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookSubscriptionUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wsuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookSubscriptionUpdate builder.
+func (wsuo *WebhookSubscriptionUpdateOne) Where(ps ...predicate.WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.Where(ps...)
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WebhookSubscriptionUpdateOne) Select(field string, fields ...string) *WebhookSubscriptionUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WebhookSubscription entity.
+func (wsuo *WebhookSubscriptionUpdateOne) Save(ctx context.Context) (*WebhookSubscription, error) {
+	wsuo.defaults()
+	return withHooks(ctx, wsuo.sqlSave, wsuo.mutation, wsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WebhookSubscriptionUpdateOne) SaveX(ctx context.Context) *WebhookSubscription {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WebhookSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WebhookSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsuo *WebhookSubscriptionUpdateOne) defaults() {
+	if _, ok := wsuo.mutation.UpdateTime(); !ok {
+		v := webhooksubscription.UpdateDefaultUpdateTime()
+		wsuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WebhookSubscriptionUpdateOne) check() error {
+	if v, ok := wsuo.mutation.URL(); ok {
+		if err := webhooksubscription.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.url": %w`, err)}
+		}
+	}
+	if v, ok := wsuo.mutation.Secret(); ok {
+		if err := webhooksubscription.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wsuo *WebhookSubscriptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookSubscriptionUpdateOne {
+	wsuo.modifiers = append(wsuo.modifiers, modifiers...)
+	return wsuo
+}
+
+func (wsuo *WebhookSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *WebhookSubscription, err error) {
+	if err := wsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhooksubscription.Table, webhooksubscription.Columns, sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeInt))
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhooksubscription.FieldID)
+		for _, f := range fields {
+			if !webhooksubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhooksubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.UpdateTime(); ok {
+		_spec.SetField(webhooksubscription.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wsuo.mutation.URL(); ok {
+		_spec.SetField(webhooksubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wsuo.mutation.EventTypes(); ok {
+		_spec.SetField(webhooksubscription.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wsuo.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhooksubscription.FieldEventTypes, value)
+		})
+	}
+	if value, ok := wsuo.mutation.Secret(); ok {
+		_spec.SetField(webhooksubscription.FieldSecret, field.TypeString, value)
+	}
+	if wsuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wsuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhooksubscription.DeliveriesTable,
+			Columns: []string{webhooksubscription.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wsuo.modifiers...)
+	_node = &WebhookSubscription{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhooksubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wsuo.mutation.done = true
+	return _node, nil
+}
@@ -1,3 +1,3 @@
 package db
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target ./ent --feature sql/upsert --feature sql/modifier ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target ./ent --feature sql/upsert --feature sql/modifier --feature sql/execquery ./schema
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/account"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// LockAccount takes a row lock on the account which is held till tx ends and returns the account
+// it returns a not found error if the account does not exist
+func LockAccount(ctx context.Context, tx *ent.Tx, accountID int) (*ent.Account, error) {
+	return tx.Account.
+		Query().
+		Where(account.ID(accountID)).
+		Modify(func(s *sql.Selector) {
+			// sqlite does not support row locks, it locks the whole database on write instead
+			if s.Dialect() != dialect.SQLite {
+				s.ForUpdate()
+			}
+		}).
+		Only(ctx)
+}
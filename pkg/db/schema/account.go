@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"transactor-server/pkg/money"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
@@ -19,6 +21,14 @@ func (Account) Fields() []ent.Field {
 		field.Int("id"),
 		field.String("name").MinLen(8).MaxLen(100),
 		field.String("document_number").Unique(),
+		// how far the account can go into debit, in minor units, nil means there is no limit
+		field.Int64("credit_limit").GoType(money.Amount(0)).Optional().Nillable(),
+		// the day of the month the billing cycle closes on, nil means the account is not billed
+		field.Int("cycle_closing_day").Range(1, 28).Optional().Nillable(),
+		// how many days after its cycle closed an invoice is due
+		field.Int("due_date_offset").NonNegative().Default(10),
+		// ISO 4217 code of the currency every transaction of the account is booked in
+		field.String("currency").MaxLen(3).Default(money.DefaultCurrency).Immutable(),
 	}
 }
 
@@ -27,6 +37,20 @@ func (Account) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("transactions", Transaction.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("outgoing_transfers", Transfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("incoming_transfers", Transfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("holds", Hold.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invoices", Invoice.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("accruals", Accrual.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("journal_entries", JournalEntry.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("outbox_events", OutboxEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Accrual holds the schema definition for the Accrual entity.
+// an accrual is an interest or late fee charged to an account by the accrual job, along with the debit it booked
+type Accrual struct {
+	ent.Schema
+}
+
+// Fields of the Accrual.
+func (Accrual) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("account_id").Immutable(),
+		// the UTC day the accrual is for, at midnight
+		field.Time("date").Immutable(),
+		field.Enum("kind").Values("interest", "late_fee").Immutable(),
+		// always +ve, in minor units, see money.Amount
+		field.Int64("amount").GoType(money.Amount(0)).Immutable(),
+		// the debit booked for the accrual, nil when the interest of the day rounded to 0 and nothing was booked
+		field.Int("transaction_id").Optional().Nillable().Unique().Immutable(),
+		// the overdue invoice a late fee is charged for
+		field.Int("invoice_id").Optional().Nillable().Unique().Immutable(),
+	}
+}
+
+// Indexes of the Accrual.
+func (Accrual) Indexes() []ent.Index {
+	return []ent.Index{
+		// an account accrues each kind at most once a day, so the job can be rerun safely
+		index.Fields("account_id", "date", "kind").Unique(),
+	}
+}
+
+// Edges of the Accrual.
+func (Accrual) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("account", Account.Type).
+			Field("account_id").
+			Ref("accruals").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("transaction", Transaction.Type).
+			Field("transaction_id").
+			Ref("accrual").
+			Immutable().
+			Unique(),
+		edge.
+			From("invoice", Invoice.Type).
+			Field("invoice_id").
+			Ref("late_fee").
+			Immutable().
+			Unique(),
+	}
+}
+
+// Mixin of the Accrual.
+func (Accrual) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// FxRate holds the schema definition for the FxRate entity.
+// a rate converts amounts in its base currency to its quote currency, 1 base is rate quote
+type FxRate struct {
+	ent.Schema
+}
+
+// Fields of the FxRate.
+func (FxRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		// ISO 4217 currency codes
+		field.String("base_currency").MaxLen(3).Immutable(),
+		field.String("quote_currency").MaxLen(3).Immutable(),
+		// an exact +ve decimal, it is a string so no precision is lost on the way to big.Rat
+		field.String("rate").MaxLen(32),
+	}
+}
+
+// Indexes of the FxRate.
+func (FxRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("base_currency", "quote_currency").Unique(),
+	}
+}
+
+// Mixin of the FxRate.
+func (FxRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
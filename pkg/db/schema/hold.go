@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Hold holds the schema definition for the Hold entity.
+// a hold reserves an amount of the credit limit of an account till it is captured as a debit, released or expires
+type Hold struct {
+	ent.Schema
+}
+
+// Fields of the Hold.
+func (Hold) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("account_id").Immutable(),
+		// the operation type of the debit booked on capture
+		field.Int("operation_type_id").Immutable(),
+		// always +ve, in minor units, see money.Amount
+		field.Int64("amount").GoType(money.Amount(0)).Immutable(),
+		// only active holds which have not expired reserve their amount
+		field.Enum("status").Values("active", "captured", "released", "expired").Default("active"),
+		field.Time("expires_at").Immutable(),
+		// set on capture, it can be less than amount and the rest is released
+		field.Int64("captured_amount").GoType(money.Amount(0)).Optional().Nillable(),
+		// the debit booked on capture
+		field.Int("transaction_id").Optional().Nillable().Unique(),
+	}
+}
+
+// Indexes of the Hold.
+func (Hold) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "status"),
+		index.Fields("status", "expires_at"),
+	}
+}
+
+// Edges of the Hold.
+func (Hold) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("account", Account.Type).
+			Field("account_id").
+			Ref("holds").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("operation_type", OperationType.Type).
+			Field("operation_type_id").
+			Ref("holds").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("transaction", Transaction.Type).
+			Field("transaction_id").
+			Ref("hold").
+			Unique(),
+	}
+}
+
+// Mixin of the Hold.
+func (Hold) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
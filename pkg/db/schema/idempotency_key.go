@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		// sha256 of the caller and the route, the same key sent by another caller or to another route is another key
+		field.String("scope").MaxLen(64).Immutable(),
+		field.String("key").NotEmpty().MaxLen(255).Immutable(),
+		// sha256 of the request, a replay with a different request is rejected
+		field.String("request_hash").Immutable(),
+		// response is empty while the first request is still in progress
+		field.Int("response_status").Optional().Nillable(),
+		field.Bytes("response_body").Optional(),
+		// the claim of the request in progress, a retry takes it over once it passed as the request is gone then
+		field.Time("locked_until").Optional().Nillable(),
+		field.Time("expires_at").Immutable(),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scope", "key").Unique(),
+		index.Fields("expires_at"),
+	}
+}
+
+// Mixin of the IdempotencyKey.
+func (IdempotencyKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
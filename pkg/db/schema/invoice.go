@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// an invoice is what an account owes when one of its billing cycles closes
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("account_id").Immutable(),
+		// the billing cycle is [period_start, period_end)
+		field.Time("period_start").Immutable(),
+		field.Time("period_end").Immutable(),
+		// always +ve, in minor units, see money.Amount
+		field.Int64("total_due").GoType(money.Amount(0)).Immutable(),
+		field.Int64("minimum_payment").GoType(money.Amount(0)).Immutable(),
+		field.Time("due_date").Immutable(),
+		// how much of total_due the credits booked after the cycle closed have paid
+		field.Int64("paid_amount").GoType(money.Amount(0)).Default(0),
+		// an invoice is paid once paid_amount reaches total_due
+		// the total due of an invoice has what is left of the previous one, which is superseded by it
+		field.Enum("status").Values("open", "paid", "superseded").Default("open"),
+	}
+}
+
+// Indexes of the Invoice.
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		// a cycle is closed at most once
+		index.Fields("account_id", "period_end").Unique(),
+		index.Fields("account_id", "status"),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("account", Account.Type).
+			Field("account_id").
+			Ref("invoices").
+			Required().
+			Immutable().
+			Unique(),
+		// the late fee charged when the invoice went overdue
+		edge.To("late_fee", Accrual.Type).
+			Unique(),
+		// the credits which paid the invoice
+		edge.To("payments", InvoicePayment.Type),
+	}
+}
+
+// Mixin of the Invoice.
+func (Invoice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
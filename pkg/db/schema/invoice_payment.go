@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// InvoicePayment holds the schema definition for the InvoicePayment entity.
+// an invoice payment records how much of a credit transaction went into paying an invoice
+type InvoicePayment struct {
+	ent.Schema
+}
+
+// Fields of the InvoicePayment.
+func (InvoicePayment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("invoice_id").Immutable(),
+		field.Int("transaction_id").Immutable(),
+		// always +ve, in minor units, see money.Amount
+		field.Int64("amount").GoType(money.Amount(0)).Immutable(),
+		// set when the credit is reversed and the payment is undone
+		field.Time("reversed_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the InvoicePayment.
+func (InvoicePayment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id"),
+		// a credit pays at most the one invoice which is open when it is booked
+		index.Fields("transaction_id").Unique(),
+	}
+}
+
+// Edges of the InvoicePayment.
+func (InvoicePayment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("invoice", Invoice.Type).
+			Field("invoice_id").
+			Ref("payments").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("transaction", Transaction.Type).
+			Field("transaction_id").
+			Ref("invoice_payment").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Mixin of the InvoicePayment.
+func (InvoicePayment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// JournalEntry holds the schema definition for the JournalEntry entity.
+// a journal entry is one side of the double entry posting of a transaction, the entries of a transaction always balance
+type JournalEntry struct {
+	ent.Schema
+}
+
+// Fields of the JournalEntry.
+func (JournalEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("transaction_id").Immutable(),
+		field.Enum("ledger").
+			Values("customer_receivable", "merchant_payable", "cash", "fee_income", "interest_income", "transfer_clearing", "suspense").
+			Immutable(),
+		// only set on the customer_receivable entries, which are kept per account
+		field.Int("account_id").Optional().Nillable().Immutable(),
+		field.Enum("side").Values("debit", "credit").Immutable(),
+		// always +ve, in minor units, see money.Amount
+		field.Int64("amount").GoType(money.Amount(0)).Immutable(),
+		// ISO 4217 code, the currency of the transaction
+		field.String("currency").MaxLen(3).Immutable(),
+		// the timestamp of the transaction, which is in the past for back-dated transactions
+		field.Time("timestamp").Immutable(),
+	}
+}
+
+// Indexes of the JournalEntry.
+func (JournalEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("transaction_id"),
+		index.Fields("account_id"),
+		index.Fields("timestamp"),
+	}
+}
+
+// Edges of the JournalEntry.
+func (JournalEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("transaction", Transaction.Type).
+			Field("transaction_id").
+			Ref("journal_entries").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("account", Account.Type).
+			Field("account_id").
+			Ref("journal_entries").
+			Immutable().
+			Unique(),
+	}
+}
+
+// Mixin of the JournalEntry.
+func (JournalEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -27,6 +27,8 @@ func (OperationType) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("transactions", Transaction.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("holds", Hold.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
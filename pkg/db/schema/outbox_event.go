@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// an outbox event is a domain event written in the DB transaction which caused it, a relay publishes it afterwards
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// events are published in id order, so it also orders the events of an account
+		field.Int("id"),
+		// AccountCreated, TransactionCreated or BalanceChanged
+		field.String("type").MaxLen(64).Immutable(),
+		// the account the event happened to
+		field.Int("account_id").Immutable(),
+		// the id of the account or transaction the event is about
+		field.Int("aggregate_id").Immutable(),
+		field.JSON("payload", json.RawMessage{}).Immutable(),
+		// nil till the event is published
+		field.Time("published_at").Optional().Nillable(),
+		// how many times publishing failed and why it last failed
+		field.Int("attempts").Default(0),
+		field.String("last_error").MaxLen(1024).Optional().Nillable(),
+	}
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// the relay only looks for the events which are not published yet
+		index.Fields("published_at"),
+		index.Fields("account_id"),
+	}
+}
+
+// Edges of the OutboxEvent.
+func (OutboxEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("account", Account.Type).
+			Field("account_id").
+			Ref("outbox_events").
+			Required().
+			Immutable().
+			Unique(),
+		edge.To("webhook_deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Mixin of the OutboxEvent.
+func (OutboxEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
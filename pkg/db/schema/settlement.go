@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Settlement holds the schema definition for the Settlement entity.
+// a settlement records how much of a credit transaction went into paying a debit transaction
+type Settlement struct {
+	ent.Schema
+}
+
+// Fields of the Settlement.
+func (Settlement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("credit_txn_id").Immutable(),
+		field.Int("debit_txn_id").Immutable(),
+		// always +ve, in minor units, see money.Amount
+		field.Int64("amount").GoType(money.Amount(0)).Immutable(),
+		field.Time("timestamp").Immutable(),
+		// set when the credit or the debit is reversed and the payment is undone
+		field.Time("reversed_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the Settlement.
+func (Settlement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("credit_txn_id"),
+		index.Fields("debit_txn_id"),
+	}
+}
+
+// Edges of the Settlement.
+func (Settlement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("credit", Transaction.Type).
+			Field("credit_txn_id").
+			Ref("credit_settlements").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("debit", Transaction.Type).
+			Field("debit_txn_id").
+			Ref("debit_settlements").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Mixin of the Settlement.
+func (Settlement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
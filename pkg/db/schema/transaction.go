@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"transactor-server/pkg/money"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -19,10 +21,38 @@ func (Transaction) Fields() []ent.Field {
 		// this is a generated uuid
 		field.Int("id"),
 		field.Int("account_id").Immutable(),
-		field.Float("amount").Immutable(),
-		field.Float("balance").Default(0),
+		// money is stored in minor units, see money.Amount
+		field.Int64("amount").GoType(money.Amount(0)).Immutable(),
+		field.Int64("balance").GoType(money.Amount(0)).Default(0),
 		field.Int("operation_type_id").Immutable(),
 		field.Time("timestamp").Immutable(),
+		// installments of a purchase point to the purchase, which has no balance of its own
+		field.Int("parent_id").Optional().Nillable().Immutable(),
+		// 1 based position of the installment in the purchase
+		field.Int("installment_number").Optional().Nillable().Immutable(),
+		// installments are only outstanding once they are due, nil means due right away
+		field.Time("due_date").Optional().Nillable().Immutable(),
+		// a reversed transaction has no balance and its settlements are undone
+		field.Enum("status").Values("posted", "reversed").Default("posted"),
+		// set on the compensating transaction which reverses another one, a transaction is reversed at most once
+		field.Int("reversal_of_id").Optional().Nillable().Unique().Immutable(),
+		// both legs of a transfer point to the transfer
+		field.Int("transfer_id").Optional().Nillable().Immutable(),
+		// what the transaction was, these are only informational and shown on statements
+		field.String("description").MaxLen(255).Optional().Nillable().Immutable(),
+		field.String("merchant_name").MaxLen(255).Optional().Nillable().Immutable(),
+		// ISO 18245 merchant category code
+		field.String("mcc").MaxLen(4).Optional().Nillable().Immutable(),
+		// the id of the transaction in the system which sent it, searchable within an account
+		field.String("external_reference").MaxLen(128).Optional().Nillable().Immutable(),
+		field.JSON("metadata", map[string]string{}).Optional().Immutable(),
+		// ISO 4217 code of the currency of amount & balance, always the currency of the account
+		field.String("currency").MaxLen(3).Default(money.DefaultCurrency).Immutable(),
+		// set when the transaction was sent in another currency and converted with fx_rate
+		field.Int64("original_amount").GoType(money.Amount(0)).Optional().Nillable().Immutable(),
+		field.String("original_currency").MaxLen(3).Optional().Nillable().Immutable(),
+		// the exact decimal rate of one original_currency in currency
+		field.String("fx_rate").MaxLen(32).Optional().Nillable().Immutable(),
 	}
 }
 
@@ -33,6 +63,9 @@ func (Transaction) Indexes() []ent.Index {
 		index.Fields("account_id", "operation_type_id"),
 		index.Fields("account_id", "timestamp"),
 		index.Fields("account_id", "operation_type_id", "timestamp"),
+		index.Fields("parent_id"),
+		index.Fields("transfer_id"),
+		index.Fields("account_id", "external_reference"),
 	}
 }
 
@@ -53,6 +86,39 @@ func (Transaction) Edges() []ent.Edge {
 			Required().
 			Immutable().
 			Unique(),
+		edge.
+			To("installments", Transaction.Type).
+			From("parent").
+			Field("parent_id").
+			Immutable().
+			Unique(),
+		edge.
+			To("reversal", Transaction.Type).
+			From("reversal_of").
+			Field("reversal_of_id").
+			Immutable().
+			Unique(),
+		edge.
+			From("transfer", Transfer.Type).
+			Field("transfer_id").
+			Ref("transactions").
+			Immutable().
+			Unique(),
+		// the hold this debit captured
+		edge.To("hold", Hold.Type).
+			Unique(),
+		// the interest or late fee this debit was booked for
+		edge.To("accrual", Accrual.Type).
+			Unique(),
+		// settlements paid by this transaction when it is a credit
+		edge.To("credit_settlements", Settlement.Type),
+		// settlements which paid this transaction when it is a debit
+		edge.To("debit_settlements", Settlement.Type),
+		// the invoice this transaction paid when it is a credit
+		edge.To("invoice_payment", InvoicePayment.Type).
+			Unique(),
+		// the double entry posting of this transaction
+		edge.To("journal_entries", JournalEntry.Type),
 	}
 }
 
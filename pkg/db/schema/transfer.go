@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Transfer holds the schema definition for the Transfer entity.
+// a transfer moves money from one account to another with a debit on the source and a credit on the destination
+type Transfer struct {
+	ent.Schema
+}
+
+// Fields of the Transfer.
+func (Transfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("source_account_id").Immutable(),
+		field.Int("destination_account_id").Immutable(),
+		// always +ve, in minor units, see money.Amount
+		field.Int64("amount").GoType(money.Amount(0)).Immutable(),
+		field.Time("timestamp").Immutable(),
+	}
+}
+
+// Indexes of the Transfer.
+func (Transfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_account_id"),
+		index.Fields("destination_account_id"),
+	}
+}
+
+// Edges of the Transfer.
+func (Transfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("source_account", Account.Type).
+			Field("source_account_id").
+			Ref("outgoing_transfers").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("destination_account", Account.Type).
+			Field("destination_account_id").
+			Ref("incoming_transfers").
+			Required().
+			Immutable().
+			Unique(),
+		// the debit on the source account and the credit on the destination account
+		edge.To("transactions", Transaction.Type),
+	}
+}
+
+// Mixin of the Transfer.
+func (Transfer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// a delivery is one outbox event which has to be POSTed to one subscription, it is retried till it is delivered or dead
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("subscription_id").Immutable(),
+		field.Int("event_id").Immutable(),
+		// dead deliveries failed too many times, they form the dead letter list and are only sent again on redelivery
+		field.Enum("status").Values("pending", "delivered", "dead").Default("pending"),
+		// how many times sending failed since it was created or redelivered
+		field.Int("attempts").Default(0),
+		// pending deliveries are sent once this passes
+		field.Time("next_attempt_at"),
+		// the status code the subscriber responded with on the last attempt, nil when it did not respond
+		field.Int("response_status").Optional().Nillable(),
+		field.String("last_error").MaxLen(1024).Optional().Nillable(),
+		field.Time("delivered_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// an event is enqueued at most once per subscription, even when the relay publishes it again
+		index.Fields("subscription_id", "event_id").Unique(),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("subscription", WebhookSubscription.Type).
+			Field("subscription_id").
+			Ref("deliveries").
+			Required().
+			Immutable().
+			Unique(),
+		edge.
+			From("event", OutboxEvent.Type).
+			Field("event_id").
+			Ref("webhook_deliveries").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// WebhookSubscription holds the schema definition for the WebhookSubscription entity.
+// a subscription gets the outbox events of the types it filters on POSTed to its url, signed with its secret
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+// Fields of the WebhookSubscription.
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("url").MaxLen(2048),
+		// the outbox event types which are delivered, every type when it is empty
+		field.JSON("event_types", []string{}),
+		// the key of the HMAC-SHA256 signature of every delivery, it is never returned by the apis
+		field.String("secret").MaxLen(128).Sensitive(),
+	}
+}
+
+// Edges of the WebhookSubscription.
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Mixin of the WebhookSubscription.
+func (WebhookSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
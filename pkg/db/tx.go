@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"transactor-server/pkg/db/ent"
+)
+
+const (
+	// maxTxAttempts is how many times WithTx runs a transaction which keeps failing with a retryable error
+	maxTxAttempts = 5
+	// txRetryBackoff is multiplied by the attempt number to get the wait before the next attempt
+	txRetryBackoff = 10 * time.Millisecond
+)
+
+// WithTx runs fn inside an ent transaction, it commits when fn succeeds and rolls back otherwise
+// if the database aborts the transaction because of a serialization failure or a deadlock
+// the whole transaction is retried, so fn must not have side effects outside of tx
+func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	for attempt := 1; ; attempt++ {
+		err := runTx(ctx, client, fn)
+		if err == nil || !IsRetryable(err) || attempt == maxTxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(txRetryBackoff * time.Duration(attempt)):
+		}
+	}
+}
+
+func runTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) (err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// make sure a panic does not leave the transaction open
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	err = fn(tx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// IsRetryable reports whether the error means the transaction was aborted because of
+// contention with another transaction and running it again can succeed
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// pgconn.PgError exposes the postgres error code
+	var pgErr interface{ SQLState() string }
+	if errors.As(err, &pgErr) {
+		switch pgErr.SQLState() {
+		case "40001", // serialization_failure
+			"40P01": // deadlock_detected
+			return true
+		}
+		return false
+	}
+
+	// sqlite (used in tests) locks the whole database and reports contention as SQLITE_BUSY
+	return strings.Contains(err.Error(), "database is locked")
+}
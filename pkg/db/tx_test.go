@@ -0,0 +1,92 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryable(t *testing.T) {
+	require.False(t, db.IsRetryable(nil))
+	require.False(t, db.IsRetryable(errors.New("some error")))
+	require.False(t, db.IsRetryable(&pgconn.PgError{Code: "23505"}))
+	require.True(t, db.IsRetryable(&pgconn.PgError{Code: "40001"}))
+	require.True(t, db.IsRetryable(fmt.Errorf("wrapped: %w", &pgconn.PgError{Code: "40P01"})))
+	require.True(t, db.IsRetryable(errors.New("database is locked")))
+}
+
+func TestWithTx(t *testing.T) {
+	t.Run("commit", func(t *testing.T) {
+		t.Parallel()
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+
+		err := db.WithTx(context.Background(), client, func(tx *ent.Tx) error {
+			return tx.Account.Create().SetDocumentNumber("12345").SetName("John Doe").Exec(context.Background())
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, client.Account.Query().CountX(context.Background()))
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		t.Parallel()
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+
+		calls := 0
+		err := db.WithTx(context.Background(), client, func(tx *ent.Tx) error {
+			calls++
+			tx.Account.Create().SetDocumentNumber("12345").SetName("John Doe").ExecX(context.Background())
+			return errors.New("some error")
+		})
+
+		require.Error(t, err)
+		require.Equal(t, 1, calls)
+		require.Equal(t, 0, client.Account.Query().CountX(context.Background()))
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		t.Parallel()
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+
+		calls := 0
+		err := db.WithTx(context.Background(), client, func(tx *ent.Tx) error {
+			calls++
+			tx.Account.Create().SetDocumentNumber(fmt.Sprint(calls)).SetName("John Doe").ExecX(context.Background())
+			if calls < 3 {
+				return &pgconn.PgError{Code: "40001"}
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+		// only the last attempt is committed
+		require.Equal(t, "3", client.Account.Query().OnlyX(context.Background()).DocumentNumber)
+	})
+
+	t.Run("retry gives up", func(t *testing.T) {
+		t.Parallel()
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+
+		calls := 0
+		err := db.WithTx(context.Background(), client, func(tx *ent.Tx) error {
+			calls++
+			return &pgconn.PgError{Code: "40P01"}
+		})
+
+		require.Error(t, err)
+		require.Equal(t, 5, calls)
+	})
+}
@@ -111,38 +111,290 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/transactions": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "description": "send a new credit_limit or unlimited as true to remove the limit, and/or a new cycle_closing_day \u0026 due_date_offset",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transaction"
+                    "account"
                 ],
-                "summary": "create a transaction",
+                "summary": "update an account",
                 "parameters": [
                     {
-                        "description": "transaction details to create",
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "account details to update",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/transaction.CreateRequest"
+                            "$ref": "#/definitions/account.UpdateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/transaction.CreateResponse"
+                            "$ref": "#/definitions/account.Account"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{id}/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "outstanding debit \u0026 unapplied credit are summed from the open balance of the transactions, in total and per operation type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "get the balance of an account",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/account.Balance"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "every TransactionCreated \u0026 BalanceChanged event of the account is sent as a server-sent event with its id, type and the event as JSON data.\na stream starts with the next new event, or right after the event of the Last-Event-ID header or last_event_id query param, so a client which reconnects misses nothing",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "stream the events of an account",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the id of the last event the client got",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the same as the Last-Event-ID header, which wins when both are sent",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/outbox.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{id}/invoices": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "an invoice is created when a billing cycle of the account closes, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "billing"
+                ],
+                "summary": "list the invoices of an account",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/billing.ListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{id}/statement": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "the period is either a calendar month in UTC or from \u0026 to, installments are listed as their purchase",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "statement"
+                ],
+                "summary": "get the statement of an account",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "calendar month like 2026-09, can not be sent with from \u0026 to",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "start of the period as an RFC3339 timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "end of the period as an RFC3339 timestamp, not included",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default), csv or pdf",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/statement.Statement"
                         }
                     },
                     "400": {
@@ -151,6 +403,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -159,98 +417,2276 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "account.Account": {
+        },
+        "/api/v1/accounts/{id}/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "list transactions of an account",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "account id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "only return transactions of this operation type",
+                        "name": "operation_type_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return transactions on or after this RFC3339 timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return transactions before this RFC3339 timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only return transactions which still have a balance",
+                        "name": "open_balance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return transactions sent with this external reference",
+                        "name": "external_reference",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size, defaults to 20 and can be at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.ListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/fx-rates": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "list the fx rates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/fx.ListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/fx-rates/{base}/{quote}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "transactions sent in the base currency are converted with the rate when they are booked on an account in the quote currency",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "set an fx rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 base currency",
+                        "name": "base",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 quote currency",
+                        "name": "quote",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "how much one base is in quote",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/fx.SetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/fx.Rate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "delete an fx rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 base currency",
+                        "name": "base",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 quote currency",
+                        "name": "quote",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/holds": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hold"
+                ],
+                "summary": "authorize a hold",
+                "parameters": [
+                    {
+                        "description": "hold details to authorize",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/hold.AuthorizeRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "a unique key which makes the request safe to retry",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/hold.Hold"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/holds/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hold"
+                ],
+                "summary": "get a hold",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "hold id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hold.Hold"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/holds/{id}/capture": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hold"
+                ],
+                "summary": "capture a hold",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "hold id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "amount to capture, the whole hold when not sent",
+                        "name": "req",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/hold.CaptureRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hold.Hold"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/holds/{id}/release": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hold"
+                ],
+                "summary": "release a hold",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "hold id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hold.Hold"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/invoices/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "billing"
+                ],
+                "summary": "get an invoice",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "invoice id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/billing.Invoice"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/journal/trial-balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "the ledgers are summed per currency, debits equal credits in every currency when balanced is true",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "get the trial balance of the journal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only sum the transactions which happened at or before this RFC3339 timestamp, defaults to now",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/journal.TrialBalance"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "create a transaction",
+                "parameters": [
+                    {
+                        "description": "transaction details to create",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transaction.CreateRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "a unique key which makes the request safe to retry",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.CreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "create transactions in bulk",
+                "parameters": [
+                    {
+                        "description": "transactions to create, as a JSON array or one per line as NDJSON",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/transaction.CreateRequest"
+                            }
+                        }
+                    },
+                    {
+                        "enum": [
+                            "atomic",
+                            "best_effort"
+                        ],
+                        "type": "string",
+                        "description": "atomic (default) books all or none of them, best_effort books each one on its own",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "a unique key which makes the request safe to retry",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.BulkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "get a transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "transaction id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/reverse": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "reverse a transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "transaction id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "a unique key which makes the request safe to retry",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}/settlements": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "list settlements of a transaction",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "transaction id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.ListSettlementsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transfers": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "create a transfer",
+                "parameters": [
+                    {
+                        "description": "transfer details to create",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transfer.CreateRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "a unique key which makes the request safe to retry",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.Transfer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transfers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfer"
+                ],
+                "summary": "get a transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "transfer id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.Transfer"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "list the webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "the events of the types sent, or every event when none are sent, are POSTed to the url signed with the secret in the X-Webhook-Signature header",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "create a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "subscription",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhook.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.Subscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/deliveries/{id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "the delivery is pending again with all of its attempts and is sent on the next run of the deliverer, whatever its status was",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "redeliver a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "delivery id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.Delivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "get a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "subscription id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.Subscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "its deliveries which are still pending are never sent",
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "subscription id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "newest first, status=dead lists the dead letters which failed every attempt",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "list the deliveries of a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "subscription id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "dead"
+                        ],
+                        "type": "string",
+                        "description": "only deliveries with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "at most 100, the default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ListDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ValidationErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/pkgerr.ServiceErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "account.Account": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "credit_limit": {
+                    "description": "CreditLimit is null when the account has no limit",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "cycle_closing_day": {
+                    "description": "CycleClosingDay is null when the account is not billed",
+                    "type": "integer",
+                    "example": 5
+                },
+                "document_number": {
+                    "type": "string"
+                },
+                "due_date_offset": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.Balance": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "available_credit_limit": {
+                    "description": "AvailableCreditLimit is credit limit + net balance - held, it is left out when the account has no limit",
+                    "type": "number",
+                    "example": 981.25
+                },
+                "held": {
+                    "description": "Held is reserved by active holds, it is not part of the net balance as holds are not posted transactions",
+                    "type": "number",
+                    "example": 0
+                },
+                "net_balance": {
+                    "type": "number",
+                    "example": -18.75
+                },
+                "operation_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/account.OperationTypeBalance"
+                    }
+                },
+                "outstanding_debit": {
+                    "type": "number",
+                    "example": 18.75
+                },
+                "scheduled_debit": {
+                    "type": "number",
+                    "example": 0
+                },
+                "unapplied_credit": {
+                    "type": "number",
+                    "example": 0
+                }
+            }
+        },
+        "account.CreateRequest": {
+            "type": "object",
+            "properties": {
+                "credit_limit": {
+                    "description": "CreditLimit is how far the account can go into debit, the account has no limit when it is not sent",
+                    "type": "number",
+                    "example": 1000
+                },
+                "currency": {
+                    "description": "Currency is the ISO 4217 code of the currency of the account, it defaults to USD and can not be changed",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "cycle_closing_day": {
+                    "description": "CycleClosingDay is the day of the month the billing cycle closes on, the account is not billed when it is not sent",
+                    "type": "integer",
+                    "example": 5
+                },
+                "document_number": {
+                    "type": "string"
+                },
+                "due_date_offset": {
+                    "description": "DueDateOffset is how many days after the cycle closed its invoice is due, it defaults to 10",
+                    "type": "integer",
+                    "example": 10
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.CreateResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "account.OperationTypeBalance": {
+            "type": "object",
+            "properties": {
+                "net_balance": {
+                    "type": "number",
+                    "example": -18.75
+                },
+                "operation_type_id": {
+                    "type": "integer"
+                },
+                "outstanding_debit": {
+                    "type": "number",
+                    "example": 18.75
+                },
+                "scheduled_debit": {
+                    "type": "number",
+                    "example": 0
+                },
+                "unapplied_credit": {
+                    "type": "number",
+                    "example": 0
+                }
+            }
+        },
+        "account.UpdateRequest": {
+            "type": "object",
+            "properties": {
+                "credit_limit": {
+                    "type": "number",
+                    "example": 1000
+                },
+                "cycle_closing_day": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "due_date_offset": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "unlimited": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "billing.Invoice": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "minimum_payment": {
+                    "type": "number",
+                    "example": 25.05
+                },
+                "paid_amount": {
+                    "description": "PaidAmount is how much of the total due the credits booked after the cycle closed paid",
+                    "type": "number",
+                    "example": 100
+                },
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is open until the total due is paid, or superseded once the next cycle closed",
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "paid",
+                        "superseded"
+                    ]
+                },
+                "total_due": {
+                    "description": "TotalDue includes what is left of the previous invoice",
+                    "type": "number",
+                    "example": 250.5
+                }
+            }
+        },
+        "billing.ListResponse": {
+            "type": "object",
+            "properties": {
+                "invoices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/billing.Invoice"
+                    }
+                }
+            }
+        },
+        "fx.ListResponse": {
+            "type": "object",
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/fx.Rate"
+                    }
+                }
+            }
+        },
+        "fx.Rate": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "quote_currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "1.0845"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "fx.SetRequest": {
+            "type": "object",
+            "properties": {
+                "rate": {
+                    "description": "Rate is how much one base is in quote, as an exact decimal string",
+                    "type": "string",
+                    "example": "1.0845"
+                }
+            }
+        },
+        "hold.AuthorizeRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "amount": {
+                    "description": "Amount can be sent as a JSON number or string with at most 2 decimal places",
+                    "type": "number",
+                    "example": 98.75
+                },
+                "operation_type_id": {
+                    "description": "OperationTypeID is the debit operation type of the transaction booked on capture",
+                    "type": "integer"
+                }
+            }
+        },
+        "hold.CaptureRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 90
+                }
+            }
+        },
+        "hold.Hold": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "amount": {
+                    "type": "number",
+                    "example": 98.75
+                },
+                "captured_amount": {
+                    "description": "CapturedAmount \u0026 TransactionID are only set on a captured hold, TransactionID is the debit booked on capture",
+                    "type": "number",
+                    "example": 90
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "operation_type_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status is active, captured, released or expired",
+                    "type": "string",
+                    "example": "active"
+                },
+                "transaction_id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "journal.CurrencyTrialBalance": {
+            "type": "object",
+            "properties": {
+                "balanced": {
+                    "type": "boolean"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "ledgers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/journal.LedgerBalance"
+                    }
+                },
+                "total_credit": {
+                    "type": "number",
+                    "example": 1250.5
+                },
+                "total_debit": {
+                    "type": "number",
+                    "example": 1250.5
+                }
+            }
+        },
+        "journal.LedgerBalance": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "Balance is Debit less Credit, so it is -ve for a ledger with a credit balance",
+                    "type": "number",
+                    "example": -1000.5
+                },
+                "credit": {
+                    "type": "number",
+                    "example": 1100.5
+                },
+                "debit": {
+                    "type": "number",
+                    "example": 100
+                },
+                "ledger": {
+                    "description": "Ledger is customer_receivable, merchant_payable, cash, fee_income, interest_income, transfer_clearing or suspense",
+                    "type": "string",
+                    "example": "merchant_payable"
+                }
+            }
+        },
+        "journal.TrialBalance": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "type": "string"
+                },
+                "balanced": {
+                    "description": "Balanced is true when the debits equal the credits in every currency",
+                    "type": "boolean"
+                },
+                "currencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/journal.CurrencyTrialBalance"
+                    }
+                }
+            }
+        },
+        "outbox.Event": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "aggregate_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID orders the events, consumers can use it to drop the duplicates of an event which was published again",
+                    "type": "integer"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "TransactionCreated"
+                }
+            }
+        },
+        "pkgerr.ServiceErrorResponseBody": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "msg": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "pkgerr.ValidationErrorResponseBody": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "statement.Line": {
             "type": "object",
             "properties": {
-                "created_at": {
+                "amount": {
+                    "type": "number",
+                    "example": -98.75
+                },
+                "balance": {
+                    "description": "Balance is what is still open of the transaction right now, see transaction.Transaction",
+                    "type": "number",
+                    "example": -18.75
+                },
+                "description": {
                     "type": "string"
                 },
-                "document_number": {
+                "merchant_name": {
                     "type": "string"
                 },
-                "id": {
+                "operation_type": {
+                    "type": "string",
+                    "example": "Normal Purchase"
+                },
+                "operation_type_id": {
                     "type": "integer"
                 },
-                "name": {
+                "running_balance": {
+                    "description": "RunningBalance is the balance of the account right after this transaction",
+                    "type": "number",
+                    "example": -198.75
+                },
+                "status": {
+                    "type": "string",
+                    "example": "posted"
+                },
+                "timestamp": {
                     "type": "string"
                 },
-                "updated_at": {
+                "transaction_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "statement.Payment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 18.75
+                },
+                "credit_transaction_id": {
+                    "type": "integer"
+                },
+                "debit_transaction_id": {
+                    "type": "integer"
+                },
+                "reversed_at": {
+                    "description": "ReversedAt is set when the payment was undone by reversing the credit or the debit",
+                    "type": "string"
+                },
+                "settlement_id": {
+                    "type": "integer"
+                },
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "account.CreateRequest": {
+        "statement.Statement": {
             "type": "object",
             "properties": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "closing_balance": {
+                    "description": "ClosingBalance is OpeningBalance plus the transactions of the period",
+                    "type": "number",
+                    "example": -48.75
+                },
                 "document_number": {
                     "type": "string"
                 },
+                "from": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
+                },
+                "opening_balance": {
+                    "description": "OpeningBalance is the net of every transaction before From",
+                    "type": "number",
+                    "example": -100
+                },
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.Payment"
+                    }
+                },
+                "payments_applied": {
+                    "description": "PaymentsApplied is how much of the debits were paid by credits in the period, leaving out undone payments",
+                    "type": "number",
+                    "example": 150
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_credits": {
+                    "type": "number",
+                    "example": 150
+                },
+                "total_debits": {
+                    "type": "number",
+                    "example": -98.75
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.Line"
+                    }
                 }
             }
         },
-        "account.CreateResponse": {
+        "transaction.BulkResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "description": "Results are in the same order as the items of the request",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.BulkResult"
+                    }
+                }
+            }
+        },
+        "transaction.BulkResult": {
             "type": "object",
             "properties": {
+                "error": {
+                    "description": "Error is the same error body a single create would return"
+                },
                 "id": {
+                    "description": "ID of the created transaction",
+                    "type": "integer"
+                },
+                "index": {
+                    "description": "Index is the position of the item in the request, 0 based",
                     "type": "integer"
+                },
+                "status": {
+                    "description": "Status is created, failed or skipped, skipped items were not booked because another item failed in atomic mode",
+                    "type": "string",
+                    "example": "created"
                 }
             }
         },
-        "pkgerr.ServiceErrorResponseBody": {
+        "transaction.CreateRequest": {
             "type": "object",
             "properties": {
-                "code": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "amount": {
+                    "description": "Amount can be sent as a JSON number or string with at most 2 decimal places",
+                    "type": "number",
+                    "example": -98.75
+                },
+                "currency": {
+                    "description": "Currency is the ISO 4217 code of the currency of the amount, it defaults to the currency of the account\nan amount in another currency is converted with the fx rate to the currency of the account, or rejected when there is none",
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "description": {
+                    "description": "Description, MerchantName, MCC, ExternalReference \u0026 Metadata are only informational",
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "event_timestamp": {
+                    "description": "EventTimestamp is when the transaction happened, it defaults to when it is booked\nit can be back-dated by at most the configured window but can not be in the future",
                     "type": "string"
                 },
-                "msg": {
+                "external_reference": {
+                    "description": "ExternalReference is the id of the transaction in the system which sent it, transactions can be listed by it",
+                    "type": "string",
+                    "example": "order-1234"
+                },
+                "installments": {
+                    "description": "Installments splits a purchase with installments into this many monthly installments",
+                    "type": "integer",
+                    "example": 3
+                },
+                "mcc": {
+                    "description": "MCC is the 4 digit merchant category code",
+                    "type": "string",
+                    "example": "5411"
+                },
+                "merchant_name": {
+                    "type": "string",
+                    "example": "ACME Market"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "operation_type_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "transaction.CreateResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "transaction.ListResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "description": "NextCursor is empty when there are no more transactions to fetch",
                     "type": "string"
                 },
-                "namespace": {
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.Transaction"
+                    }
+                }
+            }
+        },
+        "transaction.ListSettlementsResponse": {
+            "type": "object",
+            "properties": {
+                "settlements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.Settlement"
+                    }
+                }
+            }
+        },
+        "transaction.Settlement": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 18.75
+                },
+                "credit_transaction_id": {
+                    "type": "integer"
+                },
+                "debit_transaction_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reversed_at": {
+                    "description": "ReversedAt is set when the payment was undone by reversing the credit or the debit",
+                    "type": "string"
+                },
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "pkgerr.ValidationErrorResponseBody": {
+        "transaction.Transaction": {
             "type": "object",
             "properties": {
-                "code": {
+                "account_id": {
+                    "type": "integer"
+                },
+                "amount": {
+                    "type": "number",
+                    "example": -98.75
+                },
+                "balance": {
+                    "type": "number",
+                    "example": -18.75
+                },
+                "created_at": {
                     "type": "string"
                 },
-                "errors": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "external_reference": {
+                    "type": "string"
+                },
+                "fx_rate": {
+                    "type": "string",
+                    "example": "1.0911"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "installment_number": {
+                    "type": "integer"
+                },
+                "mcc": {
+                    "type": "string"
+                },
+                "merchant_name": {
+                    "type": "string"
+                },
+                "metadata": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
                 },
-                "namespace": {
+                "operation_type_id": {
+                    "type": "integer"
+                },
+                "original_amount": {
+                    "description": "OriginalAmount, OriginalCurrency \u0026 FxRate are only set on a transaction which was sent in another currency than its account",
+                    "type": "number",
+                    "example": -90.5
+                },
+                "original_currency": {
+                    "type": "string",
+                    "example": "EUR"
+                },
+                "parent_id": {
+                    "description": "ParentID, InstallmentNumber \u0026 DueDate are only set on the installments of a purchase with installments",
+                    "type": "integer"
+                },
+                "reversal_of_id": {
+                    "description": "ReversalOfID is only set on the compensating transaction of a reversal",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status is posted or reversed",
+                    "type": "string",
+                    "example": "posted"
+                },
+                "timestamp": {
+                    "description": "Timestamp is when the transaction happened and CreatedAt is when it was booked, they differ for back-dated transactions",
+                    "type": "string"
+                },
+                "transfer_id": {
+                    "description": "TransferID is only set on the legs of a transfer",
+                    "type": "integer"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "transaction.CreateRequest": {
+        "transfer.CreateRequest": {
             "type": "object",
             "properties": {
-                "account_id": {
+                "amount": {
+                    "description": "Amount can be sent as a JSON number or string with at most 2 decimal places",
+                    "type": "number",
+                    "example": 98.75
+                },
+                "destination_account_id": {
                     "type": "integer"
                 },
+                "source_account_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "transfer.Transfer": {
+            "type": "object",
+            "properties": {
                 "amount": {
-                    "type": "number"
+                    "type": "number",
+                    "example": 98.75
                 },
-                "operation_type_id": {
+                "created_at": {
+                    "type": "string"
+                },
+                "credit": {
+                    "$ref": "#/definitions/transaction.Transaction"
+                },
+                "debit": {
+                    "$ref": "#/definitions/transaction.Transaction"
+                },
+                "destination_account_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "source_account_id": {
                     "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "transaction.CreateResponse": {
+        "webhook.CreateRequest": {
+            "type": "object",
+            "properties": {
+                "event_types": {
+                    "description": "EventTypes are the event types which are delivered, every type when it is empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "TransactionCreated"
+                    ]
+                },
+                "secret": {
+                    "description": "Secret signs every delivery, it is not returned by any api",
+                    "type": "string",
+                    "example": "a-long-random-secret"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/webhooks"
+                }
+            }
+        },
+        "webhook.Delivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Attempts is how many times sending failed since it was created or redelivered",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "integer"
+                },
+                "event_type": {
+                    "type": "string",
+                    "example": "TransactionCreated"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Status is pending till the subscriber responds with a 2xx status, or dead when it failed max attempts times",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "delivered",
+                        "dead"
+                    ]
+                },
+                "subscription_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "webhook.ListDeliveriesResponse": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhook.Delivery"
+                    }
+                }
+            }
+        },
+        "webhook.ListResponse": {
             "type": "object",
             "properties": {
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhook.Subscription"
+                    }
+                }
+            }
+        },
+        "webhook.Subscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "TransactionCreated"
+                    ]
+                },
                 "id": {
                     "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/webhooks"
                 }
             }
         }
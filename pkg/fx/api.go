@@ -0,0 +1,106 @@
+package fx
+
+import (
+	"net/http"
+
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// API is the api handler for the admin fx rate apis
+type API struct {
+	sevice Service
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+	}
+}
+
+// Handle sets up all the routes with their handler funcs for fx rate apis
+func (a *API) Handle(router fiber.Router) {
+	router.Get("/", a.listRates)
+	router.Put("/:base/:quote", a.setRate)
+	router.Delete("/:base/:quote", a.deleteRate)
+}
+
+// listRates returns every fx rate
+// @Summary      list the fx rates
+// @Produce      json
+// @Tags		 admin
+// @Success      200  {object}  ListResponse
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/admin/fx-rates [get]
+func (a *API) listRates(c *fiber.Ctx) error {
+	// call the service to list the rates
+	resp, err := a.sevice.List(c.UserContext())
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// setRate creates or replaces an fx rate
+// @Summary      set an fx rate
+// @Description  transactions sent in the base currency are converted with the rate when they are booked on an account in the quote currency
+// @Produce      json
+// @Tags		 admin
+// @Param        base   path     string      true  "ISO 4217 base currency"
+// @Param        quote  path     string      true  "ISO 4217 quote currency"
+// @Param        req    body     SetRequest  true  "how much one base is in quote"
+// @Success      200  {object}  Rate
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/admin/fx-rates/{base}/{quote} [put]
+func (a *API) setRate(c *fiber.Ctx) error {
+	req := &SetRequest{}
+
+	// try to parse the body
+	err := c.BodyParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("fx", "body_parse_failure", http.StatusBadRequest, err.Error())
+	}
+	req.BaseCurrency = c.Params("base")
+	req.QuoteCurrency = c.Params("quote")
+
+	// call the service to set the rate
+	resp, err := a.sevice.Set(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// deleteRate removes an fx rate
+// @Summary      delete an fx rate
+// @Tags		 admin
+// @Param        base   path     string  true  "ISO 4217 base currency"
+// @Param        quote  path     string  true  "ISO 4217 quote currency"
+// @Success      204
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/admin/fx-rates/{base}/{quote} [delete]
+func (a *API) deleteRate(c *fiber.Ctx) error {
+	// call the service to delete the rate
+	err := a.sevice.Delete(c.UserContext(), &DeleteRequest{
+		BaseCurrency:  c.Params("base"),
+		QuoteCurrency: c.Params("quote"),
+	})
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return 204 status
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,121 @@
+package fx_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/fx"
+	"transactor-server/pkg/mocks"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockFxService) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	service := mocks.NewMockFxService(t)
+
+	api := fx.NewAPI(service)
+	api.Handle(app.Group("/test/fx-rates"))
+
+	return app, service
+}
+
+func TestAPIList(t *testing.T) {
+	t.Parallel()
+	app, service := setupApp(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/test/fx-rates", nil)
+
+	service.On("List", mock.Anything).Return(&fx.ListResponse{
+		Rates: []*fx.Rate{fx.MapEntFxRateToRate(dbRate)},
+	}, nil)
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, int64(1), gjson.GetBytes(b, "rates.#").Int())
+	require.Equal(t, "1.0845", gjson.GetBytes(b, "rates.0.rate").String())
+}
+
+func TestAPISet(t *testing.T) {
+	t.Run("body parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPut, "/test/fx-rates/EUR/USD", strings.NewReader(`{"rate": 1.08`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPut, "/test/fx-rates/EUR/USD", strings.NewReader(`{"rate": "1.0845"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		service.On("Set", mock.Anything, &fx.SetRequest{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0845"}).
+			Return(fx.MapEntFxRateToRate(dbRate), nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, "EUR", gjson.GetBytes(b, "base_currency").String())
+		require.Equal(t, "USD", gjson.GetBytes(b, "quote_currency").String())
+	})
+}
+
+func TestAPIDelete(t *testing.T) {
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodDelete, "/test/fx-rates/EUR/USD", nil)
+
+		service.On("Delete", mock.Anything, &fx.DeleteRequest{BaseCurrency: "EUR", QuoteCurrency: "USD"}).Return(fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodDelete, "/test/fx-rates/EUR/USD", nil)
+
+		service.On("Delete", mock.Anything, &fx.DeleteRequest{BaseCurrency: "EUR", QuoteCurrency: "USD"}).Return(nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+}
@@ -0,0 +1,70 @@
+package fx
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/fxrate"
+)
+
+// DAO defines the data access object interface for fx_rate model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockFxDAO --filename fx_dao.go
+type DAO interface {
+	// List returns every rate ordered by base & quote currency
+	List(ctx context.Context) ([]*ent.FxRate, error)
+	// Set creates the rate from base to quote or replaces it when it exists
+	Set(ctx context.Context, req *SetRequest) (*ent.FxRate, error)
+	// Delete removes the rate from base to quote, it returns a not found error when there is none
+	Delete(ctx context.Context, req *DeleteRequest) error
+}
+
+type dao struct {
+	entClient *ent.Client
+}
+
+var _ DAO = (*dao)(nil)
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client) DAO {
+	return &dao{
+		entClient: entClient,
+	}
+}
+
+func (d *dao) List(ctx context.Context) ([]*ent.FxRate, error) {
+	return d.entClient.FxRate.
+		Query().
+		Order(fxrate.ByBaseCurrency(), fxrate.ByQuoteCurrency()).
+		All(ctx)
+}
+
+func (d *dao) Set(ctx context.Context, req *SetRequest) (*ent.FxRate, error) {
+	// a single upsert so concurrent sets of the same pair do not race on the unique index
+	id, err := d.entClient.FxRate.
+		Create().
+		SetBaseCurrency(req.BaseCurrency).
+		SetQuoteCurrency(req.QuoteCurrency).
+		SetRate(req.Rate).
+		OnConflictColumns(fxrate.FieldBaseCurrency, fxrate.FieldQuoteCurrency).
+		UpdateRate().
+		UpdateUpdateTime().
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.entClient.FxRate.Get(ctx, id)
+}
+
+func (d *dao) Delete(ctx context.Context, req *DeleteRequest) error {
+	dbRate, err := d.entClient.FxRate.
+		Query().
+		Where(
+			fxrate.BaseCurrency(req.BaseCurrency),
+			fxrate.QuoteCurrency(req.QuoteCurrency),
+		).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return d.entClient.FxRate.DeleteOne(dbRate).Exec(ctx)
+}
@@ -0,0 +1,75 @@
+package fx_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/fx"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRate(t *testing.T) {
+	t.Parallel()
+
+	rate, err := fx.ParseRate("1.0845")
+	require.NoError(t, err)
+	require.Equal(t, big.NewRat(10845, 10000), rate)
+
+	for _, s := range []string{"", "0", "0.000", "-1.2", "1/3", "1e3", "1.", ".5", "1.000000000000000000000000000000001"} {
+		_, err := fx.ParseRate(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestDAO(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	dao := fx.NewDAO(client)
+
+	dbRate, err := dao.Set(ctx, &fx.SetRequest{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.08"})
+	require.NoError(t, err)
+	require.Equal(t, "1.08", dbRate.Rate)
+
+	// setting the same pair again replaces its rate
+	updated, err := dao.Set(ctx, &fx.SetRequest{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0845"})
+	require.NoError(t, err)
+	require.Equal(t, dbRate.ID, updated.ID)
+	require.Equal(t, "1.0845", updated.Rate)
+
+	_, err = dao.Set(ctx, &fx.SetRequest{BaseCurrency: "CAD", QuoteCurrency: "USD", Rate: "0.73"})
+	require.NoError(t, err)
+
+	dbRates, err := dao.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbRates, 2)
+	require.Equal(t, "CAD", dbRates[0].BaseCurrency)
+	require.Equal(t, "EUR", dbRates[1].BaseCurrency)
+
+	// rates are looked up in the direction they were set only
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	s, rate, err := fx.Lookup(ctx, tx, "EUR", "USD")
+	require.NoError(t, err)
+	require.Equal(t, "1.0845", s)
+	require.Equal(t, big.NewRat(10845, 10000), rate)
+	_, _, err = fx.Lookup(ctx, tx, "USD", "EUR")
+	require.ErrorIs(t, err, fx.ErrRateNotFound)
+	require.NoError(t, tx.Rollback())
+
+	err = dao.Delete(ctx, &fx.DeleteRequest{BaseCurrency: "EUR", QuoteCurrency: "USD"})
+	require.NoError(t, err)
+
+	err = dao.Delete(ctx, &fx.DeleteRequest{BaseCurrency: "EUR", QuoteCurrency: "USD"})
+	require.True(t, ent.IsNotFound(err))
+
+	dbRates, err = dao.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbRates, 1)
+}
@@ -0,0 +1,17 @@
+package fx
+
+import "transactor-server/pkg/db/ent"
+
+// MapEntFxRateToRate maps an ent.FxRate record to fx.Rate model
+func MapEntFxRateToRate(r *ent.FxRate) *Rate {
+	if r == nil {
+		return nil
+	}
+
+	return &Rate{
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		Rate:          r.Rate,
+		UpdatedAt:     r.UpdateTime,
+	}
+}
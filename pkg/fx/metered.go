@@ -0,0 +1,104 @@
+package fx
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the fx.Service
+// it like name suggests adds metrics to each service call
+// on success it adds fx_service_<method>_success and on failure fx_service_<method>_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	listCounterSuccess   metric.Int64Counter
+	listCounterFailure   metric.Int64Counter
+	setCounterSuccess    metric.Int64Counter
+	setCounterFailure    metric.Int64Counter
+	deleteCounterSuccess metric.Int64Counter
+	deleteCounterFailure metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	listCounterSuccess, err := meter.Int64Counter("fx_service_list_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listCounterFailure, err := meter.Int64Counter("fx_service_list_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	setCounterSuccess, err := meter.Int64Counter("fx_service_set_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	setCounterFailure, err := meter.Int64Counter("fx_service_set_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	deleteCounterSuccess, err := meter.Int64Counter("fx_service_delete_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	deleteCounterFailure, err := meter.Int64Counter("fx_service_delete_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:              service,
+		meter:                meter,
+		listCounterSuccess:   listCounterSuccess,
+		listCounterFailure:   listCounterFailure,
+		setCounterSuccess:    setCounterSuccess,
+		setCounterFailure:    setCounterFailure,
+		deleteCounterSuccess: deleteCounterSuccess,
+		deleteCounterFailure: deleteCounterFailure,
+	}
+}
+
+func (m *meteredSevice) List(ctx context.Context) (resp *ListResponse, err error) {
+	defer func() {
+		if err == nil {
+			m.listCounterSuccess.Add(ctx, 1)
+		} else {
+			m.listCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.List(ctx)
+	return
+}
+
+func (m *meteredSevice) Set(ctx context.Context, req *SetRequest) (resp *Rate, err error) {
+	defer func() {
+		if err == nil {
+			m.setCounterSuccess.Add(ctx, 1)
+		} else {
+			m.setCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Set(ctx, req)
+	return
+}
+
+func (m *meteredSevice) Delete(ctx context.Context, req *DeleteRequest) (err error) {
+	defer func() {
+		if err == nil {
+			m.deleteCounterSuccess.Add(ctx, 1)
+		} else {
+			m.deleteCounterFailure.Add(ctx, 1)
+		}
+	}()
+	err = m.service.Delete(ctx, req)
+	return
+}
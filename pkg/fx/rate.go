@@ -0,0 +1,61 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/fxrate"
+	"transactor-server/pkg/pkgerr"
+)
+
+// MaxRateLength is the most characters a rate can have, which is the size of its column
+const MaxRateLength = 32
+
+// rateRegex matches a plain decimal number, big.Rat would also take fractions & exponents
+var rateRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// ErrRateNotFound indicates there is no rate to convert between two currencies
+var ErrRateNotFound = pkgerr.NewServiceError(
+	"fx", "rate_not_found",
+	http.StatusUnprocessableEntity,
+	"there is no fx rate from the currency of the transaction to the currency of the account",
+)
+
+// ParseRate parses an exact +ve decimal rate like "1.0845"
+func ParseRate(s string) (*big.Rat, error) {
+	if len(s) > MaxRateLength || !rateRegex.MatchString(s) {
+		return nil, fmt.Errorf("rate must be a decimal number of at most %d characters", MaxRateLength)
+	}
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, fmt.Errorf("rate must be more than 0")
+	}
+	return rate, nil
+}
+
+// Lookup returns the rate which converts amounts in base to quote inside tx along with its decimal string
+// it returns ErrRateNotFound when there is none, rates are not inverted or chained as that would not be exact
+func Lookup(ctx context.Context, tx *ent.Tx, base string, quote string) (string, *big.Rat, error) {
+	dbRate, err := tx.FxRate.
+		Query().
+		Where(
+			fxrate.BaseCurrency(base),
+			fxrate.QuoteCurrency(quote),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil, ErrRateNotFound
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	rate, err := ParseRate(dbRate.Rate)
+	if err != nil {
+		return "", nil, err
+	}
+	return dbRate.Rate, rate, nil
+}
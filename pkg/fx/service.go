@@ -0,0 +1,86 @@
+package fx
+
+import (
+	"context"
+	"transactor-server/pkg/pkgerr"
+
+	"go.uber.org/zap"
+)
+
+// Service handles the main business logic for fx rate related things
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockFxService  --filename fx_service.go
+type Service interface {
+	// List returns every fx rate
+	List(context.Context) (*ListResponse, error)
+	// Set creates or replaces an fx rate
+	Set(context.Context, *SetRequest) (*Rate, error)
+	// Delete removes an fx rate, transactions in its base currency are rejected by accounts in its quote currency afterwards
+	Delete(context.Context, *DeleteRequest) error
+}
+
+type service struct {
+	fxDAO DAO
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(
+	fxDAO DAO,
+
+	logger *zap.Logger,
+) Service {
+	return &service{
+		fxDAO: fxDAO,
+
+		logger: logger,
+	}
+}
+
+func (s *service) List(ctx context.Context) (*ListResponse, error) {
+	dbRates, err := s.fxDAO.List(ctx)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	resp := &ListResponse{
+		Rates: make([]*Rate, 0, len(dbRates)),
+	}
+	for _, dbRate := range dbRates {
+		resp.Rates = append(resp.Rates, MapEntFxRateToRate(dbRate))
+	}
+
+	return resp, nil
+}
+
+func (s *service) Set(ctx context.Context, req *SetRequest) (*Rate, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	dbRate, err := s.fxDAO.Set(ctx, req)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntFxRateToRate(dbRate), nil
+}
+
+func (s *service) Delete(ctx context.Context, req *DeleteRequest) error {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return pkgerr.WrapStructValidationError(err)
+	}
+
+	err = s.fxDAO.Delete(ctx, req)
+	if err != nil {
+		return pkgerr.WrapDAOError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package fx_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/fx"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var dbRate = &ent.FxRate{
+	ID:            1,
+	BaseCurrency:  "EUR",
+	QuoteCurrency: "USD",
+	Rate:          "1.0845",
+	UpdateTime:    time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+}
+
+func TestServiceSet(t *testing.T) {
+	for name, req := range map[string]*fx.SetRequest{
+		"unknown currency": {BaseCurrency: "EUX", QuoteCurrency: "USD", Rate: "1.08"},
+		"same currency":    {BaseCurrency: "USD", QuoteCurrency: "USD", Rate: "1"},
+		"zero rate":        {BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "0"},
+		"bad rate":         {BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1,08"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			service := fx.NewService(mocks.NewMockFxDAO(t), zap.NewNop())
+
+			resp, err := service.Set(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		fxDAO := mocks.NewMockFxDAO(t)
+		service := fx.NewService(fxDAO, zap.NewNop())
+
+		req := &fx.SetRequest{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0845"}
+		fxDAO.On("Set", mock.Anything, req).Return(dbRate, nil)
+
+		resp, err := service.Set(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, "EUR", resp.BaseCurrency)
+		require.Equal(t, "USD", resp.QuoteCurrency)
+		require.Equal(t, "1.0845", resp.Rate)
+	})
+}
+
+func TestServiceDelete(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		fxDAO := mocks.NewMockFxDAO(t)
+		service := fx.NewService(fxDAO, zap.NewNop())
+
+		req := &fx.DeleteRequest{BaseCurrency: "EUR", QuoteCurrency: "USD"}
+		fxDAO.On("Delete", mock.Anything, req).Return(&ent.NotFoundError{})
+
+		err := service.Delete(context.Background(), req)
+
+		require.Error(t, err)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+}
@@ -0,0 +1,107 @@
+package fx
+
+import (
+	"context"
+	"transactor-server/pkg/config"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// tracedService is a middleware/wrapper to the fx.Service
+// it like name suggests adds a span to the each of the methods
+// on error it ensures that span is marked with error
+// it also logs request and response and error if any
+type tracedService struct {
+	service Service
+	logger  *zap.Logger
+}
+
+func NewTracedService(service Service, logger *zap.Logger) Service {
+	return &tracedService{
+		service: service,
+		logger:  logger,
+	}
+}
+
+var _ Service = (*tracedService)(nil)
+
+func (t *tracedService) List(ctx context.Context) (resp *ListResponse, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "FxService.List")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling FxService.List", zapotlp.SpanCtx(ctx))
+
+	resp, err = t.service.List(ctx)
+
+	if err != nil {
+		t.logger.Error("end FxService.List with error", zapotlp.SpanCtx(ctx), zap.Error(err))
+	} else {
+		t.logger.Info("end FxService.List", zapotlp.SpanCtx(ctx), zap.Int("rates", len(resp.Rates)))
+	}
+
+	return
+}
+
+func (t *tracedService) Set(ctx context.Context, req *SetRequest) (resp *Rate, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "FxService.Set")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling FxService.Set", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	resp, err = t.service.Set(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end FxService.Set with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end FxService.Set", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) Delete(ctx context.Context, req *DeleteRequest) (err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "FxService.Delete")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling FxService.Delete", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	err = t.service.Delete(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end FxService.Delete with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end FxService.Delete", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+	}
+
+	return
+}
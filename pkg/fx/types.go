@@ -0,0 +1,57 @@
+package fx
+
+import (
+	"time"
+	"transactor-server/pkg/money"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// SetRequest creates or replaces the rate from base to quote
+type SetRequest struct {
+	BaseCurrency  string `json:"-"`
+	QuoteCurrency string `json:"-"`
+	// Rate is how much one base is in quote, as an exact decimal string
+	Rate string `json:"rate" example:"1.0845"`
+}
+
+// Validate validates the SetRequest to
+// have base & quote ISO 4217 currencies which are not the same
+// and have a +ve decimal rate
+func (req SetRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.BaseCurrency, validation.Required, money.CurrencyRule),
+		validation.Field(&req.QuoteCurrency, validation.Required, money.CurrencyRule,
+			validation.NotIn(req.BaseCurrency).Error("must not be the base currency"),
+		),
+		validation.Field(&req.Rate, validation.Required, validation.By(func(value interface{}) error {
+			_, err := ParseRate(value.(string))
+			return err
+		})),
+	)
+}
+
+// DeleteRequest removes the rate from base to quote
+type DeleteRequest struct {
+	BaseCurrency  string `json:"-"`
+	QuoteCurrency string `json:"-"`
+}
+
+// Validate validates the DeleteRequest to have base & quote ISO 4217 currencies
+func (req DeleteRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.BaseCurrency, validation.Required, money.CurrencyRule),
+		validation.Field(&req.QuoteCurrency, validation.Required, money.CurrencyRule),
+	)
+}
+
+type Rate struct {
+	BaseCurrency  string    `json:"base_currency" example:"EUR"`
+	QuoteCurrency string    `json:"quote_currency" example:"USD"`
+	Rate          string    `json:"rate" example:"1.0845"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ListResponse struct {
+	Rates []*Rate `json:"rates"`
+}
@@ -0,0 +1,161 @@
+package hold
+
+import (
+	"net/http"
+	"strconv"
+
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// API is the api handler for hold apis
+type API struct {
+	sevice Service
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+	}
+}
+
+// Handle sets up all the routes with their handler funcs for hold apis
+func (a *API) Handle(router fiber.Router) {
+	router.Post("/", a.authorizeHold)
+	router.Get("/:id", a.getHold)
+	router.Post("/:id/capture", a.captureHold)
+	router.Post("/:id/release", a.releaseHold)
+}
+
+// authorizeHold places a hold on an account
+// the amount is reserved against the credit limit of the account till the hold is captured, released or expires
+// @Summary      authorize a hold
+// @Produce      json
+// @Tags		 hold
+// @Param        req    body     AuthorizeRequest  true  "hold details to authorize"
+// @Param        Idempotency-Key  header  string  false  "a unique key which makes the request safe to retry"
+// @Success      201  {object}  Hold
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      422  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/holds [post]
+func (a *API) authorizeHold(c *fiber.Ctx) error {
+	req := &AuthorizeRequest{}
+
+	// try to parse the body
+	err := c.BodyParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("hold", "body_parse_failure", http.StatusBadRequest, err.Error())
+	}
+
+	// call the sevice to place the hold
+	resp, err := a.sevice.Authorize(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error we return the hold with 201 status
+	return c.Status(http.StatusCreated).JSON(resp)
+}
+
+// getHold returns an existing hold
+// @Summary      get a hold
+// @Produce      json
+// @Tags		 hold
+// @Param        id    path     int  true  "hold id"
+// @Success      200  {object}  Hold
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/holds/{id} [get]
+func (a *API) getHold(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to get the hold
+	resp, err := a.sevice.Get(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error we return the response with 200 status
+	return c.JSON(resp)
+}
+
+// captureHold posts the debit of a hold, for the whole hold or for the amount sent, the rest is released
+// @Summary      capture a hold
+// @Produce      json
+// @Tags		 hold
+// @Param        id    path     int  true  "hold id"
+// @Param        req    body     CaptureRequest  false  "amount to capture, the whole hold when not sent"
+// @Success      200  {object}  Hold
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      409  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      422  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/holds/{id}/capture [post]
+func (a *API) captureHold(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// the body is optional, an empty body captures the whole hold
+	req := &CaptureRequest{}
+	if len(c.Body()) > 0 {
+		err = c.BodyParser(req)
+		if err != nil {
+			return pkgerr.NewServiceError("hold", "body_parse_failure", http.StatusBadRequest, err.Error())
+		}
+	}
+	req.ID = id
+
+	// call the service to capture the hold
+	resp, err := a.sevice.Capture(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error we return the response with 200 status
+	return c.JSON(resp)
+}
+
+// releaseHold frees the amount of a hold without posting anything
+// @Summary      release a hold
+// @Produce      json
+// @Tags		 hold
+// @Param        id    path     int  true  "hold id"
+// @Success      200  {object}  Hold
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      409  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/holds/{id}/release [post]
+func (a *API) releaseHold(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to release the hold
+	resp, err := a.sevice.Release(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error we return the response with 200 status
+	return c.JSON(resp)
+}
@@ -0,0 +1,196 @@
+package hold_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/hold"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockHoldService) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	router := app.Group("/test/holds")
+	service := mocks.NewMockHoldService(t)
+
+	api := hold.NewAPI(service)
+	api.Handle(router)
+
+	return app, service
+}
+
+func TestAPIAuthorize(t *testing.T) {
+	t.Run("body parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/holds/", bytes.NewBufferString("something"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/holds/", bytes.NewBufferString(`{"account_id":1,"operation_type_id":1,"amount":"10"}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		service.On("Authorize", mock.Anything, &hold.AuthorizeRequest{
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("10"),
+		}).Return(&hold.Hold{ID: 3, AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("10"), Status: "active"}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(3), gjson.Get(string(b), "id").Int())
+		require.Equal(t, "active", gjson.Get(string(b), "status").String())
+	})
+
+}
+
+func TestAPIGet(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/holds/abc", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/holds/3", nil)
+
+		service.On("Get", mock.Anything, 3).Return(&hold.Hold{ID: 3, Status: "active"}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+}
+
+func TestAPICapture(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/holds/abc/capture", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("whole hold", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/holds/3/capture", nil)
+
+		service.On("Capture", mock.Anything, &hold.CaptureRequest{ID: 3}).Return(nil, hold.ErrExpired)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("part of the hold", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/holds/3/capture", bytes.NewBufferString(`{"amount":8}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		amount := money.MustParse("8")
+		transactionID := 7
+		service.On("Capture", mock.Anything, &hold.CaptureRequest{ID: 3, Amount: &amount}).Return(&hold.Hold{
+			ID:             3,
+			Status:         "captured",
+			CapturedAmount: &amount,
+			TransactionID:  &transactionID,
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, 8.0, gjson.Get(string(b), "captured_amount").Float())
+		require.Equal(t, int64(7), gjson.Get(string(b), "transaction_id").Int())
+	})
+
+}
+
+func TestAPIRelease(t *testing.T) {
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/holds/3/release", nil)
+
+		service.On("Release", mock.Anything, 3).Return(&hold.Hold{ID: 3, Status: "released"}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, "released", gjson.Get(string(b), "status").String())
+	})
+}
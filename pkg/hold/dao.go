@@ -0,0 +1,272 @@
+package hold
+
+import (
+	"context"
+	"net/http"
+	"time"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	enthold "transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/transaction"
+)
+
+// DefaultTTL is how long a hold reserves its amount when no ttl is configured
+const DefaultTTL = 7 * 24 * time.Hour
+
+var (
+	// ErrNotActive indicates the hold was already captured, released or expired
+	ErrNotActive = pkgerr.NewServiceError(
+		"hold", "not_active",
+		http.StatusConflict,
+		"hold is already captured, released or expired",
+	)
+	// ErrExpired indicates the hold expired before it was captured
+	ErrExpired = pkgerr.NewServiceError(
+		"hold", "expired",
+		http.StatusConflict,
+		"hold has expired",
+	)
+	// ErrInsufficientFunds indicates a hold on an account without a credit limit is more than its available balance
+	ErrInsufficientFunds = pkgerr.NewServiceError(
+		"hold", "insufficient_funds",
+		http.StatusUnprocessableEntity,
+		"amount is more than the available balance of the account",
+	)
+	// ErrCaptureExceedsHold indicates the capture amount is more than the amount of the hold
+	ErrCaptureExceedsHold = pkgerr.NewServiceError(
+		"hold", "capture_exceeds_hold",
+		http.StatusUnprocessableEntity,
+		"capture amount is more than the amount of the hold",
+	)
+)
+
+// DAO defines the data access object interface for hold model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockHoldDAO --filename hold_dao.go
+type DAO interface {
+	// Authorize reserves the amount of a hold against the credit limit of the account
+	Authorize(ctx context.Context, req *AuthorizeRequest) (*ent.Hold, error)
+	// Capture books the debit of an active hold and closes it, the amount not captured is released
+	Capture(ctx context.Context, req *CaptureRequest) (*ent.Hold, error)
+	// Release closes an active hold without booking anything
+	Release(ctx context.Context, id int) (*ent.Hold, error)
+	// Get tries to find an existing hold record in DB by id
+	Get(ctx context.Context, id int) (*ent.Hold, error)
+	// Expire marks the active holds which expired by now as expired and returns how many there were
+	Expire(ctx context.Context, now time.Time) (int, error)
+}
+
+type dao struct {
+	entClient *ent.Client
+	ttl       time.Duration
+}
+
+var _ DAO = (*dao)(nil)
+
+// DAOOption configures the DAO returned by NewDAO
+type DAOOption func(*dao)
+
+// WithTTL sets how long a hold reserves its amount before it expires, it defaults to DefaultTTL
+func WithTTL(ttl time.Duration) DAOOption {
+	return func(d *dao) {
+		if ttl > 0 {
+			d.ttl = ttl
+		}
+	}
+}
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client, opts ...DAOOption) DAO {
+	d := &dao{
+		entClient: entClient,
+		ttl:       DefaultTTL,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+func (d *dao) Authorize(ctx context.Context, req *AuthorizeRequest) (dbHold *ent.Hold, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		// the account lock makes sure concurrent holds & debits can not reserve the same limit twice
+		dbAccount, err := db.LockAccount(ctx, tx, req.AccountID)
+		if err != nil {
+			return err
+		}
+
+		err = reserve(ctx, tx, dbAccount, req.Amount)
+		if err != nil {
+			return err
+		}
+
+		dbHold, err = tx.Hold.
+			Create().
+			SetAccountID(req.AccountID).
+			SetOperationTypeID(req.OperationTypeID).
+			SetAmount(req.Amount).
+			SetExpiresAt(time.Now().Add(d.ttl)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbHold, nil
+}
+
+// reserve makes sure amount can be held on the account, the account must be locked already
+// an account with a credit limit can hold what is left of it, an account without one only what it has available
+// as a hold promises the amount can be captured later
+func reserve(ctx context.Context, tx *ent.Tx, dbAccount *ent.Account, amount money.Amount) error {
+	if dbAccount.CreditLimit != nil {
+		return transaction.CheckLimit(ctx, tx, dbAccount, -amount)
+	}
+
+	balance, err := db.NetBalance(ctx, tx, dbAccount.ID)
+	if err != nil {
+		return err
+	}
+
+	held, err := db.HeldAmount(ctx, tx, dbAccount.ID)
+	if err != nil {
+		return err
+	}
+
+	if balance-held-amount < 0 {
+		return ErrInsufficientFunds
+	}
+
+	return nil
+}
+
+func (d *dao) Capture(ctx context.Context, req *CaptureRequest) (dbHold *ent.Hold, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		dbHold, err = d.capture(ctx, tx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbHold, nil
+}
+
+// capture books the debit of a hold inside tx and closes the hold, what is not captured is released
+// the debit does not go through the limit check again as the hold already reserved more than it
+func (d *dao) capture(ctx context.Context, tx *ent.Tx, req *CaptureRequest) (*ent.Hold, error) {
+	dbHold, err := tx.Hold.Get(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbAccount, err := db.LockAccount(ctx, tx, dbHold.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := dbHold.Amount
+	if req.Amount != nil {
+		amount = *req.Amount
+	}
+
+	err = checkHold(dbHold, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// the capture is a debit, it discharges nothing so it needs neither a discharger nor a strategy
+	debit, err := transaction.Book(ctx, tx, nil, nil, tx.Transaction.
+		Create().
+		SetAccountID(dbHold.AccountID).
+		SetOperationTypeID(dbHold.OperationTypeID).
+		SetTimestamp(time.Now()).
+		SetAmount(-amount).
+		SetBalance(-amount).
+		SetCurrency(dbAccount.Currency))
+	if err != nil {
+		return nil, err
+	}
+
+	return closeHold(ctx, tx, dbHold, func(update *ent.HoldUpdate) {
+		update.
+			SetStatus(enthold.StatusCaptured).
+			SetCapturedAmount(amount).
+			SetTransactionID(debit.ID)
+	})
+}
+
+func (d *dao) Release(ctx context.Context, id int) (dbHold *ent.Hold, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		dbHold, err = tx.Hold.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if dbHold.Status != enthold.StatusActive {
+			return ErrNotActive
+		}
+
+		dbHold, err = closeHold(ctx, tx, dbHold, func(update *ent.HoldUpdate) {
+			update.SetStatus(enthold.StatusReleased)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbHold, nil
+}
+
+// checkHold makes sure amount can be captured from the hold
+func checkHold(dbHold *ent.Hold, amount money.Amount) error {
+	if dbHold.Status != enthold.StatusActive {
+		return ErrNotActive
+	}
+	if !dbHold.ExpiresAt.After(time.Now()) {
+		return ErrExpired
+	}
+	if amount > dbHold.Amount {
+		return ErrCaptureExceedsHold
+	}
+	return nil
+}
+
+// closeHold applies set to the hold only if it is still active, so a hold racing with
+// another capture, release or the sweeper is closed exactly once, and returns the updated hold
+func closeHold(ctx context.Context, tx *ent.Tx, dbHold *ent.Hold, set func(*ent.HoldUpdate)) (*ent.Hold, error) {
+	update := tx.Hold.
+		Update().
+		Where(enthold.ID(dbHold.ID), enthold.StatusEQ(enthold.StatusActive))
+	set(update)
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrNotActive
+	}
+
+	return tx.Hold.Get(ctx, dbHold.ID)
+}
+
+func (d *dao) Get(ctx context.Context, id int) (*ent.Hold, error) {
+	return d.entClient.Hold.Get(ctx, id)
+}
+
+func (d *dao) Expire(ctx context.Context, now time.Time) (int, error) {
+	// expired holds stop reserving as soon as they pass expires_at, this only updates their status
+	return d.entClient.Hold.
+		Update().
+		Where(
+			enthold.StatusEQ(enthold.StatusActive),
+			enthold.ExpiresAtLTE(now),
+		).
+		SetStatus(enthold.StatusExpired).
+		Save(ctx)
+}
@@ -0,0 +1,159 @@
+package hold_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	enthold "transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/hold"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// setupHold returns a client over an account 1 with a credit limit of 100
+func setupHold(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCreditLimit(money.MustParse("100")).ExecX(ctx)
+
+	return client
+}
+
+func TestDAOAuthorizeCapture(t *testing.T) {
+	t.Parallel()
+	client := setupHold(t)
+	dao := hold.NewDAO(client, hold.WithTTL(time.Hour))
+	ctx := context.Background()
+
+	dbHold, err := dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("60")})
+	require.NoError(t, err)
+	require.Equal(t, enthold.StatusActive, dbHold.Status)
+	require.WithinDuration(t, time.Now().Add(time.Hour), dbHold.ExpiresAt, time.Minute)
+
+	// the hold reserves its amount for other holds and debits
+	_, err = dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("50")})
+	require.ErrorIs(t, err, transaction.ErrInsufficientLimit)
+	_, err = transaction.NewDAO(client).Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-50")}, transaction.FIFO())
+	require.ErrorIs(t, err, transaction.ErrInsufficientLimit)
+	require.Equal(t, 0, client.Transaction.Query().CountX(ctx))
+
+	amount := money.MustParse("60.01")
+	_, err = dao.Capture(ctx, &hold.CaptureRequest{ID: dbHold.ID, Amount: &amount})
+	require.ErrorIs(t, err, hold.ErrCaptureExceedsHold)
+
+	// a partial capture posts the debit and releases the rest
+	amount = money.MustParse("40")
+	dbHold, err = dao.Capture(ctx, &hold.CaptureRequest{ID: dbHold.ID, Amount: &amount})
+	require.NoError(t, err)
+	require.Equal(t, enthold.StatusCaptured, dbHold.Status)
+	require.Equal(t, amount, *dbHold.CapturedAmount)
+
+	debit := client.Transaction.GetX(ctx, *dbHold.TransactionID)
+	require.Equal(t, 1, debit.OperationTypeID)
+	require.Equal(t, money.MustParse("-40"), debit.Amount)
+	require.Equal(t, money.MustParse("-40"), debit.Balance)
+
+	_, err = dao.Capture(ctx, &hold.CaptureRequest{ID: dbHold.ID})
+	require.ErrorIs(t, err, hold.ErrNotActive)
+
+	// 100 - 40 captured leaves 60 for new debits
+	_, err = transaction.NewDAO(client).Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-60")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	_, err = dao.Capture(ctx, &hold.CaptureRequest{ID: 999})
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestDAOAuthorizeWithoutLimit(t *testing.T) {
+	t.Parallel()
+	client := setupHold(t)
+	dao := hold.NewDAO(client)
+	ctx := context.Background()
+
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	// an account without a credit limit can only hold what it has available
+	_, err := dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("10")})
+	require.ErrorIs(t, err, hold.ErrInsufficientFunds)
+
+	_, err = transaction.NewDAO(client).Create(ctx, &transaction.CreateRequest{AccountID: 2, OperationTypeID: 4, Amount: money.MustParse("50")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	_, err = dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("30")})
+	require.NoError(t, err)
+
+	// 50 - 30 held leaves 20
+	_, err = dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("20.01")})
+	require.ErrorIs(t, err, hold.ErrInsufficientFunds)
+	_, err = dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("20")})
+	require.NoError(t, err)
+	require.Equal(t, 2, client.Hold.Query().CountX(ctx))
+}
+
+func TestDAORelease(t *testing.T) {
+	t.Parallel()
+	client := setupHold(t)
+	dao := hold.NewDAO(client)
+	ctx := context.Background()
+
+	dbHold, err := dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("100")})
+	require.NoError(t, err)
+
+	dbHold, err = dao.Release(ctx, dbHold.ID)
+	require.NoError(t, err)
+	require.Equal(t, enthold.StatusReleased, dbHold.Status)
+	require.Nil(t, dbHold.TransactionID)
+
+	_, err = dao.Release(ctx, dbHold.ID)
+	require.ErrorIs(t, err, hold.ErrNotActive)
+	_, err = dao.Capture(ctx, &hold.CaptureRequest{ID: dbHold.ID})
+	require.ErrorIs(t, err, hold.ErrNotActive)
+
+	// the whole limit is available again
+	_, err = transaction.NewDAO(client).Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-100")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	got, err := dao.Get(ctx, dbHold.ID)
+	require.NoError(t, err)
+	require.Equal(t, enthold.StatusReleased, got.Status)
+}
+
+func TestDAOExpire(t *testing.T) {
+	t.Parallel()
+	client := setupHold(t)
+	dao := hold.NewDAO(client, hold.WithTTL(time.Millisecond))
+	ctx := context.Background()
+
+	dbHold, err := dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("100")})
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	// an expired hold stops reserving its amount and can not be captured even before it is swept
+	_, err = dao.Capture(ctx, &hold.CaptureRequest{ID: dbHold.ID})
+	require.ErrorIs(t, err, hold.ErrExpired)
+	_, err = dao.Authorize(ctx, &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("100")})
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	expired, err := dao.Expire(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, expired)
+	require.Equal(t, enthold.StatusExpired, client.Hold.GetX(ctx, dbHold.ID).Status)
+
+	expired, err = dao.Expire(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 0, expired)
+}
@@ -0,0 +1,23 @@
+package hold
+
+import "transactor-server/pkg/db/ent"
+
+// MapEntHoldToHold maps an ent.Hold record to hold.Hold model
+func MapEntHoldToHold(h *ent.Hold) *Hold {
+	if h == nil {
+		return nil
+	}
+
+	return &Hold{
+		ID:              h.ID,
+		AccountID:       h.AccountID,
+		OperationTypeID: h.OperationTypeID,
+		Amount:          h.Amount,
+		Status:          h.Status.String(),
+		ExpiresAt:       h.ExpiresAt,
+		CapturedAmount:  h.CapturedAmount,
+		TransactionID:   h.TransactionID,
+		CreatedAt:       h.CreateTime,
+		UpdatedAt:       h.UpdateTime,
+	}
+}
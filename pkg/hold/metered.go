@@ -0,0 +1,128 @@
+package hold
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the hold.Service
+// it like name suggests adds metrics to each service call
+// on success it adds hold_service_<method>_success and on failure hold_service_<method>_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	authorizeCounterSuccess metric.Int64Counter
+	authorizeCounterFailure metric.Int64Counter
+	captureCounterSuccess   metric.Int64Counter
+	captureCounterFailure   metric.Int64Counter
+	releaseCounterSuccess   metric.Int64Counter
+	releaseCounterFailure   metric.Int64Counter
+	getCounterSuccess       metric.Int64Counter
+	getCounterFailure       metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	authorizeCounterSuccess, err := meter.Int64Counter("hold_service_authorize_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	authorizeCounterFailure, err := meter.Int64Counter("hold_service_authorize_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	captureCounterSuccess, err := meter.Int64Counter("hold_service_capture_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	captureCounterFailure, err := meter.Int64Counter("hold_service_capture_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	releaseCounterSuccess, err := meter.Int64Counter("hold_service_release_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	releaseCounterFailure, err := meter.Int64Counter("hold_service_release_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterSuccess, err := meter.Int64Counter("hold_service_get_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterFailure, err := meter.Int64Counter("hold_service_get_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:                 service,
+		meter:                   meter,
+		authorizeCounterSuccess: authorizeCounterSuccess,
+		authorizeCounterFailure: authorizeCounterFailure,
+		captureCounterSuccess:   captureCounterSuccess,
+		captureCounterFailure:   captureCounterFailure,
+		releaseCounterSuccess:   releaseCounterSuccess,
+		releaseCounterFailure:   releaseCounterFailure,
+		getCounterSuccess:       getCounterSuccess,
+		getCounterFailure:       getCounterFailure,
+	}
+}
+
+func (m *meteredSevice) Authorize(ctx context.Context, req *AuthorizeRequest) (resp *Hold, err error) {
+	defer func() {
+		if err == nil {
+			m.authorizeCounterSuccess.Add(ctx, 1)
+		} else {
+			m.authorizeCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Authorize(ctx, req)
+	return
+}
+
+func (m *meteredSevice) Capture(ctx context.Context, req *CaptureRequest) (resp *Hold, err error) {
+	defer func() {
+		if err == nil {
+			m.captureCounterSuccess.Add(ctx, 1)
+		} else {
+			m.captureCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Capture(ctx, req)
+	return
+}
+
+func (m *meteredSevice) Release(ctx context.Context, id int) (resp *Hold, err error) {
+	defer func() {
+		if err == nil {
+			m.releaseCounterSuccess.Add(ctx, 1)
+		} else {
+			m.releaseCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Release(ctx, id)
+	return
+}
+
+func (m *meteredSevice) Get(ctx context.Context, id int) (resp *Hold, err error) {
+	defer func() {
+		if err == nil {
+			m.getCounterSuccess.Add(ctx, 1)
+		} else {
+			m.getCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Get(ctx, id)
+	return
+}
@@ -0,0 +1,127 @@
+package hold
+
+import (
+	"context"
+	"net/http"
+	"transactor-server/pkg/operationtype"
+	"transactor-server/pkg/pkgerr"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"go.uber.org/zap"
+)
+
+// Service handles the main business logic for hold related things
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockHoldService  --filename hold_service.go
+type Service interface {
+	// Authorize places a hold on an account which reserves its amount without posting a transaction
+	Authorize(context.Context, *AuthorizeRequest) (*Hold, error)
+	// Capture posts the debit of a hold for all or part of its amount and releases the rest
+	Capture(context.Context, *CaptureRequest) (*Hold, error)
+	// Release frees the amount of a hold without posting anything
+	Release(context.Context, int) (*Hold, error)
+	// Get returns an existing hold
+	Get(context.Context, int) (*Hold, error)
+}
+
+type service struct {
+	operationtypeDAO operationtype.DAO
+	holdDAO          DAO
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(
+	operationtypeDAO operationtype.DAO,
+	holdDAO DAO,
+
+	logger *zap.Logger,
+) Service {
+	return &service{
+		operationtypeDAO: operationtypeDAO,
+		holdDAO:          holdDAO,
+
+		logger: logger,
+	}
+}
+
+// ErrNotDebit indicates a hold was placed with an operation type which is not a debit
+var ErrNotDebit = pkgerr.NewServiceError(
+	"hold", "operation_type_not_debit",
+	http.StatusBadRequest,
+	"holds can only be placed with a debit operation type",
+)
+
+func (s *service) Authorize(ctx context.Context, req *AuthorizeRequest) (*Hold, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	// the operation type is what the hold is booked as on capture so it has to be a debit
+	operationtype, err := s.operationtypeDAO.Get(ctx, req.OperationTypeID)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+	if !operationtype.IsDebit {
+		return nil, ErrNotDebit
+	}
+
+	// calls dao to reserve the amount, it has to fit in the credit limit of the account
+	dbHold, err := s.holdDAO.Authorize(ctx, req)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntHoldToHold(dbHold), nil
+}
+
+func (s *service) Capture(ctx context.Context, req *CaptureRequest) (*Hold, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	// calls dao to book the debit, it fails if the hold is not active anymore or the amount is more than the hold
+	dbHold, err := s.holdDAO.Capture(ctx, req)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntHoldToHold(dbHold), nil
+}
+
+func (s *service) Release(ctx context.Context, id int) (*Hold, error) {
+	// validates the id to be +ve
+	err := validation.Validate(id, validation.Min(1))
+	if err != nil {
+		return nil, pkgerr.WrapValidationError(err, "id")
+	}
+
+	// calls dao to release the hold, it fails if the hold is not active anymore
+	dbHold, err := s.holdDAO.Release(ctx, id)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntHoldToHold(dbHold), nil
+}
+
+func (s *service) Get(ctx context.Context, id int) (*Hold, error) {
+	// validates the id to be +ve
+	err := validation.Validate(id, validation.Min(1))
+	if err != nil {
+		return nil, pkgerr.WrapValidationError(err, "id")
+	}
+
+	dbHold, err := s.holdDAO.Get(ctx, id)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntHoldToHold(dbHold), nil
+}
@@ -0,0 +1,246 @@
+package hold_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	enthold "transactor-server/pkg/db/ent/hold"
+	"transactor-server/pkg/hold"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/transaction"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestServiceAuthorize(t *testing.T) {
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockHoldDAO(t), zap.NewNop())
+
+		for _, req := range []*hold.AuthorizeRequest{
+			{},
+			{AccountID: 1, OperationTypeID: 1},
+			{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")},
+		} {
+			resp, err := service.Authorize(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("not a debit", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+
+		service := hold.NewService(operationTypeDAO, mocks.NewMockHoldDAO(t), zap.NewNop())
+
+		operationTypeDAO.On("Get", mock.Anything, 4).Return(&ent.OperationType{ID: 4, IsDebit: false}, nil)
+
+		resp, err := service.Authorize(context.Background(), &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("10")})
+
+		require.ErrorIs(t, err, hold.ErrNotDebit)
+		require.Nil(t, resp)
+	})
+
+	t.Run("insufficient limit", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		holdDAO := mocks.NewMockHoldDAO(t)
+
+		service := hold.NewService(operationTypeDAO, holdDAO, zap.NewNop())
+
+		req := &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("10")}
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil)
+		holdDAO.On("Authorize", mock.Anything, req).Return(nil, transaction.ErrInsufficientLimit)
+
+		resp, err := service.Authorize(context.Background(), req)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusUnprocessableEntity, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		holdDAO := mocks.NewMockHoldDAO(t)
+
+		service := hold.NewService(operationTypeDAO, holdDAO, zap.NewNop())
+
+		expiresAt := time.Now().Add(time.Hour)
+		req := &hold.AuthorizeRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("10")}
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil)
+		holdDAO.On("Authorize", mock.Anything, req).Return(&ent.Hold{
+			ID:              3,
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("10"),
+			Status:          enthold.StatusActive,
+			ExpiresAt:       expiresAt,
+		}, nil)
+
+		resp, err := service.Authorize(context.Background(), req)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, 3, resp.ID)
+		require.Equal(t, "active", resp.Status)
+		require.Equal(t, money.MustParse("10"), resp.Amount)
+		require.Equal(t, expiresAt, resp.ExpiresAt)
+	})
+}
+
+func TestServiceCapture(t *testing.T) {
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockHoldDAO(t), zap.NewNop())
+
+		zero := money.Amount(0)
+		for _, req := range []*hold.CaptureRequest{
+			{},
+			{ID: 3, Amount: &zero},
+		} {
+			resp, err := service.Capture(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("not active", func(t *testing.T) {
+		t.Parallel()
+		holdDAO := mocks.NewMockHoldDAO(t)
+
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), holdDAO, zap.NewNop())
+
+		req := &hold.CaptureRequest{ID: 3}
+		holdDAO.On("Capture", mock.Anything, req).Return(nil, hold.ErrNotActive)
+
+		resp, err := service.Capture(context.Background(), req)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusConflict, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		holdDAO := mocks.NewMockHoldDAO(t)
+
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), holdDAO, zap.NewNop())
+
+		amount := money.MustParse("8")
+		transactionID := 7
+		req := &hold.CaptureRequest{ID: 3, Amount: &amount}
+		holdDAO.On("Capture", mock.Anything, req).Return(&ent.Hold{
+			ID:             3,
+			Amount:         money.MustParse("10"),
+			Status:         enthold.StatusCaptured,
+			CapturedAmount: &amount,
+			TransactionID:  &transactionID,
+		}, nil)
+
+		resp, err := service.Capture(context.Background(), req)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, "captured", resp.Status)
+		require.Equal(t, amount, *resp.CapturedAmount)
+		require.Equal(t, 7, *resp.TransactionID)
+	})
+}
+
+func TestServiceRelease(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockHoldDAO(t), zap.NewNop())
+
+		resp, err := service.Release(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("db not found error", func(t *testing.T) {
+		t.Parallel()
+		holdDAO := mocks.NewMockHoldDAO(t)
+
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), holdDAO, zap.NewNop())
+
+		holdDAO.On("Release", mock.Anything, 999).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Release(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		holdDAO := mocks.NewMockHoldDAO(t)
+
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), holdDAO, zap.NewNop())
+
+		holdDAO.On("Release", mock.Anything, 3).Return(&ent.Hold{ID: 3, Status: enthold.StatusReleased}, nil)
+
+		resp, err := service.Release(context.Background(), 3)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, "released", resp.Status)
+	})
+}
+
+func TestServiceGet(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockHoldDAO(t), zap.NewNop())
+
+		resp, err := service.Get(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		holdDAO := mocks.NewMockHoldDAO(t)
+
+		service := hold.NewService(mocks.NewMockOperationTypeDAO(t), holdDAO, zap.NewNop())
+
+		holdDAO.On("Get", mock.Anything, 3).Return(&ent.Hold{ID: 3, AccountID: 1, Status: enthold.StatusExpired}, nil)
+
+		resp, err := service.Get(context.Background(), 3)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, 3, resp.ID)
+		require.Equal(t, "expired", resp.Status)
+	})
+}
@@ -0,0 +1,37 @@
+package hold
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/job"
+
+	"go.uber.org/zap"
+)
+
+// Sweeper periodically marks the holds which were not captured or released before their ttl as expired
+// expired holds stop reserving their amount as soon as they expire, this only closes them
+type Sweeper struct {
+	dao      DAO
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+// NewSweeper returns a new Sweeper which runs every interval
+func NewSweeper(dao DAO, interval time.Duration, logger *zap.Logger) *Sweeper {
+	return &Sweeper{
+		dao:      dao,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run expires holds every interval until the context is cancelled
+func (s *Sweeper) Run(ctx context.Context) error {
+	return job.Run(ctx, s.interval, s.logger, func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+		expired, err := s.dao.Expire(ctx, now)
+		if err != nil || expired == 0 {
+			return nil, err
+		}
+		return []zap.Field{zap.Int("expired_holds", expired)}, nil
+	})
+}
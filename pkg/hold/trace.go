@@ -0,0 +1,133 @@
+package hold
+
+import (
+	"context"
+	"transactor-server/pkg/config"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// tracedService is a middleware/wrapper to the hold.Service
+// it like name suggests adds a span to the each of the methods
+// on error it ensures that span is marked with error
+// it also logs request and response and error if any
+type tracedService struct {
+	service Service
+	logger  *zap.Logger
+}
+
+func NewTracedService(service Service, logger *zap.Logger) Service {
+	return &tracedService{
+		service: service,
+		logger:  logger,
+	}
+}
+
+var _ Service = (*tracedService)(nil)
+
+func (t *tracedService) Authorize(ctx context.Context, req *AuthorizeRequest) (resp *Hold, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "HoldService.Authorize")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling HoldService.Authorize", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	resp, err = t.service.Authorize(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end HoldService.Authorize with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end HoldService.Authorize", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) Capture(ctx context.Context, req *CaptureRequest) (resp *Hold, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "HoldService.Capture")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling HoldService.Capture", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	resp, err = t.service.Capture(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end HoldService.Capture with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end HoldService.Capture", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) Release(ctx context.Context, id int) (resp *Hold, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "HoldService.Release")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling HoldService.Release", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	resp, err = t.service.Release(ctx, id)
+
+	if err != nil {
+		t.logger.Error("end HoldService.Release with error", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+	} else {
+		t.logger.Info("end HoldService.Release", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) Get(ctx context.Context, id int) (resp *Hold, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "HoldService.Get")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling HoldService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	resp, err = t.service.Get(ctx, id)
+
+	if err != nil {
+		t.logger.Error("end HoldService.Get with error", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+	} else {
+		t.logger.Info("end HoldService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+	}
+
+	return
+}
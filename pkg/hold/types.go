@@ -0,0 +1,60 @@
+package hold
+
+import (
+	"time"
+	"transactor-server/pkg/money"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// AuthorizeRequest reserves amount of the credit limit of an account till it is captured, released or expires
+type AuthorizeRequest struct {
+	AccountID int `json:"account_id"`
+	// OperationTypeID is the debit operation type of the transaction booked on capture
+	OperationTypeID int `json:"operation_type_id"`
+	// Amount can be sent as a JSON number or string with at most 2 decimal places
+	Amount money.Amount `json:"amount" swaggertype:"number" example:"98.75"`
+}
+
+// Validate validates the AuthorizeRequest to
+// have +ve account id & operation type id
+// and have a +ve amount
+func (req AuthorizeRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Min(1)),
+		validation.Field(&req.OperationTypeID, validation.Min(1)),
+		validation.Field(&req.Amount, validation.Required, validation.Min(money.Amount(0))),
+	)
+}
+
+// CaptureRequest books the debit of a hold, the whole hold is captured when no amount is sent
+type CaptureRequest struct {
+	ID     int           `json:"-"`
+	Amount *money.Amount `json:"amount,omitempty" swaggertype:"number" example:"90"`
+}
+
+// Validate validates the CaptureRequest to
+// have +ve id
+// and have an optional +ve amount
+func (req CaptureRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.ID, validation.Required, validation.Min(1)),
+		validation.Field(&req.Amount, validation.NilOrNotEmpty, validation.Min(money.Amount(0))),
+	)
+}
+
+// Hold is an amount reserved on an account which is not a posted transaction yet
+type Hold struct {
+	ID              int          `json:"id"`
+	AccountID       int          `json:"account_id"`
+	OperationTypeID int          `json:"operation_type_id"`
+	Amount          money.Amount `json:"amount" swaggertype:"number" example:"98.75"`
+	// Status is active, captured, released or expired
+	Status    string    `json:"status" example:"active"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// CapturedAmount & TransactionID are only set on a captured hold, TransactionID is the debit booked on capture
+	CapturedAmount *money.Amount `json:"captured_amount,omitempty" swaggertype:"number" example:"90"`
+	TransactionID  *int          `json:"transaction_id,omitempty"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
@@ -0,0 +1,98 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/idempotencykey"
+)
+
+// DAO defines the data access object interface for idempotency_key model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockIdempotencyDAO --filename idempotency_dao.go
+type DAO interface {
+	// Get tries to find an existing idempotency key record in DB by scope and key
+	Get(ctx context.Context, scope string, key string) (*ent.IdempotencyKey, error)
+	// Create claims a new idempotency key in a scope till lockedUntil, it fails with a constraint error if the key is already claimed
+	Create(ctx context.Context, scope string, key string, requestHash string, lockedUntil time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error)
+	// Reclaim takes over the claim of a key which has no response and whose claim passed before now till lockedUntil
+	// it returns false when the key was completed or taken over by another request meanwhile
+	Reclaim(ctx context.Context, id int, now time.Time, lockedUntil time.Time) (bool, error)
+	// Complete stores the response of the request which claimed the key and ends its claim
+	Complete(ctx context.Context, id int, status int, body []byte) error
+	// Delete releases a claimed key so the request can be retried
+	Delete(ctx context.Context, id int) error
+	// DeleteExpired removes all the keys which have expired before now and returns how many were removed
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+type dao struct {
+	entClient *ent.Client
+}
+
+var _ DAO = (*dao)(nil)
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client) DAO {
+	return &dao{
+		entClient: entClient,
+	}
+}
+
+func (d *dao) Get(ctx context.Context, scope string, key string) (*ent.IdempotencyKey, error) {
+	return d.entClient.IdempotencyKey.
+		Query().
+		Where(
+			idempotencykey.Scope(scope),
+			idempotencykey.Key(key),
+		).
+		Only(ctx)
+}
+
+func (d *dao) Create(ctx context.Context, scope string, key string, requestHash string, lockedUntil time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error) {
+	return d.entClient.IdempotencyKey.
+		Create().
+		SetScope(scope).
+		SetKey(key).
+		SetRequestHash(requestHash).
+		SetLockedUntil(lockedUntil).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+func (d *dao) Reclaim(ctx context.Context, id int, now time.Time, lockedUntil time.Time) (bool, error) {
+	// the claim is checked in the update itself so that only one of the concurrent retries takes it over
+	n, err := d.entClient.IdempotencyKey.
+		Update().
+		Where(
+			idempotencykey.ID(id),
+			idempotencykey.ResponseStatusIsNil(),
+			idempotencykey.Or(
+				idempotencykey.LockedUntilIsNil(),
+				idempotencykey.LockedUntilLTE(now),
+			),
+		).
+		SetLockedUntil(lockedUntil).
+		Save(ctx)
+	return n == 1, err
+}
+
+func (d *dao) Complete(ctx context.Context, id int, status int, body []byte) error {
+	return d.entClient.IdempotencyKey.
+		UpdateOneID(id).
+		SetResponseStatus(status).
+		SetResponseBody(body).
+		ClearLockedUntil().
+		Exec(ctx)
+}
+
+func (d *dao) Delete(ctx context.Context, id int) error {
+	return d.entClient.IdempotencyKey.DeleteOneID(id).Exec(ctx)
+}
+
+func (d *dao) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	return d.entClient.IdempotencyKey.
+		Delete().
+		Where(idempotencykey.ExpiresAtLTE(now)).
+		Exec(ctx)
+}
@@ -0,0 +1,147 @@
+package idempotency_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/idempotency"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAOCreate(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	dao := idempotency.NewDAO(client)
+	expiresAt := time.Now().Add(time.Hour)
+
+	resp, err := dao.Create(ctx, "scope", "key-1", "hash", expiresAt, expiresAt)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, "scope", resp.Scope)
+	require.Equal(t, "key-1", resp.Key)
+	require.Equal(t, "hash", resp.RequestHash)
+	require.Nil(t, resp.ResponseStatus)
+
+	// the same key can not be claimed twice in a scope
+	_, err = dao.Create(ctx, "scope", "key-1", "other hash", expiresAt, expiresAt)
+	require.True(t, ent.IsConstraintError(err))
+
+	// but it can in another one
+	_, err = dao.Create(ctx, "other scope", "key-1", "other hash", expiresAt, expiresAt)
+	require.NoError(t, err)
+}
+
+func TestDAOComplete(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	dao := idempotency.NewDAO(client)
+
+	record, err := dao.Create(ctx, "scope", "key-1", "hash", time.Now().Add(time.Minute), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dao.Complete(ctx, record.ID, 201, []byte(`{"id":1}`))
+	require.NoError(t, err)
+
+	resp, err := dao.Get(ctx, "scope", "key-1")
+	require.NoError(t, err)
+	require.NotNil(t, resp.ResponseStatus)
+	require.Equal(t, 201, *resp.ResponseStatus)
+	require.Equal(t, `{"id":1}`, string(resp.ResponseBody))
+	require.Nil(t, resp.LockedUntil)
+}
+
+func TestDAOReclaim(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	dao := idempotency.NewDAO(client)
+	now := time.Now()
+
+	record, err := dao.Create(ctx, "scope", "key-1", "hash", now.Add(time.Minute), now.Add(time.Hour))
+	require.NoError(t, err)
+
+	// the claim has not passed yet
+	reclaimed, err := dao.Reclaim(ctx, record.ID, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.False(t, reclaimed)
+
+	// once it has, only the first retry takes it over
+	later := now.Add(2 * time.Minute)
+	reclaimed, err = dao.Reclaim(ctx, record.ID, later, later.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, reclaimed)
+
+	reclaimed, err = dao.Reclaim(ctx, record.ID, later, later.Add(time.Minute))
+	require.NoError(t, err)
+	require.False(t, reclaimed)
+
+	// a completed key is never taken over
+	require.NoError(t, dao.Complete(ctx, record.ID, 201, []byte(`{"id":1}`)))
+	reclaimed, err = dao.Reclaim(ctx, record.ID, later.Add(time.Hour), later.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.False(t, reclaimed)
+}
+
+func TestDAODelete(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	dao := idempotency.NewDAO(client)
+
+	record, err := dao.Create(ctx, "scope", "key-1", "hash", time.Now().Add(time.Minute), time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dao.Delete(ctx, record.ID)
+	require.NoError(t, err)
+
+	_, err = dao.Get(ctx, "scope", "key-1")
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestDAODeleteExpired(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	dao := idempotency.NewDAO(client)
+	now := time.Now()
+
+	_, err := dao.Create(ctx, "scope", "expired", "hash", now.Add(-time.Minute), now.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dao.Create(ctx, "scope", "alive", "hash", now.Add(time.Minute), now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := dao.DeleteExpired(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	_, err = dao.Get(ctx, "scope", "expired")
+	require.True(t, ent.IsNotFound(err))
+
+	_, err = dao.Get(ctx, "scope", "alive")
+	require.NoError(t, err)
+}
@@ -0,0 +1,212 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/pkgerr"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+const (
+	// HeaderKey is the request header which carries the idempotency key
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on a response which is a replay of a stored response
+	HeaderReplayed = "Idempotent-Replayed"
+	// maxKeyLength is the longest idempotency key we accept
+	maxKeyLength = 255
+	// DefaultLockTimeout is how long a request holds its claim of a key by default
+	DefaultLockTimeout = time.Minute
+)
+
+var (
+	// ErrInvalidKey indicates the idempotency key header is too long
+	ErrInvalidKey = pkgerr.NewServiceError(
+		"idempotency", "invalid_key",
+		http.StatusBadRequest,
+		"idempotency key must be at most 255 characters",
+	)
+	// ErrKeyReused indicates the idempotency key was already used for a different request
+	ErrKeyReused = pkgerr.NewServiceError(
+		"idempotency", "key_reused",
+		http.StatusConflict,
+		"idempotency key was already used with a different request",
+	)
+	// ErrRequestInProgress indicates the request which claimed the idempotency key has not finished yet
+	ErrRequestInProgress = pkgerr.NewServiceError(
+		"idempotency", "request_in_progress",
+		http.StatusConflict,
+		"a request with the same idempotency key is still in progress",
+	)
+)
+
+// middleware makes unsafe requests carrying an Idempotency-Key header safe to retry
+// the first request claims the key, runs and stores its response
+// a retry with the same key and request gets the stored response back without running again
+type middleware struct {
+	dao         DAO
+	ttl         time.Duration
+	lockTimeout time.Duration
+	logger      *zap.Logger
+}
+
+// Option configures the middleware returned by New
+type Option func(*middleware)
+
+// WithLockTimeout sets how long a request holds its claim of a key, it defaults to DefaultLockTimeout
+// a retry takes the claim over once it passed, so it has to be longer than the slowest request
+func WithLockTimeout(lockTimeout time.Duration) Option {
+	return func(m *middleware) {
+		if lockTimeout > 0 {
+			m.lockTimeout = lockTimeout
+		}
+	}
+}
+
+// New returns a fiber middleware which stores the response of a request against its Idempotency-Key
+// stored keys expire after ttl, after which the same key can be used again
+// only successful responses are stored, on failure the key is released so the client can retry
+func New(dao DAO, ttl time.Duration, logger *zap.Logger, opts ...Option) fiber.Handler {
+	m := &middleware{
+		dao:         dao,
+		ttl:         ttl,
+		lockTimeout: DefaultLockTimeout,
+		logger:      logger,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m.handle
+}
+
+func (m *middleware) handle(c *fiber.Ctx) error {
+	// safe methods are idempotent by definition
+	switch c.Method() {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return c.Next()
+	}
+
+	key := c.Get(HeaderKey)
+	if key == "" {
+		return c.Next()
+	}
+	if len(key) > maxKeyLength {
+		return ErrInvalidKey
+	}
+
+	ctx := c.UserContext()
+	scope := requestScope(c)
+	hash := requestHash(c)
+	now := time.Now()
+
+	record, err := m.dao.Get(ctx, scope, key)
+	if err != nil && !ent.IsNotFound(err) {
+		return pkgerr.WrapDAOError(err)
+	}
+
+	// an expired key is as good as a new one, a concurrent request may have deleted it already
+	if record != nil && !record.ExpiresAt.After(now) {
+		err = m.dao.Delete(ctx, record.ID)
+		if err != nil && !ent.IsNotFound(err) {
+			return pkgerr.WrapDAOError(err)
+		}
+		record = nil
+	}
+
+	if record != nil {
+		// a different request is rejected whether the one which claimed the key finished or not
+		if record.RequestHash != hash {
+			return ErrKeyReused
+		}
+		if record.ResponseStatus != nil {
+			return m.replay(c, record)
+		}
+
+		err = m.reclaim(ctx, record, now)
+		if err != nil {
+			return err
+		}
+	} else {
+		// claim the key, if someone else claimed it in the meantime they are still running
+		record, err = m.dao.Create(ctx, scope, key, hash, now.Add(m.lockTimeout), now.Add(m.ttl))
+		if ent.IsConstraintError(err) {
+			return ErrRequestInProgress
+		} else if err != nil {
+			return pkgerr.WrapDAOError(err)
+		}
+	}
+
+	err = c.Next()
+
+	status := c.Response().StatusCode()
+	if err != nil || status >= http.StatusMultipleChoices {
+		// release the key so the client can retry the failed request
+		if deleteErr := m.dao.Delete(ctx, record.ID); deleteErr != nil {
+			m.logger.Error("failed to release idempotency key", zapotlp.SpanCtx(ctx), zap.String("key", key), zap.Error(deleteErr))
+		}
+		return err
+	}
+
+	// the response has been sent so an error here only means retries will see the request as in progress until its claim passes
+	body := bytes.Clone(c.Response().Body())
+	if err := m.dao.Complete(ctx, record.ID, status, body); err != nil {
+		m.logger.Error("failed to store idempotent response", zapotlp.SpanCtx(ctx), zap.String("key", key), zap.Error(err))
+	}
+
+	return nil
+}
+
+// reclaim takes over the claim of a key without a response once it passed, as the request which claimed it
+// is gone without storing its response or releasing the key, say the server was stopped while it ran
+func (m *middleware) reclaim(ctx context.Context, record *ent.IdempotencyKey, now time.Time) error {
+	if record.LockedUntil != nil && record.LockedUntil.After(now) {
+		return ErrRequestInProgress
+	}
+
+	reclaimed, err := m.dao.Reclaim(ctx, record.ID, now, now.Add(m.lockTimeout))
+	if err != nil {
+		return pkgerr.WrapDAOError(err)
+	}
+	// another retry took it over first or the request finished after all
+	if !reclaimed {
+		return ErrRequestInProgress
+	}
+	return nil
+}
+
+// replay sends back the stored response of the request which claimed the key
+func (m *middleware) replay(c *fiber.Ctx, record *ent.IdempotencyKey) error {
+	c.Set(HeaderReplayed, "true")
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Status(*record.ResponseStatus).Send(record.ResponseBody)
+}
+
+// requestScope identifies who sent a request and to which route, so that keys of different callers
+// or routes never collide, the api key is hashed so it is not stored in DB
+func requestScope(c *fiber.Ctx) string {
+	h := sha256.New()
+	h.Write([]byte(c.Get(fiber.HeaderAuthorization)))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(c.Method()))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(c.Path()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// requestHash identifies a request by its method, url and body
+func requestHash(c *fiber.Ctx) string {
+	h := sha256.New()
+	h.Write([]byte(c.Method()))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(c.OriginalURL()))
+	h.Write([]byte{'\n'})
+	h.Write(c.Body())
+	return hex.EncodeToString(h.Sum(nil))
+}
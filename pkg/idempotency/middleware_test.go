@@ -0,0 +1,257 @@
+package idempotency_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/idempotency"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+// setupApp mounts a handler which counts how many times it really ran
+// the handler fails when the body is "fail"
+var setupApp = func(t *testing.T, ttl time.Duration) (*fiber.App, *ent.Client, *atomic.Int64) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	calls := &atomic.Int64{}
+	router := app.Group("/test", idempotency.New(idempotency.NewDAO(client), ttl, zap.NewNop()))
+	handler := func(c *fiber.Ctx) error {
+		if string(c.Body()) == "fail" {
+			return pkgerr.NewServiceError("test", "failed", http.StatusBadRequest, "failed")
+		}
+		return c.Status(http.StatusCreated).JSON(fiber.Map{"call": calls.Add(1)})
+	}
+	router.Post("/", handler)
+	router.Post("/other", handler)
+	router.Get("/", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"call": calls.Add(1)})
+	})
+
+	return app, client, calls
+}
+
+func doRequest(t *testing.T, app *fiber.App, method string, key string, body string) (*http.Response, string) {
+	return doScopedRequest(t, app, method, "/test/", "api-key", key, body)
+}
+
+// doScopedRequest sends a request to path as the caller with apiKey
+func doScopedRequest(t *testing.T, app *fiber.App, method string, path string, apiKey string, key string, body string) (*http.Response, string) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(fiber.HeaderAuthorization, apiKey)
+	if key != "" {
+		req.Header.Set(idempotency.HeaderKey, key)
+	}
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp, string(b)
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("no key", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, time.Hour)
+
+		resp, _ := doRequest(t, app, http.MethodPost, "", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, _ = doRequest(t, app, http.MethodPost, "", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		require.Equal(t, int64(2), calls.Load())
+	})
+
+	t.Run("safe method", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, time.Hour)
+
+		doRequest(t, app, http.MethodGet, "key-1", "")
+		doRequest(t, app, http.MethodGet, "key-1", "")
+
+		require.Equal(t, int64(2), calls.Load())
+	})
+
+	t.Run("key too long", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, time.Hour)
+
+		resp, body := doRequest(t, app, http.MethodPost, strings.Repeat("k", 256), "body")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.Equal(t, "invalid_key", gjson.Get(body, "code").String())
+		require.Equal(t, int64(0), calls.Load())
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, time.Hour)
+
+		resp, body := doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get(idempotency.HeaderReplayed))
+		require.Equal(t, int64(1), gjson.Get(body, "call").Int())
+
+		resp, body = doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Equal(t, "true", resp.Header.Get(idempotency.HeaderReplayed))
+		require.Equal(t, int64(1), gjson.Get(body, "call").Int())
+
+		require.Equal(t, int64(1), calls.Load())
+	})
+
+	t.Run("different request", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, time.Hour)
+
+		resp, _ := doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, body := doRequest(t, app, http.MethodPost, "key-1", "other body")
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		require.Equal(t, "key_reused", gjson.Get(body, "code").String())
+
+		require.Equal(t, int64(1), calls.Load())
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		t.Parallel()
+		app, client, calls := setupApp(t, time.Hour)
+
+		doRequest(t, app, http.MethodPost, "key-1", "body")
+
+		// the request which claimed the key has not stored the response yet
+		client.IdempotencyKey.Update().ClearResponseStatus().SetLockedUntil(time.Now().Add(time.Minute)).ExecX(context.Background())
+
+		resp, body := doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		require.Equal(t, "request_in_progress", gjson.Get(body, "code").String())
+
+		// a different request is told the key is taken whether the first one finished or not
+		resp, body = doRequest(t, app, http.MethodPost, "key-1", "other body")
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		require.Equal(t, "key_reused", gjson.Get(body, "code").String())
+
+		require.Equal(t, int64(1), calls.Load())
+	})
+
+	t.Run("abandoned claim", func(t *testing.T) {
+		t.Parallel()
+		app, client, calls := setupApp(t, time.Hour)
+
+		doRequest(t, app, http.MethodPost, "key-1", "body")
+
+		// the request which claimed the key is gone without storing its response
+		client.IdempotencyKey.Update().ClearResponseStatus().SetLockedUntil(time.Now().Add(-time.Second)).ExecX(context.Background())
+
+		resp, body := doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get(idempotency.HeaderReplayed))
+		require.Equal(t, int64(2), gjson.Get(body, "call").Int())
+
+		// the retry which took it over stored its response
+		resp, body = doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, "true", resp.Header.Get(idempotency.HeaderReplayed))
+		require.Equal(t, int64(2), gjson.Get(body, "call").Int())
+
+		require.Equal(t, int64(2), calls.Load())
+	})
+
+	t.Run("failed request releases key", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, time.Hour)
+
+		resp, _ := doRequest(t, app, http.MethodPost, "key-1", "fail")
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, body := doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get(idempotency.HeaderReplayed))
+		require.Equal(t, int64(1), gjson.Get(body, "call").Int())
+
+		require.Equal(t, int64(1), calls.Load())
+	})
+
+	t.Run("expired key", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, -time.Second)
+
+		resp, _ := doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		resp, body := doRequest(t, app, http.MethodPost, "key-1", "other body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get(idempotency.HeaderReplayed))
+		require.Equal(t, int64(2), gjson.Get(body, "call").Int())
+
+		require.Equal(t, int64(2), calls.Load())
+	})
+
+	t.Run("expired key deleted concurrently", func(t *testing.T) {
+		t.Parallel()
+		dao := mocks.NewMockIdempotencyDAO(t)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: api.ErrorHandler,
+		})
+		app.Post("/test/", idempotency.New(dao, time.Hour, zap.NewNop()), func(c *fiber.Ctx) error {
+			return c.SendStatus(http.StatusCreated)
+		})
+
+		dao.On("Get", mock.Anything, mock.Anything, "key-1").Return(&ent.IdempotencyKey{ID: 1, ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+		// another request removed the expired key first
+		dao.On("Delete", mock.Anything, 1).Return(&ent.NotFoundError{})
+		dao.On("Create", mock.Anything, mock.Anything, "key-1", mock.Anything, mock.Anything, mock.Anything).Return(&ent.IdempotencyKey{ID: 2}, nil)
+		dao.On("Complete", mock.Anything, 2, http.StatusCreated, mock.Anything).Return(nil)
+
+		resp, _ := doRequest(t, app, http.MethodPost, "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("scoped by caller and route", func(t *testing.T) {
+		t.Parallel()
+		app, _, calls := setupApp(t, time.Hour)
+
+		resp, _ := doScopedRequest(t, app, http.MethodPost, "/test/", "api-key", "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		// another caller using the same key
+		resp, _ = doScopedRequest(t, app, http.MethodPost, "/test/", "other-api-key", "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get(idempotency.HeaderReplayed))
+
+		// the same key on another route
+		resp, _ = doScopedRequest(t, app, http.MethodPost, "/test/other", "api-key", "key-1", "body")
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get(idempotency.HeaderReplayed))
+
+		require.Equal(t, int64(3), calls.Load())
+	})
+}
@@ -0,0 +1,37 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/job"
+
+	"go.uber.org/zap"
+)
+
+// Sweeper periodically removes expired idempotency keys
+// expired keys are also replaced lazily when reused, this only keeps the table from growing forever
+type Sweeper struct {
+	dao      DAO
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+// NewSweeper returns a new Sweeper which runs every interval
+func NewSweeper(dao DAO, interval time.Duration, logger *zap.Logger) *Sweeper {
+	return &Sweeper{
+		dao:      dao,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run sweeps expired keys every interval until the context is cancelled
+func (s *Sweeper) Run(ctx context.Context) error {
+	return job.Run(ctx, s.interval, s.logger, func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+		deleted, err := s.dao.DeleteExpired(ctx, now)
+		if err != nil || deleted == 0 {
+			return nil, err
+		}
+		return []zap.Field{zap.Int("deleted_keys", deleted)}, nil
+	})
+}
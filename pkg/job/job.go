@@ -0,0 +1,42 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Func does one run of a periodic job at now and returns the log fields which tell what it did
+// a run which did nothing returns no fields
+type Func func(ctx context.Context, now time.Time) ([]zap.Field, error)
+
+// Run calls fn every interval until the context is cancelled
+// a run which failed is logged as an error along with its fields and the next run is tried as usual
+// jobs run often, so only the runs which did something are logged
+// it returns an error right away when interval is not +ve, as a ticker can not run on it
+func Run(ctx context.Context, interval time.Duration, logger *zap.Logger, fn Func) error {
+	if interval <= 0 {
+		return fmt.Errorf("job interval must be positive, got %s", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			fields, err := fn(ctx, time.Now())
+			if err != nil {
+				logger.Error("job run failed", append(fields, zap.Error(err))...)
+				continue
+			}
+			if len(fields) > 0 {
+				logger.Info("job run done", fields...)
+			}
+		}
+	}
+}
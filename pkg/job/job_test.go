@@ -0,0 +1,68 @@
+package job_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+	"transactor-server/pkg/job"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	runs := 0
+	go func() {
+		done <- job.Run(ctx, time.Millisecond, zap.New(core), func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+			runs++
+			switch runs {
+			case 1:
+				return []zap.Field{zap.Int("count", 3)}, nil
+			case 2:
+				return []zap.Field{zap.Int("count", 1)}, fmt.Errorf("some error")
+			case 3:
+				// nothing to do
+				return nil, nil
+			}
+			cancel()
+			return nil, nil
+		})
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("job did not stop")
+	}
+
+	require.Equal(t, 4, runs)
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+	require.Equal(t, zapcore.InfoLevel, entries[0].Level)
+	require.Equal(t, map[string]any{"count": int64(3)}, entries[0].ContextMap())
+	require.Equal(t, zapcore.ErrorLevel, entries[1].Level)
+	require.Equal(t, map[string]any{"count": int64(1), "error": "some error"}, entries[1].ContextMap())
+}
+
+func TestRunInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		err := job.Run(context.Background(), interval, zap.NewNop(), func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+			t.Fatal("job ran")
+			return nil, nil
+		})
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,56 @@
+package journal
+
+import (
+	"net/http"
+
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// API is the api handler for journal apis
+type API struct {
+	sevice Service
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+	}
+}
+
+// Handle sets up all the routes with their handler funcs for journal apis
+func (a *API) Handle(router fiber.Router) {
+	router.Get("/trial-balance", a.getTrialBalance)
+}
+
+// getTrialBalance returns the debits & credits of every ledger of the journal
+// @Summary      get the trial balance of the journal
+// @Description  the ledgers are summed per currency, debits equal credits in every currency when balanced is true
+// @Produce      json
+// @Tags		 journal
+// @Param        as_of  query    string  false  "only sum the transactions which happened at or before this RFC3339 timestamp, defaults to now"
+// @Success      200  {object}  TrialBalance
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/journal/trial-balance [get]
+func (a *API) getTrialBalance(c *fiber.Ctx) error {
+	req := &TrialBalanceRequest{}
+
+	// try to parse the query params
+	err := c.QueryParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("journal", "query_parse_failure", http.StatusBadRequest, err.Error())
+	}
+
+	// call the service to sum the journal
+	resp, err := a.sevice.TrialBalance(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
@@ -0,0 +1,82 @@
+package journal_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/journal"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockJournalService) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	service := mocks.NewMockJournalService(t)
+
+	api := journal.NewAPI(service)
+	api.Handle(app.Group("/test/journal"))
+
+	return app, service
+}
+
+func TestAPITrialBalance(t *testing.T) {
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/journal/trial-balance", nil)
+
+		service.On("TrialBalance", mock.Anything, &journal.TrialBalanceRequest{}).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/journal/trial-balance?as_of=2026-10-18T00:00:00Z", nil)
+
+		service.On("TrialBalance", mock.Anything, &journal.TrialBalanceRequest{AsOf: "2026-10-18T00:00:00Z"}).Return(&journal.TrialBalance{
+			Currencies: []*journal.CurrencyTrialBalance{{
+				Currency: "USD",
+				Ledgers: []*journal.LedgerBalance{
+					{Ledger: "cash", Debit: money.MustParse("60"), Balance: money.MustParse("60")},
+					{Ledger: "customer_receivable", Credit: money.MustParse("60"), Balance: money.MustParse("-60")},
+				},
+				TotalDebit:  money.MustParse("60"),
+				TotalCredit: money.MustParse("60"),
+				Balanced:    true,
+			}},
+			Balanced: true,
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.True(t, gjson.GetBytes(b, "balanced").Bool())
+		require.Equal(t, int64(2), gjson.GetBytes(b, "currencies.0.ledgers.#").Int())
+		require.Equal(t, -60.0, gjson.GetBytes(b, "currencies.0.ledgers.1.balance").Float())
+		require.Equal(t, 60.0, gjson.GetBytes(b, "currencies.0.total_credit").Float())
+	})
+}
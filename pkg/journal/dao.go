@@ -0,0 +1,62 @@
+package journal
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/journalentry"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// DAO defines the data access object interface for journal_entry model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockJournalDAO --filename journal_dao.go
+type DAO interface {
+	// TrialBalance returns the debits & credits of every ledger per currency for the entries at or before asOf
+	// ordered by currency & ledger
+	TrialBalance(ctx context.Context, asOf time.Time) ([]*LedgerTotal, error)
+}
+
+type dao struct {
+	entClient *ent.Client
+}
+
+var _ DAO = (*dao)(nil)
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client) DAO {
+	return &dao{
+		entClient: entClient,
+	}
+}
+
+func (d *dao) TrialBalance(ctx context.Context, asOf time.Time) ([]*LedgerTotal, error) {
+	totals := []*LedgerTotal{}
+
+	// a single statement sees a consistent snapshot, so the entries of a transaction are either all summed or none
+	err := d.entClient.JournalEntry.
+		Query().
+		Where(journalentry.TimestampLTE(asOf)).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(journalentry.FieldCurrency), s.C(journalentry.FieldLedger)).
+				AppendSelectExprAs(sumAmount(journalentry.SideDebit), "debit").
+				AppendSelectExprAs(sumAmount(journalentry.SideCredit), "credit").
+				GroupBy(s.C(journalentry.FieldCurrency), s.C(journalentry.FieldLedger)).
+				OrderBy(s.C(journalentry.FieldCurrency), s.C(journalentry.FieldLedger))
+		}).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// sumAmount sums the amount of the entries on side
+// postgres sums bigints as numeric so it is cast back to bigint
+func sumAmount(side journalentry.Side) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("CAST(COALESCE(SUM(CASE WHEN side = ").Arg(side.String()).WriteString(" THEN amount ELSE 0 END), 0) AS BIGINT)")
+	})
+}
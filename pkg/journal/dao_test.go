@@ -0,0 +1,93 @@
+package journal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/journal"
+	"transactor-server/pkg/money"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAndTrialBalance(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Debit Reversal").SetID(5).SetIsDebit(false).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Late Fee").SetID(10).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Unmapped").SetID(42).SetIsDebit(true).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").SetCurrency("EUR").ExecX(ctx)
+
+	yesterday := time.Now().Add(-24 * time.Hour)
+
+	post := func(accountID int, operationTypeID int, amount string, timestamp time.Time, reversalOfID *int) *ent.Transaction {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+
+		dbAccount := tx.Account.GetX(ctx, accountID)
+		txn := tx.Transaction.Create().
+			SetAccountID(accountID).
+			SetOperationTypeID(operationTypeID).
+			SetAmount(money.MustParse(amount)).
+			SetCurrency(dbAccount.Currency).
+			SetTimestamp(timestamp).
+			SetNillableReversalOfID(reversalOfID).
+			SaveX(ctx)
+
+		require.NoError(t, journal.Post(ctx, tx, txn))
+		require.NoError(t, tx.Commit())
+		return txn
+	}
+
+	purchase := post(1, 1, "-100", yesterday, nil)
+	post(1, 4, "60", time.Now(), nil)
+	post(1, 10, "-25", time.Now(), nil)
+	post(1, 42, "-5", time.Now(), nil)
+	post(2, 1, "-30", time.Now(), nil)
+	// a reversal posts against the ledger of what it reverses
+	post(1, 5, "100", time.Now(), &purchase.ID)
+
+	entries := client.JournalEntry.Query().Where(journalentry.TransactionID(purchase.ID)).Order(journalentry.ByID()).AllX(ctx)
+	require.Len(t, entries, 2)
+	require.Equal(t, journalentry.LedgerCustomerReceivable, entries[0].Ledger)
+	require.Equal(t, 1, *entries[0].AccountID)
+	require.Equal(t, journalentry.SideDebit, entries[0].Side)
+	require.Equal(t, money.MustParse("100"), entries[0].Amount)
+	require.Equal(t, journalentry.LedgerMerchantPayable, entries[1].Ledger)
+	require.Nil(t, entries[1].AccountID)
+	require.Equal(t, journalentry.SideCredit, entries[1].Side)
+	require.Equal(t, money.MustParse("100"), entries[1].Amount)
+
+	dao := journal.NewDAO(client)
+
+	totals, err := dao.TrialBalance(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, []*journal.LedgerTotal{
+		{Currency: "EUR", Ledger: "customer_receivable", Debit: money.MustParse("30")},
+		{Currency: "EUR", Ledger: "merchant_payable", Credit: money.MustParse("30")},
+		{Currency: "USD", Ledger: "cash", Debit: money.MustParse("60")},
+		{Currency: "USD", Ledger: "customer_receivable", Debit: money.MustParse("130"), Credit: money.MustParse("160")},
+		{Currency: "USD", Ledger: "fee_income", Credit: money.MustParse("25")},
+		{Currency: "USD", Ledger: "merchant_payable", Debit: money.MustParse("100"), Credit: money.MustParse("100")},
+		{Currency: "USD", Ledger: "suspense", Credit: money.MustParse("5")},
+	}, totals)
+
+	// only the purchase happened before an hour ago
+	totals, err = dao.TrialBalance(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []*journal.LedgerTotal{
+		{Currency: "USD", Ledger: "customer_receivable", Debit: money.MustParse("100")},
+		{Currency: "USD", Ledger: "merchant_payable", Credit: money.MustParse("100")},
+	}, totals)
+}
@@ -0,0 +1,56 @@
+package journal
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the journal.Service
+// it like name suggests adds metrics to each service call
+// on success it adds journal_service_<method>_success and on failure journal_service_<method>_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	trialBalanceCounterSuccess metric.Int64Counter
+	trialBalanceCounterFailure metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	trialBalanceCounterSuccess, err := meter.Int64Counter("journal_service_trial_balance_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	trialBalanceCounterFailure, err := meter.Int64Counter("journal_service_trial_balance_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:                    service,
+		meter:                      meter,
+		trialBalanceCounterSuccess: trialBalanceCounterSuccess,
+		trialBalanceCounterFailure: trialBalanceCounterFailure,
+	}
+}
+
+func (m *meteredSevice) TrialBalance(ctx context.Context, req *TrialBalanceRequest) (resp *TrialBalance, err error) {
+	defer func() {
+		if err == nil {
+			m.trialBalanceCounterSuccess.Add(ctx, 1)
+		} else {
+			m.trialBalanceCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.TrialBalance(ctx, req)
+	return
+}
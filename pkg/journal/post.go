@@ -0,0 +1,71 @@
+package journal
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/journalentry"
+)
+
+// counterLedgers is the system ledger each operation type posts against, the other side is always the
+// customer_receivable ledger of the account of the transaction
+// reversals post against the ledger of what they reverse, so they are not in here
+var counterLedgers = map[int]journalentry.Ledger{
+	1:  journalentry.LedgerMerchantPayable,  // Normal Purchase
+	2:  journalentry.LedgerMerchantPayable,  // Purchase with installments
+	3:  journalentry.LedgerCash,             // Withdrawal
+	4:  journalentry.LedgerCash,             // Credit Voucher
+	7:  journalentry.LedgerTransferClearing, // Transfer Out
+	8:  journalentry.LedgerTransferClearing, // Transfer In
+	9:  journalentry.LedgerInterestIncome,   // Interest
+	10: journalentry.LedgerFeeIncome,        // Late Fee
+}
+
+// counterLedger returns the system ledger an operation type posts against
+// operation types which are not mapped post to the suspense ledger so the journal still balances
+func counterLedger(operationTypeID int) journalentry.Ledger {
+	ledger, ok := counterLedgers[operationTypeID]
+	if !ok {
+		return journalentry.LedgerSuspense
+	}
+	return ledger
+}
+
+// Post records the balanced journal entries of a transaction inside tx, it must run in the DB transaction which books it
+// a debit of the account is a debit of its customer_receivable ledger and a credit of the system ledger of its operation type
+// and a credit of the account is the other way round
+// installments must not be posted as their purchase already posted the whole amount
+func Post(ctx context.Context, tx *ent.Tx, txn *ent.Transaction) error {
+	operationTypeID := txn.OperationTypeID
+	if txn.ReversalOfID != nil {
+		original, err := tx.Transaction.Get(ctx, *txn.ReversalOfID)
+		if err != nil {
+			return err
+		}
+		operationTypeID = original.OperationTypeID
+	}
+
+	customerSide, counterSide := journalentry.SideDebit, journalentry.SideCredit
+	if txn.Amount > 0 {
+		customerSide, counterSide = counterSide, customerSide
+	}
+
+	return tx.JournalEntry.CreateBulk(
+		tx.JournalEntry.
+			Create().
+			SetTransactionID(txn.ID).
+			SetLedger(journalentry.LedgerCustomerReceivable).
+			SetAccountID(txn.AccountID).
+			SetSide(customerSide).
+			SetAmount(txn.Amount.Abs()).
+			SetCurrency(txn.Currency).
+			SetTimestamp(txn.Timestamp),
+		tx.JournalEntry.
+			Create().
+			SetTransactionID(txn.ID).
+			SetLedger(counterLedger(operationTypeID)).
+			SetSide(counterSide).
+			SetAmount(txn.Amount.Abs()).
+			SetCurrency(txn.Currency).
+			SetTimestamp(txn.Timestamp),
+	).Exec(ctx)
+}
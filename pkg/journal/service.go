@@ -0,0 +1,90 @@
+package journal
+
+import (
+	"context"
+	"transactor-server/pkg/pkgerr"
+
+	"go.uber.org/zap"
+)
+
+// Service handles the main business logic for journal related things
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockJournalService  --filename journal_service.go
+type Service interface {
+	// TrialBalance sums the debits & credits of every ledger and tells whether they are equal
+	TrialBalance(context.Context, *TrialBalanceRequest) (*TrialBalance, error)
+}
+
+type service struct {
+	journalDAO DAO
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(
+	journalDAO DAO,
+
+	logger *zap.Logger,
+) Service {
+	return &service{
+		journalDAO: journalDAO,
+
+		logger: logger,
+	}
+}
+
+func (s *service) TrialBalance(ctx context.Context, req *TrialBalanceRequest) (*TrialBalance, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	asOf := req.asOf()
+
+	totals, err := s.journalDAO.TrialBalance(ctx, asOf)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	resp := &TrialBalance{
+		AsOf:       asOf,
+		Currencies: []*CurrencyTrialBalance{},
+		Balanced:   true,
+	}
+
+	// the totals are ordered by currency, so every currency is one run of them
+	var current *CurrencyTrialBalance
+	for _, total := range totals {
+		if current == nil || current.Currency != total.Currency {
+			current = &CurrencyTrialBalance{
+				Currency: total.Currency,
+				Ledgers:  []*LedgerBalance{},
+			}
+			resp.Currencies = append(resp.Currencies, current)
+		}
+
+		current.Ledgers = append(current.Ledgers, &LedgerBalance{
+			Ledger:  total.Ledger,
+			Debit:   total.Debit,
+			Credit:  total.Credit,
+			Balance: total.Debit - total.Credit,
+		})
+		current.TotalDebit += total.Debit
+		current.TotalCredit += total.Credit
+	}
+
+	for _, currency := range resp.Currencies {
+		currency.Balanced = currency.TotalDebit == currency.TotalCredit
+		if !currency.Balanced {
+			// entries are always posted in balanced pairs, so this means they were changed outside of the app
+			s.logger.Error("trial balance is not balanced", zap.String("currency", currency.Currency),
+				zap.Int64("debit", int64(currency.TotalDebit)), zap.Int64("credit", int64(currency.TotalCredit)))
+			resp.Balanced = false
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package journal_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"transactor-server/pkg/journal"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var totals = []*journal.LedgerTotal{
+	{Currency: "EUR", Ledger: "customer_receivable", Debit: money.MustParse("30")},
+	{Currency: "EUR", Ledger: "merchant_payable", Credit: money.MustParse("30")},
+	{Currency: "USD", Ledger: "cash", Debit: money.MustParse("60")},
+	{Currency: "USD", Ledger: "customer_receivable", Debit: money.MustParse("100"), Credit: money.MustParse("60")},
+	{Currency: "USD", Ledger: "merchant_payable", Credit: money.MustParse("100")},
+}
+
+func TestServiceTrialBalance(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := journal.NewService(mocks.NewMockJournalDAO(t), zap.NewNop())
+
+		resp, err := service.TrialBalance(context.Background(), &journal.TrialBalanceRequest{AsOf: "2026-10-18"})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		t.Parallel()
+		journalDAO := mocks.NewMockJournalDAO(t)
+		service := journal.NewService(journalDAO, zap.NewNop())
+
+		asOf := time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+		journalDAO.On("TrialBalance", mock.Anything, asOf).Return(totals, nil)
+
+		resp, err := service.TrialBalance(context.Background(), &journal.TrialBalanceRequest{AsOf: "2026-10-18T00:00:00Z"})
+
+		require.NoError(t, err)
+		require.True(t, resp.Balanced)
+		require.Len(t, resp.Currencies, 2)
+
+		require.Equal(t, "EUR", resp.Currencies[0].Currency)
+		require.Len(t, resp.Currencies[0].Ledgers, 2)
+		require.True(t, resp.Currencies[0].Balanced)
+
+		usd := resp.Currencies[1]
+		require.Equal(t, "USD", usd.Currency)
+		require.Len(t, usd.Ledgers, 3)
+		require.Equal(t, money.MustParse("160"), usd.TotalDebit)
+		require.Equal(t, money.MustParse("160"), usd.TotalCredit)
+		require.True(t, usd.Balanced)
+		require.Equal(t, money.MustParse("40"), usd.Ledgers[1].Balance)
+		require.Equal(t, money.MustParse("-100"), usd.Ledgers[2].Balance)
+	})
+
+	t.Run("not balanced", func(t *testing.T) {
+		t.Parallel()
+		journalDAO := mocks.NewMockJournalDAO(t)
+		service := journal.NewService(journalDAO, zap.NewNop())
+
+		journalDAO.On("TrialBalance", mock.Anything, mock.Anything).Return([]*journal.LedgerTotal{
+			{Currency: "USD", Ledger: "cash", Debit: money.MustParse("60")},
+		}, nil)
+
+		resp, err := service.TrialBalance(context.Background(), &journal.TrialBalanceRequest{})
+
+		require.NoError(t, err)
+		require.False(t, resp.Balanced)
+		require.False(t, resp.Currencies[0].Balanced)
+	})
+}
@@ -0,0 +1,55 @@
+package journal
+
+import (
+	"context"
+	"transactor-server/pkg/config"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// tracedService is a middleware/wrapper to the journal.Service
+// it like name suggests adds a span to the each of the methods
+// on error it ensures that span is marked with error
+// it also logs request and response and error if any
+type tracedService struct {
+	service Service
+	logger  *zap.Logger
+}
+
+func NewTracedService(service Service, logger *zap.Logger) Service {
+	return &tracedService{
+		service: service,
+		logger:  logger,
+	}
+}
+
+var _ Service = (*tracedService)(nil)
+
+func (t *tracedService) TrialBalance(ctx context.Context, req *TrialBalanceRequest) (resp *TrialBalance, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "JournalService.TrialBalance")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling JournalService.TrialBalance", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	resp, err = t.service.TrialBalance(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end JournalService.TrialBalance with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end JournalService.TrialBalance", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Int("currencies", len(resp.Currencies)), zap.Bool("balanced", resp.Balanced))
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package journal
+
+import (
+	"time"
+	"transactor-server/pkg/money"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// TrialBalanceRequest asks for the trial balance of the journal as of a point in time
+type TrialBalanceRequest struct {
+	// AsOf only sums the entries of transactions which happened at or before it, it defaults to now
+	AsOf string `query:"as_of"`
+}
+
+// Validate validates the TrialBalanceRequest to have as_of as an RFC3339 timestamp when it is sent
+func (req TrialBalanceRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AsOf, validation.Date(time.RFC3339)),
+	)
+}
+
+// asOf returns the as of time of a validated request
+func (req TrialBalanceRequest) asOf() time.Time {
+	if req.AsOf == "" {
+		return time.Now()
+	}
+	asOf, _ := time.Parse(time.RFC3339, req.AsOf)
+	return asOf
+}
+
+// LedgerTotal is what a ledger was debited & credited in a currency
+type LedgerTotal struct {
+	Currency string       `json:"currency"`
+	Ledger   string       `json:"ledger"`
+	Debit    money.Amount `json:"debit"`
+	Credit   money.Amount `json:"credit"`
+}
+
+// TrialBalance lists the debits & credits of every ledger, they are summed per currency as amounts in different currencies do not add up
+type TrialBalance struct {
+	AsOf       time.Time               `json:"as_of"`
+	Currencies []*CurrencyTrialBalance `json:"currencies"`
+	// Balanced is true when the debits equal the credits in every currency
+	Balanced bool `json:"balanced"`
+}
+
+type CurrencyTrialBalance struct {
+	Currency    string           `json:"currency" example:"USD"`
+	Ledgers     []*LedgerBalance `json:"ledgers"`
+	TotalDebit  money.Amount     `json:"total_debit" swaggertype:"number" example:"1250.5"`
+	TotalCredit money.Amount     `json:"total_credit" swaggertype:"number" example:"1250.5"`
+	Balanced    bool             `json:"balanced"`
+}
+
+type LedgerBalance struct {
+	// Ledger is customer_receivable, merchant_payable, cash, fee_income, interest_income, transfer_clearing or suspense
+	Ledger string       `json:"ledger" example:"merchant_payable"`
+	Debit  money.Amount `json:"debit" swaggertype:"number" example:"100"`
+	Credit money.Amount `json:"credit" swaggertype:"number" example:"1100.5"`
+	// Balance is Debit less Credit, so it is -ve for a ledger with a credit balance
+	Balance money.Amount `json:"balance" swaggertype:"number" example:"-1000.5"`
+}
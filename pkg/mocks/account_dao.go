@@ -16,6 +16,36 @@ type MockAccountDAO struct {
 	mock.Mock
 }
 
+// Balance provides a mock function with given fields: ctx, id
+func (_m *MockAccountDAO) Balance(ctx context.Context, id int) (*account.AccountBalance, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Balance")
+	}
+
+	var r0 *account.AccountBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*account.AccountBalance, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *account.AccountBalance); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.AccountBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, req
 func (_m *MockAccountDAO) Create(ctx context.Context, req *account.CreateRequest) (*ent.Account, error) {
 	ret := _m.Called(ctx, req)
@@ -76,6 +106,36 @@ func (_m *MockAccountDAO) Get(ctx context.Context, id int) (*ent.Account, error)
 	return r0, r1
 }
 
+// Update provides a mock function with given fields: ctx, req
+func (_m *MockAccountDAO) Update(ctx context.Context, req *account.UpdateRequest) (*ent.Account, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *ent.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *account.UpdateRequest) (*ent.Account, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *account.UpdateRequest) *ent.Account); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *account.UpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockAccountDAO creates a new instance of MockAccountDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAccountDAO(t interface {
@@ -14,6 +14,36 @@ type MockAccountService struct {
 	mock.Mock
 }
 
+// Balance provides a mock function with given fields: _a0, _a1
+func (_m *MockAccountService) Balance(_a0 context.Context, _a1 int) (*account.Balance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Balance")
+	}
+
+	var r0 *account.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*account.Balance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *account.Balance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.Balance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *MockAccountService) Create(_a0 context.Context, _a1 *account.CreateRequest) (*account.CreateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -74,6 +104,36 @@ func (_m *MockAccountService) Get(_a0 context.Context, _a1 int) (*account.Accoun
 	return r0, r1
 }
 
+// Update provides a mock function with given fields: _a0, _a1
+func (_m *MockAccountService) Update(_a0 context.Context, _a1 *account.UpdateRequest) (*account.Account, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *account.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *account.UpdateRequest) (*account.Account, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *account.UpdateRequest) *account.Account); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *account.UpdateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockAccountService creates a new instance of MockAccountService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAccountService(t interface {
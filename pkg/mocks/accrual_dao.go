@@ -0,0 +1,151 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAccrualDAO is an autogenerated mock type for the DAO type
+type MockAccrualDAO struct {
+	mock.Mock
+}
+
+// AccrueInterest provides a mock function with given fields: ctx, accountID, now
+func (_m *MockAccrualDAO) AccrueInterest(ctx context.Context, accountID int, now time.Time) ([]*ent.Accrual, error) {
+	ret := _m.Called(ctx, accountID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccrueInterest")
+	}
+
+	var r0 []*ent.Accrual
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) ([]*ent.Accrual, error)); ok {
+		return rf(ctx, accountID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) []*ent.Accrual); ok {
+		r0 = rf(ctx, accountID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Accrual)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, accountID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChargeLateFee provides a mock function with given fields: ctx, invoiceID, now
+func (_m *MockAccrualDAO) ChargeLateFee(ctx context.Context, invoiceID int, now time.Time) (*ent.Accrual, error) {
+	ret := _m.Called(ctx, invoiceID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChargeLateFee")
+	}
+
+	var r0 *ent.Accrual
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (*ent.Accrual, error)); ok {
+		return rf(ctx, invoiceID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) *ent.Accrual); ok {
+		r0 = rf(ctx, invoiceID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Accrual)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, invoiceID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InterestAccounts provides a mock function with given fields: ctx, now
+func (_m *MockAccrualDAO) InterestAccounts(ctx context.Context, now time.Time) ([]int, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InterestAccounts")
+	}
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OverdueInvoices provides a mock function with given fields: ctx, now
+func (_m *MockAccrualDAO) OverdueInvoices(ctx context.Context, now time.Time) ([]*ent.Invoice, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OverdueInvoices")
+	}
+
+	var r0 []*ent.Invoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*ent.Invoice, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*ent.Invoice); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Invoice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockAccrualDAO creates a new instance of MockAccrualDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAccrualDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAccrualDAO {
+	mock := &MockAccrualDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
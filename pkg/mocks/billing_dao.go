@@ -0,0 +1,183 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	billing "transactor-server/pkg/billing"
+
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockBillingDAO is an autogenerated mock type for the DAO type
+type MockBillingDAO struct {
+	mock.Mock
+}
+
+// Account provides a mock function with given fields: ctx, id
+func (_m *MockBillingDAO) Account(ctx context.Context, id int) (*ent.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Account")
+	}
+
+	var r0 *ent.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Close provides a mock function with given fields: ctx, cycle
+func (_m *MockBillingDAO) Close(ctx context.Context, cycle *billing.Cycle) (*ent.Invoice, error) {
+	ret := _m.Called(ctx, cycle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 *ent.Invoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.Cycle) (*ent.Invoice, error)); ok {
+		return rf(ctx, cycle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *billing.Cycle) *ent.Invoice); ok {
+		r0 = rf(ctx, cycle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Invoice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *billing.Cycle) error); ok {
+		r1 = rf(ctx, cycle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DueCycles provides a mock function with given fields: ctx, now
+func (_m *MockBillingDAO) DueCycles(ctx context.Context, now time.Time) ([]*billing.Cycle, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DueCycles")
+	}
+
+	var r0 []*billing.Cycle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*billing.Cycle, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*billing.Cycle); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*billing.Cycle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockBillingDAO) Get(ctx context.Context, id int) (*ent.Invoice, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *ent.Invoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Invoice, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Invoice); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Invoice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, accountID
+func (_m *MockBillingDAO) List(ctx context.Context, accountID int) ([]*ent.Invoice, error) {
+	ret := _m.Called(ctx, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*ent.Invoice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*ent.Invoice, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*ent.Invoice); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Invoice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockBillingDAO creates a new instance of MockBillingDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBillingDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBillingDAO {
+	mock := &MockBillingDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
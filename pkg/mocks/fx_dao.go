@@ -0,0 +1,108 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+	fx "transactor-server/pkg/fx"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFxDAO is an autogenerated mock type for the DAO type
+type MockFxDAO struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, req
+func (_m *MockFxDAO) Delete(ctx context.Context, req *fx.DeleteRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *fx.DeleteRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *MockFxDAO) List(ctx context.Context) ([]*ent.FxRate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*ent.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ent.FxRate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ent.FxRate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.FxRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Set provides a mock function with given fields: ctx, req
+func (_m *MockFxDAO) Set(ctx context.Context, req *fx.SetRequest) (*ent.FxRate, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 *ent.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *fx.SetRequest) (*ent.FxRate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *fx.SetRequest) *ent.FxRate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.FxRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *fx.SetRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockFxDAO creates a new instance of MockFxDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFxDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFxDAO {
+	mock := &MockFxDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	fx "transactor-server/pkg/fx"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockFxService is an autogenerated mock type for the Service type
+type MockFxService struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *MockFxService) Delete(_a0 context.Context, _a1 *fx.DeleteRequest) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *fx.DeleteRequest) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: _a0
+func (_m *MockFxService) List(_a0 context.Context) (*fx.ListResponse, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *fx.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*fx.ListResponse, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *fx.ListResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fx.ListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Set provides a mock function with given fields: _a0, _a1
+func (_m *MockFxService) Set(_a0 context.Context, _a1 *fx.SetRequest) (*fx.Rate, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 *fx.Rate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *fx.SetRequest) (*fx.Rate, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *fx.SetRequest) *fx.Rate); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*fx.Rate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *fx.SetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockFxService creates a new instance of MockFxService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFxService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFxService {
+	mock := &MockFxService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,180 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+	hold "transactor-server/pkg/hold"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockHoldDAO is an autogenerated mock type for the DAO type
+type MockHoldDAO struct {
+	mock.Mock
+}
+
+// Authorize provides a mock function with given fields: ctx, req
+func (_m *MockHoldDAO) Authorize(ctx context.Context, req *hold.AuthorizeRequest) (*ent.Hold, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 *ent.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.AuthorizeRequest) (*ent.Hold, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.AuthorizeRequest) *ent.Hold); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hold.AuthorizeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Capture provides a mock function with given fields: ctx, req
+func (_m *MockHoldDAO) Capture(ctx context.Context, req *hold.CaptureRequest) (*ent.Hold, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capture")
+	}
+
+	var r0 *ent.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.CaptureRequest) (*ent.Hold, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.CaptureRequest) *ent.Hold); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hold.CaptureRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Expire provides a mock function with given fields: ctx, now
+func (_m *MockHoldDAO) Expire(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expire")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockHoldDAO) Get(ctx context.Context, id int) (*ent.Hold, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *ent.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Hold, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Hold); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, id
+func (_m *MockHoldDAO) Release(ctx context.Context, id int) (*ent.Hold, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 *ent.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Hold, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Hold); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockHoldDAO creates a new instance of MockHoldDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHoldDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHoldDAO {
+	mock := &MockHoldDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,149 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	hold "transactor-server/pkg/hold"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockHoldService is an autogenerated mock type for the Service type
+type MockHoldService struct {
+	mock.Mock
+}
+
+// Authorize provides a mock function with given fields: _a0, _a1
+func (_m *MockHoldService) Authorize(_a0 context.Context, _a1 *hold.AuthorizeRequest) (*hold.Hold, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 *hold.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.AuthorizeRequest) (*hold.Hold, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.AuthorizeRequest) *hold.Hold); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hold.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hold.AuthorizeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Capture provides a mock function with given fields: _a0, _a1
+func (_m *MockHoldService) Capture(_a0 context.Context, _a1 *hold.CaptureRequest) (*hold.Hold, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Capture")
+	}
+
+	var r0 *hold.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.CaptureRequest) (*hold.Hold, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hold.CaptureRequest) *hold.Hold); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hold.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hold.CaptureRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *MockHoldService) Get(_a0 context.Context, _a1 int) (*hold.Hold, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *hold.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*hold.Hold, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *hold.Hold); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hold.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: _a0, _a1
+func (_m *MockHoldService) Release(_a0 context.Context, _a1 int) (*hold.Hold, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 *hold.Hold
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*hold.Hold, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *hold.Hold); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hold.Hold)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockHoldService creates a new instance of MockHoldService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHoldService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHoldService {
+	mock := &MockHoldService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,183 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockIdempotencyDAO is an autogenerated mock type for the DAO type
+type MockIdempotencyDAO struct {
+	mock.Mock
+}
+
+// Complete provides a mock function with given fields: ctx, id, status, body
+func (_m *MockIdempotencyDAO) Complete(ctx context.Context, id int, status int, body []byte) error {
+	ret := _m.Called(ctx, id, status, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, []byte) error); ok {
+		r0 = rf(ctx, id, status, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, scope, key, requestHash, lockedUntil, expiresAt
+func (_m *MockIdempotencyDAO) Create(ctx context.Context, scope string, key string, requestHash string, lockedUntil time.Time, expiresAt time.Time) (*ent.IdempotencyKey, error) {
+	ret := _m.Called(ctx, scope, key, requestHash, lockedUntil, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *ent.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time, time.Time) (*ent.IdempotencyKey, error)); ok {
+		return rf(ctx, scope, key, requestHash, lockedUntil, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time, time.Time) *ent.IdempotencyKey); ok {
+		r0 = rf(ctx, scope, key, requestHash, lockedUntil, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, scope, key, requestHash, lockedUntil, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIdempotencyDAO) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteExpired provides a mock function with given fields: ctx, now
+func (_m *MockIdempotencyDAO) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, scope, key
+func (_m *MockIdempotencyDAO) Get(ctx context.Context, scope string, key string) (*ent.IdempotencyKey, error) {
+	ret := _m.Called(ctx, scope, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *ent.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*ent.IdempotencyKey, error)); ok {
+		return rf(ctx, scope, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *ent.IdempotencyKey); ok {
+		r0 = rf(ctx, scope, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, scope, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reclaim provides a mock function with given fields: ctx, id, now, lockedUntil
+func (_m *MockIdempotencyDAO) Reclaim(ctx context.Context, id int, now time.Time, lockedUntil time.Time) (bool, error) {
+	ret := _m.Called(ctx, id, now, lockedUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reclaim")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, id, now, lockedUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, id, now, lockedUntil)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, id, now, lockedUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockIdempotencyDAO creates a new instance of MockIdempotencyDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyDAO {
+	mock := &MockIdempotencyDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	journal "transactor-server/pkg/journal"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockJournalDAO is an autogenerated mock type for the DAO type
+type MockJournalDAO struct {
+	mock.Mock
+}
+
+// TrialBalance provides a mock function with given fields: ctx, asOf
+func (_m *MockJournalDAO) TrialBalance(ctx context.Context, asOf time.Time) ([]*journal.LedgerTotal, error) {
+	ret := _m.Called(ctx, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrialBalance")
+	}
+
+	var r0 []*journal.LedgerTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*journal.LedgerTotal, error)); ok {
+		return rf(ctx, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*journal.LedgerTotal); ok {
+		r0 = rf(ctx, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*journal.LedgerTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockJournalDAO creates a new instance of MockJournalDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJournalDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJournalDAO {
+	mock := &MockJournalDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	journal "transactor-server/pkg/journal"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockJournalService is an autogenerated mock type for the Service type
+type MockJournalService struct {
+	mock.Mock
+}
+
+// TrialBalance provides a mock function with given fields: _a0, _a1
+func (_m *MockJournalService) TrialBalance(_a0 context.Context, _a1 *journal.TrialBalanceRequest) (*journal.TrialBalance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrialBalance")
+	}
+
+	var r0 *journal.TrialBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *journal.TrialBalanceRequest) (*journal.TrialBalance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *journal.TrialBalanceRequest) *journal.TrialBalance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*journal.TrialBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *journal.TrialBalanceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockJournalService creates a new instance of MockJournalService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJournalService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJournalService {
+	mock := &MockJournalService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
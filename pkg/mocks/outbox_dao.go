@@ -0,0 +1,125 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockOutboxDAO is an autogenerated mock type for the DAO type
+type MockOutboxDAO struct {
+	mock.Mock
+}
+
+// DeletePublished provides a mock function with given fields: ctx, before
+func (_m *MockOutboxDAO) DeletePublished(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePublished")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkFailed provides a mock function with given fields: ctx, id, reason
+func (_m *MockOutboxDAO) MarkFailed(ctx context.Context, id int, reason string) error {
+	ret := _m.Called(ctx, id, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPublished provides a mock function with given fields: ctx, id, at
+func (_m *MockOutboxDAO) MarkPublished(ctx context.Context, id int, at time.Time) error {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPublished")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pending provides a mock function with given fields: ctx, limit
+func (_m *MockOutboxDAO) Pending(ctx context.Context, limit int) ([]*ent.OutboxEvent, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pending")
+	}
+
+	var r0 []*ent.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*ent.OutboxEvent, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*ent.OutboxEvent); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockOutboxDAO creates a new instance of MockOutboxDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxDAO {
+	mock := &MockOutboxDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
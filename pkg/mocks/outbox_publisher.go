@@ -0,0 +1,47 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	outbox "transactor-server/pkg/outbox"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOutboxPublisher is an autogenerated mock type for the Publisher type
+type MockOutboxPublisher struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: ctx, event
+func (_m *MockOutboxPublisher) Publish(ctx context.Context, event *outbox.Event) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *outbox.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMockOutboxPublisher creates a new instance of MockOutboxPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxPublisher {
+	mock := &MockOutboxPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	money "transactor-server/pkg/money"
+
+	time "time"
+)
+
+// MockStatementDAO is an autogenerated mock type for the DAO type
+type MockStatementDAO struct {
+	mock.Mock
+}
+
+// Account provides a mock function with given fields: ctx, id
+func (_m *MockStatementDAO) Account(ctx context.Context, id int) (*ent.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Account")
+	}
+
+	var r0 *ent.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OpeningBalance provides a mock function with given fields: ctx, accountID, before
+func (_m *MockStatementDAO) OpeningBalance(ctx context.Context, accountID int, before time.Time) (money.Amount, error) {
+	ret := _m.Called(ctx, accountID, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpeningBalance")
+	}
+
+	var r0 money.Amount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (money.Amount, error)); ok {
+		return rf(ctx, accountID, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) money.Amount); ok {
+		r0 = rf(ctx, accountID, before)
+	} else {
+		r0 = ret.Get(0).(money.Amount)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, accountID, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Payments provides a mock function with given fields: ctx, accountID, from, to
+func (_m *MockStatementDAO) Payments(ctx context.Context, accountID int, from time.Time, to time.Time) ([]*ent.Settlement, error) {
+	ret := _m.Called(ctx, accountID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Payments")
+	}
+
+	var r0 []*ent.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) ([]*ent.Settlement, error)); ok {
+		return rf(ctx, accountID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) []*ent.Settlement); ok {
+		r0 = rf(ctx, accountID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, accountID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Transactions provides a mock function with given fields: ctx, accountID, from, to
+func (_m *MockStatementDAO) Transactions(ctx context.Context, accountID int, from time.Time, to time.Time) ([]*ent.Transaction, error) {
+	ret := _m.Called(ctx, accountID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transactions")
+	}
+
+	var r0 []*ent.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) ([]*ent.Transaction, error)); ok {
+		return rf(ctx, accountID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) []*ent.Transaction); ok {
+		r0 = rf(ctx, accountID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, accountID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockStatementDAO creates a new instance of MockStatementDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatementDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatementDAO {
+	mock := &MockStatementDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	statement "transactor-server/pkg/statement"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockStatementService is an autogenerated mock type for the Service type
+type MockStatementService struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *MockStatementService) Get(_a0 context.Context, _a1 *statement.Request) (*statement.Statement, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *statement.Statement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statement.Request) (*statement.Statement, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statement.Request) *statement.Statement); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statement.Statement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statement.Request) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockStatementService creates a new instance of MockStatementService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatementService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatementService {
+	mock := &MockStatementService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
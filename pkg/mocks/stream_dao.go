@@ -0,0 +1,117 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockStreamDAO is an autogenerated mock type for the DAO type
+type MockStreamDAO struct {
+	mock.Mock
+}
+
+// Account provides a mock function with given fields: ctx, id
+func (_m *MockStreamDAO) Account(ctx context.Context, id int) (*ent.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Account")
+	}
+
+	var r0 *ent.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Account, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Events provides a mock function with given fields: ctx, accountID, afterID, limit
+func (_m *MockStreamDAO) Events(ctx context.Context, accountID int, afterID int, limit int) ([]*ent.OutboxEvent, error) {
+	ret := _m.Called(ctx, accountID, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Events")
+	}
+
+	var r0 []*ent.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) ([]*ent.OutboxEvent, error)); ok {
+		return rf(ctx, accountID, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, int) []*ent.OutboxEvent); ok {
+		r0 = rf(ctx, accountID, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, int) error); ok {
+		r1 = rf(ctx, accountID, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LastEventID provides a mock function with given fields: ctx, accountID
+func (_m *MockStreamDAO) LastEventID(ctx context.Context, accountID int) (int, error) {
+	ret := _m.Called(ctx, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastEventID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockStreamDAO creates a new instance of MockStreamDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreamDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreamDAO {
+	mock := &MockStreamDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	outbox "transactor-server/pkg/outbox"
+
+	mock "github.com/stretchr/testify/mock"
+
+	stream "transactor-server/pkg/stream"
+)
+
+// MockStreamService is an autogenerated mock type for the Service type
+type MockStreamService struct {
+	mock.Mock
+}
+
+// Subscribe provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamService) Subscribe(_a0 context.Context, _a1 *stream.SubscribeRequest) (<-chan *outbox.Event, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan *outbox.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.SubscribeRequest) (<-chan *outbox.Event, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.SubscribeRequest) <-chan *outbox.Event); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *outbox.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *stream.SubscribeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockStreamService creates a new instance of MockStreamService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreamService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreamService {
+	mock := &MockStreamService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -16,9 +16,9 @@ type MockTransactionDAO struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, req
-func (_m *MockTransactionDAO) Create(ctx context.Context, req *transaction.CreateRequest) (*ent.Transaction, error) {
-	ret := _m.Called(ctx, req)
+// Create provides a mock function with given fields: ctx, req, strategy
+func (_m *MockTransactionDAO) Create(ctx context.Context, req *transaction.CreateRequest, strategy transaction.AllocationStrategy) (*ent.Transaction, error) {
+	ret := _m.Called(ctx, req, strategy)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -26,19 +26,169 @@ func (_m *MockTransactionDAO) Create(ctx context.Context, req *transaction.Creat
 
 	var r0 *ent.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *transaction.CreateRequest) (*ent.Transaction, error)); ok {
-		return rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.CreateRequest, transaction.AllocationStrategy) (*ent.Transaction, error)); ok {
+		return rf(ctx, req, strategy)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *transaction.CreateRequest) *ent.Transaction); ok {
-		r0 = rf(ctx, req)
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.CreateRequest, transaction.AllocationStrategy) *ent.Transaction); ok {
+		r0 = rf(ctx, req, strategy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*ent.Transaction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *transaction.CreateRequest) error); ok {
-		r1 = rf(ctx, req)
+	if rf, ok := ret.Get(1).(func(context.Context, *transaction.CreateRequest, transaction.AllocationStrategy) error); ok {
+		r1 = rf(ctx, req, strategy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBulk provides a mock function with given fields: ctx, reqs, strategy
+func (_m *MockTransactionDAO) CreateBulk(ctx context.Context, reqs []*transaction.CreateRequest, strategy transaction.AllocationStrategy) ([]*ent.Transaction, error) {
+	ret := _m.Called(ctx, reqs, strategy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBulk")
+	}
+
+	var r0 []*ent.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*transaction.CreateRequest, transaction.AllocationStrategy) ([]*ent.Transaction, error)); ok {
+		return rf(ctx, reqs, strategy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*transaction.CreateRequest, transaction.AllocationStrategy) []*ent.Transaction); ok {
+		r0 = rf(ctx, reqs, strategy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*transaction.CreateRequest, transaction.AllocationStrategy) error); ok {
+		r1 = rf(ctx, reqs, strategy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockTransactionDAO) Get(ctx context.Context, id int) (*ent.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *ent.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *MockTransactionDAO) List(ctx context.Context, filter *transaction.ListFilter) ([]*ent.Transaction, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*ent.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.ListFilter) ([]*ent.Transaction, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.ListFilter) []*ent.Transaction); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transaction.ListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSettlements provides a mock function with given fields: ctx, transactionID
+func (_m *MockTransactionDAO) ListSettlements(ctx context.Context, transactionID int) ([]*ent.Settlement, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSettlements")
+	}
+
+	var r0 []*ent.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*ent.Settlement, error)); ok {
+		return rf(ctx, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*ent.Settlement); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reverse provides a mock function with given fields: ctx, id, strategy
+func (_m *MockTransactionDAO) Reverse(ctx context.Context, id int, strategy transaction.AllocationStrategy) (*ent.Transaction, error) {
+	ret := _m.Called(ctx, id, strategy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reverse")
+	}
+
+	var r0 *ent.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, transaction.AllocationStrategy) (*ent.Transaction, error)); ok {
+		return rf(ctx, id, strategy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, transaction.AllocationStrategy) *ent.Transaction); ok {
+		r0 = rf(ctx, id, strategy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, transaction.AllocationStrategy) error); ok {
+		r1 = rf(ctx, id, strategy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,6 +44,156 @@ func (_m *MockTransactionService) Create(ctx context.Context, req *transaction.C
 	return r0, r1
 }
 
+// CreateBulk provides a mock function with given fields: ctx, req
+func (_m *MockTransactionService) CreateBulk(ctx context.Context, req *transaction.BulkRequest) (*transaction.BulkResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBulk")
+	}
+
+	var r0 *transaction.BulkResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.BulkRequest) (*transaction.BulkResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.BulkRequest) *transaction.BulkResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transaction.BulkResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transaction.BulkRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockTransactionService) Get(ctx context.Context, id int) (*transaction.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *transaction.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*transaction.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *transaction.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transaction.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, req
+func (_m *MockTransactionService) List(ctx context.Context, req *transaction.ListRequest) (*transaction.ListResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *transaction.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.ListRequest) (*transaction.ListResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.ListRequest) *transaction.ListResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transaction.ListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transaction.ListRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSettlements provides a mock function with given fields: ctx, id
+func (_m *MockTransactionService) ListSettlements(ctx context.Context, id int) (*transaction.ListSettlementsResponse, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSettlements")
+	}
+
+	var r0 *transaction.ListSettlementsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*transaction.ListSettlementsResponse, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *transaction.ListSettlementsResponse); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transaction.ListSettlementsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reverse provides a mock function with given fields: ctx, id
+func (_m *MockTransactionService) Reverse(ctx context.Context, id int) (*transaction.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reverse")
+	}
+
+	var r0 *transaction.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*transaction.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *transaction.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transaction.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockTransactionService creates a new instance of MockTransactionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTransactionService(t interface {
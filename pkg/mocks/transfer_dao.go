@@ -0,0 +1,93 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	transaction "transactor-server/pkg/transaction"
+
+	transfer "transactor-server/pkg/transfer"
+)
+
+// MockTransferDAO is an autogenerated mock type for the DAO type
+type MockTransferDAO struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, req, strategy
+func (_m *MockTransferDAO) Create(ctx context.Context, req *transfer.CreateRequest, strategy transaction.AllocationStrategy) (*ent.Transfer, error) {
+	ret := _m.Called(ctx, req, strategy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *ent.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateRequest, transaction.AllocationStrategy) (*ent.Transfer, error)); ok {
+		return rf(ctx, req, strategy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateRequest, transaction.AllocationStrategy) *ent.Transfer); ok {
+		r0 = rf(ctx, req, strategy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.CreateRequest, transaction.AllocationStrategy) error); ok {
+		r1 = rf(ctx, req, strategy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockTransferDAO) Get(ctx context.Context, id int) (*ent.Transfer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *ent.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.Transfer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.Transfer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockTransferDAO creates a new instance of MockTransferDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransferDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransferDAO {
+	mock := &MockTransferDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
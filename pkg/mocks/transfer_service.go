@@ -0,0 +1,89 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	transfer "transactor-server/pkg/transfer"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTransferService is an autogenerated mock type for the Service type
+type MockTransferService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *MockTransferService) Create(_a0 context.Context, _a1 *transfer.CreateRequest) (*transfer.Transfer, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *transfer.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateRequest) (*transfer.Transfer, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *transfer.CreateRequest) *transfer.Transfer); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *transfer.CreateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *MockTransferService) Get(_a0 context.Context, _a1 int) (*transfer.Transfer, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *transfer.Transfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*transfer.Transfer, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *transfer.Transfer); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transfer.Transfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockTransferService creates a new instance of MockTransferService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTransferService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTransferService {
+	mock := &MockTransferService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
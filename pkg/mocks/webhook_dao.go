@@ -0,0 +1,297 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	ent "transactor-server/pkg/db/ent"
+
+	mock "github.com/stretchr/testify/mock"
+
+	outbox "transactor-server/pkg/outbox"
+
+	time "time"
+
+	webhook "transactor-server/pkg/webhook"
+)
+
+// MockWebhookDAO is an autogenerated mock type for the DAO type
+type MockWebhookDAO struct {
+	mock.Mock
+}
+
+// Claim provides a mock function with given fields: ctx, now, limit, until
+func (_m *MockWebhookDAO) Claim(ctx context.Context, now time.Time, limit int, until time.Time) ([]*ent.WebhookDelivery, error) {
+	ret := _m.Called(ctx, now, limit, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 []*ent.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, time.Time) ([]*ent.WebhookDelivery, error)); ok {
+		return rf(ctx, now, limit, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int, time.Time) []*ent.WebhookDelivery); ok {
+		r0 = rf(ctx, now, limit, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int, time.Time) error); ok {
+		r1 = rf(ctx, now, limit, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *MockWebhookDAO) Create(ctx context.Context, req *webhook.CreateRequest) (*ent.WebhookSubscription, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *ent.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.CreateRequest) (*ent.WebhookSubscription, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.CreateRequest) *ent.WebhookSubscription); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *webhook.CreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockWebhookDAO) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Enqueue provides a mock function with given fields: ctx, event, at
+func (_m *MockWebhookDAO) Enqueue(ctx context.Context, event *outbox.Event, at time.Time) (int, error) {
+	ret := _m.Called(ctx, event, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *outbox.Event, time.Time) (int, error)); ok {
+		return rf(ctx, event, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *outbox.Event, time.Time) int); ok {
+		r0 = rf(ctx, event, at)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *outbox.Event, time.Time) error); ok {
+		r1 = rf(ctx, event, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *MockWebhookDAO) Get(ctx context.Context, id int) (*ent.WebhookSubscription, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *ent.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*ent.WebhookSubscription, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *ent.WebhookSubscription); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *MockWebhookDAO) List(ctx context.Context) ([]*ent.WebhookSubscription, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*ent.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*ent.WebhookSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*ent.WebhookSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, req
+func (_m *MockWebhookDAO) ListDeliveries(ctx context.Context, req *webhook.ListDeliveriesRequest) ([]*ent.WebhookDelivery, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []*ent.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.ListDeliveriesRequest) ([]*ent.WebhookDelivery, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.ListDeliveriesRequest) []*ent.WebhookDelivery); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ent.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *webhook.ListDeliveriesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkDelivered provides a mock function with given fields: ctx, id, responseStatus, at
+func (_m *MockWebhookDAO) MarkDelivered(ctx context.Context, id int, responseStatus int, at time.Time) error {
+	ret := _m.Called(ctx, id, responseStatus, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDelivered")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, time.Time) error); ok {
+		r0 = rf(ctx, id, responseStatus, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkFailed provides a mock function with given fields: ctx, id, responseStatus, reason, retryAt
+func (_m *MockWebhookDAO) MarkFailed(ctx context.Context, id int, responseStatus *int, reason string, retryAt *time.Time) error {
+	ret := _m.Called(ctx, id, responseStatus, reason, retryAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, string, *time.Time) error); ok {
+		r0 = rf(ctx, id, responseStatus, reason, retryAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Redeliver provides a mock function with given fields: ctx, id, at
+func (_m *MockWebhookDAO) Redeliver(ctx context.Context, id int, at time.Time) (*ent.WebhookDelivery, error) {
+	ret := _m.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 *ent.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (*ent.WebhookDelivery, error)); ok {
+		return rf(ctx, id, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) *ent.WebhookDelivery); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ent.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockWebhookDAO creates a new instance of MockWebhookDAO. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookDAO(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookDAO {
+	mock := &MockWebhookDAO{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,197 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	webhook "transactor-server/pkg/webhook"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWebhookService is an autogenerated mock type for the Service type
+type MockWebhookService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookService) Create(_a0 context.Context, _a1 *webhook.CreateRequest) (*webhook.Subscription, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.CreateRequest) (*webhook.Subscription, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.CreateRequest) *webhook.Subscription); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *webhook.CreateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookService) Delete(_a0 context.Context, _a1 int) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookService) Get(_a0 context.Context, _a1 int) (*webhook.Subscription, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*webhook.Subscription, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *webhook.Subscription); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: _a0
+func (_m *MockWebhookService) List(_a0 context.Context) (*webhook.ListResponse, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *webhook.ListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*webhook.ListResponse, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *webhook.ListResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.ListResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeliveries provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookService) ListDeliveries(_a0 context.Context, _a1 *webhook.ListDeliveriesRequest) (*webhook.ListDeliveriesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 *webhook.ListDeliveriesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.ListDeliveriesRequest) (*webhook.ListDeliveriesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.ListDeliveriesRequest) *webhook.ListDeliveriesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.ListDeliveriesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *webhook.ListDeliveriesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Redeliver provides a mock function with given fields: _a0, _a1
+func (_m *MockWebhookService) Redeliver(_a0 context.Context, _a1 int) (*webhook.Delivery, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 *webhook.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*webhook.Delivery, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *webhook.Delivery); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockWebhookService creates a new instance of MockWebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookService {
+	mock := &MockWebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
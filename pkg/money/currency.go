@@ -0,0 +1,59 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// DefaultCurrency is the currency of the accounts which were opened without one
+const DefaultCurrency = "USD"
+
+// currencies are the active ISO 4217 currency codes which have 2 minor units like an Amount
+// currencies with 0 minor units like JPY or 3 like KWD and BHD would be stored with the wrong scale, so they are left out
+var currencies = func() map[string]struct{} {
+	codes := strings.Fields(`
+		AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BMD BND BOB BRL BSD BTN BWP BYN BZD
+		CAD CDF CHF CNY COP CRC CUP CVE CZK DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD
+		GTQ GYD HKD HNL HTG HUF IDR ILS INR IRR JMD KES KGS KHR KPW KYD KZT
+		LAK LBP LKR LRD LSL MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MYR MZN NAD NGN NIO NOK NPR
+		NZD PAB PEN PGK PHP PKR PLN QAR RON RSD RUB SAR SBD SCR SDG SEK SGD SHP SLE SOS SRD SSP
+		STN SVC SYP SZL THB TJS TMT TOP TRY TTD TWD TZS UAH USD UYU UZS VES WST XCD
+		YER ZAR ZMW ZWG`)
+	set := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		set[code] = struct{}{}
+	}
+	return set
+}()
+
+// IsCurrency tells if code is an active ISO 4217 currency code with 2 minor units, codes are upper case
+func IsCurrency(code string) bool {
+	_, ok := currencies[code]
+	return ok
+}
+
+// CurrencyRule validates that a string is an active ISO 4217 currency code with 2 minor units
+// an empty string is valid like with the ozzo is rules
+var CurrencyRule = validation.NewStringRuleWithError(IsCurrency, validation.NewError("validation_is_currency", "must be an ISO 4217 currency code with 2 decimal places"))
+
+// Convert multiplies the amount by an exchange rate and rounds the result half away from zero to the minor unit
+// every currency has Scale decimal places, so the amount is the same number of minor units before and after
+// it fails with ErrOverflow when the result does not fit in an Amount
+func (a Amount) Convert(rate *big.Rat) (Amount, error) {
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(int64(a)), rate)
+
+	// round half away from zero by adding half of the denominator to the absolute numerator before the division
+	num := new(big.Int).Abs(product.Num())
+	den := product.Denom()
+	num.Add(num, new(big.Int).Rsh(den, 1))
+	num.Quo(num, den)
+	if product.Sign() < 0 {
+		num.Neg(num)
+	}
+	if !num.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return Amount(num.Int64()), nil
+}
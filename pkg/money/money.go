@@ -0,0 +1,168 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of decimal places an Amount keeps
+const Scale = 2
+
+// unit is the number of minor units in one major unit i.e. cents in a dollar
+const unit = 100
+
+var (
+	// ErrInvalidAmount indicates the amount is not a decimal number
+	ErrInvalidAmount = errors.New("amount must be a decimal number")
+	// ErrPrecision indicates the amount has more decimal places than Scale
+	ErrPrecision = errors.New("amount must have at most 2 decimal places")
+	// ErrOverflow indicates the amount does not fit in an Amount
+	ErrOverflow = errors.New("amount is too large")
+)
+
+// Amount is an exact amount of money stored in minor units i.e. 12.34 is stored as 1234
+// all arithmetic on an Amount is integer arithmetic so it never drifts like a float64 does
+//
+// in JSON an Amount is written as a decimal number and can be read from a decimal number or string
+type Amount int64
+
+// Parse parses a decimal string like "-12.34" into an Amount
+// it fails if the string has more than 2 decimal places
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+
+	negative := false
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return 0, ErrInvalidAmount
+	}
+	if hasFraction && fraction == "" {
+		return 0, ErrInvalidAmount
+	}
+	if !isDigits(whole) || !isDigits(fraction) {
+		return 0, ErrInvalidAmount
+	}
+
+	// trailing zeros do not add any precision
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > Scale {
+		return 0, ErrPrecision
+	}
+	fraction += strings.Repeat("0", Scale-len(fraction))
+
+	if whole == "" {
+		whole = "0"
+	}
+	cents, _ := strconv.ParseInt(fraction, 10, 64)
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > (math.MaxInt64-cents)/unit {
+		return 0, ErrOverflow
+	}
+
+	amount := Amount(units*unit + cents)
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// MustParse is like Parse but panics if the string can not be parsed
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String returns the amount as a decimal string like "-12.34"
+func (a Amount) String() string {
+	sign := ""
+	// work on uint64 so that math.MinInt64 can be negated
+	v := uint64(a)
+	if a < 0 {
+		sign = "-"
+		v = uint64(-a)
+	}
+	return sign + strconv.FormatUint(v/unit, 10) + "." + leftPad(strconv.FormatUint(v%unit, 10))
+}
+
+// Abs returns the absolute value of the amount
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Split splits the amount into n parts of the same sign which add up to exactly the amount
+// every part gets an equal share and the last part also gets whatever could not be shared equally
+// i.e. 100.00 split in 3 is 33.33, 33.33 & 33.34, it panics if n < 1
+func (a Amount) Split(n int) []Amount {
+	if n < 1 {
+		panic("money: split in less than 1 part")
+	}
+
+	share := a / Amount(n)
+
+	parts := make([]Amount, n)
+	for i := range parts {
+		parts[i] = share
+	}
+	parts[n-1] = a - share*Amount(n-1)
+
+	return parts
+}
+
+// MarshalJSON writes the amount as a JSON decimal number
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON reads the amount from a JSON number or a JSON string holding a decimal number
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	s := string(b)
+	if len(b) > 0 && b[0] == '"' {
+		err := json.Unmarshal(b, &s)
+		if err != nil {
+			return ErrInvalidAmount
+		}
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func leftPad(s string) string {
+	if len(s) >= Scale {
+		return s
+	}
+	return strings.Repeat("0", Scale-len(s)) + s
+}
@@ -0,0 +1,142 @@
+package money_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+	"transactor-server/pkg/money"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		out money.Amount
+		err error
+	}{
+		{in: "0", out: 0},
+		{in: "12", out: 1200},
+		{in: "12.3", out: 1230},
+		{in: "12.34", out: 1234},
+		{in: "12.340", out: 1234},
+		{in: "-0.01", out: -1},
+		{in: "+5.5", out: 550},
+		{in: ".5", out: 50},
+		{in: " 7.25 ", out: 725},
+		{in: "12.345", err: money.ErrPrecision},
+		{in: "", err: money.ErrInvalidAmount},
+		{in: "-", err: money.ErrInvalidAmount},
+		{in: "1.", err: money.ErrInvalidAmount},
+		{in: "1e3", err: money.ErrInvalidAmount},
+		{in: "abc", err: money.ErrInvalidAmount},
+		{in: "1.2.3", err: money.ErrInvalidAmount},
+		{in: "92233720368547758.08", err: money.ErrOverflow},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			out, err := money.Parse(tc.in)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.out, out)
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	require.Equal(t, "0.00", money.Amount(0).String())
+	require.Equal(t, "0.01", money.Amount(1).String())
+	require.Equal(t, "-0.10", money.Amount(-10).String())
+	require.Equal(t, "12.34", money.Amount(1234).String())
+	require.Equal(t, "-98.75", money.Amount(-9875).String())
+}
+
+func TestArithmeticIsExact(t *testing.T) {
+	// 0.1 + 0.2 != 0.3 with float64
+	sum := money.MustParse("0.1") + money.MustParse("0.2")
+	require.Equal(t, money.MustParse("0.3"), sum)
+}
+
+func TestSplit(t *testing.T) {
+	require.Equal(t, []money.Amount{3333, 3333, 3334}, money.MustParse("100").Split(3))
+	require.Equal(t, []money.Amount{-3333, -3333, -3334}, money.MustParse("-100").Split(3))
+	require.Equal(t, []money.Amount{2500, 2500, 2500, 2500}, money.MustParse("100").Split(4))
+	require.Equal(t, []money.Amount{-1, -1, -1}, money.MustParse("-0.03").Split(3))
+	require.Equal(t, []money.Amount{-9875}, money.MustParse("-98.75").Split(1))
+
+	// the parts always add up to the amount
+	for n := 1; n <= 48; n++ {
+		var sum money.Amount
+		for _, part := range money.MustParse("-1234.57").Split(n) {
+			sum += part
+		}
+		require.Equal(t, money.MustParse("-1234.57"), sum)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var v struct {
+		Amount money.Amount `json:"amount"`
+	}
+
+	err := json.Unmarshal([]byte(`{"amount": -98.75}`), &v)
+	require.NoError(t, err)
+	require.Equal(t, money.Amount(-9875), v.Amount)
+
+	err = json.Unmarshal([]byte(`{"amount": "60.5"}`), &v)
+	require.NoError(t, err)
+	require.Equal(t, money.Amount(6050), v.Amount)
+
+	err = json.Unmarshal([]byte(`{"amount": 0.001}`), &v)
+	require.ErrorIs(t, err, money.ErrPrecision)
+
+	err = json.Unmarshal([]byte(`{"amount": true}`), &v)
+	require.Error(t, err)
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.Equal(t, `{"amount":60.50}`, string(b))
+}
+
+func TestCurrency(t *testing.T) {
+	require.True(t, money.IsCurrency("USD"))
+	require.True(t, money.IsCurrency("EUR"))
+	require.False(t, money.IsCurrency("usd"))
+	require.False(t, money.IsCurrency("XYZ"))
+	require.False(t, money.IsCurrency(""))
+	// only currencies with 2 minor units fit an Amount
+	require.False(t, money.IsCurrency("JPY"))
+	require.False(t, money.IsCurrency("KWD"))
+}
+
+func TestConvert(t *testing.T) {
+	for _, tc := range []struct {
+		amount string
+		rate   string
+		out    string
+	}{
+		{"100", "1.0845", "108.45"},
+		{"-100", "1.0845", "-108.45"},
+		// half a cent is rounded away from zero
+		{"0.10", "0.05", "0.01"},
+		{"-0.10", "0.05", "-0.01"},
+		{"0.10", "0.04", "0"},
+		{"12.34", "1", "12.34"},
+		{"1000000", "0.000001", "1"},
+	} {
+		rate, ok := new(big.Rat).SetString(tc.rate)
+		require.True(t, ok)
+		out, err := money.MustParse(tc.amount).Convert(rate)
+		require.NoError(t, err)
+		require.Equal(t, money.MustParse(tc.out), out, tc)
+	}
+
+	// the result does not fit in an Amount
+	_, err := money.Amount(math.MaxInt64).Convert(big.NewRat(2, 1))
+	require.ErrorIs(t, err, money.ErrOverflow)
+	_, err = money.Amount(math.MinInt64 + 1).Convert(big.NewRat(2, 1))
+	require.ErrorIs(t, err, money.ErrOverflow)
+}
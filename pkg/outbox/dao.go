@@ -0,0 +1,81 @@
+package outbox
+
+import (
+	"context"
+	"strings"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+)
+
+// maxErrorLength is the most characters of a publish error which are kept, which is the size of its column
+const maxErrorLength = 1024
+
+// DAO defines the data access object interface for outbox_event model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockOutboxDAO --filename outbox_dao.go
+type DAO interface {
+	// Pending returns at most limit events which are not published yet in id order
+	Pending(ctx context.Context, limit int) ([]*ent.OutboxEvent, error)
+	// MarkPublished records that an event was published at
+	MarkPublished(ctx context.Context, id int, at time.Time) error
+	// MarkFailed records that publishing an event failed with reason
+	MarkFailed(ctx context.Context, id int, reason string) error
+	// DeletePublished removes the events which were published before and returns how many were removed
+	// events which still have webhook deliveries pending are kept
+	DeletePublished(ctx context.Context, before time.Time) (int, error)
+}
+
+type dao struct {
+	entClient *ent.Client
+}
+
+var _ DAO = (*dao)(nil)
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client) DAO {
+	return &dao{
+		entClient: entClient,
+	}
+}
+
+func (d *dao) Pending(ctx context.Context, limit int) ([]*ent.OutboxEvent, error) {
+	return d.entClient.OutboxEvent.
+		Query().
+		Where(outboxevent.PublishedAtIsNil()).
+		Order(outboxevent.ByID()).
+		Limit(limit).
+		All(ctx)
+}
+
+func (d *dao) MarkPublished(ctx context.Context, id int, at time.Time) error {
+	return d.entClient.OutboxEvent.
+		UpdateOneID(id).
+		SetPublishedAt(at).
+		Exec(ctx)
+}
+
+func (d *dao) MarkFailed(ctx context.Context, id int, reason string) error {
+	// the reason is cut to fit its column, the start of an error tells the most
+	// a rune which was cut in half is dropped as postgres only takes valid utf-8
+	if len(reason) > maxErrorLength {
+		reason = strings.ToValidUTF8(reason[:maxErrorLength], "")
+	}
+	return d.entClient.OutboxEvent.
+		UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(reason).
+		Exec(ctx)
+}
+
+func (d *dao) DeletePublished(ctx context.Context, before time.Time) (int, error) {
+	// the deliveries of a deleted event go with it, so only the delivered & dead ones are lost
+	return d.entClient.OutboxEvent.
+		Delete().
+		Where(
+			outboxevent.PublishedAtLT(before),
+			outboxevent.Not(outboxevent.HasWebhookDeliveriesWith(webhookdelivery.StatusEQ(webhookdelivery.StatusPending))),
+		).
+		Exec(ctx)
+}
@@ -0,0 +1,98 @@
+package outbox_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/outbox"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// write adds an event for account 1 to the outbox in its own transaction
+func write(t *testing.T, client *ent.Client, eventType string, aggregateID int) {
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, outbox.Write(ctx, tx, eventType, 1, aggregateID, map[string]int{"id": aggregateID}))
+	require.NoError(t, tx.Commit())
+}
+
+func setupDB(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(context.Background())
+	return client
+}
+
+func TestDAO(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+
+	write(t, client, outbox.EventAccountCreated, 1)
+	write(t, client, outbox.EventTransactionCreated, 7)
+	write(t, client, outbox.EventTransactionCreated, 8)
+
+	// an event written in a rolled back transaction is never published
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, outbox.Write(ctx, tx, outbox.EventTransactionCreated, 1, 9, nil))
+	require.NoError(t, tx.Rollback())
+
+	dao := outbox.NewDAO(client)
+
+	dbEvents, err := dao.Pending(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, dbEvents, 2)
+	require.Equal(t, outbox.EventAccountCreated, dbEvents[0].Type)
+	require.Equal(t, 1, dbEvents[0].AccountID)
+	require.JSONEq(t, `{"id": 1}`, string(dbEvents[0].Payload))
+	require.Equal(t, 7, dbEvents[1].AggregateID)
+
+	require.NoError(t, dao.MarkPublished(ctx, dbEvents[0].ID, time.Now()))
+	require.NoError(t, dao.MarkFailed(ctx, dbEvents[1].ID, strings.Repeat("é", 1000)))
+	require.NoError(t, dao.MarkFailed(ctx, dbEvents[1].ID, "connection refused"))
+
+	dbEvents, err = dao.Pending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, dbEvents, 2)
+	require.Equal(t, 2, dbEvents[0].Attempts)
+	require.Equal(t, "connection refused", *dbEvents[0].LastError)
+	require.Equal(t, 8, dbEvents[1].AggregateID)
+}
+
+func TestDAODeletePublished(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+
+	for id := range 4 {
+		write(t, client, outbox.EventTransactionCreated, id+1)
+	}
+	dbEvents := client.OutboxEvent.Query().Order(outboxevent.ByID()).AllX(ctx)
+
+	dao := outbox.NewDAO(client)
+	now := time.Now()
+
+	// published long ago, published long ago with a pending webhook delivery, published just now and not published
+	require.NoError(t, dao.MarkPublished(ctx, dbEvents[0].ID, now.Add(-time.Hour)))
+	require.NoError(t, dao.MarkPublished(ctx, dbEvents[1].ID, now.Add(-time.Hour)))
+	require.NoError(t, dao.MarkPublished(ctx, dbEvents[2].ID, now))
+
+	dbSubscription := client.WebhookSubscription.Create().SetURL("http://localhost/hook").SetEventTypes([]string{}).SetSecret("secret").SaveX(ctx)
+	client.WebhookDelivery.Create().SetSubscriptionID(dbSubscription.ID).SetEventID(dbEvents[1].ID).SetNextAttemptAt(now).ExecX(ctx)
+
+	deleted, err := dao.DeletePublished(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	ids := client.OutboxEvent.Query().Order(outboxevent.ByID()).IDsX(ctx)
+	require.Equal(t, []int{dbEvents[1].ID, dbEvents[2].ID, dbEvents[3].ID}, ids)
+}
@@ -0,0 +1,65 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"transactor-server/pkg/db/ent"
+)
+
+// types of the domain events
+const (
+	// EventAccountCreated has the account as its payload
+	EventAccountCreated = "AccountCreated"
+	// EventTransactionCreated has the transaction as its payload, with its balance right after it was booked
+	EventTransactionCreated = "TransactionCreated"
+	// EventBalanceChanged follows every TransactionCreated, it has the net balance of the account right after the transaction
+	EventBalanceChanged = "BalanceChanged"
+)
+
+// EventTypes are all the types of the domain events
+var EventTypes = []string{EventAccountCreated, EventTransactionCreated, EventBalanceChanged}
+
+// Event is a domain event as it is published
+type Event struct {
+	// ID orders the events, consumers can use it to drop the duplicates of an event which was published again
+	ID          int             `json:"id"`
+	Type        string          `json:"type" example:"TransactionCreated"`
+	AccountID   int             `json:"account_id"`
+	AggregateID int             `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Payload     json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// Write adds an event to the outbox inside tx, so the event is only published when tx commits
+// payload is marshalled to JSON as it is
+func Write(ctx context.Context, tx *ent.Tx, eventType string, accountID int, aggregateID int, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return tx.OutboxEvent.
+		Create().
+		SetType(eventType).
+		SetAccountID(accountID).
+		SetAggregateID(aggregateID).
+		SetPayload(b).
+		Exec(ctx)
+}
+
+// MapEntOutboxEventToEvent maps an ent.OutboxEvent record to outbox.Event model
+func MapEntOutboxEventToEvent(e *ent.OutboxEvent) *Event {
+	if e == nil {
+		return nil
+	}
+
+	return &Event{
+		ID:          e.ID,
+		Type:        e.Type,
+		AccountID:   e.AccountID,
+		AggregateID: e.AggregateID,
+		OccurredAt:  e.CreateTime,
+		Payload:     e.Payload,
+	}
+}
@@ -0,0 +1,162 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// publishers which can be picked with NewPublisher
+const (
+	PublisherNone   = "none"
+	PublisherStdout = "stdout"
+	PublisherFile   = "file"
+	PublisherHTTP   = "http"
+)
+
+// Publisher delivers events to whoever consumes them, the relay publishes one event at a time in id order
+// an event is published again when Publish returns an error, so a publisher must be safe to retry
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Publisher --output ../mocks --structname MockOutboxPublisher --filename outbox_publisher.go
+type Publisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// NewPublisher returns the publisher picked by name, which is none (default), stdout, file or http
+// path is only used by file and url & timeout only by http
+func NewPublisher(name string, path string, url string, timeout time.Duration) (Publisher, error) {
+	switch name {
+	case "", PublisherNone:
+		return NopPublisher{}, nil
+	case PublisherStdout:
+		return NewWriterPublisher(os.Stdout), nil
+	case PublisherFile:
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return NewWriterPublisher(f), nil
+	case PublisherHTTP:
+		if url == "" {
+			return nil, fmt.Errorf("an url is required by the http outbox publisher")
+		}
+		return NewHTTPPublisher(url, &http.Client{Timeout: timeout}), nil
+	}
+	return nil, fmt.Errorf("unknown outbox publisher %q", name)
+}
+
+// NopPublisher drops every event, the events are then only marked published and handed to the webhooks
+type NopPublisher struct{}
+
+var _ Publisher = NopPublisher{}
+
+func (NopPublisher) Publish(ctx context.Context, event *Event) error {
+	return nil
+}
+
+// WriterPublisher writes every event as one line of JSON, it is used for stdout & files
+type WriterPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+var _ Publisher = (*WriterPublisher)(nil)
+
+// NewWriterPublisher returns a new WriterPublisher which writes to w
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{
+		w: w,
+	}
+}
+
+func (p *WriterPublisher) Publish(ctx context.Context, event *Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// a single write so lines of events are never interleaved
+	_, err = p.w.Write(append(b, '\n'))
+	return err
+}
+
+// HTTPPublisher POSTs every event as JSON to an url, any 2xx status means it was delivered
+type HTTPPublisher struct {
+	url    string
+	client *http.Client
+}
+
+var _ Publisher = (*HTTPPublisher)(nil)
+
+// NewHTTPPublisher returns a new HTTPPublisher which POSTs to url with client
+func NewHTTPPublisher(url string, client *http.Client) *HTTPPublisher {
+	return &HTTPPublisher{
+		url:    url,
+		client: client,
+	}
+}
+
+func (p *HTTPPublisher) Publish(ctx context.Context, event *Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// the receiver can drop an event it already got without parsing it
+	req.Header.Set("X-Event-ID", strconv.Itoa(event.ID))
+	req.Header.Set("X-Event-Type", event.Type)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("publishing event %d got status %d", event.ID, resp.StatusCode)
+	}
+	return nil
+}
+
+// FanoutPublisher publishes every event to each of its publishers in order
+// it stops at the first one which fails, so the ones before it get the event again when it is retried
+// so publishers which can take an event more than once, like writing it to the DB, should come first
+type FanoutPublisher struct {
+	publishers []Publisher
+}
+
+var _ Publisher = (*FanoutPublisher)(nil)
+
+// NewFanoutPublisher returns a new FanoutPublisher which publishes to publishers
+func NewFanoutPublisher(publishers ...Publisher) *FanoutPublisher {
+	return &FanoutPublisher{
+		publishers: publishers,
+	}
+}
+
+func (p *FanoutPublisher) Publish(ctx context.Context, event *Event) error {
+	for _, publisher := range p.publishers {
+		err := publisher.Publish(ctx, event)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
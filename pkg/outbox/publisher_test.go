@@ -0,0 +1,124 @@
+package outbox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"transactor-server/pkg/outbox"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var event = &outbox.Event{
+	ID:          42,
+	Type:        outbox.EventTransactionCreated,
+	AccountID:   1,
+	AggregateID: 7,
+	OccurredAt:  time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+	Payload:     json.RawMessage(`{"id":7,"amount":-10}`),
+}
+
+func TestNewPublisher(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{outbox.PublisherStdout, outbox.PublisherHTTP} {
+		_, err := outbox.NewPublisher(name, "", "http://localhost:9999/events", time.Second)
+		require.NoError(t, err, name)
+	}
+
+	// events are only handed to the webhooks by default
+	for _, name := range []string{"", outbox.PublisherNone} {
+		publisher, err := outbox.NewPublisher(name, "", "", 0)
+		require.NoError(t, err, name)
+		require.Equal(t, outbox.NopPublisher{}, publisher)
+		require.NoError(t, publisher.Publish(context.Background(), event))
+	}
+
+	_, err := outbox.NewPublisher(outbox.PublisherHTTP, "", "", time.Second)
+	require.Error(t, err)
+
+	_, err = outbox.NewPublisher("kafka", "", "", time.Second)
+	require.Error(t, err)
+
+	// the file publisher appends one event per line
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	for range 2 {
+		publisher, err := outbox.NewPublisher(outbox.PublisherFile, path, "", 0)
+		require.NoError(t, err)
+		require.NoError(t, publisher.Publish(context.Background(), event))
+	}
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, bytes.Split(bytes.TrimSpace(b), []byte("\n")), 2)
+}
+
+func TestWriterPublisher(t *testing.T) {
+	t.Parallel()
+	w := &bytes.Buffer{}
+
+	require.NoError(t, outbox.NewWriterPublisher(w).Publish(context.Background(), event))
+
+	require.Equal(t, byte('\n'), w.Bytes()[w.Len()-1])
+	require.Equal(t, int64(42), gjson.GetBytes(w.Bytes(), "id").Int())
+	require.Equal(t, "TransactionCreated", gjson.GetBytes(w.Bytes(), "type").String())
+	require.Equal(t, -10.0, gjson.GetBytes(w.Bytes(), "payload.amount").Float())
+}
+
+func TestHTTPPublisher(t *testing.T) {
+	t.Run("delivered", func(t *testing.T) {
+		t.Parallel()
+		var body []byte
+		var header http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			header = r.Header
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		err := outbox.NewHTTPPublisher(server.URL, server.Client()).Publish(context.Background(), event)
+
+		require.NoError(t, err)
+		require.Equal(t, "application/json", header.Get("Content-Type"))
+		require.Equal(t, "42", header.Get("X-Event-ID"))
+		require.Equal(t, "TransactionCreated", header.Get("X-Event-Type"))
+		require.Equal(t, int64(7), gjson.GetBytes(body, "aggregate_id").Int())
+	})
+
+	t.Run("not delivered", func(t *testing.T) {
+		t.Parallel()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := outbox.NewHTTPPublisher(server.URL, server.Client()).Publish(context.Background(), event)
+
+		require.ErrorContains(t, err, "503")
+	})
+}
+
+func TestFanoutPublisher(t *testing.T) {
+	t.Parallel()
+	first, second := &bytes.Buffer{}, &bytes.Buffer{}
+
+	publisher := outbox.NewFanoutPublisher(outbox.NewWriterPublisher(first), outbox.NewWriterPublisher(second))
+	require.NoError(t, publisher.Publish(context.Background(), event))
+
+	require.Equal(t, first.String(), second.String())
+	require.Equal(t, int64(42), gjson.Get(first.String(), "id").Int())
+
+	// a failing publisher stops the ones after it
+	failing := outbox.NewHTTPPublisher("http://127.0.0.1:0", http.DefaultClient)
+	publisher = outbox.NewFanoutPublisher(failing, outbox.NewWriterPublisher(second))
+	require.Error(t, publisher.Publish(context.Background(), event))
+	require.Equal(t, first.String(), second.String())
+}
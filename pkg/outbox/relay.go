@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/job"
+
+	"go.uber.org/zap"
+)
+
+// Relay periodically publishes the events of the outbox in id order
+// an event which fails to publish stops the batch, so the events after it wait and stay in order
+// events are published at least once, one is published again when it can not be marked published afterwards
+type Relay struct {
+	dao       DAO
+	publisher Publisher
+	interval  time.Duration
+	batchSize int
+	logger    *zap.Logger
+}
+
+// NewRelay returns a new Relay which runs every interval and reads batchSize events at a time
+func NewRelay(dao DAO, publisher Publisher, interval time.Duration, batchSize int, logger *zap.Logger) *Relay {
+	return &Relay{
+		dao:       dao,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run publishes pending events every interval until the context is cancelled
+func (r *Relay) Run(ctx context.Context) error {
+	return job.Run(ctx, r.interval, r.logger, func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+		published, err := r.RelayPending(ctx)
+		if published == 0 {
+			return nil, err
+		}
+		// a failed run tells how many events it published before the one which failed
+		return []zap.Field{zap.Int("published_events", published)}, err
+	})
+}
+
+// RelayPending publishes the pending events batch by batch till none are left and returns how many were published
+// it stops at the first event which fails to publish and returns its error
+func (r *Relay) RelayPending(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		dbEvents, err := r.dao.Pending(ctx, r.batchSize)
+		if err != nil {
+			return published, err
+		}
+
+		for _, dbEvent := range dbEvents {
+			err = r.publisher.Publish(ctx, MapEntOutboxEventToEvent(dbEvent))
+			if err != nil {
+				markErr := r.dao.MarkFailed(ctx, dbEvent.ID, err.Error())
+				if markErr != nil {
+					r.logger.Error("failed to mark outbox event failed", zap.Int("id", dbEvent.ID), zap.Error(markErr))
+				}
+				return published, err
+			}
+
+			err = r.dao.MarkPublished(ctx, dbEvent.ID, time.Now())
+			if err != nil {
+				return published, err
+			}
+			published++
+		}
+
+		if len(dbEvents) < r.batchSize {
+			return published, nil
+		}
+	}
+}
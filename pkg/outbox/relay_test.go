@@ -0,0 +1,65 @@
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/outbox"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRelayPending(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+
+	for i := range 5 {
+		write(t, client, outbox.EventTransactionCreated, i+1)
+	}
+
+	publisher := mocks.NewMockOutboxPublisher(t)
+	dao := outbox.NewDAO(client)
+	// a small batch so the relay has to read several of them
+	relay := outbox.NewRelay(dao, publisher, 0, 2, zap.NewNop())
+
+	isAggregate := func(id int) interface{} {
+		return mock.MatchedBy(func(e *outbox.Event) bool { return e.AggregateID == id })
+	}
+
+	// the 4th event fails, so the 5th one waits for it
+	publisher.On("Publish", mock.Anything, isAggregate(1)).Return(nil).Once()
+	publisher.On("Publish", mock.Anything, isAggregate(2)).Return(nil).Once()
+	publisher.On("Publish", mock.Anything, isAggregate(3)).Return(nil).Once()
+	publisher.On("Publish", mock.Anything, isAggregate(4)).Return(fmt.Errorf("broker down")).Once()
+
+	published, err := relay.RelayPending(ctx)
+	require.ErrorContains(t, err, "broker down")
+	require.Equal(t, 3, published)
+
+	pending, err := dao.Pending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, 4, pending[0].AggregateID)
+	require.Equal(t, 1, pending[0].Attempts)
+	require.Equal(t, "broker down", *pending[0].LastError)
+
+	// the next run goes on from the failed event, in order
+	publisher.On("Publish", mock.Anything, isAggregate(4)).Return(nil).Once()
+	publisher.On("Publish", mock.Anything, isAggregate(5)).Return(nil).Once()
+
+	published, err = relay.RelayPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, published)
+
+	pending, err = dao.Pending(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	published, err = relay.RelayPending(ctx)
+	require.NoError(t, err)
+	require.Zero(t, published)
+}
@@ -0,0 +1,39 @@
+package outbox
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/job"
+
+	"go.uber.org/zap"
+)
+
+// Sweeper periodically removes the events which were published longer than the retention ago
+// it keeps the outbox from growing forever, event streams can only resume from the events which are kept
+type Sweeper struct {
+	dao       DAO
+	retention time.Duration
+	interval  time.Duration
+	logger    *zap.Logger
+}
+
+// NewSweeper returns a new Sweeper which runs every interval and keeps published events for retention
+func NewSweeper(dao DAO, retention time.Duration, interval time.Duration, logger *zap.Logger) *Sweeper {
+	return &Sweeper{
+		dao:       dao,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// Run sweeps published events every interval until the context is cancelled
+func (s *Sweeper) Run(ctx context.Context) error {
+	return job.Run(ctx, s.interval, s.logger, func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+		deleted, err := s.dao.DeletePublished(ctx, now.Add(-s.retention))
+		if err != nil || deleted == 0 {
+			return nil, err
+		}
+		return []zap.Field{zap.Int("deleted_events", deleted)}, nil
+	})
+}
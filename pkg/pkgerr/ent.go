@@ -1,13 +1,18 @@
 package pkgerr
 
 import (
+	"errors"
 	"net/http"
 	"transactor-server/pkg/db/ent"
 )
 
 // WrapDAOError wraps certain ent errors to specific status codes
+// errors which already know their status code, like a business rule broken inside a DB transaction, are returned as is
 func WrapDAOError(err error) error {
-	if ent.IsConstraintError(err) {
+	var httpErr HttpError
+	if errors.As(err, &httpErr) {
+		return err
+	} else if ent.IsConstraintError(err) {
 		return NewServiceError("db", "constraint", http.StatusBadRequest, err.Error())
 	} else if ent.IsNotFound(err) {
 		return NewServiceError("db", "not_found", http.StatusNotFound, err.Error())
@@ -0,0 +1,100 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// mimeTextCSV & mimeApplicationPDF are the content types of the csv & pdf statements
+const (
+	mimeTextCSV        = "text/csv"
+	mimeApplicationPDF = "application/pdf"
+)
+
+// API is the api handler for statement apis
+type API struct {
+	sevice Service
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+	}
+}
+
+// Handle sets up all the routes with their handler funcs for statement apis, it is mounted on the accounts
+func (a *API) Handle(router fiber.Router) {
+	router.Get("/:id/statement", a.getStatement)
+}
+
+// getStatement returns the statement of an account for a billing period
+// @Summary      get the statement of an account
+// @Description  the period is either a calendar month in UTC or from & to, installments are listed as their purchase
+// @Produce      json
+// @Produce      text/csv
+// @Produce      application/pdf
+// @Tags		 statement
+// @Param        id      path     int     true   "account id"
+// @Param        month   query    string  false  "calendar month like 2026-09, can not be sent with from & to"
+// @Param        from    query    string  false  "start of the period as an RFC3339 timestamp"
+// @Param        to      query    string  false  "end of the period as an RFC3339 timestamp, not included"
+// @Param        format  query    string  false  "json (default), csv or pdf"
+// @Success      200  {object}  Statement
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/accounts/{id}/statement [get]
+func (a *API) getStatement(c *fiber.Ctx) error {
+	// we try to parse the account id to an int
+	accountID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	req := &Request{}
+
+	// try to parse the query params
+	err = c.QueryParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("statement", "query_parse_failure", http.StatusBadRequest, err.Error())
+	}
+	req.AccountID = accountID
+
+	// call the service to build the statement
+	resp, err := a.sevice.Get(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// the same statement is rendered in the requested format
+	var render func(w io.Writer, s *Statement) error
+	contentType := ""
+	switch req.Format {
+	case FormatCSV:
+		render, contentType = RenderCSV, mimeTextCSV
+	case FormatPDF:
+		render, contentType = RenderPDF, mimeApplicationPDF
+	default:
+		// incase of no error return response with 200 status
+		return c.Status(http.StatusOK).JSON(resp)
+	}
+
+	body := &bytes.Buffer{}
+	err = render(body, resp)
+	if err != nil {
+		return pkgerr.NewServiceError("statement", "render_failure", http.StatusInternalServerError, err.Error())
+	}
+
+	c.Attachment(fmt.Sprintf("statement-%d-%s.%s", resp.AccountID, resp.From.Format("2006-01-02"), req.Format))
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Status(http.StatusOK).Send(body.Bytes())
+}
@@ -0,0 +1,210 @@
+package statement_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/statement"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockStatementService) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	router := app.Group("/test/accounts")
+	service := mocks.NewMockStatementService(t)
+
+	api := statement.NewAPI(service)
+	api.Handle(router)
+
+	return app, service
+}
+
+func TestAPIGet(t *testing.T) {
+	from := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	description := "Groceries (weekly)"
+	statementResp := &statement.Statement{
+		AccountID:       373,
+		Name:            "John Doe",
+		DocumentNumber:  "12345",
+		From:            from,
+		To:              from.AddDate(0, 1, 0),
+		OpeningBalance:  money.MustParse("-100"),
+		TotalDebits:     money.MustParse("-50"),
+		TotalCredits:    money.MustParse("120"),
+		PaymentsApplied: money.MustParse("50"),
+		ClosingBalance:  money.MustParse("-30"),
+		Transactions: []*statement.Line{
+			{TransactionID: 1, Timestamp: from.Add(time.Hour), OperationType: "Normal Purchase", Description: &description, Amount: money.MustParse("-50"), RunningBalance: money.MustParse("-150"), Status: "posted"},
+			{TransactionID: 2, Timestamp: from.Add(time.Hour * 2), OperationType: "Credit Voucher", Amount: money.MustParse("120"), RunningBalance: money.MustParse("-30"), Status: "posted"},
+		},
+		Payments: []*statement.Payment{
+			{SettlementID: 1, Timestamp: from.Add(time.Hour * 2), CreditTransactionID: 2, DebitTransactionID: 1, Amount: money.MustParse("50")},
+		},
+	}
+
+	t.Run("id parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/abc/statement?month=2026-09", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/statement?month=2026-09", nil)
+
+		service.On("Get", mock.Anything, &statement.Request{AccountID: 373, Month: "2026-09"}).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/statement?from=2026-09-01T00:00:00Z&to=2026-10-01T00:00:00Z", nil)
+
+		service.On("Get", mock.Anything, &statement.Request{
+			AccountID: 373,
+			From:      "2026-09-01T00:00:00Z",
+			To:        "2026-10-01T00:00:00Z",
+		}).Return(statementResp, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, -100.0, gjson.GetBytes(b, "opening_balance").Float())
+		require.Equal(t, -30.0, gjson.GetBytes(b, "closing_balance").Float())
+		require.Equal(t, -150.0, gjson.GetBytes(b, "transactions.0.running_balance").Float())
+		require.Equal(t, int64(1), gjson.GetBytes(b, "payments.0.settlement_id").Int())
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/statement?month=2026-09&format=csv", nil)
+
+		service.On("Get", mock.Anything, &statement.Request{AccountID: 373, Month: "2026-09", Format: "csv"}).Return(statementResp, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/csv", resp.Header.Get(fiber.HeaderContentType))
+		require.Contains(t, resp.Header.Get(fiber.HeaderContentDisposition), "statement-373-2026-09-01.csv")
+
+		defer resp.Body.Close()
+		rows, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"type", "id", "timestamp", "operation_type", "description", "amount", "running_balance", "status"},
+			{"opening_balance", "", "2026-09-01T00:00:00Z", "", "", "", "-100.00", ""},
+			{"transaction", "1", "2026-09-01T01:00:00Z", "Normal Purchase", "Groceries (weekly)", "-50.00", "-150.00", "posted"},
+			{"transaction", "2", "2026-09-01T02:00:00Z", "Credit Voucher", "", "120.00", "-30.00", "posted"},
+			{"payment", "1", "2026-09-01T02:00:00Z", "", "transaction 2 paid transaction 1", "50.00", "", "applied"},
+			{"closing_balance", "", "2026-10-01T00:00:00Z", "", "", "", "-30.00", ""},
+		}, rows)
+	})
+
+	t.Run("csv formula", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/statement?month=2026-09&format=csv", nil)
+
+		formula := "=HYPERLINK(\"http://example.com\")"
+		merchant := "@SUM(A1:A2)"
+		service.On("Get", mock.Anything, &statement.Request{AccountID: 373, Month: "2026-09", Format: "csv"}).Return(&statement.Statement{
+			AccountID: 373,
+			From:      from,
+			To:        from.AddDate(0, 1, 0),
+			Transactions: []*statement.Line{
+				{TransactionID: 1, Timestamp: from, OperationType: "+Purchase", Description: &formula, Amount: money.MustParse("-50"), RunningBalance: money.MustParse("-50"), Status: "posted"},
+				{TransactionID: 2, Timestamp: from, OperationType: "Purchase", MerchantName: &merchant, Amount: money.MustParse("-10"), RunningBalance: money.MustParse("-60"), Status: "posted"},
+			},
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		rows, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		// text is prefixed so it is not run as a formula, amounts are left as they are
+		require.Equal(t, []string{"transaction", "1", "2026-09-01T00:00:00Z", "'+Purchase", "'" + formula, "-50.00", "-50.00", "posted"}, rows[2])
+		require.Equal(t, []string{"transaction", "2", "2026-09-01T00:00:00Z", "Purchase", "'" + merchant, "-10.00", "-60.00", "posted"}, rows[3])
+	})
+
+	t.Run("pdf", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/statement?month=2026-09&format=pdf", nil)
+
+		service.On("Get", mock.Anything, &statement.Request{AccountID: 373, Month: "2026-09", Format: "pdf"}).Return(statementResp, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/pdf", resp.Header.Get(fiber.HeaderContentType))
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.True(t, bytes.HasPrefix(b, []byte("%PDF-1.4\n")))
+		require.True(t, bytes.HasSuffix(b, []byte("%%EOF\n")))
+		require.Contains(t, string(b), "(Statement of account 373) Tj")
+		// the parentheses of the text are escaped
+		require.Contains(t, string(b), `Groceries \(weekly\)`)
+		require.Contains(t, string(b), "(Page 1 of 1) Tj")
+
+		// every object is where the cross reference table says it is
+		startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(b)
+		require.NotNil(t, startxref)
+		xref, err := strconv.Atoi(string(startxref[1]))
+		require.NoError(t, err)
+		entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(b[xref:], -1)
+		require.Len(t, entries, 5)
+		for i, entry := range entries {
+			offset, err := strconv.Atoi(string(entry[1]))
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(b[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))))
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package statement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// row types of a csv statement
+const (
+	csvRowOpeningBalance = "opening_balance"
+	csvRowTransaction    = "transaction"
+	csvRowPayment        = "payment"
+	csvRowClosingBalance = "closing_balance"
+)
+
+var csvHeader = []string{"type", "id", "timestamp", "operation_type", "description", "amount", "running_balance", "status"}
+
+// RenderCSV writes the statement as one csv table
+// it starts with the opening balance, then has a row per transaction & payment and ends with the closing balance
+// the type column tells the rows apart
+func RenderCSV(w io.Writer, s *Statement) error {
+	cw := csv.NewWriter(w)
+
+	rows := [][]string{
+		csvHeader,
+		{csvRowOpeningBalance, "", s.From.Format(time.RFC3339), "", "", "", s.OpeningBalance.String(), ""},
+	}
+	for _, line := range s.Transactions {
+		rows = append(rows, []string{
+			csvRowTransaction,
+			strconv.Itoa(line.TransactionID),
+			line.Timestamp.Format(time.RFC3339),
+			csvText(line.OperationType),
+			csvText(line.describe()),
+			line.Amount.String(),
+			line.RunningBalance.String(),
+			line.Status,
+		})
+	}
+	for _, payment := range s.Payments {
+		rows = append(rows, []string{
+			csvRowPayment,
+			strconv.Itoa(payment.SettlementID),
+			payment.Timestamp.Format(time.RFC3339),
+			"",
+			payment.describe(),
+			payment.Amount.String(),
+			"",
+			payment.status(),
+		})
+	}
+	rows = append(rows, []string{csvRowClosingBalance, "", s.To.Format(time.RFC3339), "", "", "", s.ClosingBalance.String(), ""})
+
+	err := cw.WriteAll(rows)
+	if err != nil {
+		return fmt.Errorf("writing csv statement: %w", err)
+	}
+	return nil
+}
+
+// csvText neutralises text which a spreadsheet would run as a formula, like a description of =HYPERLINK(..)
+// such text gets a leading ' so it is shown as it is
+func csvText(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// describe returns the description and merchant of the transaction as one text
+func (l *Line) describe() string {
+	switch {
+	case l.Description != nil && l.MerchantName != nil:
+		return *l.Description + " - " + *l.MerchantName
+	case l.Description != nil:
+		return *l.Description
+	case l.MerchantName != nil:
+		return *l.MerchantName
+	}
+	return ""
+}
+
+// describe returns which transaction paid which
+func (p *Payment) describe() string {
+	return fmt.Sprintf("transaction %d paid transaction %d", p.CreditTransactionID, p.DebitTransactionID)
+}
+
+// status returns reversed when the payment was undone and applied otherwise
+func (p *Payment) status() string {
+	if p.ReversedAt != nil {
+		return "reversed"
+	}
+	return "applied"
+}
@@ -0,0 +1,101 @@
+package statement
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/settlement"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// DAO defines the data access object interface for the data of a statement
+// only top level transactions are read, installments are part of their purchase
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockStatementDAO --filename statement_dao.go
+type DAO interface {
+	// Account tries to find the account the statement is for
+	Account(ctx context.Context, id int) (*ent.Account, error)
+	// OpeningBalance sums the amounts of the transactions of an account which happened before the timestamp
+	OpeningBalance(ctx context.Context, accountID int, before time.Time) (money.Amount, error)
+	// Transactions returns the transactions of an account which happened in [from, to) oldest first
+	// with their operation type loaded
+	Transactions(ctx context.Context, accountID int, from, to time.Time) ([]*ent.Transaction, error)
+	// Payments returns the settlements made by the credits of an account in [from, to) oldest first
+	Payments(ctx context.Context, accountID int, from, to time.Time) ([]*ent.Settlement, error)
+}
+
+type dao struct {
+	entClient *ent.Client
+}
+
+var _ DAO = (*dao)(nil)
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client) DAO {
+	return &dao{
+		entClient: entClient,
+	}
+}
+
+func (d *dao) Account(ctx context.Context, id int) (*ent.Account, error) {
+	return d.entClient.Account.Get(ctx, id)
+}
+
+func (d *dao) OpeningBalance(ctx context.Context, accountID int, before time.Time) (money.Amount, error) {
+	balance := []struct {
+		Balance money.Amount `json:"balance"`
+	}{}
+
+	// a reversed transaction & its reversal net out, so the status does not matter here
+	err := d.entClient.Transaction.
+		Query().
+		Where(
+			transaction.AccountID(accountID),
+			transaction.ParentIDIsNil(),
+			transaction.TimestampLT(before),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select().AppendSelectExprAs(sql.Raw("CAST(COALESCE(SUM(amount), 0) AS BIGINT)"), "balance")
+		}).
+		Scan(ctx, &balance)
+	if err != nil || len(balance) == 0 {
+		return 0, err
+	}
+
+	return balance[0].Balance, nil
+}
+
+func (d *dao) Transactions(ctx context.Context, accountID int, from, to time.Time) ([]*ent.Transaction, error) {
+	return d.entClient.Transaction.
+		Query().
+		Where(
+			transaction.AccountID(accountID),
+			transaction.ParentIDIsNil(),
+			transaction.TimestampGTE(from),
+			transaction.TimestampLT(to),
+		).
+		WithOperationType().
+		Order(
+			transaction.ByTimestamp(),
+			transaction.ByID(),
+		).
+		All(ctx)
+}
+
+func (d *dao) Payments(ctx context.Context, accountID int, from, to time.Time) ([]*ent.Settlement, error) {
+	return d.entClient.Settlement.
+		Query().
+		Where(
+			settlement.HasCreditWith(transaction.AccountID(accountID)),
+			settlement.TimestampGTE(from),
+			settlement.TimestampLT(to),
+		).
+		Order(
+			settlement.ByTimestamp(),
+			settlement.ByID(),
+		).
+		All(ctx)
+}
@@ -0,0 +1,108 @@
+package statement_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/statement"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAO(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Purchase with installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	from := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	create := func(accountID, operationTypeID int, amount string, timestamp time.Time) *ent.Transaction {
+		return client.Transaction.Create().
+			SetAccountID(accountID).
+			SetOperationTypeID(operationTypeID).
+			SetAmount(money.MustParse(amount)).
+			SetBalance(money.MustParse(amount)).
+			SetTimestamp(timestamp).
+			SaveX(ctx)
+	}
+
+	// before the period
+	create(1, 1, "-50", from.Add(-time.Hour))
+	create(1, 4, "20", from.Add(-time.Minute))
+	// in the period, a purchase with 2 installments, only the purchase is on the statement
+	purchase := create(1, 2, "-30", from.Add(time.Hour))
+	for i := range 2 {
+		client.Transaction.Create().
+			SetAccountID(1).
+			SetOperationTypeID(2).
+			SetAmount(money.MustParse("-15")).
+			SetBalance(money.MustParse("-15")).
+			SetTimestamp(from.Add(time.Hour)).
+			SetParentID(purchase.ID).
+			SetInstallmentNumber(i + 1).
+			ExecX(ctx)
+	}
+	credit := create(1, 4, "40", from.Add(time.Hour*2))
+	// after the period & another account
+	create(1, 1, "-5", to)
+	create(2, 1, "-5", from.Add(time.Hour))
+
+	client.Settlement.Create().
+		SetCreditTxnID(credit.ID).
+		SetDebitTxnID(purchase.ID).
+		SetAmount(money.MustParse("15")).
+		SetTimestamp(from.Add(time.Hour * 2)).
+		ExecX(ctx)
+	client.Settlement.Create().
+		SetCreditTxnID(credit.ID).
+		SetDebitTxnID(purchase.ID).
+		SetAmount(money.MustParse("15")).
+		SetTimestamp(to).
+		ExecX(ctx)
+
+	dao := statement.NewDAO(client)
+
+	dbAccount, err := dao.Account(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "John Doe", dbAccount.Name)
+
+	_, err = dao.Account(ctx, 999)
+	require.True(t, ent.IsNotFound(err))
+
+	openingBalance, err := dao.OpeningBalance(ctx, 1, from)
+	require.NoError(t, err)
+	require.Equal(t, money.MustParse("-30"), openingBalance)
+
+	openingBalance, err = dao.OpeningBalance(ctx, 1, from.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, money.Amount(0), openingBalance)
+
+	dbTransactions, err := dao.Transactions(ctx, 1, from, to)
+	require.NoError(t, err)
+	require.Equal(t, []int{purchase.ID, credit.ID}, lo.Map(dbTransactions, func(t *ent.Transaction, _ int) int { return t.ID }))
+	require.Equal(t, "Purchase with installments", dbTransactions[0].Edges.OperationType.Description)
+
+	dbSettlements, err := dao.Payments(ctx, 1, from, to)
+	require.NoError(t, err)
+	require.Len(t, dbSettlements, 1)
+	require.Equal(t, money.MustParse("15"), dbSettlements[0].Amount)
+
+	dbSettlements, err = dao.Payments(ctx, 2, from, to)
+	require.NoError(t, err)
+	require.Empty(t, dbSettlements)
+}
@@ -0,0 +1,47 @@
+package statement
+
+import (
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/money"
+)
+
+// MapEntTransactionToLine maps an ent.Transaction record to statement.Line model
+// the operation type edge is expected to be loaded to fill in its description
+func MapEntTransactionToLine(t *ent.Transaction, runningBalance money.Amount) *Line {
+	if t == nil {
+		return nil
+	}
+
+	line := &Line{
+		TransactionID:   t.ID,
+		Timestamp:       t.Timestamp,
+		OperationTypeID: t.OperationTypeID,
+		Description:     t.Description,
+		MerchantName:    t.MerchantName,
+		Amount:          t.Amount,
+		Balance:         t.Balance,
+		RunningBalance:  runningBalance,
+		Status:          t.Status.String(),
+	}
+	if t.Edges.OperationType != nil {
+		line.OperationType = t.Edges.OperationType.Description
+	}
+
+	return line
+}
+
+// MapEntSettlementToPayment maps an ent.Settlement record to statement.Payment model
+func MapEntSettlementToPayment(s *ent.Settlement) *Payment {
+	if s == nil {
+		return nil
+	}
+
+	return &Payment{
+		SettlementID:        s.ID,
+		Timestamp:           s.Timestamp,
+		CreditTransactionID: s.CreditTxnID,
+		DebitTransactionID:  s.DebitTxnID,
+		Amount:              s.Amount,
+		ReversedAt:          s.ReversedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package statement
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the statement.Service
+// it like name suggests adds metrics to each service call
+// on success it adds statement_service_get_success and on failure statement_service_get_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	getCounterSuccess metric.Int64Counter
+	getCounterFailure metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	getCounterSuccess, err := meter.Int64Counter("statement_service_get_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterFailure, err := meter.Int64Counter("statement_service_get_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:           service,
+		meter:             meter,
+		getCounterSuccess: getCounterSuccess,
+		getCounterFailure: getCounterFailure,
+	}
+}
+
+func (m *meteredSevice) Get(ctx context.Context, req *Request) (resp *Statement, err error) {
+	defer func() {
+		if err == nil {
+			m.getCounterSuccess.Add(ctx, 1)
+		} else {
+			m.getCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Get(ctx, req)
+	return
+}
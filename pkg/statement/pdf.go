@@ -0,0 +1,175 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// layout of a pdf statement in points, the pages are A4 and the font is Courier
+// which is one of the standard pdf fonts so nothing has to be embedded, it is 0.6 em wide which keeps columns aligned
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 9
+	pdfLeading      = 12
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+)
+
+// pdfTimeLayout is short enough to leave room for the description column
+const pdfTimeLayout = "2006-01-02 15:04"
+
+// RenderPDF writes the statement as a plain text pdf document
+// it has a summary of the balances followed by tables of the transactions and payments, spread over as many pages as needed
+func RenderPDF(w io.Writer, s *Statement) error {
+	_, err := w.Write(writePDF(pdfLines(s)))
+	if err != nil {
+		return fmt.Errorf("writing pdf statement: %w", err)
+	}
+	return nil
+}
+
+// pdfLines lays the statement out as lines of at most 95 characters, which is what fits on a page
+func pdfLines(s *Statement) []string {
+	lines := []string{
+		fmt.Sprintf("Statement of account %d", s.AccountID),
+		fmt.Sprintf("%s (%s)", s.Name, s.DocumentNumber),
+		fmt.Sprintf("Period: %s to %s", s.From.Format(pdfTimeLayout), s.To.Format(pdfTimeLayout)),
+		fmt.Sprintf("Generated at: %s", s.GeneratedAt.Format(pdfTimeLayout)),
+		"",
+		fmt.Sprintf("%-20s %15s", "Opening balance", s.OpeningBalance),
+		fmt.Sprintf("%-20s %15s", "Total debits", s.TotalDebits),
+		fmt.Sprintf("%-20s %15s", "Total credits", s.TotalCredits),
+		fmt.Sprintf("%-20s %15s", "Payments applied", s.PaymentsApplied),
+		fmt.Sprintf("%-20s %15s", "Closing balance", s.ClosingBalance),
+		"",
+		"Transactions",
+		fmt.Sprintf("%-16s %8s %-20s %-24s %11s %11s", "Date", "ID", "Type", "Description", "Amount", "Balance"),
+	}
+	for _, line := range s.Transactions {
+		description := line.describe()
+		if line.Status != "posted" {
+			description = "[" + line.Status + "] " + description
+		}
+		lines = append(lines, fmt.Sprintf("%-16s %8d %-20s %-24s %11s %11s",
+			line.Timestamp.Format(pdfTimeLayout),
+			line.TransactionID,
+			truncate(line.OperationType, 20),
+			truncate(description, 24),
+			line.Amount,
+			line.RunningBalance,
+		))
+	}
+
+	lines = append(lines,
+		"",
+		"Payments applied",
+		fmt.Sprintf("%-16s %8s %8s %8s %11s %-8s", "Date", "ID", "Credit", "Debit", "Amount", "Status"),
+	)
+	for _, payment := range s.Payments {
+		lines = append(lines, fmt.Sprintf("%-16s %8d %8d %8d %11s %-8s",
+			payment.Timestamp.Format(pdfTimeLayout),
+			payment.SettlementID,
+			payment.CreditTransactionID,
+			payment.DebitTransactionID,
+			payment.Amount,
+			payment.status(),
+		))
+	}
+
+	return lines
+}
+
+// truncate cuts text to at most n characters
+func truncate(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	return string(runes[:n-1]) + "~"
+}
+
+// writePDF returns a pdf document with the lines on as many pages as needed
+// object 1 is the catalog, 2 the page tree, 3 the font and every page is a page object followed by its content stream
+func writePDF(lines []string) []byte {
+	pages := [][]string{}
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	buf := &bytes.Buffer{}
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 5+2*i,
+		))
+
+		content := pdfPageContent(page, fmt.Sprintf("Page %d of %d", i+1, len(pages)))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// pdfPageContent returns the content stream which draws the lines from the top of a page and the footer at its bottom
+func pdfPageContent(lines []string, footer string) string {
+	content := &strings.Builder{}
+	fmt.Fprintf(content, "BT /F1 %d Tf %d TL %d %d Td", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+	for i, line := range lines {
+		if i > 0 {
+			content.WriteString(" T*")
+		}
+		fmt.Fprintf(content, " (%s) Tj", pdfEscape(line))
+	}
+	content.WriteString(" ET")
+	fmt.Fprintf(content, "\nBT /F1 %d Tf %d %d Td (%s) Tj ET", pdfFontSize, pdfMargin, pdfMargin/2, pdfEscape(footer))
+	return content.String()
+}
+
+// pdfEscape turns text into the bytes of a pdf string in WinAnsiEncoding
+// latin-1 characters are kept, everything else which the font can not show becomes ?
+func pdfEscape(text string) string {
+	escaped := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			escaped = append(escaped, '\\', byte(r))
+		case r < ' ':
+			escaped = append(escaped, ' ')
+		case r < 0x7f || (r >= 0xa0 && r <= 0xff):
+			escaped = append(escaped, byte(r))
+		default:
+			escaped = append(escaped, '?')
+		}
+	}
+	return string(escaped)
+}
@@ -0,0 +1,110 @@
+package statement
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/pkgerr"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"go.uber.org/zap"
+)
+
+// Service handles the main business logic for statement related things
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockStatementService  --filename statement_service.go
+type Service interface {
+	// Get builds the statement of an account for a billing period
+	Get(context.Context, *Request) (*Statement, error)
+}
+
+type service struct {
+	statementDAO DAO
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(
+	statementDAO DAO,
+
+	logger *zap.Logger,
+) Service {
+	return &service{
+		statementDAO: statementDAO,
+
+		logger: logger,
+	}
+}
+
+func (s *service) Get(ctx context.Context, req *Request) (*Statement, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	// the period must not be empty and not be longer than MaxPeriod
+	from, to := req.period()
+	if !to.After(from) {
+		return nil, pkgerr.WrapValidationError(validation.NewError("validation_statement_period_empty", "must be after from"), "to")
+	}
+	if to.Sub(from) > MaxPeriod {
+		return nil, pkgerr.WrapValidationError(validation.NewError("validation_statement_period_too_long", "must be at most "+MaxPeriod.String()+" after from"), "to")
+	}
+
+	// make sure the account exists, an unknown account would otherwise have an empty statement
+	dbAccount, err := s.statementDAO.Account(ctx, req.AccountID)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	openingBalance, err := s.statementDAO.OpeningBalance(ctx, req.AccountID, from)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	dbTransactions, err := s.statementDAO.Transactions(ctx, req.AccountID, from, to)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	dbSettlements, err := s.statementDAO.Payments(ctx, req.AccountID, from, to)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	statement := &Statement{
+		AccountID:      dbAccount.ID,
+		Name:           dbAccount.Name,
+		DocumentNumber: dbAccount.DocumentNumber,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		Transactions:   make([]*Line, 0, len(dbTransactions)),
+		Payments:       make([]*Payment, 0, len(dbSettlements)),
+		GeneratedAt:    time.Now(),
+	}
+
+	// every transaction moves the balance of the account by its amount
+	runningBalance := openingBalance
+	for _, dbTransaction := range dbTransactions {
+		runningBalance += dbTransaction.Amount
+		if dbTransaction.Amount < 0 {
+			statement.TotalDebits += dbTransaction.Amount
+		} else {
+			statement.TotalCredits += dbTransaction.Amount
+		}
+		statement.Transactions = append(statement.Transactions, MapEntTransactionToLine(dbTransaction, runningBalance))
+	}
+	statement.ClosingBalance = runningBalance
+
+	for _, dbSettlement := range dbSettlements {
+		if dbSettlement.ReversedAt == nil {
+			statement.PaymentsApplied += dbSettlement.Amount
+		}
+		statement.Payments = append(statement.Payments, MapEntSettlementToPayment(dbSettlement))
+	}
+
+	return statement, nil
+}
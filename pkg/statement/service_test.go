@@ -0,0 +1,131 @@
+package statement_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/statement"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestServiceGet(t *testing.T) {
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := statement.NewService(mocks.NewMockStatementDAO(t), zap.NewNop())
+
+		for _, req := range []*statement.Request{
+			{},
+			{AccountID: 1},
+			{AccountID: 1, Month: "september"},
+			{AccountID: 1, Month: "2026-09", From: "2026-09-01T00:00:00Z"},
+			{AccountID: 1, From: "2026-09-01T00:00:00Z"},
+			{AccountID: 1, Month: "2026-09", Format: "xml"},
+			// the period is empty or too long
+			{AccountID: 1, From: "2026-09-01T00:00:00Z", To: "2026-09-01T00:00:00Z"},
+			{AccountID: 1, From: "2025-01-01T00:00:00Z", To: "2026-09-01T00:00:00Z"},
+		} {
+			resp, err := service.Get(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		t.Parallel()
+		statementDAO := mocks.NewMockStatementDAO(t)
+
+		service := statement.NewService(statementDAO, zap.NewNop())
+
+		statementDAO.On("Account", mock.Anything, 373).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Get(context.Background(), &statement.Request{AccountID: 373, Month: "2026-09"})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		t.Parallel()
+		statementDAO := mocks.NewMockStatementDAO(t)
+
+		service := statement.NewService(statementDAO, zap.NewNop())
+
+		from := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+		statementDAO.On("Account", mock.Anything, 373).Return(&ent.Account{ID: 373}, nil)
+		statementDAO.On("OpeningBalance", mock.Anything, 373, from).Return(money.Amount(0), fmt.Errorf("some error"))
+
+		resp, err := service.Get(context.Background(), &statement.Request{AccountID: 373, Month: "2026-09"})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		statementDAO := mocks.NewMockStatementDAO(t)
+
+		service := statement.NewService(statementDAO, zap.NewNop())
+
+		from := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 1, 0)
+		reversedAt := from.Add(time.Hour * 3)
+
+		statementDAO.On("Account", mock.Anything, 373).Return(&ent.Account{ID: 373, Name: "John Doe", DocumentNumber: "12345"}, nil)
+		statementDAO.On("OpeningBalance", mock.Anything, 373, from).Return(money.MustParse("-100"), nil)
+		statementDAO.On("Transactions", mock.Anything, 373, from, to).Return([]*ent.Transaction{
+			{ID: 1, Amount: money.MustParse("-50"), Balance: 0, Status: transaction.StatusPosted, Edges: ent.TransactionEdges{
+				OperationType: &ent.OperationType{Description: "Normal Purchase"},
+			}},
+			{ID: 2, Amount: money.MustParse("120"), Balance: 0, Status: transaction.StatusPosted},
+			{ID: 3, Amount: money.MustParse("-20.5"), Balance: money.MustParse("-20.5"), Status: transaction.StatusPosted},
+		}, nil)
+		statementDAO.On("Payments", mock.Anything, 373, from, to).Return([]*ent.Settlement{
+			{ID: 1, CreditTxnID: 2, DebitTxnID: 1, Amount: money.MustParse("50")},
+			{ID: 2, CreditTxnID: 2, DebitTxnID: 9, Amount: money.MustParse("70")},
+			{ID: 3, CreditTxnID: 2, DebitTxnID: 8, Amount: money.MustParse("5"), ReversedAt: &reversedAt},
+		}, nil)
+
+		resp, err := service.Get(context.Background(), &statement.Request{AccountID: 373, Month: "2026-09"})
+
+		require.NoError(t, err)
+		require.Equal(t, 373, resp.AccountID)
+		require.Equal(t, "John Doe", resp.Name)
+		require.Equal(t, from, resp.From)
+		require.Equal(t, to, resp.To)
+		require.Equal(t, money.MustParse("-100"), resp.OpeningBalance)
+		require.Equal(t, money.MustParse("-70.5"), resp.TotalDebits)
+		require.Equal(t, money.MustParse("120"), resp.TotalCredits)
+		require.Equal(t, money.MustParse("120"), resp.PaymentsApplied)
+		require.Equal(t, money.MustParse("-50.5"), resp.ClosingBalance)
+
+		require.Len(t, resp.Transactions, 3)
+		require.Equal(t, "Normal Purchase", resp.Transactions[0].OperationType)
+		require.Equal(t, money.MustParse("-150"), resp.Transactions[0].RunningBalance)
+		require.Equal(t, money.MustParse("-30"), resp.Transactions[1].RunningBalance)
+		require.Equal(t, money.MustParse("-50.5"), resp.Transactions[2].RunningBalance)
+		require.Equal(t, money.MustParse("-20.5"), resp.Transactions[2].Balance)
+
+		require.Len(t, resp.Payments, 3)
+		require.Equal(t, &reversedAt, resp.Payments[2].ReversedAt)
+	})
+}
@@ -0,0 +1,57 @@
+package statement
+
+import (
+	"context"
+	"transactor-server/pkg/config"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// tracedService is a middleware/wrapper to the statement.Service
+// it like name suggests adds a span to the each of the methods
+// on error it ensures that span is marked with error
+// it also logs request and response and error if any
+type tracedService struct {
+	service Service
+	logger  *zap.Logger
+}
+
+func NewTracedService(service Service, logger *zap.Logger) Service {
+	return &tracedService{
+		service: service,
+		logger:  logger,
+	}
+}
+
+var _ Service = (*tracedService)(nil)
+
+func (t *tracedService) Get(ctx context.Context, req *Request) (resp *Statement, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "StatementService.Get")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling StatementService.Get", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	resp, err = t.service.Get(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end StatementService.Get with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		// a statement can have a lot of transactions so only its size is logged
+		t.logger.Info("end StatementService.Get", zapotlp.SpanCtx(ctx), zap.Any("req", req),
+			zap.Int("transactions", len(resp.Transactions)), zap.Int("payments", len(resp.Payments)))
+	}
+
+	return
+}
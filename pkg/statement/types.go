@@ -0,0 +1,109 @@
+package statement
+
+import (
+	"time"
+	"transactor-server/pkg/money"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// formats a statement can be rendered in
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+	FormatPDF  = "pdf"
+)
+
+// MaxPeriod is the longest billing period a statement can cover
+const MaxPeriod = 366 * 24 * time.Hour
+
+// monthLayout is the layout of the month query param, like 2026-09
+const monthLayout = "2006-01"
+
+// Request asks for the statement of an account for a billing period
+// the period is either a calendar month in UTC or the from & to timestamps
+type Request struct {
+	AccountID int    `query:"-"`
+	Month     string `query:"month"`
+	From      string `query:"from"`
+	To        string `query:"to"`
+	// Format is json (default), csv or pdf
+	Format string `query:"format"`
+}
+
+// Validate validates the Request to
+// have +ve account id,
+// have either a month like 2026-09 or from & to as RFC3339 timestamps
+// and have format as json, csv or pdf
+func (req Request) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Min(1)),
+		validation.Field(&req.Month,
+			validation.Date(monthLayout).Error("must be a month like 2026-09"),
+			validation.When(req.From != "" || req.To != "", validation.Empty.Error("must be empty when from & to are sent")),
+		),
+		validation.Field(&req.From, validation.When(req.Month == "", validation.Required), validation.Date(time.RFC3339)),
+		validation.Field(&req.To, validation.When(req.Month == "", validation.Required), validation.Date(time.RFC3339)),
+		validation.Field(&req.Format, validation.In(FormatJSON, FormatCSV, FormatPDF)),
+	)
+}
+
+// period returns the billing period [from, to) of a validated request
+func (req Request) period() (from time.Time, to time.Time) {
+	if req.Month != "" {
+		from, _ = time.Parse(monthLayout, req.Month)
+		return from, from.AddDate(0, 1, 0)
+	}
+	from, _ = time.Parse(time.RFC3339, req.From)
+	to, _ = time.Parse(time.RFC3339, req.To)
+	return from, to
+}
+
+// Statement is what an account owed at the start & end of a billing period and everything which happened in between
+// balances are +ve when the account is in credit and -ve when it owes
+type Statement struct {
+	AccountID      int       `json:"account_id"`
+	Name           string    `json:"name"`
+	DocumentNumber string    `json:"document_number"`
+	From           time.Time `json:"from"`
+	To             time.Time `json:"to"`
+	// OpeningBalance is the net of every transaction before From
+	OpeningBalance money.Amount `json:"opening_balance" swaggertype:"number" example:"-100"`
+	TotalDebits    money.Amount `json:"total_debits" swaggertype:"number" example:"-98.75"`
+	TotalCredits   money.Amount `json:"total_credits" swaggertype:"number" example:"150"`
+	// PaymentsApplied is how much of the debits were paid by credits in the period, leaving out undone payments
+	PaymentsApplied money.Amount `json:"payments_applied" swaggertype:"number" example:"150"`
+	// ClosingBalance is OpeningBalance plus the transactions of the period
+	ClosingBalance money.Amount `json:"closing_balance" swaggertype:"number" example:"-48.75"`
+	Transactions   []*Line      `json:"transactions"`
+	Payments       []*Payment   `json:"payments"`
+	GeneratedAt    time.Time    `json:"generated_at"`
+}
+
+// Line is a transaction on a statement
+// installments are not listed on their own, their purchase is listed with its full amount
+type Line struct {
+	TransactionID   int          `json:"transaction_id"`
+	Timestamp       time.Time    `json:"timestamp"`
+	OperationTypeID int          `json:"operation_type_id"`
+	OperationType   string       `json:"operation_type" example:"Normal Purchase"`
+	Description     *string      `json:"description,omitempty"`
+	MerchantName    *string      `json:"merchant_name,omitempty"`
+	Amount          money.Amount `json:"amount" swaggertype:"number" example:"-98.75"`
+	// Balance is what is still open of the transaction right now, see transaction.Transaction
+	Balance money.Amount `json:"balance" swaggertype:"number" example:"-18.75"`
+	// RunningBalance is the balance of the account right after this transaction
+	RunningBalance money.Amount `json:"running_balance" swaggertype:"number" example:"-198.75"`
+	Status         string       `json:"status" example:"posted"`
+}
+
+// Payment is a credit paying a debit of the account in the period
+type Payment struct {
+	SettlementID        int          `json:"settlement_id"`
+	Timestamp           time.Time    `json:"timestamp"`
+	CreditTransactionID int          `json:"credit_transaction_id"`
+	DebitTransactionID  int          `json:"debit_transaction_id"`
+	Amount              money.Amount `json:"amount" swaggertype:"number" example:"18.75"`
+	// ReversedAt is set when the payment was undone by reversing the credit or the debit
+	ReversedAt *time.Time `json:"reversed_at,omitempty"`
+}
@@ -0,0 +1,152 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// heartbeatInterval is how often an idle stream sends a comment, so proxies keep it open and a client which is gone is noticed
+const heartbeatInterval = 15 * time.Second
+
+// API is the api handler for the event stream apis
+type API struct {
+	sevice Service
+
+	// done is closed to end every open stream, so the server can shut down
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+		done:   make(chan struct{}),
+	}
+}
+
+// HandleAccount sets up the routes of the event stream of an account with their handler funcs, it is mounted on the accounts
+func (a *API) HandleAccount(router fiber.Router) {
+	router.Get("/:id/events", a.streamEvents)
+}
+
+// Close ends every open stream, clients reconnect with the Last-Event-ID they got and miss nothing
+func (a *API) Close() {
+	a.closeOnce.Do(func() {
+		close(a.done)
+	})
+}
+
+// streamEvents streams the events of an account as server-sent events
+// @Summary      stream the events of an account
+// @Description  every TransactionCreated & BalanceChanged event of the account is sent as a server-sent event with its id, type and the event as JSON data.
+// @Description  a stream starts with the next new event, or right after the event of the Last-Event-ID header or last_event_id query param, so a client which reconnects misses nothing
+// @Produce      text/event-stream
+// @Tags		 stream
+// @Param        id             path     int  true   "account id"
+// @Param        Last-Event-ID  header   int  false  "the id of the last event the client got"
+// @Param        last_event_id  query    int  false  "the same as the Last-Event-ID header, which wins when both are sent"
+// @Success      200  {object}  outbox.Event
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/accounts/{id}/events [get]
+func (a *API) streamEvents(c *fiber.Ctx) error {
+	// we try to parse the account id to an int
+	accountID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	req := &SubscribeRequest{
+		AccountID: accountID,
+	}
+
+	// browsers send the header on their own when they reconnect, the query param is for the first connect
+	lastEventID := c.Get("Last-Event-ID", c.Query("last_event_id"))
+	if lastEventID != "" {
+		id, err := strconv.Atoi(lastEventID)
+		if err != nil {
+			return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "last event id must be an integer")
+		}
+		req.LastEventID = &id
+	}
+
+	// the stream outlives this handler, so it is cancelled once the stream writer returns instead
+	ctx, cancel := context.WithCancel(context.WithoutCancel(c.UserContext()))
+
+	// call the service to open the stream
+	events, err := a.sevice.Subscribe(ctx, req)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// nginx would buffer the stream otherwise
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Status(http.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		// a first comment so the client knows the stream is open before any event happened
+		_, _ = w.WriteString(": connected\n\n")
+		if w.Flush() != nil {
+			return
+		}
+
+		for {
+			select {
+			case <-a.done:
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				// a client which is gone fails the flush
+				if writeEvent(w, event) != nil {
+					return
+				}
+			case <-heartbeat.C:
+				_, _ = w.WriteString(": heartbeat\n\n")
+				if w.Flush() != nil {
+					return
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+// writeEvent writes event as a server-sent event and flushes it
+// the JSON of the event has no new lines, so it fits in a single data line
+func writeEvent(w *bufio.Writer, event *outbox.Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, b)
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,160 @@
+package stream_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/stream"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var setupApp = func(t *testing.T, service stream.Service) (*fiber.App, *stream.API) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	api := stream.NewAPI(service)
+	api.HandleAccount(app.Group("/test/accounts"))
+
+	return app, api
+}
+
+// streamed returns a closed stream which had events
+func streamed(events ...*outbox.Event) <-chan *outbox.Event {
+	ch := make(chan *outbox.Event, len(events))
+	for _, event := range events {
+		ch <- event
+	}
+	close(ch)
+	return ch
+}
+
+func TestAPIStreamEvents(t *testing.T) {
+	t.Run("id not int", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t, mocks.NewMockStreamService(t))
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/test/accounts/abc/events", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("last event id not int", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t, mocks.NewMockStreamService(t))
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/1/events", nil)
+		req.Header.Set("Last-Event-ID", "abc")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		service := mocks.NewMockStreamService(t)
+		app, _ := setupApp(t, service)
+
+		service.On("Subscribe", mock.Anything, &stream.SubscribeRequest{AccountID: 3}).
+			Return(nil, pkgerr.NewServiceError("db", "not_found", http.StatusNotFound, "ent: account not found"))
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/test/accounts/3/events", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		service := mocks.NewMockStreamService(t)
+		app, _ := setupApp(t, service)
+
+		// the header wins over the query param
+		lastEventID := 41
+		service.On("Subscribe", mock.Anything, &stream.SubscribeRequest{AccountID: 1, LastEventID: &lastEventID}).
+			Return(streamed(
+				&outbox.Event{ID: 42, Type: outbox.EventTransactionCreated, AccountID: 1, AggregateID: 7, Payload: []byte(`{"id":7}`)},
+				&outbox.Event{ID: 43, Type: outbox.EventBalanceChanged, AccountID: 1, AggregateID: 1, Payload: []byte(`{"net_balance":-10}`)},
+			), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/1/events?last_event_id=3", nil)
+		req.Header.Set("Last-Event-ID", "41")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		blocks := strings.Split(strings.TrimSpace(string(b)), "\n\n")
+		require.Len(t, blocks, 3)
+		require.Equal(t, ": connected", blocks[0])
+		require.True(t, strings.HasPrefix(blocks[1], "id: 42\nevent: TransactionCreated\ndata: {\"id\":42,"), blocks[1])
+		require.Contains(t, blocks[1], `"payload":{"id":7}`)
+		require.True(t, strings.HasPrefix(blocks[2], "id: 43\nevent: BalanceChanged\ndata: "), blocks[2])
+	})
+
+	t.Run("query param", func(t *testing.T) {
+		t.Parallel()
+		service := mocks.NewMockStreamService(t)
+		app, _ := setupApp(t, service)
+
+		lastEventID := 3
+		service.On("Subscribe", mock.Anything, &stream.SubscribeRequest{AccountID: 1, LastEventID: &lastEventID}).
+			Return(streamed(), nil)
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/test/accounts/1/events?last_event_id=3", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestAPIStreamEventsLive(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	service := stream.NewService(stream.NewDAO(client), zap.NewNop(), stream.WithPollInterval(10*time.Millisecond))
+	app, streamAPI := setupApp(t, service)
+
+	writeEvent(t, client, outbox.EventTransactionCreated, 1, 7)
+	writeEvent(t, client, outbox.EventTransactionCreated, 2, 8)
+	writeEvent(t, client, outbox.EventBalanceChanged, 1, 1)
+
+	// closing the api ends the stream, which otherwise stays open for new events
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		streamAPI.Close()
+	}()
+
+	req := httptest.NewRequest(http.MethodGet, "/test/accounts/1/events", nil)
+	req.Header.Set("Last-Event-ID", "0")
+
+	resp, err := app.Test(req, 5000)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Contains(t, string(b), "id: 1\nevent: TransactionCreated\n")
+	require.NotContains(t, string(b), "id: 2\n")
+	require.Contains(t, string(b), "id: 3\nevent: BalanceChanged\n")
+}
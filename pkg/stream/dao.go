@@ -0,0 +1,73 @@
+package stream
+
+import (
+	"context"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/outboxevent"
+	"transactor-server/pkg/outbox"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// EventTypes are the outbox event types which are streamed
+var EventTypes = []string{outbox.EventTransactionCreated, outbox.EventBalanceChanged}
+
+// DAO defines the data access object interface for the streamed outbox_event models
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockStreamDAO --filename stream_dao.go
+type DAO interface {
+	// Account returns the account with id, so a stream of an account which does not exist is not found
+	Account(ctx context.Context, id int) (*ent.Account, error)
+	// LastEventID returns the id of the latest streamed event of an account, 0 when it has none
+	LastEventID(ctx context.Context, accountID int) (int, error)
+	// Events returns at most limit streamed events of an account after the event with afterID in id order
+	Events(ctx context.Context, accountID int, afterID int, limit int) ([]*ent.OutboxEvent, error)
+}
+
+type dao struct {
+	entClient *ent.Client
+}
+
+var _ DAO = (*dao)(nil)
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client) DAO {
+	return &dao{
+		entClient: entClient,
+	}
+}
+
+func (d *dao) Account(ctx context.Context, id int) (*ent.Account, error) {
+	return d.entClient.Account.Get(ctx, id)
+}
+
+func (d *dao) LastEventID(ctx context.Context, accountID int) (int, error) {
+	dbEvent, err := d.entClient.OutboxEvent.
+		Query().
+		Where(
+			outboxevent.AccountID(accountID),
+			outboxevent.TypeIn(EventTypes...),
+		).
+		Order(outboxevent.ByID(sql.OrderDesc())).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return dbEvent.ID, nil
+}
+
+func (d *dao) Events(ctx context.Context, accountID int, afterID int, limit int) ([]*ent.OutboxEvent, error) {
+	return d.entClient.OutboxEvent.
+		Query().
+		Where(
+			outboxevent.AccountID(accountID),
+			outboxevent.TypeIn(EventTypes...),
+			outboxevent.IDGT(afterID),
+		).
+		Order(outboxevent.ByID()).
+		Limit(limit).
+		All(ctx)
+}
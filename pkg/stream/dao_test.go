@@ -0,0 +1,75 @@
+package stream_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/stream"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// writeEvent adds an event of an account to the outbox in its own transaction
+func writeEvent(t *testing.T, client *ent.Client, eventType string, accountID int, aggregateID int) {
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, outbox.Write(ctx, tx, eventType, accountID, aggregateID, map[string]int{"id": aggregateID}))
+	require.NoError(t, tx.Commit())
+}
+
+func setupDB(t *testing.T) *ent.Client {
+	// a file backed database so that the streams polling in the background see the same data
+	dsn := fmt.Sprintf("file:%s?_fk=1&_busy_timeout=5000", filepath.Join(t.TempDir(), "ent.db"))
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(context.Background())
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(context.Background())
+	return client
+}
+
+func TestDAO(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+	dao := stream.NewDAO(client)
+
+	_, err := dao.Account(ctx, 3)
+	require.True(t, ent.IsNotFound(err))
+
+	lastEventID, err := dao.LastEventID(ctx, 1)
+	require.NoError(t, err)
+	require.Zero(t, lastEventID)
+
+	writeEvent(t, client, outbox.EventAccountCreated, 1, 1)
+	writeEvent(t, client, outbox.EventTransactionCreated, 1, 7)
+	writeEvent(t, client, outbox.EventBalanceChanged, 1, 1)
+	writeEvent(t, client, outbox.EventTransactionCreated, 2, 8)
+	writeEvent(t, client, outbox.EventTransactionCreated, 1, 9)
+	writeEvent(t, client, outbox.EventAccountCreated, 1, 1)
+
+	// only the transaction & balance events of the account are streamed
+	lastEventID, err = dao.LastEventID(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 5, lastEventID)
+
+	dbEvents, err := dao.Events(ctx, 1, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, dbEvents, 3)
+	require.Equal(t, []int{2, 3, 5}, []int{dbEvents[0].ID, dbEvents[1].ID, dbEvents[2].ID})
+
+	dbEvents, err = dao.Events(ctx, 1, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, dbEvents, 1)
+	require.Equal(t, outbox.EventBalanceChanged, dbEvents[0].Type)
+
+	dbEvents, err = dao.Events(ctx, 1, 5, 10)
+	require.NoError(t, err)
+	require.Empty(t, dbEvents)
+}
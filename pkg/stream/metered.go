@@ -0,0 +1,57 @@
+package stream
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+	"transactor-server/pkg/outbox"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the stream.Service
+// it like name suggests adds metrics to each service call
+// on success it adds stream_service_<method>_success and on failure stream_service_<method>_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	subscribeCounterSuccess metric.Int64Counter
+	subscribeCounterFailure metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	subscribeCounterSuccess, err := meter.Int64Counter("stream_service_subscribe_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	subscribeCounterFailure, err := meter.Int64Counter("stream_service_subscribe_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:                 service,
+		meter:                   meter,
+		subscribeCounterSuccess: subscribeCounterSuccess,
+		subscribeCounterFailure: subscribeCounterFailure,
+	}
+}
+
+func (m *meteredSevice) Subscribe(ctx context.Context, req *SubscribeRequest) (resp <-chan *outbox.Event, err error) {
+	defer func() {
+		if err == nil {
+			m.subscribeCounterSuccess.Add(ctx, 1)
+		} else {
+			m.subscribeCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Subscribe(ctx, req)
+	return
+}
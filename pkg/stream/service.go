@@ -0,0 +1,131 @@
+package stream
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/pkgerr"
+
+	"go.uber.org/zap"
+)
+
+// DefaultPollInterval is how often a stream looks for new events unless configured otherwise
+const DefaultPollInterval = 500 * time.Millisecond
+
+// batchSize is how many events a stream reads at a time
+const batchSize = 100
+
+// Service handles the main business logic for the event streams of the accounts
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockStreamService  --filename stream_service.go
+type Service interface {
+	// Subscribe streams the TransactionCreated & BalanceChanged events of an account in order
+	// it sends every event after the last event id of the request till ctx is done and then closes the channel
+	Subscribe(context.Context, *SubscribeRequest) (<-chan *outbox.Event, error)
+}
+
+type service struct {
+	streamDAO DAO
+
+	pollInterval time.Duration
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+// ServiceOption configures the Service returned by NewService
+type ServiceOption func(*service)
+
+// WithPollInterval sets how often a stream looks for new events, it defaults to DefaultPollInterval
+func WithPollInterval(pollInterval time.Duration) ServiceOption {
+	return func(s *service) {
+		if pollInterval > 0 {
+			s.pollInterval = pollInterval
+		}
+	}
+}
+
+func NewService(
+	streamDAO DAO,
+
+	logger *zap.Logger,
+	opts ...ServiceOption,
+) Service {
+	s := &service{
+		streamDAO: streamDAO,
+
+		pollInterval: DefaultPollInterval,
+
+		logger: logger,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) Subscribe(ctx context.Context, req *SubscribeRequest) (<-chan *outbox.Event, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	_, err = s.streamDAO.Account(ctx, req.AccountID)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	// without a last event id only the events which happen from now on are sent
+	var lastEventID int
+	if req.LastEventID != nil {
+		lastEventID = *req.LastEventID
+	} else {
+		lastEventID, err = s.streamDAO.LastEventID(ctx, req.AccountID)
+		if err != nil {
+			return nil, pkgerr.WrapDAOError(err)
+		}
+	}
+
+	events := make(chan *outbox.Event)
+	go s.poll(ctx, req.AccountID, lastEventID, events)
+	return events, nil
+}
+
+// poll sends the events of an account after lastEventID to events every poll interval till ctx is done
+// the events of an account are written under its row lock, so they commit in id order and none is skipped by reading after the last id
+func (s *service) poll(ctx context.Context, accountID int, lastEventID int, events chan<- *outbox.Event) {
+	defer close(events)
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		dbEvents, err := s.streamDAO.Events(ctx, accountID, lastEventID, batchSize)
+		if err != nil && ctx.Err() == nil {
+			// the stream stays open and tries again on the next tick
+			s.logger.Error("failed to read the events of the stream", zap.Int("account_id", accountID), zap.Error(err))
+		}
+
+		for _, dbEvent := range dbEvents {
+			select {
+			case <-ctx.Done():
+				return
+			case events <- outbox.MapEntOutboxEventToEvent(dbEvent):
+				lastEventID = dbEvent.ID
+			}
+		}
+
+		// a full batch means there are more events already, so they are read right away
+		if len(dbEvents) == batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
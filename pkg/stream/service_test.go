@@ -0,0 +1,111 @@
+package stream_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/stream"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// receive waits for the next event of a stream
+func receive(t *testing.T, events <-chan *outbox.Event) *outbox.Event {
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "stream closed")
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event streamed")
+		return nil
+	}
+}
+
+func TestServiceSubscribe(t *testing.T) {
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+		service := stream.NewService(mocks.NewMockStreamDAO(t), zap.NewNop())
+
+		for _, req := range []*stream.SubscribeRequest{
+			{AccountID: 0},
+			{AccountID: 1, LastEventID: func() *int { id := -1; return &id }()},
+		} {
+			_, err := service.Subscribe(context.Background(), req)
+			_, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok, req)
+		}
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		t.Parallel()
+		streamDAO := mocks.NewMockStreamDAO(t)
+		service := stream.NewService(streamDAO, zap.NewNop())
+
+		streamDAO.On("Account", mock.Anything, 3).Return(nil, &ent.NotFoundError{})
+
+		_, err := service.Subscribe(context.Background(), &stream.SubscribeRequest{AccountID: 3})
+
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("new events", func(t *testing.T) {
+		t.Parallel()
+		client := setupDB(t)
+		service := stream.NewService(stream.NewDAO(client), zap.NewNop(), stream.WithPollInterval(10*time.Millisecond))
+
+		writeEvent(t, client, outbox.EventTransactionCreated, 1, 7)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		events, err := service.Subscribe(ctx, &stream.SubscribeRequest{AccountID: 1})
+		require.NoError(t, err)
+
+		// the events from before the stream was opened are not sent
+		writeEvent(t, client, outbox.EventTransactionCreated, 2, 8)
+		writeEvent(t, client, outbox.EventTransactionCreated, 1, 9)
+		writeEvent(t, client, outbox.EventBalanceChanged, 1, 1)
+
+		event := receive(t, events)
+		require.Equal(t, outbox.EventTransactionCreated, event.Type)
+		require.Equal(t, 9, event.AggregateID)
+		require.Equal(t, outbox.EventBalanceChanged, receive(t, events).Type)
+
+		// the stream closes once its context is done
+		cancel()
+		require.Eventually(t, func() bool {
+			_, ok := <-events
+			return !ok
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		t.Parallel()
+		client := setupDB(t)
+		service := stream.NewService(stream.NewDAO(client), zap.NewNop(), stream.WithPollInterval(10*time.Millisecond))
+
+		writeEvent(t, client, outbox.EventTransactionCreated, 1, 7)
+		writeEvent(t, client, outbox.EventBalanceChanged, 1, 1)
+		writeEvent(t, client, outbox.EventTransactionCreated, 1, 8)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		lastEventID := 1
+		events, err := service.Subscribe(ctx, &stream.SubscribeRequest{AccountID: 1, LastEventID: &lastEventID})
+		require.NoError(t, err)
+
+		// it goes on right after the last event the client got
+		require.Equal(t, 2, receive(t, events).ID)
+		require.Equal(t, 3, receive(t, events).ID)
+
+		writeEvent(t, client, outbox.EventBalanceChanged, 1, 1)
+		require.Equal(t, 4, receive(t, events).ID)
+	})
+}
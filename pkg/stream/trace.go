@@ -0,0 +1,58 @@
+package stream
+
+import (
+	"context"
+	"transactor-server/pkg/config"
+	"transactor-server/pkg/outbox"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// tracedService is a middleware/wrapper to the stream.Service
+// it like name suggests adds a span to the each of the methods
+// on error it ensures that span is marked with error
+// it also logs request and response and error if any
+// the span of Subscribe only covers opening the stream, not the events sent on it
+type tracedService struct {
+	service Service
+	logger  *zap.Logger
+}
+
+func NewTracedService(service Service, logger *zap.Logger) Service {
+	return &tracedService{
+		service: service,
+		logger:  logger,
+	}
+}
+
+var _ Service = (*tracedService)(nil)
+
+func (t *tracedService) Subscribe(ctx context.Context, req *SubscribeRequest) (resp <-chan *outbox.Event, err error) {
+	// start span
+	spanCtx, span := otel.Tracer(config.AppName).Start(ctx, "StreamService.Subscribe")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling StreamService.Subscribe", zapotlp.SpanCtx(spanCtx), zap.Any("req", req))
+
+	// the stream outlives the span, so it gets the context it was opened with
+	resp, err = t.service.Subscribe(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end StreamService.Subscribe with error", zapotlp.SpanCtx(spanCtx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end StreamService.Subscribe", zapotlp.SpanCtx(spanCtx), zap.Any("req", req))
+	}
+
+	return
+}
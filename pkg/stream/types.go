@@ -0,0 +1,22 @@
+package stream
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// SubscribeRequest opens the event stream of an account
+type SubscribeRequest struct {
+	AccountID int
+	// LastEventID resumes the stream after this event, the stream starts with the next new event when it is nil
+	LastEventID *int
+}
+
+// Validate validates the SubscribeRequest to
+// have a +ve account id
+// and a last event id which is not -ve
+func (req SubscribeRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Required, validation.Min(1)),
+		validation.Field(&req.LastEventID, validation.Min(0)),
+	)
+}
@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// names of the allocation strategies which can be selected from config
+const (
+	AllocationFIFO                  = "fifo"
+	AllocationHighestAmountFirst    = "highest_amount_first"
+	AllocationInstallmentsLast      = "installments_last"
+	AllocationOperationTypePriority = "operation_type_priority"
+)
+
+// AllocationStrategy decides the order in which the open debits of an account are paid by a credit
+type AllocationStrategy interface {
+	// Name returns the config name of the strategy
+	Name() string
+	// OrderBy returns the SQL order by terms over the columns of the transactions table
+	// the debit which comes first is paid first, the terms always end with id so the order is total
+	OrderBy() string
+}
+
+type allocationStrategy struct {
+	name    string
+	orderBy string
+}
+
+func (a *allocationStrategy) Name() string {
+	return a.name
+}
+
+func (a *allocationStrategy) OrderBy() string {
+	return a.orderBy
+}
+
+// FIFO pays the oldest debit first, this is the default
+func FIFO() AllocationStrategy {
+	return &allocationStrategy{
+		name:    AllocationFIFO,
+		orderBy: "id",
+	}
+}
+
+// HighestAmountFirst pays the debit with the biggest purchase amount first, oldest first between equal amounts
+func HighestAmountFirst() AllocationStrategy {
+	return &allocationStrategy{
+		name: AllocationHighestAmountFirst,
+		// debits are -ve so the biggest one is the smallest number
+		orderBy: "amount, id",
+	}
+}
+
+// InstallmentsLast pays every other debit before the installment purchases, oldest first otherwise
+func InstallmentsLast() AllocationStrategy {
+	return &allocationStrategy{
+		name:    AllocationInstallmentsLast,
+		orderBy: fmt.Sprintf("CASE WHEN operation_type_id = %d THEN 1 ELSE 0 END, id", installmentOperationTypeID),
+	}
+}
+
+// OperationTypePriority pays the debits in the order of their operation type in operationTypeIDs
+// operation types which are not listed are paid last, oldest first otherwise
+func OperationTypePriority(operationTypeIDs ...int) AllocationStrategy {
+	// the ids are ints so they are safe to put in the query as is
+	var b strings.Builder
+	b.WriteString("CASE operation_type_id")
+	for priority, operationTypeID := range operationTypeIDs {
+		b.WriteString(" WHEN " + strconv.Itoa(operationTypeID) + " THEN " + strconv.Itoa(priority))
+	}
+	b.WriteString(" ELSE " + strconv.Itoa(len(operationTypeIDs)) + " END, id")
+
+	return &allocationStrategy{
+		name:    AllocationOperationTypePriority,
+		orderBy: b.String(),
+	}
+}
+
+// NewAllocationStrategy returns the strategy with the given config name, an empty name means FIFO
+// operationTypePriority is only used by the operation_type_priority strategy
+func NewAllocationStrategy(name string, operationTypePriority []int) (AllocationStrategy, error) {
+	switch name {
+	case "", AllocationFIFO:
+		return FIFO(), nil
+	case AllocationHighestAmountFirst:
+		return HighestAmountFirst(), nil
+	case AllocationInstallmentsLast:
+		return InstallmentsLast(), nil
+	case AllocationOperationTypePriority:
+		if len(operationTypePriority) == 0 {
+			return nil, fmt.Errorf("allocation strategy %q needs at least one operation type", name)
+		}
+		return OperationTypePriority(operationTypePriority...), nil
+	default:
+		return nil, fmt.Errorf("unknown allocation strategy %q", name)
+	}
+}
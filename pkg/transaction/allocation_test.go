@@ -0,0 +1,51 @@
+package transaction_test
+
+import (
+	"testing"
+	"transactor-server/pkg/transaction"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAllocationStrategy(t *testing.T) {
+	t.Run("defaults to fifo", func(t *testing.T) {
+		t.Parallel()
+		strategy, err := transaction.NewAllocationStrategy("", nil)
+		require.NoError(t, err)
+		require.Equal(t, transaction.AllocationFIFO, strategy.Name())
+	})
+
+	t.Run("by name", func(t *testing.T) {
+		t.Parallel()
+		for _, name := range []string{
+			transaction.AllocationFIFO,
+			transaction.AllocationHighestAmountFirst,
+			transaction.AllocationInstallmentsLast,
+			transaction.AllocationOperationTypePriority,
+		} {
+			strategy, err := transaction.NewAllocationStrategy(name, []int{2, 1})
+			require.NoError(t, err)
+			require.Equal(t, name, strategy.Name())
+		}
+	})
+
+	t.Run("operation type priority without operation types", func(t *testing.T) {
+		t.Parallel()
+		_, err := transaction.NewAllocationStrategy(transaction.AllocationOperationTypePriority, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		_, err := transaction.NewAllocationStrategy("lifo", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("operation type priority order", func(t *testing.T) {
+		t.Parallel()
+		require.Equal(t,
+			"CASE operation_type_id WHEN 2 THEN 0 WHEN 1 THEN 1 ELSE 2 END, id",
+			transaction.OperationTypePriority(2, 1).OrderBy(),
+		)
+	})
+}
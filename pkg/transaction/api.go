@@ -1,13 +1,23 @@
 package transaction
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"transactor-server/pkg/pkgerr"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
 )
 
+// mimeApplicationNDJSON is the content type of a bulk request with one transaction per line
+const mimeApplicationNDJSON = "application/x-ndjson"
+
 // API is the api handler for transaction apis
 type API struct {
 	sevice Service
@@ -23,6 +33,15 @@ func NewAPI(service Service) *API {
 // Handle sets up all the routes with their handler funcs for transaction apis
 func (a *API) Handle(router fiber.Router) {
 	router.Post("/", a.createTransaction)
+	router.Post("/bulk", a.createBulkTransactions)
+	router.Get("/:id", a.getTransaction)
+	router.Get("/:id/settlements", a.listTransactionSettlements)
+	router.Post("/:id/reverse", a.reverseTransaction)
+}
+
+// HandleAccount sets up the account scoped transaction routes, the router is expected to be mounted on accounts
+func (a *API) HandleAccount(router fiber.Router) {
+	router.Get("/:id/transactions", a.listAccountTransactions)
 }
 
 // createTransaction creates a new transaction in DB
@@ -30,8 +49,10 @@ func (a *API) Handle(router fiber.Router) {
 // @Produce      json
 // @Tags		 transaction
 // @Param        req    body     CreateRequest  true  "transaction details to create"
+// @Param        Idempotency-Key  header  string  false  "a unique key which makes the request safe to retry"
 // @Success      201  {object}  CreateResponse
 // @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      409  {object}  pkgerr.ServiceErrorResponseBody
 // @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
 // @Security	 ApiKeyAuth
 // @Router       /api/v1/transactions [post]
@@ -54,3 +75,244 @@ func (a *API) createTransaction(c *fiber.Ctx) error {
 	// incase of no error we return the response with 201 status
 	return c.Status(http.StatusCreated).JSON(resp)
 }
+
+// createBulkTransactions creates many transactions in one call
+// the body is either a JSON array of transactions or an NDJSON stream with one transaction per line
+// @Summary      create transactions in bulk
+// @Accept       json
+// @Accept       application/x-ndjson
+// @Produce      json
+// @Tags		 transaction
+// @Param        req    body     []CreateRequest  true  "transactions to create, as a JSON array or one per line as NDJSON"
+// @Param        mode   query    string  false  "atomic (default) books all or none of them, best_effort books each one on its own"  Enums(atomic, best_effort)
+// @Param        Idempotency-Key  header  string  false  "a unique key which makes the request safe to retry"
+// @Success      200  {object}  BulkResponse
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/transactions/bulk [post]
+func (a *API) createBulkTransactions(c *fiber.Ctx) error {
+	req := &BulkRequest{}
+
+	// try to parse the query
+	err := c.QueryParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("transaction", "query_parse_failure", http.StatusBadRequest, err.Error())
+	}
+
+	// try to parse the body, a decoder reads both a JSON array and consecutive NDJSON values
+	req.Items, err = decodeBulkItems(c.Body(), c.Get(fiber.HeaderContentType))
+	if err != nil {
+		return pkgerr.NewServiceError("transaction", "body_parse_failure", http.StatusBadRequest, err.Error())
+	}
+
+	// call the sevice to create the transactions
+	resp, err := a.sevice.CreateBulk(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// the status of each item is in the response so it is always 200
+	return c.JSON(resp)
+}
+
+// decodeBulkItems decodes a JSON array of CreateRequest or, when the content type is NDJSON, one CreateRequest per line
+// items are decoded one by one and it stops once there are more than MaxBulkItems, which is enough for the request to fail validation
+func decodeBulkItems(body []byte, contentType string) ([]*CreateRequest, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+
+	if !strings.HasPrefix(contentType, mimeApplicationNDJSON) {
+		return decodeBulkArray(decoder)
+	}
+
+	var items []*CreateRequest
+	for len(items) <= MaxBulkItems {
+		item := &CreateRequest{}
+		err := decoder.Decode(item)
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", len(items), err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// decodeBulkArray decodes a JSON array of CreateRequest item by item, a null body is no items like json.Unmarshal has it
+func decodeBulkArray(decoder *json.Decoder) ([]*CreateRequest, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, nil
+	}
+	if token != json.Delim('[') {
+		return nil, fmt.Errorf("body must be a JSON array")
+	}
+
+	items := []*CreateRequest{}
+	for decoder.More() {
+		if len(items) > MaxBulkItems {
+			return items, nil
+		}
+
+		var item *CreateRequest
+		err = decoder.Decode(&item)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", len(items), err)
+		}
+		items = append(items, item)
+	}
+
+	// the closing bracket, after which there must be nothing else
+	_, err = decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("body must be a single JSON array")
+	}
+	return items, nil
+}
+
+// getTransaction return an existing transaction detail
+// @Summary      get a transaction
+// @Produce      json
+// @Tags		 transaction
+// @Param        id    path     int  true  "transaction id"
+// @Success      200  {object}  Transaction
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/transactions/{id} [get]
+func (a *API) getTransaction(c *fiber.Ctx) error {
+	idStr := c.Params("id")
+	// technically the id will never be empty bcz empty id means a different route altogether
+	// but just to be safe :)
+	if lo.IsEmpty(idStr) {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable is required")
+	}
+
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to get transaction details
+	resp, err := a.sevice.Get(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// reverseTransaction reverses a transaction with a compensating transaction
+// the settlements of the transaction are undone and it is marked reversed, installments are reversed with their purchase
+// @Summary      reverse a transaction
+// @Produce      json
+// @Tags		 transaction
+// @Param        id    path     int  true  "transaction id"
+// @Param        Idempotency-Key  header  string  false  "a unique key which makes the request safe to retry"
+// @Success      201  {object}  Transaction
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      409  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      422  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/transactions/{id}/reverse [post]
+func (a *API) reverseTransaction(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to reverse the transaction
+	resp, err := a.sevice.Reverse(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error we return the compensating transaction with 201 status
+	return c.Status(http.StatusCreated).JSON(resp)
+}
+
+// listTransactionSettlements returns the settlements of a transaction
+// for a credit these are the debits it paid and for a debit the credits which paid it
+// @Summary      list settlements of a transaction
+// @Produce      json
+// @Tags		 transaction
+// @Param        id    path     int  true  "transaction id"
+// @Success      200  {object}  ListSettlementsResponse
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/transactions/{id}/settlements [get]
+func (a *API) listTransactionSettlements(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to list the settlements
+	resp, err := a.sevice.ListSettlements(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// listAccountTransactions returns a page of transactions of an account, newest first
+// @Summary      list transactions of an account
+// @Produce      json
+// @Tags		 transaction
+// @Param        id                 path     int     true   "account id"
+// @Param        operation_type_id  query    int     false  "only return transactions of this operation type"
+// @Param        from               query    string  false  "only return transactions on or after this RFC3339 timestamp"
+// @Param        to                 query    string  false  "only return transactions before this RFC3339 timestamp"
+// @Param        open_balance       query    bool    false  "only return transactions which still have a balance"
+// @Param        external_reference query    string  false  "only return transactions sent with this external reference"
+// @Param        cursor             query    string  false  "next_cursor returned by the previous page"
+// @Param        limit              query    int     false  "page size, defaults to 20 and can be at most 100"
+// @Success      200  {object}  ListResponse
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/accounts/{id}/transactions [get]
+func (a *API) listAccountTransactions(c *fiber.Ctx) error {
+	// we try to parse the account id to an int
+	accountID, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	req := &ListRequest{}
+
+	// try to parse the query params
+	err = c.QueryParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("transaction", "query_parse_failure", http.StatusBadRequest, err.Error())
+	}
+	req.AccountID = accountID
+
+	// call the service to list the transactions
+	resp, err := a.sevice.List(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
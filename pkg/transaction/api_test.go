@@ -7,20 +7,25 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 	"transactor-server/pkg/api"
 	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
 	"transactor-server/pkg/transaction"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
 )
 
 var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockTransactionService) {
 	app := fiber.New(fiber.Config{
 		ErrorHandler: api.ErrorHandler,
+		BodyLimit:    transaction.MaxBulkBodySize,
 	})
 
 	router := app.Group("/test/transactions")
@@ -46,6 +51,66 @@ func TestAPICreate(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 
+	t.Run("amount precision error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/", bytes.NewBufferString(`{"account_id":373,"operation_type_id":1,"amount":-98.755}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("amount as string", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/", bytes.NewBufferString(`{"account_id":373,"operation_type_id":1,"amount":"-98.75"}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		service.On("Create", mock.Anything, &transaction.CreateRequest{
+			AccountID:       373,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-98.75"),
+		}).Return(&transaction.CreateResponse{
+			ID: 999,
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("event timestamp", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/", bytes.NewBufferString(`{"account_id":373,"operation_type_id":1,"amount":-98.75,"event_timestamp":"2026-10-01T10:00:00Z"}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		eventTimestamp := time.Date(2026, time.October, 1, 10, 0, 0, 0, time.UTC)
+		service.On("Create", mock.Anything, &transaction.CreateRequest{
+			AccountID:       373,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-98.75"),
+			EventTimestamp:  &eventTimestamp,
+		}).Return(&transaction.CreateResponse{
+			ID: 999,
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
 	t.Run("service errpr", func(t *testing.T) {
 		t.Parallel()
 		app, service := setupApp(t)
@@ -53,7 +118,7 @@ func TestAPICreate(t *testing.T) {
 		body := &transaction.CreateRequest{
 			AccountID:       373,
 			OperationTypeID: 1,
-			Amount:          -98.75,
+			Amount:          money.MustParse("-98.75"),
 		}
 
 		b, err := json.Marshal(body)
@@ -80,7 +145,7 @@ func TestAPICreate(t *testing.T) {
 		body := &transaction.CreateRequest{
 			AccountID:       373,
 			OperationTypeID: 1,
-			Amount:          -98.75,
+			Amount:          money.MustParse("-98.75"),
 		}
 
 		b, err := json.Marshal(body)
@@ -110,3 +175,448 @@ func TestAPICreate(t *testing.T) {
 		require.Equal(t, int64(999), gjson.Get(string(b), "id").Int())
 	})
 }
+
+func TestAPIGet(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transactions/abc", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transactions/999", nil)
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		service.On("Get", mock.Anything, 999).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transactions/999", nil)
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		txn := &transaction.Transaction{
+			ID:              999,
+			AccountID:       373,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-98.75"),
+			Balance:         money.MustParse("-18.75"),
+			Timestamp:       time.Now().Add(time.Hour * -24).Truncate(time.Millisecond),
+			CreatedAt:       time.Now().Add(time.Hour * -24).Truncate(time.Millisecond),
+			UpdatedAt:       time.Now().Truncate(time.Millisecond),
+		}
+
+		service.On("Get", mock.Anything, 999).Return(txn, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(999), gjson.Get(string(b), "id").Int())
+		require.Equal(t, int64(373), gjson.Get(string(b), "account_id").Int())
+		require.Equal(t, int64(1), gjson.Get(string(b), "operation_type_id").Int())
+		require.Equal(t, -98.75, gjson.Get(string(b), "amount").Float())
+		require.Equal(t, -18.75, gjson.Get(string(b), "balance").Float())
+
+		timestampStr := gjson.Get(string(b), "timestamp").String()
+		returnedTimestamp, err := time.Parse(time.RFC3339, timestampStr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, txn.Timestamp.UTC(), returnedTimestamp.UTC())
+	})
+}
+
+func TestAPIReverse(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/abc/reverse", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/999/reverse", nil)
+
+		service.On("Reverse", mock.Anything, 999).Return(nil, transaction.ErrAlreadyReversed)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/999/reverse", nil)
+
+		reversalOfID := 999
+		service.On("Reverse", mock.Anything, 999).Return(&transaction.Transaction{
+			ID:              1000,
+			AccountID:       373,
+			OperationTypeID: 5,
+			Amount:          money.MustParse("98.75"),
+			Status:          "posted",
+			ReversalOfID:    &reversalOfID,
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(1000), gjson.Get(string(b), "id").Int())
+		require.Equal(t, int64(999), gjson.Get(string(b), "reversal_of_id").Int())
+		require.Equal(t, 98.75, gjson.Get(string(b), "amount").Float())
+	})
+}
+
+func TestAPIListSettlements(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transactions/abc/settlements", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transactions/999/settlements", nil)
+
+		service.On("ListSettlements", mock.Anything, 999).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transactions/999/settlements", nil)
+
+		service.On("ListSettlements", mock.Anything, 999).Return(&transaction.ListSettlementsResponse{
+			Settlements: []*transaction.Settlement{{
+				ID:                  1,
+				CreditTransactionID: 999,
+				DebitTransactionID:  373,
+				Amount:              money.MustParse("18.75"),
+				Timestamp:           time.Now(),
+			}},
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(1), gjson.Get(string(b), "settlements.#").Int())
+		require.Equal(t, int64(999), gjson.Get(string(b), "settlements.0.credit_transaction_id").Int())
+		require.Equal(t, int64(373), gjson.Get(string(b), "settlements.0.debit_transaction_id").Int())
+		require.Equal(t, 18.75, gjson.Get(string(b), "settlements.0.amount").Float())
+	})
+}
+
+func TestAPIListAccountTransactions(t *testing.T) {
+	setupAccountApp := func(t *testing.T) (*fiber.App, *mocks.MockTransactionService) {
+		app := fiber.New(fiber.Config{
+			ErrorHandler: api.ErrorHandler,
+		})
+
+		router := app.Group("/test/accounts")
+		service := mocks.NewMockTransactionService(t)
+
+		api := transaction.NewAPI(service)
+		api.HandleAccount(router)
+
+		return app, service
+	}
+
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupAccountApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/abc/transactions", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("query parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupAccountApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/transactions?limit=abc", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupAccountApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/transactions", nil)
+
+		service.On("List", mock.Anything, &transaction.ListRequest{AccountID: 373}).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupAccountApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/accounts/373/transactions?operation_type_id=4&from=2024-11-01T00:00:00Z&open_balance=true&external_reference=order-1&cursor=abc&limit=2", nil)
+
+		description := "Groceries"
+		service.On("List", mock.Anything, &transaction.ListRequest{
+			AccountID:         373,
+			OperationTypeID:   4,
+			From:              "2024-11-01T00:00:00Z",
+			OpenBalance:       true,
+			ExternalReference: "order-1",
+			Cursor:            "abc",
+			Limit:             2,
+		}).Return(&transaction.ListResponse{
+			Transactions: []*transaction.Transaction{{ID: 8, Description: &description, Metadata: map[string]string{"store": "42"}}, {ID: 7}},
+			NextCursor:   "def",
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(8), gjson.Get(string(b), "transactions.0.id").Int())
+		require.Equal(t, "Groceries", gjson.Get(string(b), "transactions.0.description").String())
+		require.Equal(t, "42", gjson.Get(string(b), "transactions.0.metadata.store").String())
+		require.Equal(t, int64(7), gjson.Get(string(b), "transactions.1.id").Int())
+		require.False(t, gjson.Get(string(b), "transactions.1.description").Exists())
+		require.Equal(t, "def", gjson.Get(string(b), "next_cursor").String())
+	})
+}
+
+func TestAPICreateBulk(t *testing.T) {
+	t.Run("body parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/bulk", bytes.NewBufferString("something"))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("ndjson parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/bulk", bytes.NewBufferString(
+			`{"account_id":1,"operation_type_id":1,"amount":-10}`+"\n"+`{"account_id":1,"operation_type_id":1,"amount":-10.001}`+"\n",
+		))
+		req.Header.Set(fiber.HeaderContentType, "application/x-ndjson")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Contains(t, gjson.Get(string(b), "msg").String(), "item 1")
+	})
+
+	t.Run("too many items", func(t *testing.T) {
+		t.Parallel()
+		app := fiber.New(fiber.Config{
+			ErrorHandler: api.ErrorHandler,
+			BodyLimit:    transaction.MaxBulkBodySize,
+		})
+		// the service validates the items, so nothing reaches the DAOs
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+		transaction.NewAPI(service).Handle(app.Group("/test/transactions"))
+
+		// more than the default body limit of fiber, which would have rejected the request before it was validated
+		item := fmt.Sprintf(`{"account_id":1,"operation_type_id":1,"amount":-10,"description":%q,"merchant_name":%q}`,
+			strings.Repeat("d", transaction.MaxDescriptionLength), strings.Repeat("m", transaction.MaxMerchantNameLength))
+		body := "[" + strings.TrimSuffix(strings.Repeat(item+",", transaction.MaxBulkItems+1), ",") + "]"
+		require.Greater(t, len(body), fiber.DefaultBodyLimit)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/bulk", bytes.NewBufferString(body))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		require.Equal(t, "the length must be between 1 and 10000", gjson.Get(string(b), "errors.Items").String())
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transactions/bulk", bytes.NewBufferString(`[]`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		service.On("CreateBulk", mock.Anything, &transaction.BulkRequest{Items: []*transaction.CreateRequest{}}).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	for name, body := range map[string]struct {
+		contentType string
+		body        string
+	}{
+		"json array": {
+			fiber.MIMEApplicationJSON,
+			`[{"account_id":1,"operation_type_id":1,"amount":-10},{"account_id":2,"operation_type_id":4,"amount":"20.5"}]`,
+		},
+		"ndjson": {
+			"application/x-ndjson",
+			`{"account_id":1,"operation_type_id":1,"amount":-10}` + "\n" + `{"account_id":2,"operation_type_id":4,"amount":"20.5"}` + "\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			app, service := setupApp(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/test/transactions/bulk?mode=best_effort", bytes.NewBufferString(body.body))
+			req.Header.Set(fiber.HeaderContentType, body.contentType)
+
+			service.On("CreateBulk", mock.Anything, &transaction.BulkRequest{
+				Mode: transaction.BulkModeBestEffort,
+				Items: []*transaction.CreateRequest{
+					{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")},
+					{AccountID: 2, OperationTypeID: 4, Amount: money.MustParse("20.5")},
+				},
+			}).Return(&transaction.BulkResponse{
+				Created: 1,
+				Failed:  1,
+				Results: []*transaction.BulkResult{
+					{Index: 0, Status: transaction.BulkStatusCreated, ID: 7},
+					{Index: 1, Status: transaction.BulkStatusFailed, Error: transaction.ErrInsufficientLimit.ResponseBody()},
+				},
+			}, nil)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			defer resp.Body.Close()
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			require.Equal(t, int64(1), gjson.Get(string(b), "created").Int())
+			require.Equal(t, int64(7), gjson.Get(string(b), "results.0.id").Int())
+			require.Equal(t, "failed", gjson.Get(string(b), "results.1.status").String())
+			require.Equal(t, "insufficient_limit", gjson.Get(string(b), "results.1.error.code").String())
+		})
+	}
+}
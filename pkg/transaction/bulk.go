@@ -0,0 +1,67 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+)
+
+const (
+	// MaxBulkItems is the max number of transactions which can be sent in one bulk request
+	MaxBulkItems = 10000
+	// MaxBulkBodySize is the largest body of a bulk request, which fits MaxBulkItems transactions of 1KB each
+	// that is a transaction with a description, merchant name & some metadata, more of it needs smaller requests
+	MaxBulkBodySize = MaxBulkItems << 10
+)
+
+// modes of a bulk request
+const (
+	// BulkModeAtomic books all the transactions or none of them
+	BulkModeAtomic = "atomic"
+	// BulkModeBestEffort books every transaction on its own and keeps going when one fails
+	BulkModeBestEffort = "best_effort"
+)
+
+// statuses of an item of a bulk request
+const (
+	BulkStatusCreated = "created"
+	BulkStatusFailed  = "failed"
+	// BulkStatusSkipped is used in atomic mode for the items which were not booked because another item failed
+	BulkStatusSkipped = "skipped"
+)
+
+// BulkItemError tells which transaction of a CreateBulk call failed, the whole call was rolled back
+type BulkItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BulkItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BulkItemError) Unwrap() error {
+	return e.Err
+}
+
+func (d *dao) CreateBulk(ctx context.Context, reqs []*CreateRequest, strategy AllocationStrategy) (dbTxns []*ent.Transaction, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		dbTxns = make([]*ent.Transaction, 0, len(reqs))
+		for i, req := range reqs {
+			// every transaction goes through the same locking, limit check & discharge as a single create
+			// the account lock is taken once and held till the end so reqs should be ordered by account
+			dbTxn, err := d.create(ctx, tx, req, strategy)
+			if err != nil {
+				return &BulkItemError{Index: i, Err: err}
+			}
+			dbTxns = append(dbTxns, dbTxn)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbTxns, nil
+}
@@ -0,0 +1,57 @@
+package transaction_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAOCreateBulk(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCreditLimit(money.MustParse("100")).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	dbTxns, err := dao.CreateBulk(ctx, []*transaction.CreateRequest{
+		{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-50")},
+		{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("30")},
+		{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("-10")},
+	}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Len(t, dbTxns, 3)
+
+	// the credit discharged the debit booked before it in the same call
+	require.Equal(t, money.MustParse("-20"), client.Transaction.GetX(ctx, dbTxns[0].ID).Balance)
+	require.Equal(t, money.Amount(0), dbTxns[1].Balance)
+	require.Equal(t, 2, dbTxns[2].AccountID)
+
+	// the third debit takes account 1 past its credit limit, so nothing is booked
+	_, err = dao.CreateBulk(ctx, []*transaction.CreateRequest{
+		{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-20")},
+		{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-20")},
+		{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-60")},
+		{AccountID: 2, OperationTypeID: 4, Amount: money.MustParse("10")},
+	}, transaction.FIFO())
+
+	var itemErr *transaction.BulkItemError
+	require.True(t, errors.As(err, &itemErr))
+	require.Equal(t, 2, itemErr.Index)
+	require.ErrorIs(t, err, transaction.ErrInsufficientLimit)
+
+	require.Equal(t, 3, client.Transaction.Query().CountX(ctx))
+}
@@ -0,0 +1,63 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/fx"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// conversion is what a transaction was sent as before it was converted to the currency of its account
+type conversion struct {
+	amount   money.Amount
+	currency string
+	rate     string
+}
+
+// inAccountCurrency returns a copy of req in the currency of the account, converted with an fx rate when it was sent in another one
+// it must be called inside tx after the account is locked so every amount of the transaction is in the same currency
+func inAccountCurrency(ctx context.Context, tx *ent.Tx, dbAccount *ent.Account, req *CreateRequest) (*CreateRequest, error) {
+	converted := *req
+	converted.Currency = dbAccount.Currency
+	if req.Currency == "" || req.Currency == dbAccount.Currency {
+		return &converted, nil
+	}
+
+	rateString, rate, err := fx.Lookup(ctx, tx, req.Currency, dbAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	converted.Amount, err = req.Amount.Convert(rate)
+	if errors.Is(err, money.ErrOverflow) {
+		return nil, pkgerr.WrapValidationError(validation.NewError("validation_amount_too_large", "must not be too large in the currency of the account"), "amount")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if converted.Amount == 0 {
+		return nil, pkgerr.WrapValidationError(validation.NewError("validation_amount_converted_to_zero", "must not be 0 in the currency of the account"), "amount")
+	}
+	converted.conversion = &conversion{
+		amount:   req.Amount,
+		currency: req.Currency,
+		rate:     rateString,
+	}
+	return &converted, nil
+}
+
+// setCurrency sets the currency of a new transaction along with what it was sent as when it was converted
+func setCurrency(create *ent.TransactionCreate, req *CreateRequest) *ent.TransactionCreate {
+	create.SetCurrency(req.Currency)
+	if req.conversion != nil {
+		create.
+			SetOriginalAmount(req.conversion.amount).
+			SetOriginalCurrency(req.conversion.currency).
+			SetFxRate(req.conversion.rate)
+	}
+	return create
+}
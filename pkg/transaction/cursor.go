@@ -0,0 +1,51 @@
+package transaction
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultListLimit is the page size used when a list request does not specify one
+	DefaultListLimit = 20
+	// MaxListLimit is the biggest page size a list request can ask for
+	MaxListLimit = 100
+)
+
+// Cursor points to the last transaction of a page
+// transactions are listed newest first so the next page starts right after this one
+type Cursor struct {
+	Timestamp time.Time `json:"t"`
+	ID        int       `json:"id"`
+}
+
+var errInvalidCursor = errors.New("must be a cursor returned by a previous list call")
+
+// Encode returns an opaque url safe representation of the cursor
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a cursor created by Cursor.Encode
+// an empty string means no cursor and returns nil
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	c := &Cursor{}
+	err = json.Unmarshal(b, c)
+	if err != nil || c.ID < 1 {
+		return nil, errInvalidCursor
+	}
+
+	return c, nil
+}
@@ -2,9 +2,15 @@ package transaction
 
 import (
 	"context"
-	"time"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/db"
 	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/settlement"
 	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/journal"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/outbox"
 
 	"entgo.io/ent/dialect/sql"
 )
@@ -13,105 +19,316 @@ import (
 //
 //go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockTransactionDAO --filename transaction_dao.go
 type DAO interface {
-	Create(ctx context.Context, req *CreateRequest) (*ent.Transaction, error)
+	// Create inserts a new transaction record in DB and discharges any open balance in the order of strategy
+	Create(ctx context.Context, req *CreateRequest, strategy AllocationStrategy) (*ent.Transaction, error)
+	// CreateBulk inserts all the transactions in the given order in one DB transaction, if one fails none are inserted
+	// and the error is a *BulkItemError which tells which one failed
+	CreateBulk(ctx context.Context, reqs []*CreateRequest, strategy AllocationStrategy) ([]*ent.Transaction, error)
+	// Get tries to find an existing transaction record in DB by id
+	Get(ctx context.Context, id int) (*ent.Transaction, error)
+	// List returns the transactions of an account matching the filter, newest first
+	List(ctx context.Context, filter *ListFilter) ([]*ent.Transaction, error)
+	// Reverse books the compensating transaction of a transaction, undoes its settlements and marks it reversed
+	// the credits which get their money back discharge the other open debits in the order of strategy
+	Reverse(ctx context.Context, id int, strategy AllocationStrategy) (*ent.Transaction, error)
+	// ListSettlements returns the settlements a transaction took part in either as the credit or the debit, oldest first
+	ListSettlements(ctx context.Context, transactionID int) ([]*ent.Settlement, error)
 }
 
 type dao struct {
-	entClient *ent.Client
+	entClient  *ent.Client
+	discharger Discharger
 }
 
 var _ DAO = (*dao)(nil)
 
+// DAOOption configures the DAO returned by NewDAO
+type DAOOption func(*dao)
+
+// WithDischarger sets how credits discharge the open debits, it defaults to NewSetBasedDischarger
+func WithDischarger(discharger Discharger) DAOOption {
+	return func(d *dao) {
+		d.discharger = discharger
+	}
+}
+
 // NewDAO returns a new DAO which use ent as database orm
-func NewDAO(entClient *ent.Client) DAO {
-	return &dao{
-		entClient: entClient,
+func NewDAO(entClient *ent.Client, opts ...DAOOption) DAO {
+	d := &dao{
+		entClient:  entClient,
+		discharger: NewSetBasedDischarger(),
 	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
 }
 
-func (d *dao) Create(ctx context.Context, req *CreateRequest) (*ent.Transaction, error) {
-	tx, err := d.entClient.Tx(ctx)
+func (d *dao) Create(ctx context.Context, req *CreateRequest, strategy AllocationStrategy) (dbTxn *ent.Transaction, err error) {
+	// the transaction is retried as a whole if it loses a race with another transaction
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		dbTxn, err = d.create(ctx, tx, req, strategy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbTxn, nil
+}
+
+// create discharges the open balance of the account and inserts the new transaction inside tx
+func (d *dao) create(ctx context.Context, tx *ent.Tx, req *CreateRequest, strategy AllocationStrategy) (*ent.Transaction, error) {
+	// lock the account first so that concurrent transactions of the same account are serialized
+	// otherwise two of them can read the same open balances and both discharge them
+	dbAccount, err := db.LockAccount(ctx, tx, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// from here on the amount is in the currency of the account
+	req, err = inAccountCurrency(ctx, tx, dbAccount, req)
 	if err != nil {
 		return nil, err
 	}
 
-	balance := req.Amount
+	err = CheckLimit(ctx, tx, dbAccount, req.Amount)
+	if err != nil {
+		return nil, err
+	}
 
-	lastID := 0
+	if req.Installments > 0 {
+		return createInstallments(ctx, tx, req)
+	}
 
-	for balance > 0 {
-		balanceTransactions, err := tx.Transaction.
-			Query().
-			Where(
-				transaction.BalanceLT(0),
-				transaction.AccountID(req.AccountID),
-				transaction.IDGT(lastID),
-			).
-			Order(
-				transaction.ByID(sql.OrderAsc()),
-			).
-			Limit(10).
-			All(ctx)
+	dbTxn, err := Book(ctx, tx, d.discharger, strategy, setCurrency(setDetails(tx.Transaction.
+		Create().
+		SetAccountID(req.AccountID).
+		SetOperationTypeID(req.OperationTypeID).
+		SetTimestamp(req.timestamp()).
+		SetAmount(req.Amount).
+		SetBalance(req.Amount), req), req))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Amount > 0 {
+		err = billing.ApplyCredit(ctx, tx, dbTxn, dbTxn.Amount)
 		if err != nil {
-			tx.Rollback()
 			return nil, err
 		}
+	}
 
-		if len(balanceTransactions) < 1 {
-			break
-		}
+	return dbTxn, nil
+}
 
-		for _, balanceTransaction := range balanceTransactions {
-			txBalance := balanceTransaction.Balance * -1
-			if txBalance >= balance {
-				err = tx.Transaction.
-					UpdateOneID(balanceTransaction.ID).
-					SetBalance(balanceTransaction.Balance + balance).
-					Exec(ctx)
-				if err != nil {
-					tx.Rollback()
-					return nil, err
-				}
-
-				balance = 0
-			} else if balance > txBalance {
-				err = tx.Transaction.
-					UpdateOneID(balanceTransaction.ID).
-					SetBalance(0).
-					Exec(ctx)
-				if err != nil {
-					tx.Rollback()
-					return nil, err
-				}
-				balance -= txBalance
-			}
+// CheckLimit returns ErrInsufficientLimit when a debit of amount does not fit in what is left of the credit limit
+// after the active holds of the account, the account must be locked so that no other transaction or hold
+// of the account changes the balance till this one commits
+func CheckLimit(ctx context.Context, tx *ent.Tx, dbAccount *ent.Account, amount money.Amount) error {
+	if amount >= 0 || dbAccount.CreditLimit == nil {
+		return nil
+	}
+
+	balance, err := db.NetBalance(ctx, tx, dbAccount.ID)
+	if err != nil {
+		return err
+	}
 
-			if balance == 0 {
-				break
+	held, err := db.HeldAmount(ctx, tx, dbAccount.ID)
+	if err != nil {
+		return err
+	}
+
+	if *dbAccount.CreditLimit+balance-held+amount < 0 {
+		return ErrInsufficientLimit
+	}
+
+	return nil
+}
+
+// Book inserts the transaction built by create inside tx and posts it to the journal, when it is a credit discharger then
+// discharges the open debits of the account in the order of strategy and whatever is left stays on the credit
+// discharger and strategy are only used for credits, the account must be locked already
+func Book(ctx context.Context, tx *ent.Tx, discharger Discharger, strategy AllocationStrategy, create *ent.TransactionCreate) (*ent.Transaction, error) {
+	dbTxn, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = journal.Post(ctx, tx, dbTxn)
+	if err != nil {
+		return nil, err
+	}
+
+	// the credit is inserted first so that the settlements can refer to it
+	if dbTxn.Balance > 0 {
+		balance, err := discharger.Discharge(ctx, tx, dbTxn, strategy)
+		if err != nil {
+			return nil, err
+		}
+
+		if balance != dbTxn.Balance {
+			dbTxn, err = tx.Transaction.
+				UpdateOne(dbTxn).
+				SetBalance(balance).
+				Save(ctx)
+			if err != nil {
+				return nil, err
 			}
 		}
+	}
 
-		lastID = balanceTransactions[len(balanceTransactions)-1].ID
+	err = WriteCreatedEvent(ctx, tx, dbTxn)
+	if err != nil {
+		return nil, err
 	}
 
-	dbTxn, err := tx.Transaction.
+	return dbTxn, nil
+}
+
+// WriteCreatedEvent writes the TransactionCreated event of a transaction and the BalanceChanged event of its account to the outbox inside tx
+// it must run in the DB transaction which books it, once its balance is final
+func WriteCreatedEvent(ctx context.Context, tx *ent.Tx, dbTxn *ent.Transaction) error {
+	err := outbox.Write(ctx, tx, outbox.EventTransactionCreated, dbTxn.AccountID, dbTxn.ID, MapEntTransactionToTransaction(dbTxn))
+	if err != nil {
+		return err
+	}
+
+	// settlements move balance between the debits & credits of an account without changing its sum
+	// so the net balance is final once the transaction is, even when a reversal discharges credits again afterwards
+	netBalance, err := db.NetBalance(ctx, tx, dbTxn.AccountID)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Write(ctx, tx, outbox.EventBalanceChanged, dbTxn.AccountID, dbTxn.AccountID, &BalanceChange{
+		AccountID:     dbTxn.AccountID,
+		TransactionID: dbTxn.ID,
+		NetBalance:    netBalance,
+		Currency:      dbTxn.Currency,
+	})
+}
+
+// createInstallments inserts a purchase and its installments inside tx and returns the purchase
+// the purchase keeps the full amount with no balance, the installments owe it instead
+// and the last installment absorbs what could not be split equally
+func createInstallments(ctx context.Context, tx *ent.Tx, req *CreateRequest) (*ent.Transaction, error) {
+	// installments are due monthly from when the purchase happened, which is in the past when it is back-dated
+	timestamp := req.timestamp()
+
+	purchase, err := setCurrency(setDetails(tx.Transaction.
 		Create().
 		SetAccountID(req.AccountID).
 		SetOperationTypeID(req.OperationTypeID).
-		SetTimestamp(time.Now()).
+		SetTimestamp(timestamp).
 		SetAmount(req.Amount).
-		SetBalance(balance).
+		SetBalance(0), req), req).
 		Save(ctx)
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
-	err = tx.Commit()
+	// the purchase is posted for the whole amount, the installments only schedule when the account owes it
+	err = journal.Post(ctx, tx, purchase)
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
-	return dbTxn, nil
+	parts := req.Amount.Split(req.Installments)
+
+	// installments show up on statements on their own so they tell what was purchased too,
+	// the external reference, metadata & original amount stay on the purchase
+	details := &CreateRequest{
+		Description:  req.Description,
+		MerchantName: req.MerchantName,
+		MCC:          req.MCC,
+		Currency:     req.Currency,
+	}
+
+	builders := make([]*ent.TransactionCreate, 0, len(parts))
+	for i, part := range parts {
+		builders = append(builders, setCurrency(setDetails(tx.Transaction.
+			Create().
+			SetAccountID(req.AccountID).
+			SetOperationTypeID(req.OperationTypeID).
+			SetTimestamp(timestamp).
+			SetAmount(part).
+			SetBalance(part).
+			SetParentID(purchase.ID).
+			SetInstallmentNumber(i+1).
+			SetDueDate(installmentDueDate(timestamp, i+1)), details), details))
+	}
+
+	err = tx.Transaction.CreateBulk(builders...).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// one event for the whole purchase, like its journal entries
+	err = WriteCreatedEvent(ctx, tx, purchase)
+	if err != nil {
+		return nil, err
+	}
+
+	return purchase, nil
+}
+
+func (d *dao) Get(ctx context.Context, id int) (*ent.Transaction, error) {
+	return d.entClient.Transaction.Get(ctx, id)
+}
+
+func (d *dao) List(ctx context.Context, filter *ListFilter) ([]*ent.Transaction, error) {
+	predicates := []predicate.Transaction{
+		transaction.AccountID(filter.AccountID),
+	}
+
+	if filter.OperationTypeID > 0 {
+		predicates = append(predicates, transaction.OperationTypeID(filter.OperationTypeID))
+	}
+	if filter.From != nil {
+		predicates = append(predicates, transaction.TimestampGTE(*filter.From))
+	}
+	if filter.To != nil {
+		predicates = append(predicates, transaction.TimestampLT(*filter.To))
+	}
+	if filter.OpenBalance {
+		predicates = append(predicates, transaction.BalanceNEQ(0))
+	}
+	if filter.ExternalReference != "" {
+		predicates = append(predicates, transaction.ExternalReference(filter.ExternalReference))
+	}
+
+	// keyset pagination, we only want rows which come after the cursor in (timestamp, id) desc order
+	// this makes use of the (account_id, timestamp) & (account_id, operation_type_id, timestamp) indexes
+	if filter.After != nil {
+		predicates = append(predicates, transaction.Or(
+			transaction.TimestampLT(filter.After.Timestamp),
+			transaction.And(
+				transaction.TimestampEQ(filter.After.Timestamp),
+				transaction.IDLT(filter.After.ID),
+			),
+		))
+	}
+
+	return d.entClient.Transaction.
+		Query().
+		Where(predicates...).
+		Order(
+			transaction.ByTimestamp(sql.OrderDesc()),
+			transaction.ByID(sql.OrderDesc()),
+		).
+		Limit(filter.Limit).
+		All(ctx)
+}
+
+func (d *dao) ListSettlements(ctx context.Context, transactionID int) ([]*ent.Settlement, error) {
+	return d.entClient.Settlement.
+		Query().
+		Where(settlement.Or(
+			settlement.CreditTxnID(transactionID),
+			settlement.DebitTxnID(transactionID),
+		)).
+		Order(settlement.ByID(sql.OrderAsc())).
+		All(ctx)
 }
@@ -2,12 +2,29 @@ package transaction_test
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
 	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/journalentry"
+	"transactor-server/pkg/db/ent/outboxevent"
+	enttransaction "transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/fx"
+	"transactor-server/pkg/journal"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
 	"transactor-server/pkg/transaction"
+	"transactor-server/pkg/transfer"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 )
 
 func TestDAOCreate(t *testing.T) {
@@ -23,24 +40,270 @@ func TestDAOCreate(t *testing.T) {
 	resp, err := dao.Create(context.Background(), &transaction.CreateRequest{
 		AccountID:       373,
 		OperationTypeID: 1,
-		Amount:          -39.88,
-	})
+		Amount:          money.MustParse("-39.88"),
+	}, transaction.FIFO())
 
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 
 	require.Equal(t, 373, resp.AccountID)
 	require.Equal(t, 1, resp.OperationTypeID)
-	require.Equal(t, -39.88, resp.Amount)
+	require.Equal(t, money.MustParse("-39.88"), resp.Amount)
 	require.Equal(t, 1, resp.ID)
 
 	dbResp := client.Transaction.Query().OnlyX(context.Background())
 	require.Equal(t, 373, dbResp.AccountID)
 	require.Equal(t, 1, dbResp.OperationTypeID)
-	require.Equal(t, -39.88, dbResp.Amount)
+	require.Equal(t, money.MustParse("-39.88"), dbResp.Amount)
 	require.Equal(t, 1, dbResp.ID)
 }
 
+func TestDAOCreateAccountNotFound(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(context.Background())
+
+	dao := transaction.NewDAO(client)
+
+	resp, err := dao.Create(context.Background(), &transaction.CreateRequest{
+		AccountID:       373,
+		OperationTypeID: 1,
+		Amount:          money.MustParse("-39.88"),
+	}, transaction.FIFO())
+
+	require.Nil(t, resp)
+	require.True(t, ent.IsNotFound(err))
+	require.Equal(t, 0, client.Transaction.Query().CountX(context.Background()))
+}
+
+func TestDAOCreateCreditLimit(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCreditLimit(money.MustParse("100")).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	create := func(accountID int, amount string) error {
+		operationTypeID := 1
+		if money.MustParse(amount) > 0 {
+			operationTypeID = 4
+		}
+		_, err := dao.Create(ctx, &transaction.CreateRequest{
+			AccountID:       accountID,
+			OperationTypeID: operationTypeID,
+			Amount:          money.MustParse(amount),
+		}, transaction.FIFO())
+		return err
+	}
+
+	require.NoError(t, create(1, "-60"))
+	require.ErrorIs(t, create(1, "-50"), transaction.ErrInsufficientLimit)
+
+	// the credit restores the limit
+	require.NoError(t, create(1, "30"))
+	require.NoError(t, create(1, "-50"))
+
+	// the limit can be used up exactly
+	require.NoError(t, create(1, "-20"))
+	require.ErrorIs(t, create(1, "-0.01"), transaction.ErrInsufficientLimit)
+
+	// unapplied credit adds to the limit too
+	require.NoError(t, create(1, "100"))
+	require.NoError(t, create(1, "-80"))
+	require.ErrorIs(t, create(1, "-20.01"), transaction.ErrInsufficientLimit)
+
+	// rejected debits are never booked
+	require.Equal(t, 6, client.Transaction.Query().CountX(ctx))
+
+	// accounts without a limit can owe anything
+	require.NoError(t, create(2, "-1000000"))
+}
+
+func TestDAOCreateInstallments(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	purchase, err := dao.Create(ctx, &transaction.CreateRequest{
+		AccountID:       1,
+		OperationTypeID: 2,
+		Amount:          money.MustParse("-100"),
+		Installments:    3,
+	}, transaction.FIFO())
+	require.NoError(t, err)
+
+	// the purchase keeps the amount but the installments owe it
+	require.Equal(t, money.MustParse("-100"), purchase.Amount)
+	require.Equal(t, money.Amount(0), purchase.Balance)
+	require.Nil(t, purchase.ParentID)
+
+	installments := client.Transaction.
+		Query().
+		Where(enttransaction.ParentID(purchase.ID)).
+		Order(enttransaction.ByInstallmentNumber()).
+		AllX(ctx)
+	require.Len(t, installments, 3)
+
+	// the last installment gets what could not be split equally
+	for i, amount := range []money.Amount{money.MustParse("-33.33"), money.MustParse("-33.33"), money.MustParse("-33.34")} {
+		require.Equal(t, i+1, *installments[i].InstallmentNumber)
+		require.Equal(t, amount, installments[i].Amount)
+		require.Equal(t, amount, installments[i].Balance)
+		require.Equal(t, 2, installments[i].OperationTypeID)
+		require.Equal(t, purchase.Timestamp.AddDate(0, i, 0).Month(), installments[i].DueDate.Month())
+	}
+	require.False(t, installments[0].DueDate.After(time.Now()))
+	require.True(t, installments[1].DueDate.After(time.Now()))
+
+	// only the installment which is due is paid, the rest of the credit stays unapplied
+	credit, err := dao.Create(ctx, &transaction.CreateRequest{
+		AccountID:       1,
+		OperationTypeID: 4,
+		Amount:          money.MustParse("50"),
+	}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, money.MustParse("16.67"), credit.Balance)
+
+	require.Equal(t, money.Amount(0), client.Transaction.GetX(ctx, installments[0].ID).Balance)
+	require.Equal(t, money.MustParse("-33.33"), client.Transaction.GetX(ctx, installments[1].ID).Balance)
+	require.Equal(t, money.MustParse("-33.34"), client.Transaction.GetX(ctx, installments[2].ID).Balance)
+}
+
+func TestDAOCreateBackdated(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	eventTimestamp := time.Date(2026, time.January, 31, 10, 0, 0, 0, time.UTC)
+	resp, err := dao.Create(ctx, &transaction.CreateRequest{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          money.MustParse("-10"),
+		EventTimestamp:  &eventTimestamp,
+	}, transaction.FIFO())
+	require.NoError(t, err)
+
+	// the event timestamp is when it happened, the create time is still when it was booked
+	require.True(t, eventTimestamp.Equal(resp.Timestamp))
+	require.WithinDuration(t, time.Now(), resp.CreateTime, time.Minute)
+
+	purchase, err := dao.Create(ctx, &transaction.CreateRequest{
+		AccountID:       1,
+		OperationTypeID: 2,
+		Amount:          money.MustParse("-30"),
+		Installments:    2,
+		EventTimestamp:  &eventTimestamp,
+	}, transaction.FIFO())
+	require.NoError(t, err)
+	require.True(t, eventTimestamp.Equal(purchase.Timestamp))
+
+	// the installments are due counting from the event timestamp
+	installments := client.Transaction.
+		Query().
+		Where(enttransaction.ParentID(purchase.ID)).
+		Order(enttransaction.ByInstallmentNumber()).
+		AllX(ctx)
+	require.Len(t, installments, 2)
+	require.True(t, eventTimestamp.Equal(*installments[0].DueDate))
+	require.True(t, time.Date(2026, time.February, 28, 10, 0, 0, 0, time.UTC).Equal(*installments[1].DueDate))
+}
+
+func TestDAOCreateDetails(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	resp, err := dao.Create(ctx, &transaction.CreateRequest{
+		AccountID:         1,
+		OperationTypeID:   1,
+		Amount:            money.MustParse("-10"),
+		Description:       "Groceries",
+		MerchantName:      "ACME Market",
+		MCC:               "5411",
+		ExternalReference: "order-1",
+		Metadata:          map[string]string{"store": "42"},
+	}, transaction.FIFO())
+	require.NoError(t, err)
+
+	dbTxn := client.Transaction.GetX(ctx, resp.ID)
+	require.Equal(t, "Groceries", *dbTxn.Description)
+	require.Equal(t, "ACME Market", *dbTxn.MerchantName)
+	require.Equal(t, "5411", *dbTxn.Mcc)
+	require.Equal(t, "order-1", *dbTxn.ExternalReference)
+	require.Equal(t, map[string]string{"store": "42"}, dbTxn.Metadata)
+
+	// nothing is stored for the details which were not sent
+	resp, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	dbTxn = client.Transaction.GetX(ctx, resp.ID)
+	require.Nil(t, dbTxn.Description)
+	require.Nil(t, dbTxn.ExternalReference)
+	require.Nil(t, dbTxn.Metadata)
+
+	purchase, err := dao.Create(ctx, &transaction.CreateRequest{
+		AccountID:         1,
+		OperationTypeID:   2,
+		Amount:            money.MustParse("-30"),
+		Installments:      2,
+		Description:       "Television",
+		MerchantName:      "ACME Electronics",
+		MCC:               "5732",
+		ExternalReference: "order-2",
+		Metadata:          map[string]string{"store": "42"},
+	}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, "order-2", *purchase.ExternalReference)
+
+	// the installments tell what was purchased but the reference stays on the purchase
+	installments := client.Transaction.Query().Where(enttransaction.ParentID(purchase.ID)).AllX(ctx)
+	require.Len(t, installments, 2)
+	for _, installment := range installments {
+		require.Equal(t, "Television", *installment.Description)
+		require.Equal(t, "ACME Electronics", *installment.MerchantName)
+		require.Equal(t, "5732", *installment.Mcc)
+		require.Nil(t, installment.ExternalReference)
+		require.Nil(t, installment.Metadata)
+	}
+}
+
 func TestDAOBalance(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
 	defer client.Close()
@@ -57,63 +320,580 @@ func TestDAOBalance(t *testing.T) {
 	_, err := dao.Create(ctx, &transaction.CreateRequest{
 		AccountID:       1,
 		OperationTypeID: 1,
-		Amount:          -50,
-	})
+		Amount:          money.MustParse("-50"),
+	}, transaction.FIFO())
 	require.NoError(t, err)
 
 	_, err = dao.Create(ctx, &transaction.CreateRequest{
 		AccountID:       1,
 		OperationTypeID: 1,
-		Amount:          -23.5,
-	})
+		Amount:          money.MustParse("-23.5"),
+	}, transaction.FIFO())
 	require.NoError(t, err)
 
 	_, err = dao.Create(ctx, &transaction.CreateRequest{
 		AccountID:       1,
 		OperationTypeID: 1,
-		Amount:          -18.7,
-	})
+		Amount:          money.MustParse("-18.7"),
+	}, transaction.FIFO())
 	require.NoError(t, err)
 
 	firstCreditTxn, err := dao.Create(ctx, &transaction.CreateRequest{
 		AccountID:       1,
 		OperationTypeID: 4,
-		Amount:          60,
-	})
+		Amount:          money.MustParse("60"),
+	}, transaction.FIFO())
 	require.NoError(t, err)
 
-	require.Equal(t, 0., firstCreditTxn.Balance)
+	require.Equal(t, money.Amount(0), firstCreditTxn.Balance)
 
 	firstTxn, err := client.Transaction.Get(ctx, 1)
 	require.NoError(t, err)
-	require.Equal(t, 0., firstTxn.Balance)
+	require.Equal(t, money.Amount(0), firstTxn.Balance)
 
 	secondTxn, err := client.Transaction.Get(ctx, 2)
 	require.NoError(t, err)
-	require.Equal(t, -13.5, secondTxn.Balance)
+	require.Equal(t, money.MustParse("-13.5"), secondTxn.Balance)
 
 	thirdTxn, err := client.Transaction.Get(ctx, 3)
 	require.NoError(t, err)
-	require.Equal(t, -18.7, thirdTxn.Balance)
+	require.Equal(t, money.MustParse("-18.7"), thirdTxn.Balance)
 
 	secondCreditTxn, err := dao.Create(ctx, &transaction.CreateRequest{
 		AccountID:       1,
 		OperationTypeID: 4,
-		Amount:          100,
-	})
+		Amount:          money.MustParse("100"),
+	}, transaction.FIFO())
 	require.NoError(t, err)
 
-	require.Equal(t, 67.8, secondCreditTxn.Balance)
+	require.Equal(t, money.MustParse("67.8"), secondCreditTxn.Balance)
 
 	firstTxn, err = client.Transaction.Get(ctx, 1)
 	require.NoError(t, err)
-	require.Equal(t, 0., firstTxn.Balance)
+	require.Equal(t, money.Amount(0), firstTxn.Balance)
 
 	secondTxn, err = client.Transaction.Get(ctx, 2)
 	require.NoError(t, err)
-	require.Equal(t, 0., secondTxn.Balance)
+	require.Equal(t, money.Amount(0), secondTxn.Balance)
 
 	thirdTxn, err = client.Transaction.Get(ctx, 3)
 	require.NoError(t, err)
-	require.Equal(t, 0., thirdTxn.Balance)
+	require.Equal(t, money.Amount(0), thirdTxn.Balance)
+}
+
+func TestDAOListSettlements(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	firstDebitTxn, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-50")}, transaction.FIFO())
+	require.NoError(t, err)
+	secondDebitTxn, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-23.5")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	firstCreditTxn, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("60")}, transaction.FIFO())
+	require.NoError(t, err)
+	secondCreditTxn, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("100")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	settlements, err := dao.ListSettlements(ctx, firstCreditTxn.ID)
+	require.NoError(t, err)
+	require.Len(t, settlements, 2)
+	require.Equal(t, firstDebitTxn.ID, settlements[0].DebitTxnID)
+	require.Equal(t, money.MustParse("50"), settlements[0].Amount)
+	require.Equal(t, secondDebitTxn.ID, settlements[1].DebitTxnID)
+	require.Equal(t, money.MustParse("10"), settlements[1].Amount)
+
+	// the second debit was paid by both credits
+	settlements, err = dao.ListSettlements(ctx, secondDebitTxn.ID)
+	require.NoError(t, err)
+	require.Len(t, settlements, 2)
+	require.Equal(t, firstCreditTxn.ID, settlements[0].CreditTxnID)
+	require.Equal(t, money.MustParse("10"), settlements[0].Amount)
+	require.Equal(t, secondCreditTxn.ID, settlements[1].CreditTxnID)
+	require.Equal(t, money.MustParse("13.5"), settlements[1].Amount)
+
+	// settled amounts always add up to what the credit lost from its balance
+	settlements, err = dao.ListSettlements(ctx, secondCreditTxn.ID)
+	require.NoError(t, err)
+	require.Len(t, settlements, 1)
+	require.Equal(t, secondCreditTxn.Amount-secondCreditTxn.Balance, settlements[0].Amount)
+
+	settlements, err = dao.ListSettlements(ctx, 999)
+	require.NoError(t, err)
+	require.Empty(t, settlements)
+}
+
+func TestDAOBalanceIsExact(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(context.Background())
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(context.Background())
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(context.Background())
+
+	dao := transaction.NewDAO(client)
+	ctx := context.Background()
+
+	// with float64 0.1 + 0.2 is not 0.3 so the debit would never be fully discharged
+	debit, err := dao.Create(ctx, &transaction.CreateRequest{
+		AccountID:       1,
+		OperationTypeID: 1,
+		Amount:          money.MustParse("-0.3"),
+	}, transaction.FIFO())
+	require.NoError(t, err)
+
+	for _, amount := range []string{"0.1", "0.2"} {
+		credit, err := dao.Create(ctx, &transaction.CreateRequest{
+			AccountID:       1,
+			OperationTypeID: 4,
+			Amount:          money.MustParse(amount),
+		}, transaction.FIFO())
+		require.NoError(t, err)
+		require.Equal(t, money.Amount(0), credit.Balance)
+	}
+
+	debit, err = client.Transaction.Get(ctx, debit.ID)
+	require.NoError(t, err)
+	require.Equal(t, money.Amount(0), debit.Balance)
+}
+
+func TestDAOGet(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(context.Background())
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(context.Background())
+	client.Account.Create().SetDocumentNumber("12345").SetID(373).SetName("John Doe").ExecX(context.Background())
+
+	dao := transaction.NewDAO(client)
+
+	_, err := dao.Create(context.Background(), &transaction.CreateRequest{
+		AccountID:       373,
+		OperationTypeID: 1,
+		Amount:          money.MustParse("-50"),
+	}, transaction.FIFO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = dao.Create(context.Background(), &transaction.CreateRequest{
+		AccountID:       373,
+		OperationTypeID: 4,
+		Amount:          money.MustParse("20"),
+	}, transaction.FIFO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := dao.Get(context.Background(), 1)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	require.Equal(t, 1, resp.ID)
+	require.Equal(t, 373, resp.AccountID)
+	require.Equal(t, 1, resp.OperationTypeID)
+	require.Equal(t, money.MustParse("-50"), resp.Amount)
+	require.Equal(t, money.MustParse("-30"), resp.Balance)
+
+	resp, err = dao.Get(context.Background(), 2)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	require.Equal(t, 2, resp.ID)
+	require.Equal(t, 4, resp.OperationTypeID)
+	require.Equal(t, money.MustParse("20"), resp.Amount)
+	require.Equal(t, money.Amount(0), resp.Balance)
+
+	_, err = dao.Get(context.Background(), 3)
+	require.Error(t, err)
+}
+
+func TestDAOList(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	start := time.Date(2024, 11, 1, 10, 0, 0, 0, time.UTC)
+	// account 1 has 5 transactions an hour apart, 2 of them share the same timestamp
+	for i, ts := range []time.Time{
+		start,
+		start.Add(time.Hour),
+		start.Add(time.Hour * 2),
+		start.Add(time.Hour * 2),
+		start.Add(time.Hour * 3),
+	} {
+		operationTypeID, amount, balance := 1, money.MustParse("-10"), money.MustParse("-10")
+		if i == 1 {
+			operationTypeID, amount, balance = 4, money.MustParse("10"), 0
+		}
+		create := client.Transaction.Create().
+			SetAccountID(1).
+			SetOperationTypeID(operationTypeID).
+			SetAmount(amount).
+			SetBalance(balance).
+			SetTimestamp(ts)
+		if i == 2 {
+			create.SetExternalReference("order-1")
+		}
+		create.ExecX(ctx)
+	}
+	client.Transaction.Create().
+		SetAccountID(2).
+		SetOperationTypeID(1).
+		SetAmount(money.MustParse("-10")).
+		SetBalance(money.MustParse("-10")).
+		SetTimestamp(start).
+		SetExternalReference("order-1").
+		ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	ids := func(txns []*ent.Transaction) []int {
+		return lo.Map(txns, func(txn *ent.Transaction, _ int) int { return txn.ID })
+	}
+
+	t.Run("newest first", func(t *testing.T) {
+		resp, err := dao.List(ctx, &transaction.ListFilter{AccountID: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []int{5, 4, 3, 2, 1}, ids(resp))
+	})
+
+	t.Run("after cursor", func(t *testing.T) {
+		resp, err := dao.List(ctx, &transaction.ListFilter{
+			AccountID: 1,
+			Limit:     2,
+			After:     &transaction.Cursor{Timestamp: start.Add(time.Hour * 2), ID: 4},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []int{3, 2}, ids(resp))
+	})
+
+	t.Run("operation type", func(t *testing.T) {
+		resp, err := dao.List(ctx, &transaction.ListFilter{AccountID: 1, OperationTypeID: 4, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []int{2}, ids(resp))
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		from, to := start.Add(time.Hour), start.Add(time.Hour*3)
+		resp, err := dao.List(ctx, &transaction.ListFilter{AccountID: 1, From: &from, To: &to, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []int{4, 3, 2}, ids(resp))
+	})
+
+	t.Run("open balance", func(t *testing.T) {
+		resp, err := dao.List(ctx, &transaction.ListFilter{AccountID: 1, OpenBalance: true, Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []int{5, 4, 3, 1}, ids(resp))
+	})
+
+	t.Run("external reference", func(t *testing.T) {
+		resp, err := dao.List(ctx, &transaction.ListFilter{AccountID: 1, ExternalReference: "order-1", Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []int{3}, ids(resp))
+	})
+}
+
+func TestDAOConcurrentCreate(t *testing.T) {
+	t.Parallel()
+	// a file backed database so that all the connections of the pool see the same data
+	// sqlite has no row locks, immediate transactions take the write lock up front like SELECT ... FOR UPDATE would
+	dsn := fmt.Sprintf("file:%s?_fk=1&_busy_timeout=5000&_txlock=immediate", filepath.Join(t.TempDir(), "ent.db"))
+	client := enttest.Open(t, "sqlite3", dsn)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	// 20 debits of 7.50 and 10 credits of 12.25 per account, all at the same time
+	var wg sync.WaitGroup
+	errs := make(chan error, 120)
+	for _, accountID := range []int{1, 2} {
+		for i := 0; i < 30; i++ {
+			req := &transaction.CreateRequest{
+				AccountID:       accountID,
+				OperationTypeID: 1,
+				Amount:          money.MustParse("-7.50"),
+			}
+			if i%3 == 0 {
+				req.OperationTypeID = 4
+				req.Amount = money.MustParse("12.25")
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := dao.Create(ctx, req, transaction.FIFO())
+				errs <- err
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	for _, accountID := range []int{1, 2} {
+		txns := client.Transaction.Query().Where(enttransaction.AccountID(accountID)).AllX(ctx)
+		require.Len(t, txns, 30)
+
+		var amount, balance money.Amount
+		for _, txn := range txns {
+			amount += txn.Amount
+			balance += txn.Balance
+
+			if txn.Amount < 0 {
+				// a debit can only be discharged, never overpaid
+				require.LessOrEqual(t, txn.Balance, money.Amount(0))
+				require.GreaterOrEqual(t, txn.Balance, txn.Amount)
+			} else {
+				// a credit can only be used up, never more than its amount
+				require.GreaterOrEqual(t, txn.Balance, money.Amount(0))
+				require.LessOrEqual(t, txn.Balance, txn.Amount)
+			}
+		}
+
+		// every discharge moves the same amount off a debit and a credit so the total is unchanged
+		// a lost update, i.e. two credits discharging the same debit, breaks this
+		require.Equal(t, amount, balance)
+		// 10 x 12.25 - 20 x 7.50 = -27.50
+		require.Equal(t, money.MustParse("-27.50"), balance)
+	}
+}
+
+func TestDAOCreatePaysInvoice(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCycleClosingDay(5).ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	_, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-100")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	periodEnd := time.Now().Add(-time.Hour)
+	dbInvoice := client.Invoice.Create().
+		SetAccountID(1).
+		SetPeriodStart(periodEnd.AddDate(0, -1, 0)).
+		SetPeriodEnd(periodEnd).
+		SetTotalDue(money.MustParse("100")).
+		SetMinimumPayment(money.MustParse("20")).
+		SetDueDate(periodEnd.AddDate(0, 0, 10)).
+		SaveX(ctx)
+
+	// debits do not touch the invoice
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, money.Amount(0), client.Invoice.GetX(ctx, dbInvoice.ID).PaidAmount)
+
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("40")}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, money.MustParse("40"), client.Invoice.GetX(ctx, dbInvoice.ID).PaidAmount)
+
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("70")}, transaction.FIFO())
+	require.NoError(t, err)
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.MustParse("100"), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusPaid, dbInvoice.Status)
+}
+
+func TestDAOCreateCurrency(t *testing.T) {
+	t.Parallel()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+	client.FxRate.Create().SetBaseCurrency("EUR").SetQuoteCurrency("USD").SetRate("1.0845").ExecX(ctx)
+
+	dao := transaction.NewDAO(client)
+
+	// the account currency is used when none is sent
+	dbTxn, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, "USD", dbTxn.Currency)
+	require.Nil(t, dbTxn.OriginalAmount)
+
+	// 99.99 EUR is 108.439155 USD, which rounds to 108.44
+	dbTxn, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-99.99"), Currency: "EUR"}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, "USD", dbTxn.Currency)
+	require.Equal(t, money.MustParse("-108.44"), dbTxn.Amount)
+	require.Equal(t, money.MustParse("-108.44"), dbTxn.Balance)
+	require.Equal(t, money.MustParse("-99.99"), *dbTxn.OriginalAmount)
+	require.Equal(t, "EUR", *dbTxn.OriginalCurrency)
+	require.Equal(t, "1.0845", *dbTxn.FxRate)
+
+	// the installments split what was converted
+	purchase, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 2, Amount: money.MustParse("-100"), Currency: "EUR", Installments: 2}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, money.MustParse("-108.45"), purchase.Amount)
+	require.Equal(t, "EUR", *purchase.OriginalCurrency)
+	installments := client.Transaction.Query().Where(enttransaction.ParentID(purchase.ID)).Order(enttransaction.ByID()).AllX(ctx)
+	require.Len(t, installments, 2)
+	for _, installment := range installments {
+		require.Equal(t, "USD", installment.Currency)
+		require.Nil(t, installment.OriginalAmount)
+	}
+	require.Equal(t, money.MustParse("-108.45"), installments[0].Amount+installments[1].Amount)
+
+	// there is no rate from USD to EUR or from GBP to USD
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("10"), Currency: "GBP"}, transaction.FIFO())
+	require.ErrorIs(t, err, fx.ErrRateNotFound)
+
+	// an amount which converts to nothing is rejected
+	client.FxRate.Create().SetBaseCurrency("HUF").SetQuoteCurrency("USD").SetRate("0.0027").ExecX(ctx)
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("0.5"), Currency: "HUF"}, transaction.FIFO())
+	require.Error(t, err)
+	_, ok := err.(*pkgerr.ValidationError)
+	require.True(t, ok)
+
+	// and so is one which overflows
+	client.FxRate.Create().SetBaseCurrency("USD").SetQuoteCurrency("HUF").SetRate("370").ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("24680").SetID(9).SetName("Huf Account").SetCurrency("HUF").ExecX(ctx)
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 9, OperationTypeID: 4, Amount: money.Amount(math.MaxInt64 / 10), Currency: "USD"}, transaction.FIFO())
+	require.Error(t, err)
+	validationErr, ok := err.(*pkgerr.ValidationError)
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"amount": "must not be too large in the currency of the account"}, validationErr.ResponseBody().(pkgerr.ValidationErrorResponseBody).Errors)
+
+	require.Equal(t, 5, client.Transaction.Query().CountX(ctx))
+}
+
+// setupTransfer returns a DAO over the accounts 1 & 2 with the seeded operation types, transfers included
+func setupTransfer(t *testing.T) (*ent.Client, transaction.DAO) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Transfer Out").SetID(7).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Transfer In").SetID(8).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCreditLimit(money.MustParse("100")).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	return client, transaction.NewDAO(client)
+}
+
+func TestDAOPostsJournal(t *testing.T) {
+	t.Parallel()
+	client, dao := setupTransfer(t)
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Purchase with installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Debit Reversal").SetID(5).SetIsDebit(false).ExecX(ctx)
+
+	purchase, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-40")}, transaction.FIFO())
+	require.NoError(t, err)
+	installments, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 2, Amount: money.MustParse("-30"), Installments: 3}, transaction.FIFO())
+	require.NoError(t, err)
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("50")}, transaction.FIFO())
+	require.NoError(t, err)
+	_, err = transfer.NewDAO(client).Create(ctx, &transfer.CreateRequest{SourceAccountID: 1, DestinationAccountID: 2, Amount: money.MustParse("20")}, transaction.FIFO())
+	require.NoError(t, err)
+	_, err = dao.Reverse(ctx, purchase.ID, transaction.FIFO())
+	require.NoError(t, err)
+
+	// the installments are posted by their purchase only
+	require.Equal(t, 2, client.JournalEntry.Query().Where(journalentry.TransactionID(installments.ID)).CountX(ctx))
+	require.Zero(t, client.JournalEntry.Query().Where(journalentry.HasTransactionWith(enttransaction.ParentID(installments.ID))).CountX(ctx))
+
+	totals, err := journal.NewDAO(client).TrialBalance(ctx, time.Now())
+	require.NoError(t, err)
+
+	balances := map[string]money.Amount{}
+	for _, total := range totals {
+		balances[total.Ledger] += total.Debit - total.Credit
+	}
+	require.Equal(t, map[string]money.Amount{
+		// account 1 owes 30 + 20 and paid 50 while account 2 got 20, the reversed purchase nets out
+		"customer_receivable": money.MustParse("-20"),
+		"merchant_payable":    money.MustParse("-30"),
+		"cash":                money.MustParse("50"),
+		// both legs of the transfer clear each other
+		"transfer_clearing": 0,
+	}, balances)
+}
+
+func TestDAOWritesEvents(t *testing.T) {
+	t.Parallel()
+	client, dao := setupTransfer(t)
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Purchase with installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Debit Reversal").SetID(5).SetIsDebit(false).ExecX(ctx)
+
+	purchase, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-40")}, transaction.FIFO())
+	require.NoError(t, err)
+	installments, err := dao.Create(ctx, &transaction.CreateRequest{AccountID: 1, OperationTypeID: 2, Amount: money.MustParse("-30"), Installments: 3}, transaction.FIFO())
+	require.NoError(t, err)
+	dbTransfer, err := transfer.NewDAO(client).Create(ctx, &transfer.CreateRequest{SourceAccountID: 1, DestinationAccountID: 2, Amount: money.MustParse("20")}, transaction.FIFO())
+	require.NoError(t, err)
+	reversal, err := dao.Reverse(ctx, purchase.ID, transaction.FIFO())
+	require.NoError(t, err)
+
+	// a failed transaction writes no event
+	_, err = dao.Create(ctx, &transaction.CreateRequest{AccountID: 99, OperationTypeID: 1, Amount: money.MustParse("-1")}, transaction.FIFO())
+	require.Error(t, err)
+
+	dbEvents := client.OutboxEvent.Query().Where(outboxevent.Type("TransactionCreated")).Order(outboxevent.ByID()).AllX(ctx)
+	require.Equal(t, []int{purchase.ID, installments.ID, dbTransfer.Edges.Transactions[0].ID, dbTransfer.Edges.Transactions[1].ID, reversal.ID}, lo.Map(dbEvents, func(e *ent.OutboxEvent, _ int) int {
+		return e.AggregateID
+	}))
+	require.Equal(t, []int{1, 1, 1, 2, 1}, lo.Map(dbEvents, func(e *ent.OutboxEvent, _ int) int {
+		return e.AccountID
+	}))
+	require.Equal(t, -40.0, gjson.GetBytes(dbEvents[0].Payload, "amount").Float())
+
+	// every transaction is followed by the net balance of its account
+	dbEvents = client.OutboxEvent.Query().Where(outboxevent.Type("BalanceChanged")).Order(outboxevent.ByID()).AllX(ctx)
+	require.Equal(t, []float64{-40, -70, -90, 20, -50}, lo.Map(dbEvents, func(e *ent.OutboxEvent, _ int) float64 {
+		return gjson.GetBytes(e.Payload, "net_balance").Float()
+	}))
+	require.Equal(t, reversal.ID, int(gjson.GetBytes(dbEvents[4].Payload, "transaction_id").Int()))
+	require.Equal(t, 1, dbEvents[4].AggregateID)
 }
@@ -0,0 +1,189 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Discharger settles the open debit balances of an account with the balance of a credit transaction
+// only debits which are due are discharged, in the order of strategy, a settlement is recorded for every debit paid by the credit
+// and the part of the balance which was not needed is returned, the credit itself is not updated
+// it is always called inside the ent transaction which books the credit, after the account is locked
+type Discharger interface {
+	Discharge(ctx context.Context, tx *ent.Tx, credit *ent.Transaction, strategy AllocationStrategy) (remaining money.Amount, err error)
+}
+
+type iterativeDischarger struct {
+	pageSize int
+}
+
+// NewIterativeDischarger returns a Discharger which walks the open debits page by page and updates them one by one
+// it costs a round trip per debit but works on any database ent supports
+func NewIterativeDischarger() Discharger {
+	return &iterativeDischarger{
+		pageSize: 10,
+	}
+}
+
+func (i *iterativeDischarger) Discharge(ctx context.Context, tx *ent.Tx, credit *ent.Transaction, strategy AllocationStrategy) (money.Amount, error) {
+	balance := credit.Balance
+	now := time.Now()
+
+	// every debit of a page but the last one is paid in full and is not open anymore
+	// so the next page is simply the first page of what is still open
+	for balance > 0 {
+		balanceTransactions, err := tx.Transaction.
+			Query().
+			Where(
+				transaction.BalanceLT(0),
+				transaction.AccountID(credit.AccountID),
+				// a credit only pays debits in its own currency
+				transaction.Currency(credit.Currency),
+				transaction.Or(
+					transaction.DueDateIsNil(),
+					transaction.DueDateLTE(now),
+				),
+			).
+			Order(func(s *sql.Selector) {
+				s.OrderExpr(sql.Expr(strategy.OrderBy()))
+			}).
+			Limit(i.pageSize).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(balanceTransactions) < 1 {
+			break
+		}
+
+		for _, balanceTransaction := range balanceTransactions {
+			// the debit gets what it owes or whatever is left of the credit
+			paid := min(balanceTransaction.Balance*-1, balance)
+
+			err = tx.Transaction.
+				UpdateOneID(balanceTransaction.ID).
+				SetBalance(balanceTransaction.Balance + paid).
+				Exec(ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			err = tx.Settlement.
+				Create().
+				SetCreditTxnID(credit.ID).
+				SetDebitTxnID(balanceTransaction.ID).
+				SetAmount(paid).
+				SetTimestamp(now).
+				Exec(ctx)
+			if err != nil {
+				return 0, err
+			}
+
+			balance -= paid
+
+			if balance == 0 {
+				break
+			}
+		}
+	}
+
+	return balance, nil
+}
+
+type setBasedDischarger struct{}
+
+// NewSetBasedDischarger returns a Discharger which allocates the amount over all the open debits
+// with a single INSERT of the settlements and a single UPDATE of the debits
+// a running total of what is owed (a window function) decides how much of the amount each debit gets
+// it needs window functions and UPDATE ... FROM which both postgres and sqlite (3.33+) support
+func NewSetBasedDischarger() Discharger {
+	return &setBasedDischarger{}
+}
+
+// owedQuery returns the total open debit balance in currency $3 of account $1 which is due at time $2 as a positive amount
+const owedQuery = `SELECT CAST(COALESCE(SUM(-balance), 0) AS BIGINT) FROM transactions WHERE account_id = $1 AND balance < 0 AND (due_date IS NULL OR due_date <= $2) AND currency = $3`
+
+// allocationsQuery allocates amount $4 over the open debits in currency $3 of account $1 which are due at time $2 in the order of an AllocationStrategy
+// owed_till_here is what the account owes up to and including a debit so
+// a debit is fully paid when owed_till_here <= amount, partly paid when only what is owed before it is < amount
+// and left alone otherwise
+//
+// sqlite numbers $n parameters in the order they first appear in the query, not by n,
+// so every query built on top of this one must first use the parameters in order
+const allocationsQuery = `WITH open_debits AS (
+	SELECT id, -balance AS owed, SUM(-balance) OVER (ORDER BY %s) AS owed_till_here
+	FROM transactions
+	WHERE account_id = $1 AND balance < 0 AND (due_date IS NULL OR due_date <= $2) AND currency = $3
+), allocations AS (
+	SELECT id, CASE WHEN owed_till_here <= $4 THEN owed ELSE $4 - (owed_till_here - owed) END AS paid
+	FROM open_debits
+	WHERE owed_till_here - owed < $4
+)
+`
+
+// settleQuery records a settlement of credit $5 for every allocation at time $2
+// it must run before dischargeQuery as both of them allocate from the same open balances
+const settleQuery = `INSERT INTO settlements (create_time, update_time, credit_txn_id, debit_txn_id, amount, timestamp)
+SELECT $2, $2, $5, id, paid, $2
+FROM allocations`
+
+// dischargeQuery pays every allocation off its debit at time $2
+const dischargeQuery = `UPDATE transactions
+SET balance = transactions.balance + allocations.paid, update_time = $2
+FROM allocations
+WHERE transactions.id = allocations.id`
+
+func (s *setBasedDischarger) Discharge(ctx context.Context, tx *ent.Tx, credit *ent.Transaction, strategy AllocationStrategy) (money.Amount, error) {
+	amount := credit.Balance
+	if amount <= 0 {
+		return amount, nil
+	}
+
+	now := time.Now()
+
+	rows, err := tx.Client().QueryContext(ctx, owedQuery, credit.AccountID, now, credit.Currency)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var owed money.Amount
+	for rows.Next() {
+		err = rows.Scan(&owed)
+		if err != nil {
+			return 0, err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return 0, err
+	}
+	rows.Close()
+
+	// nothing to discharge, skip the update altogether
+	if owed == 0 {
+		return amount, nil
+	}
+
+	// the order of the open debits is the only part of the queries which depends on the strategy
+	allocations := fmt.Sprintf(allocationsQuery, strategy.OrderBy())
+
+	_, err = tx.Client().ExecContext(ctx, allocations+settleQuery, credit.AccountID, now, credit.Currency, amount, credit.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Client().ExecContext(ctx, allocations+dischargeQuery, credit.AccountID, now, credit.Currency, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return amount - min(amount, owed), nil
+}
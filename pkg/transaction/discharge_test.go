@@ -0,0 +1,294 @@
+package transaction_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/predicate"
+	"transactor-server/pkg/db/ent/settlement"
+	enttransaction "transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+
+	"entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+var dischargers = map[string]func() transaction.Discharger{
+	"iterative": transaction.NewIterativeDischarger,
+	"set based": transaction.NewSetBasedDischarger,
+}
+
+// createDebits creates one open debit per amount on the account
+func createDebits(ctx context.Context, client *ent.Client, accountID int, amounts ...money.Amount) {
+	builders := make([]*ent.TransactionCreate, 0, len(amounts))
+	for _, amount := range amounts {
+		builders = append(builders, client.Transaction.Create().
+			SetAccountID(accountID).
+			SetOperationTypeID(1).
+			SetAmount(amount).
+			SetBalance(amount).
+			SetTimestamp(time.Now()))
+	}
+	client.Transaction.CreateBulk(builders...).ExecX(ctx)
+}
+
+// discharge books a credit of amount and runs the discharger for it in its own transaction
+func discharge(ctx context.Context, client *ent.Client, discharger transaction.Discharger, strategy transaction.AllocationStrategy, accountID int, amount money.Amount) (*ent.Transaction, money.Amount, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	credit, err := tx.Transaction.
+		Create().
+		SetAccountID(accountID).
+		SetOperationTypeID(4).
+		SetAmount(amount).
+		SetBalance(amount).
+		SetTimestamp(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, 0, err
+	}
+
+	remaining, err := discharger.Discharge(ctx, tx, credit, strategy)
+	if err != nil {
+		tx.Rollback()
+		return nil, 0, err
+	}
+
+	return credit, remaining, tx.Commit()
+}
+
+// debitBalances returns the balances of the debits of an account in order
+func debitBalances(ctx context.Context, client *ent.Client, accountID int) []money.Amount {
+	return balances(ctx, client, accountID, enttransaction.OperationTypeID(1))
+}
+
+// settlements returns (debit id, amount) of every settlement of a credit in order
+func settlements(ctx context.Context, client *ent.Client, credit *ent.Transaction) [][2]int64 {
+	dbSettlements := client.Settlement.
+		Query().
+		Where(settlement.CreditTxnID(credit.ID)).
+		Order(settlement.ByID()).
+		AllX(ctx)
+
+	out := make([][2]int64, 0, len(dbSettlements))
+	for _, dbSettlement := range dbSettlements {
+		out = append(out, [2]int64{int64(dbSettlement.DebitTxnID), int64(dbSettlement.Amount)})
+	}
+	return out
+}
+
+func balances(ctx context.Context, client *ent.Client, accountID int, predicates ...predicate.Transaction) []money.Amount {
+	txns := client.Transaction.
+		Query().
+		Where(enttransaction.AccountID(accountID)).
+		Where(predicates...).
+		Order(enttransaction.ByID(sql.OrderAsc())).
+		AllX(ctx)
+
+	out := make([]money.Amount, 0, len(txns))
+	for _, txn := range txns {
+		out = append(out, txn.Balance)
+	}
+	return out
+}
+
+func TestDischarge(t *testing.T) {
+	for name, newDischarger := range dischargers {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+			defer client.Close()
+
+			ctx := context.Background()
+
+			client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+			client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+			client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+			client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+			createDebits(ctx, client, 1, money.MustParse("-50"), money.MustParse("-23.5"), money.MustParse("-18.7"))
+			createDebits(ctx, client, 2, money.MustParse("-10"))
+
+			discharger := newDischarger()
+
+			// pays the first debit and part of the second
+			credit, remaining, err := discharge(ctx, client, discharger, transaction.FIFO(), 1, money.MustParse("60"))
+			require.NoError(t, err)
+			require.Equal(t, money.Amount(0), remaining)
+			require.Equal(t, []money.Amount{0, money.MustParse("-13.5"), money.MustParse("-18.7")}, debitBalances(ctx, client, 1))
+			require.Equal(t, [][2]int64{{1, 5000}, {2, 1000}}, settlements(ctx, client, credit))
+
+			// pays exactly the second debit
+			credit, remaining, err = discharge(ctx, client, discharger, transaction.FIFO(), 1, money.MustParse("13.5"))
+			require.NoError(t, err)
+			require.Equal(t, money.Amount(0), remaining)
+			require.Equal(t, []money.Amount{0, 0, money.MustParse("-18.7")}, debitBalances(ctx, client, 1))
+			require.Equal(t, [][2]int64{{2, 1350}}, settlements(ctx, client, credit))
+
+			// pays everything and has some left over
+			credit, remaining, err = discharge(ctx, client, discharger, transaction.FIFO(), 1, money.MustParse("100"))
+			require.NoError(t, err)
+			require.Equal(t, money.MustParse("81.3"), remaining)
+			require.Equal(t, []money.Amount{0, 0, 0}, debitBalances(ctx, client, 1))
+			require.Equal(t, [][2]int64{{3, 1870}}, settlements(ctx, client, credit))
+
+			// nothing is left to pay
+			credit, remaining, err = discharge(ctx, client, discharger, transaction.FIFO(), 1, money.MustParse("5"))
+			require.NoError(t, err)
+			require.Equal(t, money.MustParse("5"), remaining)
+			require.Empty(t, settlements(ctx, client, credit))
+
+			// other accounts are never touched
+			require.Equal(t, []money.Amount{money.MustParse("-10")}, balances(ctx, client, 2))
+		})
+	}
+}
+
+func TestDischargeAllocationStrategies(t *testing.T) {
+	// debits in id order are installments -10, normal -30, normal -20 and installments -40 which are paid by a credit of 35
+	testCases := []struct {
+		strategy transaction.AllocationStrategy
+		balances []money.Amount
+	}{
+		{
+			strategy: transaction.FIFO(),
+			balances: []money.Amount{0, money.MustParse("-5"), money.MustParse("-20"), money.MustParse("-40")},
+		},
+		{
+			strategy: transaction.HighestAmountFirst(),
+			balances: []money.Amount{money.MustParse("-10"), money.MustParse("-30"), money.MustParse("-20"), money.MustParse("-5")},
+		},
+		{
+			strategy: transaction.InstallmentsLast(),
+			balances: []money.Amount{money.MustParse("-10"), 0, money.MustParse("-15"), money.MustParse("-40")},
+		},
+		{
+			strategy: transaction.OperationTypePriority(2, 1),
+			balances: []money.Amount{0, money.MustParse("-30"), money.MustParse("-20"), money.MustParse("-15")},
+		},
+	}
+
+	for name, newDischarger := range dischargers {
+		for _, tc := range testCases {
+			t.Run(name+"/"+tc.strategy.Name(), func(t *testing.T) {
+				t.Parallel()
+				client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+				defer client.Close()
+
+				ctx := context.Background()
+
+				client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+				client.OperationType.Create().SetDescription("installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+				client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+				client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+
+				for _, debit := range []struct {
+					operationTypeID int
+					amount          money.Amount
+				}{
+					{2, money.MustParse("-10")},
+					{1, money.MustParse("-30")},
+					{1, money.MustParse("-20")},
+					{2, money.MustParse("-40")},
+				} {
+					client.Transaction.Create().
+						SetAccountID(1).
+						SetOperationTypeID(debit.operationTypeID).
+						SetAmount(debit.amount).
+						SetBalance(debit.amount).
+						SetTimestamp(time.Now()).
+						ExecX(ctx)
+				}
+
+				_, remaining, err := discharge(ctx, client, newDischarger(), tc.strategy, 1, money.MustParse("35"))
+				require.NoError(t, err)
+				require.Equal(t, money.Amount(0), remaining)
+				require.Equal(t, tc.balances, balances(ctx, client, 1, enttransaction.BalanceLTE(0)))
+			})
+		}
+	}
+}
+
+// BenchmarkDischarge pays off many small debits with one big credit
+// go test ./pkg/transaction -run ^$ -bench Discharge
+func BenchmarkDischarge(b *testing.B) {
+	for _, debits := range []int{10, 100, 1000} {
+		for _, name := range []string{"iterative", "set based"} {
+			b.Run(fmt.Sprintf("%s/%d debits", name, debits), func(b *testing.B) {
+				client := enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1")
+				defer client.Close()
+
+				ctx := context.Background()
+
+				client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+				client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+
+				amounts := make([]money.Amount, debits)
+				for i := range amounts {
+					amounts[i] = money.MustParse("-0.99")
+				}
+				credit := money.Amount(debits) * money.MustParse("0.99")
+
+				discharger := dischargers[name]()
+
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					// every iteration gets a fresh account full of open debits
+					accountID := client.Account.Create().SetDocumentNumber(fmt.Sprint(i)).SetName("John Doe").SaveX(ctx).ID
+					createDebits(ctx, client, accountID, amounts...)
+					b.StartTimer()
+
+					_, remaining, err := discharge(ctx, client, discharger, transaction.FIFO(), accountID, credit)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if remaining != 0 {
+						b.Fatalf("expected everything to be discharged but %s is left", remaining)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestDischargeSameCurrencyOnly(t *testing.T) {
+	for name, newDischarger := range dischargers {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+			defer client.Close()
+
+			ctx := context.Background()
+
+			client.OperationType.Create().SetDescription("debit").SetID(1).SetIsDebit(true).ExecX(ctx)
+			client.OperationType.Create().SetDescription("credit").SetID(4).SetIsDebit(false).ExecX(ctx)
+			client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+
+			// a debit in another currency than the credit, which only a bad import could leave behind
+			client.Transaction.Create().
+				SetAccountID(1).
+				SetOperationTypeID(1).
+				SetAmount(money.MustParse("-40")).
+				SetBalance(money.MustParse("-40")).
+				SetCurrency("EUR").
+				SetTimestamp(time.Now()).
+				ExecX(ctx)
+			createDebits(ctx, client, 1, money.MustParse("-10"))
+
+			credit, remaining, err := discharge(ctx, client, newDischarger(), transaction.FIFO(), 1, money.MustParse("30"))
+			require.NoError(t, err)
+			require.Equal(t, money.MustParse("20"), remaining)
+			require.Equal(t, []money.Amount{money.MustParse("-40"), 0}, debitBalances(ctx, client, 1))
+			require.Equal(t, [][2]int64{{2, 1000}}, settlements(ctx, client, credit))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package transaction
+
+import "time"
+
+// MaxInstallments is the most installments a purchase can be split into
+const MaxInstallments = 48
+
+// installmentOperationTypeID is the seeded "Purchase with installments" operation type
+const installmentOperationTypeID = 2
+
+// installmentDueDate returns when the installment with the 1 based number of a purchase made at purchasedAt is due
+// the first installment is due right away and every next one a month later on the same day,
+// or on the last day of the month for months which are too short
+func installmentDueDate(purchasedAt time.Time, number int) time.Time {
+	year, month, day := purchasedAt.Date()
+	hour, minute, sec := purchasedAt.Clock()
+
+	dueMonth := month + time.Month(number-1)
+
+	// day 0 of the next month is the last day of the due month
+	lastDay := time.Date(year, dueMonth+1, 0, 0, 0, 0, 0, purchasedAt.Location()).Day()
+
+	return time.Date(year, dueMonth, min(day, lastDay), hour, minute, sec, purchasedAt.Nanosecond(), purchasedAt.Location())
+}
@@ -0,0 +1,52 @@
+package transaction
+
+import "transactor-server/pkg/db/ent"
+
+// MapEntTransactionToTransaction maps an ent.Transaction record to transaction.Transaction model
+func MapEntTransactionToTransaction(t *ent.Transaction) *Transaction {
+	if t == nil {
+		return nil
+	}
+
+	return &Transaction{
+		ID:                t.ID,
+		AccountID:         t.AccountID,
+		OperationTypeID:   t.OperationTypeID,
+		Amount:            t.Amount,
+		Balance:           t.Balance,
+		Currency:          t.Currency,
+		OriginalAmount:    t.OriginalAmount,
+		OriginalCurrency:  t.OriginalCurrency,
+		FxRate:            t.FxRate,
+		Timestamp:         t.Timestamp,
+		ParentID:          t.ParentID,
+		InstallmentNumber: t.InstallmentNumber,
+		DueDate:           t.DueDate,
+		Status:            t.Status.String(),
+		ReversalOfID:      t.ReversalOfID,
+		TransferID:        t.TransferID,
+		Description:       t.Description,
+		MerchantName:      t.MerchantName,
+		MCC:               t.Mcc,
+		ExternalReference: t.ExternalReference,
+		Metadata:          t.Metadata,
+		CreatedAt:         t.CreateTime,
+		UpdatedAt:         t.UpdateTime,
+	}
+}
+
+// MapEntSettlementToSettlement maps an ent.Settlement record to transaction.Settlement model
+func MapEntSettlementToSettlement(s *ent.Settlement) *Settlement {
+	if s == nil {
+		return nil
+	}
+
+	return &Settlement{
+		ID:                  s.ID,
+		CreditTransactionID: s.CreditTxnID,
+		DebitTransactionID:  s.DebitTxnID,
+		Amount:              s.Amount,
+		Timestamp:           s.Timestamp,
+		ReversedAt:          s.ReversedAt,
+	}
+}
@@ -0,0 +1,60 @@
+package transaction
+
+import (
+	"fmt"
+	"regexp"
+	"transactor-server/pkg/db/ent"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// limits of the informational fields of a transaction
+const (
+	MaxDescriptionLength       = 255
+	MaxMerchantNameLength      = 255
+	MaxExternalReferenceLength = 128
+	// MaxMetadataKeys is the max number of keys in the metadata of a transaction
+	MaxMetadataKeys        = 20
+	MaxMetadataKeyLength   = 40
+	MaxMetadataValueLength = 500
+)
+
+// mccRegex matches an ISO 18245 merchant category code
+var mccRegex = regexp.MustCompile(`^[0-9]{4}$`)
+
+// validateMetadata makes sure every key is not empty and both keys & values are within their max length
+func validateMetadata(value interface{}) error {
+	metadata, _ := value.(map[string]string)
+	for key, val := range metadata {
+		if key == "" {
+			return validation.NewError("validation_metadata_key_empty", "keys must not be empty")
+		}
+		if len(key) > MaxMetadataKeyLength {
+			return validation.NewError("validation_metadata_key_too_long", fmt.Sprintf("key %q must be at most %d long", key, MaxMetadataKeyLength))
+		}
+		if len(val) > MaxMetadataValueLength {
+			return validation.NewError("validation_metadata_value_too_long", fmt.Sprintf("value of %q must be at most %d long", key, MaxMetadataValueLength))
+		}
+	}
+	return nil
+}
+
+// setDetails sets the informational fields which were sent in the request
+func setDetails(create *ent.TransactionCreate, req *CreateRequest) *ent.TransactionCreate {
+	if req.Description != "" {
+		create.SetDescription(req.Description)
+	}
+	if req.MerchantName != "" {
+		create.SetMerchantName(req.MerchantName)
+	}
+	if req.MCC != "" {
+		create.SetMcc(req.MCC)
+	}
+	if req.ExternalReference != "" {
+		create.SetExternalReference(req.ExternalReference)
+	}
+	if len(req.Metadata) > 0 {
+		create.SetMetadata(req.Metadata)
+	}
+	return create
+}
@@ -0,0 +1,222 @@
+package transaction
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/settlement"
+	"transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/journal"
+	"transactor-server/pkg/pkgerr"
+)
+
+// operation types of the compensating transactions, they have the opposite sign of what they reverse
+const (
+	debitReversalOperationTypeID  = 5
+	creditReversalOperationTypeID = 6
+)
+
+var (
+	// ErrAlreadyReversed indicates the transaction was reversed before
+	ErrAlreadyReversed = pkgerr.NewServiceError(
+		"transaction", "already_reversed",
+		http.StatusConflict,
+		"transaction is already reversed",
+	)
+	// ErrNotReversible indicates the transaction can not be reversed on its own
+	ErrNotReversible = pkgerr.NewServiceError(
+		"transaction", "not_reversible",
+		http.StatusUnprocessableEntity,
+		"reversals, installments and transfers can not be reversed, reverse the purchase of an installment instead",
+	)
+)
+
+func (d *dao) Reverse(ctx context.Context, id int, strategy AllocationStrategy) (reversal *ent.Transaction, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		reversal, err = d.reverse(ctx, tx, id, strategy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reversal, nil
+}
+
+// reverse books the compensating transaction of a transaction inside tx and undoes its settlements
+// a reversed debit gives back what credits paid for it and those credits then discharge other open debits
+// a reversed credit takes back what it paid so the debits and the invoice it paid are open again
+func (d *dao) reverse(ctx context.Context, tx *ent.Tx, id int, strategy AllocationStrategy) (*ent.Transaction, error) {
+	original, err := tx.Transaction.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.LockAccount(ctx, tx, original.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// read it again now that the account is locked, it may have been reversed meanwhile
+	original, err = tx.Transaction.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if original.Status == transaction.StatusReversed {
+		return nil, ErrAlreadyReversed
+	}
+	// reversing one leg of a transfer would leave the other one in place
+	if original.ReversalOfID != nil || original.ParentID != nil || original.TransferID != nil {
+		return nil, ErrNotReversible
+	}
+
+	// a purchase with installments is reversed along with all of its installments
+	installments, err := tx.Transaction.
+		Query().
+		Where(transaction.ParentID(original.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	restoredCreditIDs := []int{}
+	for _, txn := range append([]*ent.Transaction{original}, installments...) {
+		creditIDs, err := undoSettlements(ctx, tx, txn, now)
+		if err != nil {
+			return nil, err
+		}
+		restoredCreditIDs = append(restoredCreditIDs, creditIDs...)
+
+		err = tx.Transaction.
+			UpdateOne(txn).
+			SetStatus(transaction.StatusReversed).
+			SetBalance(0).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	operationTypeID := debitReversalOperationTypeID
+	if original.Amount > 0 {
+		operationTypeID = creditReversalOperationTypeID
+
+		// what the credit paid of an invoice is owed again
+		err = billing.UnapplyCredit(ctx, tx, original.ID, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the compensating transaction nets the original out, so it has no balance of its own
+	reversal, err := tx.Transaction.
+		Create().
+		SetAccountID(original.AccountID).
+		SetOperationTypeID(operationTypeID).
+		SetTimestamp(now).
+		SetAmount(-original.Amount).
+		SetBalance(0).
+		SetCurrency(original.Currency).
+		SetReversalOfID(original.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = journal.Post(ctx, tx, reversal)
+	if err != nil {
+		return nil, err
+	}
+
+	err = WriteCreatedEvent(ctx, tx, reversal)
+	if err != nil {
+		return nil, err
+	}
+
+	// the credits which got their money back pay the other open debits, oldest credit first
+	slices.Sort(restoredCreditIDs)
+	for _, creditID := range slices.Compact(restoredCreditIDs) {
+		err = d.redischarge(ctx, tx, creditID, strategy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reversal, nil
+}
+
+// undoSettlements marks the settlements of txn as reversed and gives the amounts back to the other side
+// it returns the ids of the credits which got money back
+func undoSettlements(ctx context.Context, tx *ent.Tx, txn *ent.Transaction, now time.Time) ([]int, error) {
+	settlements, err := tx.Settlement.
+		Query().
+		Where(
+			settlement.Or(
+				settlement.CreditTxnID(txn.ID),
+				settlement.DebitTxnID(txn.ID),
+			),
+			settlement.ReversedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	creditIDs := []int{}
+	for _, s := range settlements {
+		if s.CreditTxnID == txn.ID {
+			// the debit owes again what this credit paid for it
+			err = tx.Transaction.UpdateOneID(s.DebitTxnID).AddBalance(-s.Amount).Exec(ctx)
+		} else {
+			// the credit has again what it paid for this debit
+			err = tx.Transaction.UpdateOneID(s.CreditTxnID).AddBalance(s.Amount).Exec(ctx)
+			creditIDs = append(creditIDs, s.CreditTxnID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.Settlement.UpdateOne(s).SetReversedAt(now).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return creditIDs, nil
+}
+
+// redischarge discharges the open debits of the account with whatever balance a credit has left
+// what it discharges pays the open invoice too, unless the credit paid an invoice when it was booked
+func (d *dao) redischarge(ctx context.Context, tx *ent.Tx, creditID int, strategy AllocationStrategy) error {
+	credit, err := tx.Transaction.Get(ctx, creditID)
+	if err != nil {
+		return err
+	}
+
+	if credit.Balance <= 0 {
+		return nil
+	}
+
+	balance, err := d.discharger.Discharge(ctx, tx, credit, strategy)
+	if err != nil {
+		return err
+	}
+
+	if balance == credit.Balance {
+		return nil
+	}
+
+	err = billing.ApplyCredit(ctx, tx, credit, credit.Balance-balance)
+	if err != nil {
+		return err
+	}
+
+	return tx.Transaction.UpdateOne(credit).SetBalance(balance).Exec(ctx)
+}
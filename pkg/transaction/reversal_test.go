@@ -0,0 +1,217 @@
+package transaction_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/invoice"
+	"transactor-server/pkg/db/ent/settlement"
+	enttransaction "transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// setupReversal returns a DAO over an account 1 with the seeded operation types
+func setupReversal(t *testing.T) (*ent.Client, transaction.DAO) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Purchase with installments").SetID(2).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Debit Reversal").SetID(5).SetIsDebit(false).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Reversal").SetID(6).SetIsDebit(true).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(ctx)
+
+	return client, transaction.NewDAO(client)
+}
+
+func mustCreate(t *testing.T, dao transaction.DAO, operationTypeID int, amount string, installments int) *ent.Transaction {
+	txn, err := dao.Create(context.Background(), &transaction.CreateRequest{
+		AccountID:       1,
+		OperationTypeID: operationTypeID,
+		Amount:          money.MustParse(amount),
+		Installments:    installments,
+	}, transaction.FIFO())
+	require.NoError(t, err)
+	return txn
+}
+
+func TestDAOReverseCredit(t *testing.T) {
+	t.Parallel()
+	client, dao := setupReversal(t)
+	ctx := context.Background()
+
+	firstDebit := mustCreate(t, dao, 1, "-50", 0)
+	secondDebit := mustCreate(t, dao, 1, "-23.5", 0)
+	credit := mustCreate(t, dao, 4, "60", 0)
+
+	reversal, err := dao.Reverse(ctx, credit.ID, transaction.FIFO())
+	require.NoError(t, err)
+
+	require.Equal(t, money.MustParse("-60"), reversal.Amount)
+	require.Equal(t, money.Amount(0), reversal.Balance)
+	require.Equal(t, 6, reversal.OperationTypeID)
+	require.Equal(t, credit.ID, *reversal.ReversalOfID)
+	require.Equal(t, enttransaction.StatusPosted, reversal.Status)
+
+	// the debits owe again what the credit paid
+	require.Equal(t, money.MustParse("-50"), client.Transaction.GetX(ctx, firstDebit.ID).Balance)
+	require.Equal(t, money.MustParse("-23.5"), client.Transaction.GetX(ctx, secondDebit.ID).Balance)
+
+	credit = client.Transaction.GetX(ctx, credit.ID)
+	require.Equal(t, enttransaction.StatusReversed, credit.Status)
+	require.Equal(t, money.Amount(0), credit.Balance)
+
+	require.Equal(t, 0, client.Settlement.Query().Where(settlement.ReversedAtIsNil()).CountX(ctx))
+	require.Equal(t, 2, client.Settlement.Query().Where(settlement.ReversedAtNotNil()).CountX(ctx))
+
+	// a transaction is reversed only once
+	_, err = dao.Reverse(ctx, credit.ID, transaction.FIFO())
+	require.ErrorIs(t, err, transaction.ErrAlreadyReversed)
+
+	// and a reversal can not be reversed
+	_, err = dao.Reverse(ctx, reversal.ID, transaction.FIFO())
+	require.ErrorIs(t, err, transaction.ErrNotReversible)
+}
+
+func TestDAOReverseCreditWhichPaidAnInvoice(t *testing.T) {
+	t.Parallel()
+	client, dao := setupReversal(t)
+	ctx := context.Background()
+
+	mustCreate(t, dao, 1, "-50", 0)
+	periodEnd := time.Now().Add(-time.Hour)
+	dbInvoice := client.Invoice.Create().
+		SetAccountID(1).
+		SetPeriodStart(periodEnd.AddDate(0, -1, 0)).
+		SetPeriodEnd(periodEnd).
+		SetTotalDue(money.MustParse("50")).
+		SetMinimumPayment(money.MustParse("10")).
+		SetDueDate(periodEnd.AddDate(0, 0, 10)).
+		SaveX(ctx)
+
+	credit := mustCreate(t, dao, 4, "50", 0)
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, invoice.StatusPaid, dbInvoice.Status)
+
+	_, err := dao.Reverse(ctx, credit.ID, transaction.FIFO())
+	require.NoError(t, err)
+
+	// the invoice asks again for what the credit paid
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.Amount(0), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusOpen, dbInvoice.Status)
+}
+
+func TestDAOReverseDebitRedischargePaysInvoice(t *testing.T) {
+	t.Parallel()
+	client, dao := setupReversal(t)
+	ctx := context.Background()
+
+	// the credit pays the first debit before there is an invoice
+	firstDebit := mustCreate(t, dao, 1, "-50", 0)
+	credit := mustCreate(t, dao, 4, "50", 0)
+	mustCreate(t, dao, 1, "-30", 0)
+
+	periodEnd := time.Now().Add(-time.Hour)
+	dbInvoice := client.Invoice.Create().
+		SetAccountID(1).
+		SetPeriodStart(periodEnd.AddDate(0, -1, 0)).
+		SetPeriodEnd(periodEnd).
+		SetTotalDue(money.MustParse("30")).
+		SetMinimumPayment(money.MustParse("10")).
+		SetDueDate(periodEnd.AddDate(0, 0, 10)).
+		SaveX(ctx)
+
+	_, err := dao.Reverse(ctx, firstDebit.ID, transaction.FIFO())
+	require.NoError(t, err)
+
+	// what the credit got back pays the second debit and so the invoice
+	require.Equal(t, money.MustParse("20"), client.Transaction.GetX(ctx, credit.ID).Balance)
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.MustParse("30"), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusPaid, dbInvoice.Status)
+}
+
+func TestDAOReverseDebit(t *testing.T) {
+	t.Parallel()
+	client, dao := setupReversal(t)
+	ctx := context.Background()
+
+	firstDebit := mustCreate(t, dao, 1, "-50", 0)
+	secondDebit := mustCreate(t, dao, 1, "-30", 0)
+	credit := mustCreate(t, dao, 4, "60", 0)
+	require.Equal(t, money.MustParse("-20"), client.Transaction.GetX(ctx, secondDebit.ID).Balance)
+
+	reversal, err := dao.Reverse(ctx, firstDebit.ID, transaction.FIFO())
+	require.NoError(t, err)
+
+	require.Equal(t, money.MustParse("50"), reversal.Amount)
+	require.Equal(t, money.Amount(0), reversal.Balance)
+	require.Equal(t, 5, reversal.OperationTypeID)
+
+	firstDebit = client.Transaction.GetX(ctx, firstDebit.ID)
+	require.Equal(t, enttransaction.StatusReversed, firstDebit.Status)
+	require.Equal(t, money.Amount(0), firstDebit.Balance)
+
+	// the credit got 50 back and used 20 of it to pay the second debit off
+	require.Equal(t, money.Amount(0), client.Transaction.GetX(ctx, secondDebit.ID).Balance)
+	require.Equal(t, money.MustParse("30"), client.Transaction.GetX(ctx, credit.ID).Balance)
+
+	settlements, err := dao.ListSettlements(ctx, secondDebit.ID)
+	require.NoError(t, err)
+	require.Len(t, settlements, 2)
+	require.Equal(t, money.MustParse("10"), settlements[0].Amount)
+	require.Equal(t, money.MustParse("20"), settlements[1].Amount)
+
+	settlements, err = dao.ListSettlements(ctx, firstDebit.ID)
+	require.NoError(t, err)
+	require.Len(t, settlements, 1)
+	require.NotNil(t, settlements[0].ReversedAt)
+}
+
+func TestDAOReverseInstallments(t *testing.T) {
+	t.Parallel()
+	client, dao := setupReversal(t)
+	ctx := context.Background()
+
+	purchase := mustCreate(t, dao, 2, "-90", 3)
+	credit := mustCreate(t, dao, 4, "30", 0)
+	require.Equal(t, money.Amount(0), credit.Balance)
+
+	installments := client.Transaction.Query().Where(enttransaction.ParentID(purchase.ID)).AllX(ctx)
+	require.Len(t, installments, 3)
+
+	// installments go with their purchase
+	_, err := dao.Reverse(ctx, installments[0].ID, transaction.FIFO())
+	require.ErrorIs(t, err, transaction.ErrNotReversible)
+
+	reversal, err := dao.Reverse(ctx, purchase.ID, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, money.MustParse("90"), reversal.Amount)
+
+	for _, installment := range client.Transaction.Query().Where(enttransaction.ParentID(purchase.ID)).AllX(ctx) {
+		require.Equal(t, enttransaction.StatusReversed, installment.Status)
+		require.Equal(t, money.Amount(0), installment.Balance)
+	}
+
+	// nothing else is open so the credit keeps what it paid for the first installment
+	require.Equal(t, money.MustParse("30"), client.Transaction.GetX(ctx, credit.ID).Balance)
+}
+
+func TestDAOReverseNotFound(t *testing.T) {
+	t.Parallel()
+	_, dao := setupReversal(t)
+
+	_, err := dao.Reverse(context.Background(), 999, transaction.FIFO())
+	require.True(t, ent.IsNotFound(err))
+}
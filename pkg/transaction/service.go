@@ -1,14 +1,20 @@
 package transaction
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"net/http"
+	"slices"
+	"time"
 	"transactor-server/pkg/config"
+	"transactor-server/pkg/db/ent"
 	"transactor-server/pkg/infra/log"
 	"transactor-server/pkg/operationtype"
 	"transactor-server/pkg/pkgerr"
 
 	zapotlp "github.com/SigNoz/zap_otlp"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
@@ -22,6 +28,16 @@ import (
 type Service interface {
 	// Create creates a new transaction record in the database layer
 	Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error)
+	// CreateBulk creates many transactions in one call and tells the outcome of each one
+	CreateBulk(ctx context.Context, req *BulkRequest) (*BulkResponse, error)
+	// Get tries to find an existing transaction in the database layer
+	Get(ctx context.Context, id int) (*Transaction, error)
+	// List returns a page of transactions of an account with the next page cursor
+	List(ctx context.Context, req *ListRequest) (*ListResponse, error)
+	// Reverse reverses a transaction and returns the compensating transaction
+	Reverse(ctx context.Context, id int) (*Transaction, error)
+	// ListSettlements returns which credits paid a debit transaction or which debits a credit transaction paid
+	ListSettlements(ctx context.Context, id int) (*ListSettlementsResponse, error)
 }
 
 // a traced, logged and metered transaction service
@@ -32,16 +48,59 @@ type service struct {
 	createCounterSuccess metric.Int64Counter
 	createCounterFailure metric.Int64Counter
 
+	createBulkCounterSuccess metric.Int64Counter
+	createBulkCounterFailure metric.Int64Counter
+
+	getCounterSuccess metric.Int64Counter
+	getCounterFailure metric.Int64Counter
+
+	listCounterSuccess metric.Int64Counter
+	listCounterFailure metric.Int64Counter
+
+	reverseCounterSuccess metric.Int64Counter
+	reverseCounterFailure metric.Int64Counter
+
+	listSettlementsCounterSuccess metric.Int64Counter
+	listSettlementsCounterFailure metric.Int64Counter
+
+	maxBackdate        time.Duration
+	allocationStrategy AllocationStrategy
+
 	logger *zap.Logger
 }
 
 var _ Service = (*service)(nil)
 
+// DefaultMaxBackdate is how far in the past an event timestamp can be unless configured otherwise
+const DefaultMaxBackdate = 30 * 24 * time.Hour
+
+// ServiceOption configures the Service returned by NewService
+type ServiceOption func(*service)
+
+// WithMaxBackdate sets how far in the past an event timestamp can be, it defaults to DefaultMaxBackdate
+func WithMaxBackdate(maxBackdate time.Duration) ServiceOption {
+	return func(s *service) {
+		if maxBackdate > 0 {
+			s.maxBackdate = maxBackdate
+		}
+	}
+}
+
+// WithAllocationStrategy sets the order in which credits discharge the open debits, it defaults to FIFO
+func WithAllocationStrategy(strategy AllocationStrategy) ServiceOption {
+	return func(s *service) {
+		if strategy != nil {
+			s.allocationStrategy = strategy
+		}
+	}
+}
+
 func NewService(
 	operationtypeDAO operationtype.DAO,
 	transactionDAO DAO,
 
 	logger *zap.Logger,
+	opts ...ServiceOption,
 ) Service {
 	meter := otel.GetMeterProvider().Meter("transactor-server")
 
@@ -54,14 +113,76 @@ func NewService(
 		log.L.Fatal("", zap.Error(err))
 	}
 
-	return &service{
-		operationtypeDAO:     operationtypeDAO,
-		transactionDAO:       transactionDAO,
-		createCounterSuccess: createCounterSuccess,
-		createCounterFailure: createCounterFailure,
+	createBulkCounterSuccess, err := meter.Int64Counter("transaction_service_create_bulk_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	createBulkCounterFailure, err := meter.Int64Counter("transaction_service_create_bulk_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	getCounterSuccess, err := meter.Int64Counter("transaction_service_get_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterFailure, err := meter.Int64Counter("transaction_service_get_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	listCounterSuccess, err := meter.Int64Counter("transaction_service_list_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listCounterFailure, err := meter.Int64Counter("transaction_service_list_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	reverseCounterSuccess, err := meter.Int64Counter("transaction_service_reverse_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	reverseCounterFailure, err := meter.Int64Counter("transaction_service_reverse_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	listSettlementsCounterSuccess, err := meter.Int64Counter("transaction_service_list_settlements_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listSettlementsCounterFailure, err := meter.Int64Counter("transaction_service_list_settlements_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	s := &service{
+		operationtypeDAO:              operationtypeDAO,
+		transactionDAO:                transactionDAO,
+		createCounterSuccess:          createCounterSuccess,
+		createCounterFailure:          createCounterFailure,
+		createBulkCounterSuccess:      createBulkCounterSuccess,
+		createBulkCounterFailure:      createBulkCounterFailure,
+		getCounterSuccess:             getCounterSuccess,
+		getCounterFailure:             getCounterFailure,
+		listCounterSuccess:            listCounterSuccess,
+		listCounterFailure:            listCounterFailure,
+		reverseCounterSuccess:         reverseCounterSuccess,
+		reverseCounterFailure:         reverseCounterFailure,
+		listSettlementsCounterSuccess: listSettlementsCounterSuccess,
+		listSettlementsCounterFailure: listSettlementsCounterFailure,
+
+		maxBackdate:        DefaultMaxBackdate,
+		allocationStrategy: FIFO(),
 
 		logger: logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 var (
@@ -71,6 +192,18 @@ var (
 		http.StatusBadRequest,
 		"operator type amount sign mismatch",
 	)
+	// ErrInstallmentsNotAllowed indicates installments were sent for an operation type other than purchase with installments
+	ErrInstallmentsNotAllowed = pkgerr.NewServiceError(
+		"transaction", "installments_not_allowed",
+		http.StatusBadRequest,
+		"installments are only allowed for purchases with installments",
+	)
+	// ErrInsufficientLimit indicates a debit is more than what is left of the credit limit of the account
+	ErrInsufficientLimit = pkgerr.NewServiceError(
+		"transaction", "insufficient_limit",
+		http.StatusUnprocessableEntity,
+		"amount is more than the available credit limit of the account",
+	)
 )
 
 func (s *service) Create(ctx context.Context, req *CreateRequest) (resp *CreateResponse, err error) {
@@ -94,37 +227,388 @@ func (s *service) Create(ctx context.Context, req *CreateRequest) (resp *CreateR
 	s.logger.Info("calling TransactionService.Create", zapotlp.SpanCtx(ctx), zap.Any("req", req))
 
 	// run validations, please the function to know more!
+	err = s.validateCreate(ctx, req, s.operationtypeDAO.Get)
+	if err != nil {
+		return
+	}
+
+	// fianll call dao to insert the record in db
+	dbTransaction, err := s.transactionDAO.Create(ctx, req, s.allocationStrategy)
+	if err != nil {
+		err = pkgerr.WrapDAOError(err)
+		return
+	}
+
+	// send back id of newly created transction
+	return &CreateResponse{
+		ID: dbTransaction.ID,
+	}, nil
+}
+
+func (s *service) CreateBulk(ctx context.Context, req *BulkRequest) (resp *BulkResponse, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "TransactionService.CreateBulk")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		// the items are not logged as there can be thousands of them
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+			s.logger.Error("end TransactionService.CreateBulk", zapotlp.SpanCtx(ctx), zap.String("mode", req.Mode), zap.Int("items", len(req.Items)), zap.Error(err))
+			s.createBulkCounterFailure.Add(ctx, 1)
+		} else {
+			s.logger.Info("end TransactionService.CreateBulk", zapotlp.SpanCtx(ctx), zap.String("mode", req.Mode), zap.Int("created", resp.Created), zap.Int("failed", resp.Failed))
+			s.createBulkCounterSuccess.Add(ctx, 1)
+		}
+	}()
+
+	s.logger.Info("calling TransactionService.CreateBulk", zapotlp.SpanCtx(ctx), zap.String("mode", req.Mode), zap.Int("items", len(req.Items)))
+
+	if req.Mode == "" {
+		req.Mode = BulkModeAtomic
+	}
+
 	err = req.Validate()
 	if err != nil {
 		err = pkgerr.WrapStructValidationError(err)
 		return
 	}
 
+	resp = &BulkResponse{Results: make([]*BulkResult, len(req.Items))}
+	fail := func(i int, err error) {
+		result := &BulkResult{Index: i, Status: BulkStatusFailed, Error: err.Error()}
+		if httpErr, ok := err.(pkgerr.HttpError); ok {
+			result.Error = httpErr.ResponseBody()
+		}
+		resp.Results[i] = result
+		resp.Failed++
+	}
+
+	// each operation type is looked up once for the whole batch
+	operationTypes := map[int]*ent.OperationType{}
+	getOperationType := func(ctx context.Context, id int) (*ent.OperationType, error) {
+		if operationType, ok := operationTypes[id]; ok {
+			return operationType, nil
+		}
+		operationType, err := s.operationtypeDAO.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		operationTypes[id] = operationType
+		return operationType, nil
+	}
+
+	// the items are booked grouped by account in ascending account id and in request order within an account
+	// so the discharges of an account happen in the order they were sent and accounts are always locked in the same order
+	order := make([]int, 0, len(req.Items))
+	for i, item := range req.Items {
+		err = s.validateCreate(ctx, item, getOperationType)
+		if err != nil {
+			fail(i, err)
+			continue
+		}
+		order = append(order, i)
+	}
+	err = nil
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(req.Items[a].AccountID, req.Items[b].AccountID)
+	})
+
+	if req.Mode == BulkModeBestEffort {
+		for _, i := range order {
+			dbTransaction, err := s.transactionDAO.Create(ctx, req.Items[i], s.allocationStrategy)
+			if err != nil {
+				fail(i, pkgerr.WrapDAOError(err))
+				continue
+			}
+			resp.Results[i] = &BulkResult{Index: i, Status: BulkStatusCreated, ID: dbTransaction.ID}
+			resp.Created++
+		}
+		return resp, nil
+	}
+
+	// in atomic mode nothing is booked once an item fails
+	if resp.Failed == 0 {
+		reqs := make([]*CreateRequest, len(order))
+		for j, i := range order {
+			reqs[j] = req.Items[i]
+		}
+
+		var dbTransactions []*ent.Transaction
+		dbTransactions, err = s.transactionDAO.CreateBulk(ctx, reqs, s.allocationStrategy)
+
+		var itemErr *BulkItemError
+		if errors.As(err, &itemErr) {
+			err = nil
+			fail(order[itemErr.Index], pkgerr.WrapDAOError(itemErr.Err))
+		} else if err != nil {
+			err = pkgerr.WrapDAOError(err)
+			return nil, err
+		}
+
+		for j, dbTransaction := range dbTransactions {
+			resp.Results[order[j]] = &BulkResult{Index: order[j], Status: BulkStatusCreated, ID: dbTransaction.ID}
+			resp.Created++
+		}
+	}
+
+	for i, result := range resp.Results {
+		if result == nil {
+			resp.Results[i] = &BulkResult{Index: i, Status: BulkStatusSkipped}
+		}
+	}
+
+	return resp, nil
+}
+
+// validateCreate validates the request and makes sure its amount sign and installments fit its operation type
+// getOperationType is how the operation type is looked up, bulk creates look up each operation type once
+func (s *service) validateCreate(
+	ctx context.Context,
+	req *CreateRequest,
+	getOperationType func(ctx context.Context, id int) (*ent.OperationType, error),
+) error {
+	// a null bulk item decodes to a nil request
+	if req == nil {
+		return pkgerr.WrapValidationError(validation.NewError("validation_required", "cannot be blank"), "item")
+	}
+
+	err := req.Validate()
+	if err != nil {
+		return pkgerr.WrapStructValidationError(err)
+	}
+
+	// back dated transactions are only accepted within the configured window
+	if req.EventTimestamp != nil {
+		now := time.Now()
+		if req.EventTimestamp.After(now) {
+			return pkgerr.WrapValidationError(validation.NewError("validation_event_timestamp_in_future", "must not be in the future"), "event_timestamp")
+		}
+		if req.EventTimestamp.Before(now.Add(-s.maxBackdate)) {
+			return pkgerr.WrapValidationError(validation.NewError("validation_event_timestamp_too_old", "must be no older than "+s.maxBackdate.String()), "event_timestamp")
+		}
+	}
+
 	// next we try to find the operation type from id sent
-	operationtype, err := s.operationtypeDAO.Get(ctx, req.OperationTypeID)
+	operationtype, err := getOperationType(ctx, req.OperationTypeID)
 	if err != nil {
-		err = pkgerr.WrapDAOError(err)
-		return
+		return pkgerr.WrapDAOError(err)
 	}
 
 	// next we want to make sure the sign on amount matches operation type
 	if operationtype.IsDebit && req.Amount > 0 {
-		err = ErrOperationTypeAmountSignMismatch
-		return
+		return ErrOperationTypeAmountSignMismatch
 	} else if !operationtype.IsDebit && req.Amount < 0 {
-		err = ErrOperationTypeAmountSignMismatch
+		return ErrOperationTypeAmountSignMismatch
+	}
+
+	// only purchases with installments can be split in installments
+	if req.Installments > 0 && operationtype.ID != installmentOperationTypeID {
+		return ErrInstallmentsNotAllowed
+	}
+
+	return nil
+}
+
+func (s *service) Get(ctx context.Context, id int) (resp *Transaction, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "TransactionService.Get")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+			s.logger.Error("end TransactionService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+			s.getCounterFailure.Add(ctx, 1)
+		} else {
+			s.logger.Info("end TransactionService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+			s.getCounterSuccess.Add(ctx, 1)
+		}
+	}()
+
+	s.logger.Info("calling TransactionService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	// validates the id to be +ve
+	err = validation.Validate(id, validation.Min(1))
+	if err != nil {
+		err = pkgerr.WrapValidationError(err, "id")
 		return
 	}
 
-	// fianll call dao to insert the record in db
-	dbTransaction, err := s.transactionDAO.Create(ctx, req)
+	// calls dao to get the record from database
+	dbTransaction, err := s.transactionDAO.Get(ctx, id)
 	if err != nil {
 		err = pkgerr.WrapDAOError(err)
 		return
 	}
 
-	// send back id of newly created transction
-	return &CreateResponse{
-		ID: dbTransaction.ID,
-	}, nil
+	// map the ent model to return format
+	return MapEntTransactionToTransaction(dbTransaction), nil
+}
+
+func (s *service) List(ctx context.Context, req *ListRequest) (resp *ListResponse, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "TransactionService.List")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+			s.logger.Error("end TransactionService.List", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+			s.listCounterFailure.Add(ctx, 1)
+		} else {
+			s.logger.Info("end TransactionService.List", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Int("count", len(resp.Transactions)))
+			s.listCounterSuccess.Add(ctx, 1)
+		}
+	}()
+
+	s.logger.Info("calling TransactionService.List", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	// run validations, please the function to know more!
+	err = req.Validate()
+	if err != nil {
+		err = pkgerr.WrapStructValidationError(err)
+		return
+	}
+
+	// the request is already validated so parsing can not fail here
+	filter := &ListFilter{
+		AccountID:         req.AccountID,
+		OperationTypeID:   req.OperationTypeID,
+		OpenBalance:       req.OpenBalance,
+		ExternalReference: req.ExternalReference,
+		Limit:             req.Limit,
+	}
+	filter.After, _ = DecodeCursor(req.Cursor)
+	if req.From != "" {
+		from, _ := time.Parse(time.RFC3339, req.From)
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, _ := time.Parse(time.RFC3339, req.To)
+		filter.To = &to
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultListLimit
+	}
+
+	// we ask for one extra record to know if there is a next page
+	limit := filter.Limit
+	filter.Limit++
+
+	dbTransactions, err := s.transactionDAO.List(ctx, filter)
+	if err != nil {
+		err = pkgerr.WrapDAOError(err)
+		return
+	}
+
+	resp = &ListResponse{
+		Transactions: make([]*Transaction, 0, len(dbTransactions)),
+	}
+
+	if len(dbTransactions) > limit {
+		dbTransactions = dbTransactions[:limit]
+		last := dbTransactions[limit-1]
+		resp.NextCursor = Cursor{Timestamp: last.Timestamp, ID: last.ID}.Encode()
+	}
+
+	for _, dbTransaction := range dbTransactions {
+		resp.Transactions = append(resp.Transactions, MapEntTransactionToTransaction(dbTransaction))
+	}
+
+	return resp, nil
+}
+
+func (s *service) Reverse(ctx context.Context, id int) (resp *Transaction, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "TransactionService.Reverse")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+			s.logger.Error("end TransactionService.Reverse", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+			s.reverseCounterFailure.Add(ctx, 1)
+		} else {
+			s.logger.Info("end TransactionService.Reverse", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+			s.reverseCounterSuccess.Add(ctx, 1)
+		}
+	}()
+
+	s.logger.Info("calling TransactionService.Reverse", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	// validates the id to be +ve
+	err = validation.Validate(id, validation.Min(1))
+	if err != nil {
+		err = pkgerr.WrapValidationError(err, "id")
+		return
+	}
+
+	// calls dao to reverse the transaction, it fails if it is already reversed
+	dbTransaction, err := s.transactionDAO.Reverse(ctx, id, s.allocationStrategy)
+	if err != nil {
+		err = pkgerr.WrapDAOError(err)
+		return
+	}
+
+	// map the compensating transaction to return format
+	return MapEntTransactionToTransaction(dbTransaction), nil
+}
+
+func (s *service) ListSettlements(ctx context.Context, id int) (resp *ListSettlementsResponse, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "TransactionService.ListSettlements")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+			s.logger.Error("end TransactionService.ListSettlements", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+			s.listSettlementsCounterFailure.Add(ctx, 1)
+		} else {
+			s.logger.Info("end TransactionService.ListSettlements", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Int("count", len(resp.Settlements)))
+			s.listSettlementsCounterSuccess.Add(ctx, 1)
+		}
+	}()
+
+	s.logger.Info("calling TransactionService.ListSettlements", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	// validates the id to be +ve
+	err = validation.Validate(id, validation.Min(1))
+	if err != nil {
+		err = pkgerr.WrapValidationError(err, "id")
+		return
+	}
+
+	// make sure the transaction exists, otherwise an empty list would hide a wrong id
+	_, err = s.transactionDAO.Get(ctx, id)
+	if err != nil {
+		err = pkgerr.WrapDAOError(err)
+		return
+	}
+
+	dbSettlements, err := s.transactionDAO.ListSettlements(ctx, id)
+	if err != nil {
+		err = pkgerr.WrapDAOError(err)
+		return
+	}
+
+	resp = &ListSettlementsResponse{
+		Settlements: make([]*Settlement, 0, len(dbSettlements)),
+	}
+	for _, dbSettlement := range dbSettlements {
+		resp.Settlements = append(resp.Settlements, MapEntSettlementToSettlement(dbSettlement))
+	}
+
+	return resp, nil
 }
@@ -2,10 +2,15 @@ package transaction_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 	"transactor-server/pkg/db/ent"
+	enttransaction "transactor-server/pkg/db/ent/transaction"
 	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
 	"transactor-server/pkg/pkgerr"
 	"transactor-server/pkg/transaction"
 
@@ -29,6 +34,133 @@ func TestServiceCreate(t *testing.T) {
 		require.NotNil(t, validationErr.ResponseBody())
 	})
 
+	t.Run("installments validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		for _, req := range []*transaction.CreateRequest{
+			{AccountID: 1, OperationTypeID: 2, Amount: money.MustParse("-100"), Installments: -1},
+			{AccountID: 1, OperationTypeID: 2, Amount: money.MustParse("-100"), Installments: transaction.MaxInstallments + 1},
+			// every installment must be at least 0.01
+			{AccountID: 1, OperationTypeID: 2, Amount: money.MustParse("-0.02"), Installments: 3},
+		} {
+			resp, err := service.Create(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("details validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		tooManyKeys := map[string]string{}
+		for i := range transaction.MaxMetadataKeys + 1 {
+			tooManyKeys[fmt.Sprint(i)] = "value"
+		}
+
+		for _, req := range []*transaction.CreateRequest{
+			{Description: strings.Repeat("a", transaction.MaxDescriptionLength+1)},
+			{MerchantName: strings.Repeat("a", transaction.MaxMerchantNameLength+1)},
+			{MCC: "541"},
+			{MCC: "54a1"},
+			{ExternalReference: strings.Repeat("a", transaction.MaxExternalReferenceLength+1)},
+			{Metadata: tooManyKeys},
+			{Metadata: map[string]string{"": "value"}},
+			{Metadata: map[string]string{strings.Repeat("a", transaction.MaxMetadataKeyLength+1): "value"}},
+			{Metadata: map[string]string{"key": strings.Repeat("a", transaction.MaxMetadataValueLength+1)}},
+			{Currency: "usd"},
+			{Currency: "EUX"},
+		} {
+			req.AccountID, req.OperationTypeID, req.Amount = 1, 1, money.MustParse("-10")
+
+			resp, err := service.Create(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("event timestamp validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(
+			mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop(),
+			transaction.WithMaxBackdate(24*time.Hour),
+		)
+
+		for _, eventTimestamp := range []time.Time{
+			time.Now().Add(time.Hour),
+			time.Now().Add(-25 * time.Hour),
+		} {
+			resp, err := service.Create(context.Background(), &transaction.CreateRequest{
+				AccountID:       1,
+				OperationTypeID: 1,
+				Amount:          money.MustParse("-10"),
+				EventTimestamp:  &eventTimestamp,
+			})
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("back dated", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, transactionDAO, zap.NewNop(), transaction.WithMaxBackdate(24*time.Hour))
+
+		eventTimestamp := time.Now().Add(-23 * time.Hour)
+		req := &transaction.CreateRequest{
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-10"),
+			EventTimestamp:  &eventTimestamp,
+		}
+
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil)
+		transactionDAO.On("Create", mock.Anything, req, transaction.FIFO()).Return(&ent.Transaction{ID: 1}, nil)
+
+		resp, err := service.Create(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, resp.ID)
+	})
+
+	t.Run("installments not allowed", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{
+			ID:      1,
+			IsDebit: true,
+		}, nil)
+
+		resp, err := service.Create(context.Background(), &transaction.CreateRequest{
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-98.99"),
+			Installments:    3,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, transaction.ErrInstallmentsNotAllowed, err)
+	})
+
 	t.Run("operation type not found", func(t *testing.T) {
 		t.Parallel()
 		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
@@ -41,7 +173,7 @@ func TestServiceCreate(t *testing.T) {
 		resp, err := service.Create(context.Background(), &transaction.CreateRequest{
 			AccountID:       1,
 			OperationTypeID: 1,
-			Amount:          98.99,
+			Amount:          money.MustParse("98.99"),
 		})
 
 		require.Error(t, err)
@@ -67,7 +199,7 @@ func TestServiceCreate(t *testing.T) {
 		resp, err := service.Create(context.Background(), &transaction.CreateRequest{
 			AccountID:       1,
 			OperationTypeID: 1,
-			Amount:          98.99,
+			Amount:          money.MustParse("98.99"),
 		})
 
 		require.Error(t, err)
@@ -93,7 +225,7 @@ func TestServiceCreate(t *testing.T) {
 		resp, err := service.Create(context.Background(), &transaction.CreateRequest{
 			AccountID:       1,
 			OperationTypeID: 1,
-			Amount:          -98.99,
+			Amount:          money.MustParse("-98.99"),
 		})
 
 		require.Error(t, err)
@@ -119,13 +251,13 @@ func TestServiceCreate(t *testing.T) {
 		transactionDAO.On("Create", mock.Anything, &transaction.CreateRequest{
 			AccountID:       1,
 			OperationTypeID: 1,
-			Amount:          98.99,
-		}).Return(nil, &ent.ConstraintError{})
+			Amount:          money.MustParse("98.99"),
+		}, transaction.FIFO()).Return(nil, &ent.ConstraintError{})
 
 		resp, err := service.Create(context.Background(), &transaction.CreateRequest{
 			AccountID:       1,
 			OperationTypeID: 1,
-			Amount:          98.99,
+			Amount:          money.MustParse("98.99"),
 		})
 
 		require.Error(t, err)
@@ -136,6 +268,38 @@ func TestServiceCreate(t *testing.T) {
 		require.NotNil(t, serviceErr.ResponseBody())
 	})
 
+	t.Run("insufficient limit", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, transactionDAO, zap.NewNop())
+
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{
+			ID:      1,
+			IsDebit: true,
+		}, nil)
+
+		transactionDAO.On("Create", mock.Anything, &transaction.CreateRequest{
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-98.99"),
+		}, transaction.FIFO()).Return(nil, transaction.ErrInsufficientLimit)
+
+		resp, err := service.Create(context.Background(), &transaction.CreateRequest{
+			AccountID:       1,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-98.99"),
+		})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusUnprocessableEntity, serviceErr.HttpStatusCode())
+		require.Equal(t, transaction.ErrInsufficientLimit, serviceErr)
+	})
+
 	t.Run("no error", func(t *testing.T) {
 		t.Parallel()
 		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
@@ -151,17 +315,559 @@ func TestServiceCreate(t *testing.T) {
 		transactionDAO.On("Create", mock.Anything, &transaction.CreateRequest{
 			AccountID:       1,
 			OperationTypeID: 1,
-			Amount:          98.99,
-		}).Return(&ent.Transaction{ID: 1}, nil)
+			Amount:          money.MustParse("98.99"),
+		}, transaction.FIFO()).Return(&ent.Transaction{ID: 1}, nil)
 
 		resp, err := service.Create(context.Background(), &transaction.CreateRequest{
 			AccountID:       1,
 			OperationTypeID: 1,
-			Amount:          98.99,
+			Amount:          money.MustParse("98.99"),
 		})
 
 		require.NoError(t, err)
 		require.NotNil(t, resp)
 		require.Equal(t, 1, resp.ID)
 	})
+
+	t.Run("configured allocation strategy", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, transactionDAO, zap.NewNop(),
+			transaction.WithAllocationStrategy(transaction.HighestAmountFirst()))
+
+		operationTypeDAO.On("Get", mock.Anything, 4).Return(&ent.OperationType{
+			ID:      4,
+			IsDebit: false,
+		}, nil)
+
+		// the strategy the service was configured with is the one the credit discharges with
+		req := &transaction.CreateRequest{AccountID: 1, OperationTypeID: 4, Amount: money.MustParse("10")}
+		transactionDAO.On("Create", mock.Anything, req, transaction.HighestAmountFirst()).Return(&ent.Transaction{ID: 1}, nil)
+
+		resp, err := service.Create(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, resp.ID)
+	})
+}
+
+func TestServiceGet(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		resp, err := service.Get(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		require.NotNil(t, validationErr.ResponseBody())
+	})
+
+	t.Run("db not found error", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("Get", mock.Anything, 999).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Get(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+		require.NotNil(t, serviceErr.ResponseBody())
+	})
+
+	t.Run("db some other error", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("Get", mock.Anything, 999).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := service.Get(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, serviceErr.HttpStatusCode())
+		require.NotNil(t, serviceErr.ResponseBody())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		dbTransaction := &ent.Transaction{
+			ID:              999,
+			CreateTime:      time.Now().AddDate(0, 0, -1),
+			UpdateTime:      time.Now(),
+			AccountID:       373,
+			OperationTypeID: 1,
+			Amount:          money.MustParse("-98.75"),
+			Balance:         money.MustParse("-18.75"),
+			Timestamp:       time.Now().AddDate(0, 0, -1),
+		}
+
+		transactionDAO.
+			On("Get", mock.Anything, 999).
+			Return(dbTransaction, nil)
+
+		resp, err := service.Get(context.Background(), 999)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, dbTransaction.ID, resp.ID)
+		require.Equal(t, dbTransaction.AccountID, resp.AccountID)
+		require.Equal(t, dbTransaction.OperationTypeID, resp.OperationTypeID)
+		require.Equal(t, dbTransaction.Amount, resp.Amount)
+		require.Equal(t, dbTransaction.Balance, resp.Balance)
+		require.Equal(t, dbTransaction.Timestamp, resp.Timestamp)
+		require.Equal(t, dbTransaction.CreateTime, resp.CreatedAt)
+		require.Equal(t, dbTransaction.UpdateTime, resp.UpdatedAt)
+	})
+}
+
+func TestServiceList(t *testing.T) {
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		resp, err := service.List(context.Background(), &transaction.ListRequest{
+			AccountID:         1,
+			From:              "yesterday",
+			ExternalReference: strings.Repeat("a", transaction.MaxExternalReferenceLength+1),
+			Cursor:            "not-a-cursor",
+			Limit:             1000,
+		})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+
+		body, ok := validationErr.ResponseBody().(pkgerr.ValidationErrorResponseBody)
+		require.True(t, ok)
+		require.Contains(t, body.Errors, "From")
+		require.Contains(t, body.Errors, "ExternalReference")
+		require.Contains(t, body.Errors, "Cursor")
+		require.Contains(t, body.Errors, "Limit")
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("List", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := service.List(context.Background(), &transaction.ListRequest{AccountID: 1})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("List", mock.Anything, &transaction.ListFilter{
+			AccountID: 1,
+			Limit:     transaction.DefaultListLimit + 1,
+		}).Return([]*ent.Transaction{{ID: 2}, {ID: 1}}, nil)
+
+		resp, err := service.List(context.Background(), &transaction.ListRequest{AccountID: 1})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Transactions, 2)
+		require.Empty(t, resp.NextCursor)
+	})
+
+	t.Run("next page", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		from := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+		after := &transaction.Cursor{Timestamp: from.Add(time.Hour * 5), ID: 9}
+		last := time.Date(2024, 11, 1, 4, 0, 0, 0, time.UTC)
+
+		transactionDAO.On("List", mock.Anything, mock.MatchedBy(func(filter *transaction.ListFilter) bool {
+			return filter.AccountID == 1 &&
+				filter.OperationTypeID == 4 &&
+				filter.From.Equal(from) &&
+				filter.To == nil &&
+				filter.OpenBalance &&
+				filter.After.ID == 9 &&
+				filter.After.Timestamp.Equal(after.Timestamp) &&
+				filter.Limit == 3
+		})).Return([]*ent.Transaction{{ID: 8}, {ID: 7, Timestamp: last}, {ID: 6}}, nil)
+
+		resp, err := service.List(context.Background(), &transaction.ListRequest{
+			AccountID:       1,
+			OperationTypeID: 4,
+			From:            from.Format(time.RFC3339),
+			OpenBalance:     true,
+			Cursor:          after.Encode(),
+			Limit:           2,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Transactions, 2)
+		require.Equal(t, 8, resp.Transactions[0].ID)
+		require.Equal(t, 7, resp.Transactions[1].ID)
+
+		next, err := transaction.DecodeCursor(resp.NextCursor)
+		require.NoError(t, err)
+		require.Equal(t, 7, next.ID)
+		require.True(t, last.Equal(next.Timestamp))
+	})
+}
+
+func TestServiceReverse(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		resp, err := service.Reverse(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("transaction not found", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("Reverse", mock.Anything, 999, transaction.FIFO()).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Reverse(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("already reversed", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("Reverse", mock.Anything, 999, transaction.FIFO()).Return(nil, transaction.ErrAlreadyReversed)
+
+		resp, err := service.Reverse(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusConflict, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		reversalOfID := 999
+		transactionDAO.On("Reverse", mock.Anything, 999, transaction.FIFO()).Return(&ent.Transaction{
+			ID:              1000,
+			AccountID:       373,
+			OperationTypeID: 5,
+			Amount:          money.MustParse("98.75"),
+			Status:          enttransaction.StatusPosted,
+			ReversalOfID:    &reversalOfID,
+		}, nil)
+
+		resp, err := service.Reverse(context.Background(), 999)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, 1000, resp.ID)
+		require.Equal(t, 5, resp.OperationTypeID)
+		require.Equal(t, money.MustParse("98.75"), resp.Amount)
+		require.Equal(t, "posted", resp.Status)
+		require.Equal(t, 999, *resp.ReversalOfID)
+	})
+}
+
+func TestServiceListSettlements(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		resp, err := service.ListSettlements(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("transaction not found", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("Get", mock.Anything, 999).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.ListSettlements(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		transactionDAO.On("Get", mock.Anything, 999).Return(&ent.Transaction{ID: 999}, nil)
+		transactionDAO.On("ListSettlements", mock.Anything, 999).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := service.ListSettlements(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), transactionDAO, zap.NewNop())
+
+		dbSettlement := &ent.Settlement{
+			ID:          1,
+			CreditTxnID: 999,
+			DebitTxnID:  373,
+			Amount:      money.MustParse("18.75"),
+			Timestamp:   time.Now(),
+		}
+
+		transactionDAO.On("Get", mock.Anything, 999).Return(&ent.Transaction{ID: 999}, nil)
+		transactionDAO.On("ListSettlements", mock.Anything, 999).Return([]*ent.Settlement{dbSettlement}, nil)
+
+		resp, err := service.ListSettlements(context.Background(), 999)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Settlements, 1)
+		require.Equal(t, dbSettlement.ID, resp.Settlements[0].ID)
+		require.Equal(t, dbSettlement.CreditTxnID, resp.Settlements[0].CreditTransactionID)
+		require.Equal(t, dbSettlement.DebitTxnID, resp.Settlements[0].DebitTransactionID)
+		require.Equal(t, dbSettlement.Amount, resp.Settlements[0].Amount)
+		require.Equal(t, dbSettlement.Timestamp, resp.Settlements[0].Timestamp)
+	})
+}
+
+func TestServiceCreateBulk(t *testing.T) {
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		for _, req := range []*transaction.BulkRequest{
+			{},
+			{Mode: "some", Items: []*transaction.CreateRequest{{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-1")}}},
+		} {
+			resp, err := service.CreateBulk(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("atomic with an invalid item", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		// looked up once for both items
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil).Once()
+
+		resp, err := service.CreateBulk(context.Background(), &transaction.BulkRequest{
+			Items: []*transaction.CreateRequest{
+				{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")},
+				{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("10")},
+				{AccountID: 1},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 0, resp.Created)
+		require.Equal(t, 2, resp.Failed)
+		require.Equal(t, transaction.BulkStatusSkipped, resp.Results[0].Status)
+		require.Equal(t, transaction.BulkStatusFailed, resp.Results[1].Status)
+		require.Equal(t, "operator_type_amount_sign_mismatch", resp.Results[1].Error.(pkgerr.ServiceErrorResponseBody).Code)
+		require.Equal(t, transaction.BulkStatusFailed, resp.Results[2].Status)
+		require.Equal(t, 2, resp.Results[2].Index)
+	})
+
+	t.Run("null item", func(t *testing.T) {
+		t.Parallel()
+		service := transaction.NewService(mocks.NewMockOperationTypeDAO(t), mocks.NewMockTransactionDAO(t), zap.NewNop())
+
+		resp, err := service.CreateBulk(context.Background(), &transaction.BulkRequest{
+			Mode:  transaction.BulkModeBestEffort,
+			Items: []*transaction.CreateRequest{nil},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 0, resp.Created)
+		require.Equal(t, 1, resp.Failed)
+		require.Equal(t, transaction.BulkStatusFailed, resp.Results[0].Status)
+		require.Equal(t, map[string]string{"item": "cannot be blank"}, resp.Results[0].Error.(pkgerr.ValidationErrorResponseBody).Errors)
+	})
+
+	t.Run("atomic with a failing item", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, transactionDAO, zap.NewNop())
+
+		items := []*transaction.CreateRequest{
+			{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("-10")},
+			{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-20")},
+			{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("-30")},
+		}
+
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil).Once()
+		// booked by account and in request order within an account
+		transactionDAO.On("CreateBulk", mock.Anything, []*transaction.CreateRequest{items[1], items[0], items[2]}, transaction.FIFO()).
+			Return(nil, &transaction.BulkItemError{Index: 1, Err: transaction.ErrInsufficientLimit})
+
+		resp, err := service.CreateBulk(context.Background(), &transaction.BulkRequest{Items: items})
+
+		require.NoError(t, err)
+		require.Equal(t, 0, resp.Created)
+		require.Equal(t, 1, resp.Failed)
+		require.Equal(t, transaction.BulkStatusFailed, resp.Results[0].Status)
+		require.Equal(t, "insufficient_limit", resp.Results[0].Error.(pkgerr.ServiceErrorResponseBody).Code)
+		require.Equal(t, transaction.BulkStatusSkipped, resp.Results[1].Status)
+		require.Equal(t, transaction.BulkStatusSkipped, resp.Results[2].Status)
+	})
+
+	t.Run("atomic db error", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, transactionDAO, zap.NewNop())
+
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil)
+		transactionDAO.On("CreateBulk", mock.Anything, mock.Anything, transaction.FIFO()).Return(nil, fmt.Errorf("some error"))
+
+		resp, err := service.CreateBulk(context.Background(), &transaction.BulkRequest{
+			Items: []*transaction.CreateRequest{{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")}},
+		})
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("atomic no error", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, transactionDAO, zap.NewNop())
+
+		items := []*transaction.CreateRequest{
+			{AccountID: 2, OperationTypeID: 4, Amount: money.MustParse("10")},
+			{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-20")},
+		}
+
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil)
+		operationTypeDAO.On("Get", mock.Anything, 4).Return(&ent.OperationType{ID: 4, IsDebit: false}, nil)
+		transactionDAO.On("CreateBulk", mock.Anything, []*transaction.CreateRequest{items[1], items[0]}, transaction.FIFO()).
+			Return([]*ent.Transaction{{ID: 7}, {ID: 8}}, nil)
+
+		resp, err := service.CreateBulk(context.Background(), &transaction.BulkRequest{Mode: transaction.BulkModeAtomic, Items: items})
+
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.Created)
+		require.Equal(t, 0, resp.Failed)
+		require.Equal(t, &transaction.BulkResult{Index: 0, Status: transaction.BulkStatusCreated, ID: 8}, resp.Results[0])
+		require.Equal(t, &transaction.BulkResult{Index: 1, Status: transaction.BulkStatusCreated, ID: 7}, resp.Results[1])
+	})
+
+	t.Run("best effort", func(t *testing.T) {
+		t.Parallel()
+		operationTypeDAO := mocks.NewMockOperationTypeDAO(t)
+		transactionDAO := mocks.NewMockTransactionDAO(t)
+
+		service := transaction.NewService(operationTypeDAO, transactionDAO, zap.NewNop())
+
+		items := []*transaction.CreateRequest{
+			{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-10")},
+			{AccountID: 1, OperationTypeID: 1, Amount: money.MustParse("-20")},
+			{AccountID: 1, OperationTypeID: 9, Amount: money.MustParse("-30")},
+		}
+
+		operationTypeDAO.On("Get", mock.Anything, 1).Return(&ent.OperationType{ID: 1, IsDebit: true}, nil)
+		operationTypeDAO.On("Get", mock.Anything, 9).Return(nil, &ent.NotFoundError{})
+		transactionDAO.On("Create", mock.Anything, items[0], transaction.FIFO()).Return(&ent.Transaction{ID: 7}, nil)
+		transactionDAO.On("Create", mock.Anything, items[1], transaction.FIFO()).Return(nil, transaction.ErrInsufficientLimit)
+
+		resp, err := service.CreateBulk(context.Background(), &transaction.BulkRequest{Mode: transaction.BulkModeBestEffort, Items: items})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, resp.Created)
+		require.Equal(t, 2, resp.Failed)
+		require.Equal(t, transaction.BulkStatusCreated, resp.Results[0].Status)
+		require.Equal(t, 7, resp.Results[0].ID)
+		require.Equal(t, transaction.BulkStatusFailed, resp.Results[1].Status)
+		require.Equal(t, "insufficient_limit", resp.Results[1].Error.(pkgerr.ServiceErrorResponseBody).Code)
+		require.Equal(t, transaction.BulkStatusFailed, resp.Results[2].Status)
+		require.Equal(t, "not_found", resp.Results[2].Error.(pkgerr.ServiceErrorResponseBody).Code)
+	})
 }
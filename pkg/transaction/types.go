@@ -1,24 +1,217 @@
 package transaction
 
-import validation "github.com/go-ozzo/ozzo-validation/v4"
+import (
+	"time"
+	"transactor-server/pkg/money"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
 
 type CreateRequest struct {
-	AccountID       int     `json:"account_id"`
-	OperationTypeID int     `json:"operation_type_id"`
-	Amount          float64 `json:"amount"`
+	AccountID       int `json:"account_id"`
+	OperationTypeID int `json:"operation_type_id"`
+	// Amount can be sent as a JSON number or string with at most 2 decimal places
+	Amount money.Amount `json:"amount" swaggertype:"number" example:"-98.75"`
+	// Installments splits a purchase with installments into this many monthly installments
+	Installments int `json:"installments,omitempty" example:"3"`
+	// EventTimestamp is when the transaction happened, it defaults to when it is booked
+	// it can be back-dated by at most the configured window but can not be in the future
+	EventTimestamp *time.Time `json:"event_timestamp,omitempty"`
+	// Description, MerchantName, MCC, ExternalReference & Metadata are only informational
+	Description  string `json:"description,omitempty" example:"Groceries"`
+	MerchantName string `json:"merchant_name,omitempty" example:"ACME Market"`
+	// MCC is the 4 digit merchant category code
+	MCC string `json:"mcc,omitempty" example:"5411"`
+	// ExternalReference is the id of the transaction in the system which sent it, transactions can be listed by it
+	ExternalReference string            `json:"external_reference,omitempty" example:"order-1234"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+	// Currency is the ISO 4217 code of the currency of the amount, it defaults to the currency of the account
+	// an amount in another currency is converted with the fx rate to the currency of the account, or rejected when there is none
+	Currency string `json:"currency,omitempty" example:"EUR"`
+
+	// conversion is set once the amount was converted to the currency of the account
+	conversion *conversion
+}
+
+// timestamp returns when the transaction happened
+func (req CreateRequest) timestamp() time.Time {
+	if req.EventTimestamp != nil {
+		return *req.EventTimestamp
+	}
+	return time.Now()
 }
 
 // Validate validates the CreateRequest to
-// have +ve account and operation type id and
+// have +ve account and operation type id,
 // ensure amount is not ZERO
+// have installments between 0 and MaxInstallments with every installment being at least 0.01,
+// have a 4 digit mcc, the informational fields within their max length
+// and have at most MaxMetadataKeys metadata keys
+// the precision of the amount is already checked when parsing it, see money.Amount
 func (req CreateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(&req.AccountID, validation.Min(1)),
 		validation.Field(&req.OperationTypeID, validation.Min(1)),
 		validation.Field(&req.Amount, validation.Required), // if there can be 0 amount then we should remove this!
+		validation.Field(&req.Installments,
+			validation.Min(0),
+			validation.Max(MaxInstallments),
+			validation.Max(int(min(req.Amount.Abs(), MaxInstallments))).Error("every installment must be at least 0.01"),
+		),
+		validation.Field(&req.Description, validation.Length(0, MaxDescriptionLength)),
+		validation.Field(&req.MerchantName, validation.Length(0, MaxMerchantNameLength)),
+		validation.Field(&req.MCC, validation.Match(mccRegex).Error("must be 4 digits")),
+		validation.Field(&req.ExternalReference, validation.Length(0, MaxExternalReferenceLength)),
+		validation.Field(&req.Metadata, validation.Length(0, MaxMetadataKeys), validation.By(validateMetadata)),
+		validation.Field(&req.Currency, money.CurrencyRule),
 	)
 }
 
 type CreateResponse struct {
 	ID int `json:"id"`
 }
+
+// BulkRequest books many transactions in one call
+type BulkRequest struct {
+	// Mode is atomic (default) to book all or none of the items or best_effort to book every item on its own
+	Mode  string           `query:"mode"`
+	Items []*CreateRequest `query:"-"`
+}
+
+// Validate validates the BulkRequest to
+// have mode as atomic or best_effort
+// and have at least 1 and at most MaxBulkItems items, the items are validated one by one by the service
+func (req BulkRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.Mode, validation.In(BulkModeAtomic, BulkModeBestEffort)),
+		// skip stops ozzo from validating every item here, the service reports them one by one instead
+		validation.Field(&req.Items, validation.Required, validation.Length(1, MaxBulkItems), validation.Skip),
+	)
+}
+
+// BulkResult is the outcome of one item of a BulkRequest
+type BulkResult struct {
+	// Index is the position of the item in the request, 0 based
+	Index int `json:"index"`
+	// Status is created, failed or skipped, skipped items were not booked because another item failed in atomic mode
+	Status string `json:"status" example:"created"`
+	// ID of the created transaction
+	ID int `json:"id,omitempty"`
+	// Error is the same error body a single create would return
+	Error any `json:"error,omitempty"`
+}
+
+type BulkResponse struct {
+	Created int `json:"created"`
+	Failed  int `json:"failed"`
+	// Results are in the same order as the items of the request
+	Results []*BulkResult `json:"results"`
+}
+
+type Transaction struct {
+	ID              int          `json:"id"`
+	AccountID       int          `json:"account_id"`
+	OperationTypeID int          `json:"operation_type_id"`
+	Amount          money.Amount `json:"amount" swaggertype:"number" example:"-98.75"`
+	Balance         money.Amount `json:"balance" swaggertype:"number" example:"-18.75"`
+	Currency        string       `json:"currency" example:"USD"`
+	// OriginalAmount, OriginalCurrency & FxRate are only set on a transaction which was sent in another currency than its account
+	OriginalAmount   *money.Amount `json:"original_amount,omitempty" swaggertype:"number" example:"-90.50"`
+	OriginalCurrency *string       `json:"original_currency,omitempty" example:"EUR"`
+	FxRate           *string       `json:"fx_rate,omitempty" example:"1.0911"`
+	// Timestamp is when the transaction happened and CreatedAt is when it was booked, they differ for back-dated transactions
+	Timestamp time.Time `json:"timestamp"`
+	// ParentID, InstallmentNumber & DueDate are only set on the installments of a purchase with installments
+	ParentID          *int       `json:"parent_id,omitempty"`
+	InstallmentNumber *int       `json:"installment_number,omitempty"`
+	DueDate           *time.Time `json:"due_date,omitempty"`
+	// Status is posted or reversed
+	Status string `json:"status" example:"posted"`
+	// ReversalOfID is only set on the compensating transaction of a reversal
+	ReversalOfID *int `json:"reversal_of_id,omitempty"`
+	// TransferID is only set on the legs of a transfer
+	TransferID        *int              `json:"transfer_id,omitempty"`
+	Description       *string           `json:"description,omitempty"`
+	MerchantName      *string           `json:"merchant_name,omitempty"`
+	MCC               *string           `json:"mcc,omitempty"`
+	ExternalReference *string           `json:"external_reference,omitempty"`
+	Metadata          map[string]string `json:"metadata,omitempty"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+// BalanceChange is the payload of the BalanceChanged event, the net balance of an account right after a transaction was booked
+type BalanceChange struct {
+	AccountID     int `json:"account_id"`
+	TransactionID int `json:"transaction_id"`
+	// NetBalance is the same as the net_balance of the balance api, what the account has available minus what it owes
+	NetBalance money.Amount `json:"net_balance" swaggertype:"number" example:"-18.75"`
+	Currency   string       `json:"currency" example:"USD"`
+}
+
+// ListRequest defines the filters and pagination options to list transactions of an account
+type ListRequest struct {
+	AccountID       int    `query:"-"`
+	OperationTypeID int    `query:"operation_type_id"`
+	From            string `query:"from"`
+	To              string `query:"to"`
+	OpenBalance     bool   `query:"open_balance"`
+	// ExternalReference only lists the transactions sent with this external reference
+	ExternalReference string `query:"external_reference"`
+	Cursor            string `query:"cursor"`
+	Limit             int    `query:"limit"`
+}
+
+// Validate validates the ListRequest to
+// have +ve account id and an optional +ve operation type id,
+// have from & to as RFC3339 timestamps,
+// have an external reference within its max length,
+// have a cursor which was returned by a previous list call
+// and ensure limit is not more than MaxListLimit
+func (req ListRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.AccountID, validation.Min(1)),
+		validation.Field(&req.OperationTypeID, validation.Min(0)),
+		validation.Field(&req.From, validation.Date(time.RFC3339)),
+		validation.Field(&req.To, validation.Date(time.RFC3339)),
+		validation.Field(&req.ExternalReference, validation.Length(0, MaxExternalReferenceLength)),
+		validation.Field(&req.Cursor, validation.By(func(value interface{}) error {
+			_, err := DecodeCursor(value.(string))
+			return err
+		})),
+		validation.Field(&req.Limit, validation.Min(0), validation.Max(MaxListLimit)),
+	)
+}
+
+// ListFilter is the parsed version of ListRequest which is understood by the DAO
+type ListFilter struct {
+	AccountID         int
+	OperationTypeID   int
+	From              *time.Time
+	To                *time.Time
+	OpenBalance       bool
+	ExternalReference string
+	After             *Cursor
+	Limit             int
+}
+
+type ListResponse struct {
+	Transactions []*Transaction `json:"transactions"`
+	// NextCursor is empty when there are no more transactions to fetch
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// Settlement tells how much of a credit transaction paid a debit transaction
+type Settlement struct {
+	ID                  int          `json:"id"`
+	CreditTransactionID int          `json:"credit_transaction_id"`
+	DebitTransactionID  int          `json:"debit_transaction_id"`
+	Amount              money.Amount `json:"amount" swaggertype:"number" example:"18.75"`
+	Timestamp           time.Time    `json:"timestamp"`
+	// ReversedAt is set when the payment was undone by reversing the credit or the debit
+	ReversedAt *time.Time `json:"reversed_at,omitempty"`
+}
+
+type ListSettlementsResponse struct {
+	Settlements []*Settlement `json:"settlements"`
+}
@@ -0,0 +1,90 @@
+package transfer
+
+import (
+	"net/http"
+	"strconv"
+
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// API is the api handler for transfer apis
+type API struct {
+	sevice Service
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+	}
+}
+
+// Handle sets up all the routes with their handler funcs for transfer apis
+func (a *API) Handle(router fiber.Router) {
+	router.Post("/", a.createTransfer)
+	router.Get("/:id", a.getTransfer)
+}
+
+// createTransfer moves money from one account to another
+// it books a debit on the source account and a credit on the destination account atomically
+// @Summary      create a transfer
+// @Produce      json
+// @Tags		 transfer
+// @Param        req    body     CreateRequest  true  "transfer details to create"
+// @Param        Idempotency-Key  header  string  false  "a unique key which makes the request safe to retry"
+// @Success      201  {object}  Transfer
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      409  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      422  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/transfers [post]
+func (a *API) createTransfer(c *fiber.Ctx) error {
+	req := &CreateRequest{}
+
+	// try to parse the body
+	err := c.BodyParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("transfer", "body_parse_failure", http.StatusBadRequest, err.Error())
+	}
+
+	// call the sevice to create the transfer
+	resp, err := a.sevice.Create(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error we return the transfer with 201 status
+	return c.Status(http.StatusCreated).JSON(resp)
+}
+
+// getTransfer returns an existing transfer along with its debit and credit
+// @Summary      get a transfer
+// @Produce      json
+// @Tags		 transfer
+// @Param        id    path     int  true  "transfer id"
+// @Success      200  {object}  Transfer
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/transfers/{id} [get]
+func (a *API) getTransfer(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to get the transfer
+	resp, err := a.sevice.Get(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error we return the response with 200 status
+	return c.JSON(resp)
+}
@@ -0,0 +1,148 @@
+package transfer_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+	"transactor-server/pkg/transfer"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockTransferService) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	router := app.Group("/test/transfers")
+	service := mocks.NewMockTransferService(t)
+
+	api := transfer.NewAPI(service)
+	api.Handle(router)
+
+	return app, service
+}
+
+func TestAPICreate(t *testing.T) {
+	t.Run("body parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transfers/", bytes.NewBufferString("something"))
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transfers/", bytes.NewBufferString(`{"source_account_id":1,"destination_account_id":2,"amount":"10"}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		service.On("Create", mock.Anything, &transfer.CreateRequest{
+			SourceAccountID:      1,
+			DestinationAccountID: 2,
+			Amount:               money.MustParse("10"),
+		}).Return(nil, transaction.ErrInsufficientLimit)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/transfers/", bytes.NewBufferString(`{"source_account_id":1,"destination_account_id":2,"amount":10}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+		transferID := 3
+		service.On("Create", mock.Anything, &transfer.CreateRequest{
+			SourceAccountID:      1,
+			DestinationAccountID: 2,
+			Amount:               money.MustParse("10"),
+		}).Return(&transfer.Transfer{
+			ID:                   transferID,
+			SourceAccountID:      1,
+			DestinationAccountID: 2,
+			Amount:               money.MustParse("10"),
+			Debit:                &transaction.Transaction{ID: 7, AccountID: 1, Amount: money.MustParse("-10"), TransferID: &transferID},
+			Credit:               &transaction.Transaction{ID: 8, AccountID: 2, Amount: money.MustParse("10"), TransferID: &transferID},
+		}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(3), gjson.Get(string(b), "id").Int())
+		require.Equal(t, int64(7), gjson.Get(string(b), "debit.id").Int())
+		require.Equal(t, -10.0, gjson.Get(string(b), "debit.amount").Float())
+		require.Equal(t, int64(8), gjson.Get(string(b), "credit.id").Int())
+		require.Equal(t, int64(3), gjson.Get(string(b), "credit.transfer_id").Int())
+	})
+
+}
+
+func TestAPIGet(t *testing.T) {
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transfers/abc", nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/test/transfers/3", nil)
+
+		service.On("Get", mock.Anything, 3).Return(&transfer.Transfer{ID: 3, SourceAccountID: 1, DestinationAccountID: 2}, nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(3), gjson.Get(string(b), "id").Int())
+		require.Equal(t, int64(2), gjson.Get(string(b), "destination_account_id").Int())
+	})
+}
@@ -0,0 +1,173 @@
+package transfer
+
+import (
+	"context"
+	"net/http"
+	"time"
+	"transactor-server/pkg/billing"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	enttransaction "transactor-server/pkg/db/ent/transaction"
+	enttransfer "transactor-server/pkg/db/ent/transfer"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/transaction"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// operation types of the two legs of a transfer
+const (
+	transferOutOperationTypeID = 7
+	transferInOperationTypeID  = 8
+)
+
+// ErrCurrencyMismatch indicates a transfer between accounts which are in different currencies
+var ErrCurrencyMismatch = pkgerr.NewServiceError(
+	"transfer", "currency_mismatch",
+	http.StatusUnprocessableEntity,
+	"source and destination accounts must have the same currency",
+)
+
+// DAO defines the data access object interface for transfer model
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockTransferDAO --filename transfer_dao.go
+type DAO interface {
+	// Create books the debit on the source account and the credit on the destination account of a transfer
+	// the credit discharges the open debits of the destination account in the order of strategy
+	Create(ctx context.Context, req *CreateRequest, strategy transaction.AllocationStrategy) (*ent.Transfer, error)
+	// Get tries to find an existing transfer record in DB by id along with its debit and credit
+	Get(ctx context.Context, id int) (*ent.Transfer, error)
+}
+
+type dao struct {
+	entClient  *ent.Client
+	discharger transaction.Discharger
+}
+
+var _ DAO = (*dao)(nil)
+
+// DAOOption configures the DAO returned by NewDAO
+type DAOOption func(*dao)
+
+// WithDischarger sets how the credit of a transfer discharges the open debits, it defaults to transaction.NewSetBasedDischarger
+func WithDischarger(discharger transaction.Discharger) DAOOption {
+	return func(d *dao) {
+		d.discharger = discharger
+	}
+}
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client, opts ...DAOOption) DAO {
+	d := &dao{
+		entClient:  entClient,
+		discharger: transaction.NewSetBasedDischarger(),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+func (d *dao) Create(ctx context.Context, req *CreateRequest, strategy transaction.AllocationStrategy) (dbTransfer *ent.Transfer, err error) {
+	// both legs are booked in one transaction so a transfer never half fails
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		dbTransfer, err = d.create(ctx, tx, req, strategy)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dbTransfer, nil
+}
+
+// create books a debit on the source account and a credit on the destination account inside tx
+// the credit discharges the open debits of the destination account like any other credit
+func (d *dao) create(ctx context.Context, tx *ent.Tx, req *CreateRequest, strategy transaction.AllocationStrategy) (*ent.Transfer, error) {
+	// the accounts are always locked in id order so that two opposite transfers between
+	// the same accounts wait for each other instead of deadlocking
+	first, second := req.SourceAccountID, req.DestinationAccountID
+	if first > second {
+		first, second = second, first
+	}
+
+	locked := make(map[int]*ent.Account, 2)
+	for _, accountID := range []int{first, second} {
+		dbAccount, err := db.LockAccount(ctx, tx, accountID)
+		if err != nil {
+			return nil, err
+		}
+		locked[accountID] = dbAccount
+	}
+
+	// a transfer moves the same amount out of one account & into the other, which only holds in the same currency
+	currency := locked[req.SourceAccountID].Currency
+	if locked[req.DestinationAccountID].Currency != currency {
+		return nil, ErrCurrencyMismatch
+	}
+
+	err := transaction.CheckLimit(ctx, tx, locked[req.SourceAccountID], -req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	dbTransfer, err := tx.Transfer.
+		Create().
+		SetSourceAccountID(req.SourceAccountID).
+		SetDestinationAccountID(req.DestinationAccountID).
+		SetAmount(req.Amount).
+		SetTimestamp(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	debit, err := transaction.Book(ctx, tx, d.discharger, strategy, tx.Transaction.
+		Create().
+		SetAccountID(req.SourceAccountID).
+		SetOperationTypeID(transferOutOperationTypeID).
+		SetTimestamp(now).
+		SetAmount(-req.Amount).
+		SetBalance(-req.Amount).
+		SetCurrency(currency).
+		SetTransferID(dbTransfer.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	credit, err := transaction.Book(ctx, tx, d.discharger, strategy, tx.Transaction.
+		Create().
+		SetAccountID(req.DestinationAccountID).
+		SetOperationTypeID(transferInOperationTypeID).
+		SetTimestamp(now).
+		SetAmount(req.Amount).
+		SetBalance(req.Amount).
+		SetCurrency(currency).
+		SetTransferID(dbTransfer.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// the credit pays the open invoice of the destination account like any other credit
+	err = billing.ApplyCredit(ctx, tx, credit, credit.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	dbTransfer.Edges.Transactions = []*ent.Transaction{debit, credit}
+
+	return dbTransfer, nil
+}
+
+func (d *dao) Get(ctx context.Context, id int) (*ent.Transfer, error) {
+	return d.entClient.Transfer.
+		Query().
+		Where(enttransfer.ID(id)).
+		WithTransactions(func(q *ent.TransactionQuery) {
+			// the debit is booked first
+			q.Order(enttransaction.ByID(sql.OrderAsc()))
+		}).
+		Only(ctx)
+}
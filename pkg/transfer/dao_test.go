@@ -0,0 +1,165 @@
+package transfer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/db/ent/invoice"
+	enttransaction "transactor-server/pkg/db/ent/transaction"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+	"transactor-server/pkg/transfer"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// setupTransfer returns a DAO over the accounts 1 & 2 with the seeded operation types
+func setupTransfer(t *testing.T) (*ent.Client, transfer.DAO) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+
+	client.OperationType.Create().SetDescription("Normal Purchase").SetID(1).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Credit Voucher").SetID(4).SetIsDebit(false).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Transfer Out").SetID(7).SetIsDebit(true).ExecX(ctx)
+	client.OperationType.Create().SetDescription("Transfer In").SetID(8).SetIsDebit(false).ExecX(ctx)
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").SetCreditLimit(money.MustParse("100")).ExecX(ctx)
+	client.Account.Create().SetDocumentNumber("78901").SetID(2).SetName("Jane Doe").ExecX(ctx)
+
+	return client, transfer.NewDAO(client)
+}
+
+func TestDAOCreate(t *testing.T) {
+	t.Parallel()
+	client, dao := setupTransfer(t)
+	ctx := context.Background()
+
+	transactionDAO := transaction.NewDAO(client)
+
+	debit, err := transactionDAO.Create(ctx, &transaction.CreateRequest{AccountID: 2, OperationTypeID: 1, Amount: money.MustParse("-30")}, transaction.FIFO())
+	require.NoError(t, err)
+
+	dbTransfer, err := dao.Create(ctx, &transfer.CreateRequest{
+		SourceAccountID:      1,
+		DestinationAccountID: 2,
+		Amount:               money.MustParse("50"),
+	}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, 1, dbTransfer.SourceAccountID)
+	require.Equal(t, 2, dbTransfer.DestinationAccountID)
+	require.Equal(t, money.MustParse("50"), dbTransfer.Amount)
+	require.Len(t, dbTransfer.Edges.Transactions, 2)
+
+	out, in := dbTransfer.Edges.Transactions[0], dbTransfer.Edges.Transactions[1]
+
+	require.Equal(t, 1, out.AccountID)
+	require.Equal(t, 7, out.OperationTypeID)
+	require.Equal(t, money.MustParse("-50"), out.Amount)
+	require.Equal(t, money.MustParse("-50"), out.Balance)
+	require.Equal(t, dbTransfer.ID, *out.TransferID)
+
+	// the credit pays the open debit of the destination account
+	require.Equal(t, 2, in.AccountID)
+	require.Equal(t, 8, in.OperationTypeID)
+	require.Equal(t, money.MustParse("50"), in.Amount)
+	require.Equal(t, money.MustParse("20"), in.Balance)
+	require.Equal(t, dbTransfer.ID, *in.TransferID)
+	require.Equal(t, money.Amount(0), client.Transaction.GetX(ctx, debit.ID).Balance)
+
+	got, err := dao.Get(ctx, dbTransfer.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Edges.Transactions, 2)
+	require.Equal(t, out.ID, got.Edges.Transactions[0].ID)
+	require.Equal(t, in.ID, got.Edges.Transactions[1].ID)
+
+	// a leg can not be reversed on its own
+	_, err = transactionDAO.Reverse(ctx, out.ID, transaction.FIFO())
+	require.ErrorIs(t, err, transaction.ErrNotReversible)
+
+	_, err = dao.Get(ctx, 999)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestDAOCreatePaysInvoice(t *testing.T) {
+	t.Parallel()
+	client, dao := setupTransfer(t)
+	ctx := context.Background()
+
+	periodEnd := time.Now().Add(-time.Hour)
+	dbInvoice := client.Invoice.Create().
+		SetAccountID(2).
+		SetPeriodStart(periodEnd.AddDate(0, -1, 0)).
+		SetPeriodEnd(periodEnd).
+		SetTotalDue(money.MustParse("30")).
+		SetMinimumPayment(money.MustParse("10")).
+		SetDueDate(periodEnd.AddDate(0, 0, 10)).
+		SaveX(ctx)
+
+	_, err := dao.Create(ctx, &transfer.CreateRequest{
+		SourceAccountID:      1,
+		DestinationAccountID: 2,
+		Amount:               money.MustParse("20"),
+	}, transaction.FIFO())
+	require.NoError(t, err)
+
+	// the credit leg pays the open invoice of the destination account like any other credit
+	dbInvoice = client.Invoice.GetX(ctx, dbInvoice.ID)
+	require.Equal(t, money.MustParse("20"), dbInvoice.PaidAmount)
+	require.Equal(t, invoice.StatusOpen, dbInvoice.Status)
+	require.Equal(t, 1, client.InvoicePayment.Query().CountX(ctx))
+}
+
+func TestDAOCreateFailsAsAWhole(t *testing.T) {
+	t.Parallel()
+	client, dao := setupTransfer(t)
+	ctx := context.Background()
+
+	// the source account only has a credit limit of 100
+	_, err := dao.Create(ctx, &transfer.CreateRequest{
+		SourceAccountID:      1,
+		DestinationAccountID: 2,
+		Amount:               money.MustParse("100.01"),
+	}, transaction.FIFO())
+	require.ErrorIs(t, err, transaction.ErrInsufficientLimit)
+
+	// the destination account does not exist
+	_, err = dao.Create(ctx, &transfer.CreateRequest{
+		SourceAccountID:      1,
+		DestinationAccountID: 3,
+		Amount:               money.MustParse("10"),
+	}, transaction.FIFO())
+	require.True(t, ent.IsNotFound(err))
+
+	require.Equal(t, 0, client.Transfer.Query().CountX(ctx))
+	require.Equal(t, 0, client.Transaction.Query().CountX(ctx))
+
+	// transfers in the other direction lock the accounts in the same order
+	_, err = dao.Create(ctx, &transfer.CreateRequest{
+		SourceAccountID:      2,
+		DestinationAccountID: 1,
+		Amount:               money.MustParse("10"),
+	}, transaction.FIFO())
+	require.NoError(t, err)
+	require.Equal(t, 2, client.Transaction.Query().Where(enttransaction.TransferIDNotNil()).CountX(ctx))
+}
+
+func TestDAOCreateCurrencyMismatch(t *testing.T) {
+	t.Parallel()
+	client, dao := setupTransfer(t)
+	ctx := context.Background()
+
+	client.Account.Create().SetDocumentNumber("45678").SetID(3).SetName("James Doe").SetCurrency("EUR").ExecX(ctx)
+
+	_, err := dao.Create(ctx, &transfer.CreateRequest{
+		SourceAccountID:      1,
+		DestinationAccountID: 3,
+		Amount:               money.MustParse("50"),
+	}, transaction.FIFO())
+	require.ErrorIs(t, err, transfer.ErrCurrencyMismatch)
+	require.Zero(t, client.Transaction.Query().CountX(ctx))
+}
@@ -0,0 +1,33 @@
+package transfer
+
+import (
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/transaction"
+)
+
+// MapEntTransferToTransfer maps an ent.Transfer record along with its loaded transactions to transfer.Transfer model
+func MapEntTransferToTransfer(t *ent.Transfer) *Transfer {
+	if t == nil {
+		return nil
+	}
+
+	resp := &Transfer{
+		ID:                   t.ID,
+		SourceAccountID:      t.SourceAccountID,
+		DestinationAccountID: t.DestinationAccountID,
+		Amount:               t.Amount,
+		Timestamp:            t.Timestamp,
+		CreatedAt:            t.CreateTime,
+		UpdatedAt:            t.UpdateTime,
+	}
+
+	for _, leg := range t.Edges.Transactions {
+		if leg.Amount < 0 {
+			resp.Debit = transaction.MapEntTransactionToTransaction(leg)
+		} else {
+			resp.Credit = transaction.MapEntTransactionToTransaction(leg)
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,80 @@
+package transfer
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the transfer.Service
+// it like name suggests adds metrics to each service call
+// on success it adds transfer_service_<method>_success and on failure transfer_service_<method>_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	createCounterSuccess metric.Int64Counter
+	createCounterFailure metric.Int64Counter
+	getCounterSuccess    metric.Int64Counter
+	getCounterFailure    metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	createCounterSuccess, err := meter.Int64Counter("transfer_service_create_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	createCounterFailure, err := meter.Int64Counter("transfer_service_create_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterSuccess, err := meter.Int64Counter("transfer_service_get_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterFailure, err := meter.Int64Counter("transfer_service_get_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:              service,
+		meter:                meter,
+		createCounterSuccess: createCounterSuccess,
+		createCounterFailure: createCounterFailure,
+		getCounterSuccess:    getCounterSuccess,
+		getCounterFailure:    getCounterFailure,
+	}
+}
+
+func (m *meteredSevice) Create(ctx context.Context, req *CreateRequest) (resp *Transfer, err error) {
+	defer func() {
+		if err == nil {
+			m.createCounterSuccess.Add(ctx, 1)
+		} else {
+			m.createCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Create(ctx, req)
+	return
+}
+
+func (m *meteredSevice) Get(ctx context.Context, id int) (resp *Transfer, err error) {
+	defer func() {
+		if err == nil {
+			m.getCounterSuccess.Add(ctx, 1)
+		} else {
+			m.getCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Get(ctx, id)
+	return
+}
@@ -0,0 +1,93 @@
+package transfer
+
+import (
+	"context"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/transaction"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"go.uber.org/zap"
+)
+
+// Service handles the main business logic for transfer related things
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockTransferService  --filename transfer_service.go
+type Service interface {
+	// Create moves money between two accounts by booking a debit and a credit atomically
+	Create(context.Context, *CreateRequest) (*Transfer, error)
+	// Get returns an existing transfer along with its debit and credit
+	Get(context.Context, int) (*Transfer, error)
+}
+
+type service struct {
+	transferDAO DAO
+
+	allocationStrategy transaction.AllocationStrategy
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+// ServiceOption configures the Service returned by NewService
+type ServiceOption func(*service)
+
+// WithAllocationStrategy sets the order in which the credit of a transfer discharges the open debits, it defaults to transaction.FIFO
+func WithAllocationStrategy(strategy transaction.AllocationStrategy) ServiceOption {
+	return func(s *service) {
+		if strategy != nil {
+			s.allocationStrategy = strategy
+		}
+	}
+}
+
+func NewService(
+	transferDAO DAO,
+
+	logger *zap.Logger,
+	opts ...ServiceOption,
+) Service {
+	s := &service{
+		transferDAO: transferDAO,
+
+		allocationStrategy: transaction.FIFO(),
+
+		logger: logger,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) Create(ctx context.Context, req *CreateRequest) (*Transfer, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	// calls dao to book both legs, the debit has to fit in the credit limit of the source account
+	dbTransfer, err := s.transferDAO.Create(ctx, req, s.allocationStrategy)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntTransferToTransfer(dbTransfer), nil
+}
+
+func (s *service) Get(ctx context.Context, id int) (*Transfer, error) {
+	// validates the id to be +ve
+	err := validation.Validate(id, validation.Min(1))
+	if err != nil {
+		return nil, pkgerr.WrapValidationError(err, "id")
+	}
+
+	// calls dao to get the transfer along with its legs
+	dbTransfer, err := s.transferDAO.Get(ctx, id)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntTransferToTransfer(dbTransfer), nil
+}
@@ -0,0 +1,138 @@
+package transfer_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/transaction"
+	"transactor-server/pkg/transfer"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestServiceCreate(t *testing.T) {
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+		service := transfer.NewService(mocks.NewMockTransferDAO(t), zap.NewNop())
+
+		for _, req := range []*transfer.CreateRequest{
+			{},
+			{SourceAccountID: 1, DestinationAccountID: 1, Amount: money.MustParse("10")},
+			{SourceAccountID: 1, DestinationAccountID: 2},
+			{SourceAccountID: 1, DestinationAccountID: 2, Amount: money.MustParse("-10")},
+		} {
+			resp, err := service.Create(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		}
+	})
+
+	t.Run("insufficient limit", func(t *testing.T) {
+		t.Parallel()
+		transferDAO := mocks.NewMockTransferDAO(t)
+
+		service := transfer.NewService(transferDAO, zap.NewNop())
+
+		req := &transfer.CreateRequest{SourceAccountID: 1, DestinationAccountID: 2, Amount: money.MustParse("10")}
+		transferDAO.On("Create", mock.Anything, req, transaction.FIFO()).Return(nil, transaction.ErrInsufficientLimit)
+
+		resp, err := service.Create(context.Background(), req)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusUnprocessableEntity, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		transferDAO := mocks.NewMockTransferDAO(t)
+
+		service := transfer.NewService(transferDAO, zap.NewNop())
+
+		transferID := 3
+		req := &transfer.CreateRequest{SourceAccountID: 1, DestinationAccountID: 2, Amount: money.MustParse("10")}
+		transferDAO.On("Create", mock.Anything, req, transaction.FIFO()).Return(&ent.Transfer{
+			ID:                   transferID,
+			SourceAccountID:      1,
+			DestinationAccountID: 2,
+			Amount:               money.MustParse("10"),
+			Edges: ent.TransferEdges{Transactions: []*ent.Transaction{
+				{ID: 7, AccountID: 1, OperationTypeID: 7, Amount: money.MustParse("-10"), TransferID: &transferID},
+				{ID: 8, AccountID: 2, OperationTypeID: 8, Amount: money.MustParse("10"), TransferID: &transferID},
+			}},
+		}, nil)
+
+		resp, err := service.Create(context.Background(), req)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, 3, resp.ID)
+		require.Equal(t, money.MustParse("10"), resp.Amount)
+		require.Equal(t, 7, resp.Debit.ID)
+		require.Equal(t, 1, resp.Debit.AccountID)
+		require.Equal(t, 8, resp.Credit.ID)
+		require.Equal(t, 2, resp.Credit.AccountID)
+		require.Equal(t, 3, *resp.Credit.TransferID)
+	})
+}
+
+func TestServiceGet(t *testing.T) {
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+		service := transfer.NewService(mocks.NewMockTransferDAO(t), zap.NewNop())
+
+		resp, err := service.Get(context.Background(), -1)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		validationErr, ok := err.(*pkgerr.ValidationError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+	})
+
+	t.Run("db not found error", func(t *testing.T) {
+		t.Parallel()
+		transferDAO := mocks.NewMockTransferDAO(t)
+
+		service := transfer.NewService(transferDAO, zap.NewNop())
+
+		transferDAO.On("Get", mock.Anything, 999).Return(nil, &ent.NotFoundError{})
+
+		resp, err := service.Get(context.Background(), 999)
+
+		require.Error(t, err)
+		require.Nil(t, resp)
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("no error", func(t *testing.T) {
+		t.Parallel()
+		transferDAO := mocks.NewMockTransferDAO(t)
+
+		service := transfer.NewService(transferDAO, zap.NewNop())
+
+		transferDAO.On("Get", mock.Anything, 3).Return(&ent.Transfer{ID: 3, SourceAccountID: 1, DestinationAccountID: 2}, nil)
+
+		resp, err := service.Get(context.Background(), 3)
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, 3, resp.ID)
+		require.Equal(t, 1, resp.SourceAccountID)
+		require.Equal(t, 2, resp.DestinationAccountID)
+	})
+}
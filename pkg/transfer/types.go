@@ -0,0 +1,46 @@
+package transfer
+
+import (
+	"time"
+	"transactor-server/pkg/money"
+	"transactor-server/pkg/transaction"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// CreateRequest moves amount from the source account to the destination account
+type CreateRequest struct {
+	SourceAccountID      int `json:"source_account_id"`
+	DestinationAccountID int `json:"destination_account_id"`
+	// Amount can be sent as a JSON number or string with at most 2 decimal places
+	Amount money.Amount `json:"amount" swaggertype:"number" example:"98.75"`
+}
+
+// Validate validates the CreateRequest to
+// have +ve source & destination account ids which are not the same
+// and have a +ve amount
+func (req CreateRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.SourceAccountID, validation.Min(1)),
+		validation.Field(&req.DestinationAccountID,
+			validation.Min(1),
+			validation.NotIn(req.SourceAccountID).Error("must not be the same as source_account_id"),
+		),
+		validation.Field(&req.Amount, validation.Required, validation.Min(money.Amount(0))),
+	)
+}
+
+// Transfer is a transfer along with its two legs
+// the debit is booked on the source account with operation type Transfer Out (7)
+// and the credit on the destination account with operation type Transfer In (8)
+type Transfer struct {
+	ID                   int                      `json:"id"`
+	SourceAccountID      int                      `json:"source_account_id"`
+	DestinationAccountID int                      `json:"destination_account_id"`
+	Amount               money.Amount             `json:"amount" swaggertype:"number" example:"98.75"`
+	Timestamp            time.Time                `json:"timestamp"`
+	Debit                *transaction.Transaction `json:"debit"`
+	Credit               *transaction.Transaction `json:"credit"`
+	CreatedAt            time.Time                `json:"created_at"`
+	UpdatedAt            time.Time                `json:"updated_at"`
+}
@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+
+	"transactor-server/pkg/pkgerr"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// API is the api handler for webhook apis
+type API struct {
+	sevice Service
+}
+
+// NewAPI returns a new API handler ready to handle routes
+func NewAPI(service Service) *API {
+	return &API{
+		sevice: service,
+	}
+}
+
+// Handle sets up all the routes with their handler funcs for webhook apis
+func (a *API) Handle(router fiber.Router) {
+	router.Post("/", a.createSubscription)
+	router.Get("/", a.listSubscriptions)
+	router.Get("/:id", a.getSubscription)
+	router.Delete("/:id", a.deleteSubscription)
+	router.Get("/:id/deliveries", a.listDeliveries)
+	router.Post("/deliveries/:id/redeliver", a.redeliver)
+}
+
+// createSubscription subscribes an url to events
+// @Summary      create a webhook subscription
+// @Description  the events of the types sent, or every event when none are sent, are POSTed to the url signed with the secret in the X-Webhook-Signature header
+// @Accept       json
+// @Produce      json
+// @Tags		 webhook
+// @Param        req  body      CreateRequest  true  "subscription"
+// @Success      201  {object}  Subscription
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/webhooks [post]
+func (a *API) createSubscription(c *fiber.Ctx) error {
+	req := &CreateRequest{}
+
+	// try to parse the body
+	err := c.BodyParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("webhook", "body_parse_failure", http.StatusBadRequest, err.Error())
+	}
+
+	// call the service to create the subscription
+	resp, err := a.sevice.Create(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 201 status
+	return c.Status(http.StatusCreated).JSON(resp)
+}
+
+// listSubscriptions returns every webhook subscription
+// @Summary      list the webhook subscriptions
+// @Produce      json
+// @Tags		 webhook
+// @Success      200  {object}  ListResponse
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/webhooks [get]
+func (a *API) listSubscriptions(c *fiber.Ctx) error {
+	// call the service to list the subscriptions
+	resp, err := a.sevice.List(c.UserContext())
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// getSubscription returns an existing webhook subscription
+// @Summary      get a webhook subscription
+// @Produce      json
+// @Tags		 webhook
+// @Param        id   path      int  true  "subscription id"
+// @Success      200  {object}  Subscription
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/webhooks/{id} [get]
+func (a *API) getSubscription(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to get the subscription
+	resp, err := a.sevice.Get(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// deleteSubscription removes a webhook subscription
+// @Summary      delete a webhook subscription
+// @Description  its deliveries which are still pending are never sent
+// @Tags		 webhook
+// @Param        id   path      int  true  "subscription id"
+// @Success      204
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/webhooks/{id} [delete]
+func (a *API) deleteSubscription(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to delete the subscription
+	err = a.sevice.Delete(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return 204 status
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// listDeliveries returns the deliveries of a webhook subscription
+// @Summary      list the deliveries of a webhook subscription
+// @Description  newest first, status=dead lists the dead letters which failed every attempt
+// @Produce      json
+// @Tags		 webhook
+// @Param        id      path      int     true   "subscription id"
+// @Param        status  query     string  false  "only deliveries with this status"  Enums(pending, delivered, dead)
+// @Param        limit   query     int     false  "at most 100, the default"
+// @Success      200  {object}  ListDeliveriesResponse
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/webhooks/{id}/deliveries [get]
+func (a *API) listDeliveries(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	req := &ListDeliveriesRequest{}
+
+	// try to parse the query params
+	err = c.QueryParser(req)
+	if err != nil {
+		return pkgerr.NewServiceError("webhook", "query_parse_failure", http.StatusBadRequest, err.Error())
+	}
+	req.SubscriptionID = id
+
+	// call the service to list the deliveries
+	resp, err := a.sevice.ListDeliveries(c.UserContext(), req)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
+
+// redeliver sends a webhook delivery again
+// @Summary      redeliver a webhook delivery
+// @Description  the delivery is pending again with all of its attempts and is sent on the next run of the deliverer, whatever its status was
+// @Produce      json
+// @Tags		 webhook
+// @Param        id   path      int  true  "delivery id"
+// @Success      200  {object}  Delivery
+// @Failure      400  {object}  pkgerr.ValidationErrorResponseBody
+// @Failure      404  {object}  pkgerr.ServiceErrorResponseBody
+// @Failure      500  {object}  pkgerr.ServiceErrorResponseBody
+// @Security	 ApiKeyAuth
+// @Router       /api/v1/webhooks/deliveries/{id}/redeliver [post]
+func (a *API) redeliver(c *fiber.Ctx) error {
+	// we try to parse the id to an int
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		return pkgerr.NewServiceError("validation", "validation_failed", http.StatusBadRequest, "id path variable must be an integer")
+	}
+
+	// call the service to redeliver
+	resp, err := a.sevice.Redeliver(c.UserContext(), id)
+	if err != nil {
+		return err
+	}
+
+	// incase of no error return response with 200 status
+	return c.Status(http.StatusOK).JSON(resp)
+}
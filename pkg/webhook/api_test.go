@@ -0,0 +1,164 @@
+package webhook_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"transactor-server/pkg/api"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/webhook"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+var setupApp = func(t *testing.T) (*fiber.App, *mocks.MockWebhookService) {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: api.ErrorHandler,
+	})
+
+	service := mocks.NewMockWebhookService(t)
+
+	api := webhook.NewAPI(service)
+	api.Handle(app.Group("/test/webhooks"))
+
+	return app, service
+}
+
+func TestAPICreate(t *testing.T) {
+	t.Run("body parsing error", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/webhooks", strings.NewReader(`{"url": "https://`))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/test/webhooks", strings.NewReader(
+			`{"url": "https://example.com/webhooks", "event_types": ["TransactionCreated"], "secret": "0123456789abcdef"}`,
+		))
+		req.Header.Set("Content-Type", "application/json")
+
+		service.On("Create", mock.Anything, &webhook.CreateRequest{
+			URL:        "https://example.com/webhooks",
+			EventTypes: []string{"TransactionCreated"},
+			Secret:     "0123456789abcdef",
+		}).Return(webhook.MapEntWebhookSubscriptionToSubscription(dbSubscription), nil)
+
+		resp, err := app.Test(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		require.Equal(t, int64(1), gjson.GetBytes(b, "id").Int())
+		require.Equal(t, "TransactionCreated", gjson.GetBytes(b, "event_types.0").String())
+		// the secret is never returned
+		require.False(t, gjson.GetBytes(b, "secret").Exists())
+	})
+}
+
+func TestAPIGet(t *testing.T) {
+	t.Run("id not int", func(t *testing.T) {
+		t.Parallel()
+		app, _ := setupApp(t)
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/test/webhooks/abc", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		app, service := setupApp(t)
+
+		service.On("Get", mock.Anything, 2).Return(nil, pkgerr.NewServiceError("db", "not_found", http.StatusNotFound, "ent: webhook_subscription not found"))
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/test/webhooks/2", nil))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestAPIList(t *testing.T) {
+	t.Parallel()
+	app, service := setupApp(t)
+
+	service.On("List", mock.Anything).Return(&webhook.ListResponse{
+		Subscriptions: []*webhook.Subscription{webhook.MapEntWebhookSubscriptionToSubscription(dbSubscription)},
+	}, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/test/webhooks", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, int64(1), gjson.GetBytes(b, "subscriptions.#").Int())
+}
+
+func TestAPIDelete(t *testing.T) {
+	t.Parallel()
+	app, service := setupApp(t)
+
+	service.On("Delete", mock.Anything, 1).Return(nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/test/webhooks/1", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestAPIListDeliveries(t *testing.T) {
+	t.Parallel()
+	app, service := setupApp(t)
+
+	service.On("ListDeliveries", mock.Anything, &webhook.ListDeliveriesRequest{SubscriptionID: 1, Status: "dead", Limit: 10}).
+		Return(&webhook.ListDeliveriesResponse{
+			Deliveries: []*webhook.Delivery{webhook.MapEntWebhookDeliveryToDelivery(dbDelivery)},
+		}, nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/test/webhooks/1/deliveries?status=dead&limit=10", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, "dead", gjson.GetBytes(b, "deliveries.0.status").String())
+	require.Equal(t, int64(42), gjson.GetBytes(b, "deliveries.0.event_id").Int())
+}
+
+func TestAPIRedeliver(t *testing.T) {
+	t.Parallel()
+	app, service := setupApp(t)
+
+	service.On("Redeliver", mock.Anything, 3).Return(webhook.MapEntWebhookDeliveryToDelivery(dbDelivery), nil)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/test/webhooks/deliveries/3/redeliver", nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
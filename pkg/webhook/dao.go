@@ -0,0 +1,232 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+	"time"
+	"transactor-server/pkg/db"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/db/ent/webhooksubscription"
+	"transactor-server/pkg/outbox"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+)
+
+// maxErrorLength is the most characters of a delivery error which are kept, which is the size of its column
+const maxErrorLength = 1024
+
+// DAO defines the data access object interface for webhook_subscription & webhook_delivery models
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name DAO --output ../mocks --structname MockWebhookDAO --filename webhook_dao.go
+type DAO interface {
+	// Create adds a subscription
+	Create(ctx context.Context, req *CreateRequest) (*ent.WebhookSubscription, error)
+	// List returns every subscription in id order
+	List(ctx context.Context) ([]*ent.WebhookSubscription, error)
+	// Get returns a subscription
+	Get(ctx context.Context, id int) (*ent.WebhookSubscription, error)
+	// Delete removes a subscription along with its deliveries
+	Delete(ctx context.Context, id int) error
+	// ListDeliveries returns the deliveries of a subscription newest first, with their event
+	ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) ([]*ent.WebhookDelivery, error)
+	// Redeliver makes a delivery pending again with no attempts so it is sent at, it returns the delivery with its event
+	Redeliver(ctx context.Context, id int, at time.Time) (*ent.WebhookDelivery, error)
+	// Enqueue adds a pending delivery of event for every subscription which filters on its type and returns how many there are
+	// an event which was already enqueued for a subscription is skipped, so enqueueing is safe to retry
+	Enqueue(ctx context.Context, event *outbox.Event, at time.Time) (int, error)
+	// Claim returns at most limit pending deliveries which are due at now, with their subscription & event
+	// they are claimed till until by moving their next attempt there, so no other deliverer sends them meanwhile
+	// a claimed delivery which is not marked delivered or failed by then, say the deliverer stopped, is due again
+	Claim(ctx context.Context, now time.Time, limit int, until time.Time) ([]*ent.WebhookDelivery, error)
+	// MarkDelivered records that the subscriber accepted a delivery at with responseStatus
+	MarkDelivered(ctx context.Context, id int, responseStatus int, at time.Time) error
+	// MarkFailed records that sending a delivery failed with reason and responseStatus, if the subscriber responded
+	// the delivery is sent again at retryAt, or becomes dead when retryAt is nil
+	MarkFailed(ctx context.Context, id int, responseStatus *int, reason string, retryAt *time.Time) error
+}
+
+type dao struct {
+	entClient *ent.Client
+}
+
+var _ DAO = (*dao)(nil)
+
+// NewDAO returns a new DAO which use ent as database orm
+func NewDAO(entClient *ent.Client) DAO {
+	return &dao{
+		entClient: entClient,
+	}
+}
+
+func (d *dao) Create(ctx context.Context, req *CreateRequest) (*ent.WebhookSubscription, error) {
+	// an empty filter is stored as [] rather than null
+	eventTypes := lo.Uniq(req.EventTypes)
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	return d.entClient.WebhookSubscription.
+		Create().
+		SetURL(req.URL).
+		SetEventTypes(eventTypes).
+		SetSecret(req.Secret).
+		Save(ctx)
+}
+
+func (d *dao) List(ctx context.Context) ([]*ent.WebhookSubscription, error) {
+	return d.entClient.WebhookSubscription.
+		Query().
+		Order(webhooksubscription.ByID()).
+		All(ctx)
+}
+
+func (d *dao) Get(ctx context.Context, id int) (*ent.WebhookSubscription, error) {
+	return d.entClient.WebhookSubscription.Get(ctx, id)
+}
+
+func (d *dao) Delete(ctx context.Context, id int) error {
+	return d.entClient.WebhookSubscription.DeleteOneID(id).Exec(ctx)
+}
+
+func (d *dao) ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) ([]*ent.WebhookDelivery, error) {
+	query := d.entClient.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.SubscriptionID(req.SubscriptionID))
+	if req.Status != "" {
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(req.Status)))
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = MaxListLimit
+	}
+
+	return query.
+		WithEvent().
+		Order(webhookdelivery.ByID(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+}
+
+func (d *dao) Redeliver(ctx context.Context, id int, at time.Time) (*ent.WebhookDelivery, error) {
+	// the last error & response status are kept till the next attempt replaces them
+	err := d.entClient.WebhookDelivery.
+		UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(at).
+		ClearDeliveredAt().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.entClient.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.ID(id)).
+		WithEvent().
+		Only(ctx)
+}
+
+func (d *dao) Enqueue(ctx context.Context, event *outbox.Event, at time.Time) (int, error) {
+	// there are few subscriptions, so they are filtered here instead of querying into the JSON of their event types
+	dbSubscriptions, err := d.entClient.WebhookSubscription.Query().All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	dbSubscriptions = lo.Filter(dbSubscriptions, func(s *ent.WebhookSubscription, _ int) bool {
+		return len(s.EventTypes) == 0 || lo.Contains(s.EventTypes, event.Type)
+	})
+	if len(dbSubscriptions) == 0 {
+		return 0, nil
+	}
+
+	creates := make([]*ent.WebhookDeliveryCreate, 0, len(dbSubscriptions))
+	for _, dbSubscription := range dbSubscriptions {
+		creates = append(creates, d.entClient.WebhookDelivery.
+			Create().
+			SetSubscriptionID(dbSubscription.ID).
+			SetEventID(event.ID).
+			SetNextAttemptAt(at),
+		)
+	}
+
+	err = d.entClient.WebhookDelivery.
+		CreateBulk(creates...).
+		OnConflictColumns(webhookdelivery.FieldSubscriptionID, webhookdelivery.FieldEventID).
+		DoNothing().
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(creates), nil
+}
+
+func (d *dao) Claim(ctx context.Context, now time.Time, limit int, until time.Time) (dbDeliveries []*ent.WebhookDelivery, err error) {
+	err = db.WithTx(ctx, d.entClient, func(tx *ent.Tx) error {
+		dbDeliveries, err = tx.WebhookDelivery.
+			Query().
+			Where(
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAtLTE(now),
+			).
+			WithSubscription().
+			WithEvent().
+			Order(webhookdelivery.ByNextAttemptAt(), webhookdelivery.ByID()).
+			Limit(limit).
+			Modify(func(s *sql.Selector) {
+				// the rows another deliverer is claiming are skipped instead of waited for
+				// sqlite does not support row locks, it locks the whole database on write instead
+				if s.Dialect() != dialect.SQLite {
+					s.ForUpdate(sql.WithLockAction(sql.SkipLocked))
+				}
+			}).
+			All(ctx)
+		if err != nil || len(dbDeliveries) == 0 {
+			return err
+		}
+
+		ids := lo.Map(dbDeliveries, func(dbDelivery *ent.WebhookDelivery, _ int) int { return dbDelivery.ID })
+		return tx.WebhookDelivery.
+			Update().
+			Where(webhookdelivery.IDIn(ids...)).
+			SetNextAttemptAt(until).
+			Exec(ctx)
+	})
+	return dbDeliveries, err
+}
+
+func (d *dao) MarkDelivered(ctx context.Context, id int, responseStatus int, at time.Time) error {
+	return d.entClient.WebhookDelivery.
+		UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusDelivered).
+		SetResponseStatus(responseStatus).
+		SetDeliveredAt(at).
+		ClearLastError().
+		Exec(ctx)
+}
+
+func (d *dao) MarkFailed(ctx context.Context, id int, responseStatus *int, reason string, retryAt *time.Time) error {
+	// the reason is cut to fit its column, a rune which was cut in half is dropped as postgres only takes valid utf-8
+	if len(reason) > maxErrorLength {
+		reason = strings.ToValidUTF8(reason[:maxErrorLength], "")
+	}
+
+	update := d.entClient.WebhookDelivery.
+		UpdateOneID(id).
+		AddAttempts(1).
+		SetNillableResponseStatus(responseStatus).
+		SetLastError(reason)
+	if responseStatus == nil {
+		update = update.ClearResponseStatus()
+	}
+	if retryAt == nil {
+		update = update.SetStatus(webhookdelivery.StatusDead)
+	} else {
+		update = update.SetNextAttemptAt(*retryAt)
+	}
+	return update.Exec(ctx)
+}
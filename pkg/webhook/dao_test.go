@@ -0,0 +1,155 @@
+package webhook_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/enttest"
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/webhook"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// writeEvent adds an event for account 1 to the outbox and returns it as it is published
+func writeEvent(t *testing.T, client *ent.Client, eventType string, aggregateID int) *outbox.Event {
+	ctx := context.Background()
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, outbox.Write(ctx, tx, eventType, 1, aggregateID, map[string]int{"id": aggregateID}))
+	require.NoError(t, tx.Commit())
+
+	dbEvents, err := outbox.NewDAO(client).Pending(ctx, 1000)
+	require.NoError(t, err)
+	return outbox.MapEntOutboxEventToEvent(dbEvents[len(dbEvents)-1])
+}
+
+func setupDB(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	client.Account.Create().SetDocumentNumber("12345").SetID(1).SetName("John Doe").ExecX(context.Background())
+	return client
+}
+
+func TestDAOSubscriptions(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+	dao := webhook.NewDAO(client)
+
+	created, err := dao.Create(ctx, &webhook.CreateRequest{URL: "https://example.com/all", Secret: "0123456789abcdef"})
+	require.NoError(t, err)
+	require.Equal(t, []string{}, created.EventTypes)
+
+	_, err = dao.Create(ctx, &webhook.CreateRequest{
+		URL:        "https://example.com/accounts",
+		EventTypes: []string{outbox.EventAccountCreated, outbox.EventAccountCreated},
+		Secret:     "0123456789abcdef",
+	})
+	require.NoError(t, err)
+
+	dbSubscriptions, err := dao.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, dbSubscriptions, 2)
+	require.Equal(t, []string{"AccountCreated"}, dbSubscriptions[1].EventTypes)
+
+	got, err := dao.Get(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "0123456789abcdef", got.Secret)
+
+	require.NoError(t, dao.Delete(ctx, created.ID))
+	_, err = dao.Get(ctx, created.ID)
+	require.True(t, ent.IsNotFound(err))
+	require.True(t, ent.IsNotFound(dao.Delete(ctx, created.ID)))
+}
+
+func TestDAODeliveries(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+	dao := webhook.NewDAO(client)
+	now := time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+	all, err := dao.Create(ctx, &webhook.CreateRequest{URL: "https://example.com/all", Secret: "0123456789abcdef"})
+	require.NoError(t, err)
+	transactions, err := dao.Create(ctx, &webhook.CreateRequest{
+		URL:        "https://example.com/transactions",
+		EventTypes: []string{outbox.EventTransactionCreated},
+		Secret:     "0123456789abcdef",
+	})
+	require.NoError(t, err)
+
+	accountCreated := writeEvent(t, client, outbox.EventAccountCreated, 1)
+	transactionCreated := writeEvent(t, client, outbox.EventTransactionCreated, 7)
+
+	enqueued, err := dao.Enqueue(ctx, accountCreated, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, enqueued)
+	enqueued, err = dao.Enqueue(ctx, transactionCreated, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 2, enqueued)
+	// publishing an event again does not enqueue it twice
+	_, err = dao.Enqueue(ctx, transactionCreated, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 3, client.WebhookDelivery.Query().CountX(ctx))
+
+	dbDeliveries, err := dao.Claim(ctx, now, 10, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, dbDeliveries, 1)
+	require.Equal(t, accountCreated.ID, dbDeliveries[0].Edges.Event.ID)
+	require.Equal(t, all.ID, dbDeliveries[0].Edges.Subscription.ID)
+
+	// a claimed delivery is not due till its claim passes
+	dbDeliveries, err = dao.Claim(ctx, now, 10, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Empty(t, dbDeliveries)
+
+	dbDeliveries, err = dao.Claim(ctx, now.Add(time.Second), 10, now.Add(2*time.Second))
+	require.NoError(t, err)
+	require.Len(t, dbDeliveries, 3)
+
+	// one is delivered, one is retried later and one is dead
+	status := 500
+	retryAt := now.Add(time.Minute)
+	require.NoError(t, dao.MarkDelivered(ctx, dbDeliveries[0].ID, 200, now))
+	require.NoError(t, dao.MarkFailed(ctx, dbDeliveries[1].ID, &status, strings.Repeat("é", 1000), &retryAt))
+	require.NoError(t, dao.MarkFailed(ctx, dbDeliveries[2].ID, nil, "connection refused", nil))
+
+	dbDeliveries, err = dao.Claim(ctx, now.Add(time.Minute), 10, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, dbDeliveries, 1)
+	require.Equal(t, 1, dbDeliveries[0].Attempts)
+	require.Equal(t, 500, *dbDeliveries[0].ResponseStatus)
+
+	dead, err := dao.ListDeliveries(ctx, &webhook.ListDeliveriesRequest{SubscriptionID: transactions.ID, Status: "dead"})
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	require.Equal(t, "connection refused", *dead[0].LastError)
+	require.Nil(t, dead[0].ResponseStatus)
+	require.Equal(t, outbox.EventTransactionCreated, dead[0].Edges.Event.Type)
+
+	dbDeliveries, err = dao.ListDeliveries(ctx, &webhook.ListDeliveriesRequest{SubscriptionID: all.ID})
+	require.NoError(t, err)
+	require.Len(t, dbDeliveries, 2)
+	// newest first
+	require.Equal(t, transactionCreated.ID, dbDeliveries[0].EventID)
+	require.Equal(t, "delivered", dbDeliveries[1].Status.String())
+	require.NotNil(t, dbDeliveries[1].DeliveredAt)
+
+	redelivered, err := dao.Redeliver(ctx, dead[0].ID, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, "pending", redelivered.Status.String())
+	require.Zero(t, redelivered.Attempts)
+	require.Equal(t, outbox.EventTransactionCreated, redelivered.Edges.Event.Type)
+
+	_, err = dao.Redeliver(ctx, 999, now)
+	require.True(t, ent.IsNotFound(err))
+
+	// deleting a subscription deletes its deliveries
+	require.NoError(t, dao.Delete(ctx, transactions.ID))
+	require.Equal(t, 2, client.WebhookDelivery.Query().CountX(ctx))
+}
@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/job"
+	"transactor-server/pkg/outbox"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxDrainBytes is the most of a response body which is read before the connection is closed
+	maxDrainBytes = 64 << 10
+	// defaultClaimTimeout is how long a delivery is assumed to take when the client has no timeout
+	defaultClaimTimeout = time.Minute
+)
+
+// Deliverer periodically POSTs the due deliveries to their subscribers
+// a delivery which fails is retried with exponential backoff and becomes dead after max attempts
+// deliveries are independent, so a subscriber can get the events out of order when some of them were retried
+type Deliverer struct {
+	dao         DAO
+	client      *http.Client
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	logger      *zap.Logger
+}
+
+// DelivererOption configures the Deliverer returned by NewDeliverer
+type DelivererOption func(*Deliverer)
+
+// WithBatchSize sets how many due deliveries are sent every run, 100 by default
+func WithBatchSize(batchSize int) DelivererOption {
+	return func(d *Deliverer) {
+		d.batchSize = batchSize
+	}
+}
+
+// WithMaxAttempts sets how many times a delivery is sent before it is dead, 8 by default
+func WithMaxAttempts(maxAttempts int) DelivererOption {
+	return func(d *Deliverer) {
+		d.maxAttempts = maxAttempts
+	}
+}
+
+// WithBackoff sets the wait before the first retry, which doubles on every retry up to maxBackoff
+// it is 30s up to 1h by default
+func WithBackoff(backoff time.Duration, maxBackoff time.Duration) DelivererOption {
+	return func(d *Deliverer) {
+		d.backoff = backoff
+		d.maxBackoff = maxBackoff
+	}
+}
+
+// NewDeliverer returns a new Deliverer which runs every interval and sends deliveries with client
+func NewDeliverer(dao DAO, client *http.Client, interval time.Duration, logger *zap.Logger, opts ...DelivererOption) *Deliverer {
+	d := &Deliverer{
+		dao:         dao,
+		client:      client,
+		interval:    interval,
+		batchSize:   100,
+		maxAttempts: 8,
+		backoff:     30 * time.Second,
+		maxBackoff:  time.Hour,
+		logger:      logger,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Run sends the due deliveries every interval until the context is cancelled
+func (d *Deliverer) Run(ctx context.Context) error {
+	return job.Run(ctx, d.interval, d.logger, func(ctx context.Context, now time.Time) ([]zap.Field, error) {
+		delivered, failed, err := d.DeliverDue(ctx, now)
+		if err != nil || (delivered == 0 && failed == 0) {
+			return nil, err
+		}
+		return []zap.Field{zap.Int("delivered", delivered), zap.Int("failed", failed)}, nil
+	})
+}
+
+// DeliverDue sends a batch of the deliveries which are due at now and returns how many were delivered and how many failed
+// a failed delivery is scheduled for a retry or becomes dead, only errors of the DB are returned
+// the batch is claimed first, so several deliverers never send the same delivery at once
+func (d *Deliverer) DeliverDue(ctx context.Context, now time.Time) (int, int, error) {
+	dbDeliveries, err := d.dao.Claim(ctx, now, d.batchSize, now.Add(d.claimFor()))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	delivered, failed := 0, 0
+	for _, dbDelivery := range dbDeliveries {
+		responseStatus, err := d.send(ctx, dbDelivery, now)
+		if err == nil {
+			err = d.dao.MarkDelivered(ctx, dbDelivery.ID, *responseStatus, now)
+			if err != nil {
+				return delivered, failed, err
+			}
+			delivered++
+			continue
+		}
+
+		failed++
+		var retryAt *time.Time
+		if attempts := dbDelivery.Attempts + 1; attempts < d.maxAttempts {
+			at := now.Add(d.retryAfter(attempts))
+			retryAt = &at
+		} else {
+			d.logger.Warn("webhook delivery is dead",
+				zap.Int("id", dbDelivery.ID), zap.Int("subscription_id", dbDelivery.SubscriptionID), zap.Error(err),
+			)
+		}
+
+		err = d.dao.MarkFailed(ctx, dbDelivery.ID, responseStatus, err.Error(), retryAt)
+		if err != nil {
+			return delivered, failed, err
+		}
+	}
+	return delivered, failed, nil
+}
+
+// claimFor returns how long a batch is claimed for, which is how long sending all of it can take
+// as its deliveries are sent one after the other and every one can take as long as the client timeout
+func (d *Deliverer) claimFor() time.Duration {
+	timeout := d.client.Timeout
+	if timeout <= 0 {
+		timeout = defaultClaimTimeout
+	}
+	return time.Duration(max(d.batchSize, 1)) * timeout
+}
+
+// retryAfter returns how long to wait after attempts failed attempts, doubling every time up to maxBackoff
+func (d *Deliverer) retryAfter(attempts int) time.Duration {
+	wait := d.backoff
+	for i := 1; i < attempts && wait < d.maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, d.maxBackoff)
+}
+
+// send POSTs the event of a delivery to its subscription signed at now
+// it returns the response status when the subscriber responded, which is an error unless it is 2xx
+func (d *Deliverer) send(ctx context.Context, dbDelivery *ent.WebhookDelivery, now time.Time) (*int, error) {
+	event := outbox.MapEntOutboxEventToEvent(dbDelivery.Edges.Event)
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dbDelivery.Edges.Subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-ID", strconv.Itoa(event.ID))
+	req.Header.Set("X-Event-Type", event.Type)
+	req.Header.Set(HeaderDeliveryID, strconv.Itoa(dbDelivery.ID))
+	req.Header.Set(HeaderSignature, Sign(dbDelivery.Edges.Subscription.Secret, now, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused, a subscriber which sends more is not worth reading on for
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &resp.StatusCode, fmt.Errorf("delivering event %d got status %d", event.ID, resp.StatusCode)
+	}
+	return &resp.StatusCode, nil
+}
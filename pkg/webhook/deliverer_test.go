@@ -0,0 +1,171 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+	"transactor-server/pkg/outbox"
+	"transactor-server/pkg/webhook"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+// subscriber is a local webhook receiver which checks the signature of every delivery
+type subscriber struct {
+	mu       sync.Mutex
+	failing  bool
+	received [][]byte
+	headers  []http.Header
+}
+
+func (s *subscriber) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failing {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if webhook.Verify("0123456789abcdef", r.Header.Get(webhook.HeaderSignature), body, time.Now(), time.Hour) != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	s.received = append(s.received, body)
+	s.headers = append(s.headers, r.Header)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *subscriber) setFailing(failing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failing = failing
+}
+
+func TestDeliverer(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+
+	sub := &subscriber{failing: true}
+	server := httptest.NewServer(sub)
+	defer server.Close()
+
+	dao := webhook.NewDAO(client)
+	service := webhook.NewService(dao, zap.NewNop())
+	subscription, err := service.Create(ctx, &webhook.CreateRequest{
+		URL:        server.URL,
+		EventTypes: []string{outbox.EventTransactionCreated},
+		Secret:     "0123456789abcdef",
+	})
+	require.NoError(t, err)
+
+	// the relay turns the events into deliveries, only the transaction is subscribed to
+	writeEvent(t, client, outbox.EventAccountCreated, 1)
+	event := writeEvent(t, client, outbox.EventTransactionCreated, 7)
+	relay := outbox.NewRelay(outbox.NewDAO(client), webhook.NewEnqueuer(dao), 0, 10, zap.NewNop())
+	published, err := relay.RelayPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, published)
+
+	deliverer := webhook.NewDeliverer(dao, server.Client(), 0, zap.NewNop(),
+		webhook.WithMaxAttempts(4),
+		webhook.WithBackoff(time.Minute, 3*time.Minute),
+	)
+
+	listDeliveries := func(status string) []*webhook.Delivery {
+		resp, err := service.ListDeliveries(ctx, &webhook.ListDeliveriesRequest{SubscriptionID: subscription.ID, Status: status})
+		require.NoError(t, err)
+		return resp.Deliveries
+	}
+
+	// every failure waits twice as long as the one before, up to the max backoff
+	now := time.Now()
+	for i, wait := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		delivered, failed, err := deliverer.DeliverDue(ctx, now)
+		require.NoError(t, err)
+		require.Zero(t, delivered)
+		require.Equal(t, 1, failed)
+
+		deliveries := listDeliveries("pending")
+		require.Len(t, deliveries, 1)
+		require.Equal(t, i+1, deliveries[0].Attempts)
+		require.Equal(t, http.StatusServiceUnavailable, *deliveries[0].ResponseStatus)
+		require.WithinDuration(t, now.Add(wait), deliveries[0].NextAttemptAt, time.Millisecond)
+
+		// nothing is due before the backoff passed
+		_, failed, err = deliverer.DeliverDue(ctx, now.Add(wait-time.Second))
+		require.NoError(t, err)
+		require.Zero(t, failed)
+
+		now = now.Add(wait)
+	}
+
+	// the last attempt moves it to the dead letters
+	_, failed, err := deliverer.DeliverDue(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, failed)
+	require.Empty(t, listDeliveries("pending"))
+	dead := listDeliveries("dead")
+	require.Len(t, dead, 1)
+	require.Equal(t, 4, dead[0].Attempts)
+	require.Equal(t, outbox.EventTransactionCreated, dead[0].EventType)
+
+	_, failed, err = deliverer.DeliverDue(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, failed)
+
+	// once the subscriber is back a manual redelivery gets through
+	sub.setFailing(false)
+	redelivered, err := service.Redeliver(ctx, dead[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, "pending", redelivered.Status)
+
+	delivered, _, err := deliverer.DeliverDue(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+
+	require.Len(t, sub.received, 1)
+	require.Equal(t, int64(event.ID), gjson.GetBytes(sub.received[0], "id").Int())
+	require.Equal(t, int64(7), gjson.GetBytes(sub.received[0], "payload.id").Int())
+	require.Equal(t, "TransactionCreated", sub.headers[0].Get("X-Event-Type"))
+	require.NotEmpty(t, sub.headers[0].Get(webhook.HeaderDeliveryID))
+
+	deliveries := listDeliveries("delivered")
+	require.Len(t, deliveries, 1)
+	require.Equal(t, http.StatusNoContent, *deliveries[0].ResponseStatus)
+	require.Nil(t, deliveries[0].LastError)
+}
+
+func TestDelivererWrongSecret(t *testing.T) {
+	t.Parallel()
+	client := setupDB(t)
+	ctx := context.Background()
+
+	server := httptest.NewServer(&subscriber{})
+	defer server.Close()
+
+	dao := webhook.NewDAO(client)
+	_, err := dao.Create(ctx, &webhook.CreateRequest{URL: server.URL, Secret: "fedcba9876543210"})
+	require.NoError(t, err)
+
+	event := writeEvent(t, client, outbox.EventTransactionCreated, 7)
+	_, err = dao.Enqueue(ctx, event, time.Now())
+	require.NoError(t, err)
+
+	// the subscriber rejects a delivery which was not signed with its secret
+	deliverer := webhook.NewDeliverer(dao, server.Client(), 0, zap.NewNop(), webhook.WithMaxAttempts(1))
+	delivered, failed, err := deliverer.DeliverDue(ctx, time.Now())
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+	require.Equal(t, 1, failed)
+	require.Equal(t, 1, client.WebhookDelivery.Query().CountX(ctx))
+	require.Equal(t, "dead", client.WebhookDelivery.Query().OnlyX(ctx).Status.String())
+}
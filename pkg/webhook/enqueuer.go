@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/outbox"
+)
+
+// Enqueuer is the outbox publisher of the webhooks, it turns every event into the deliveries of its subscriptions
+// the deliverer sends them afterwards, so a slow subscriber never holds up the outbox
+type Enqueuer struct {
+	dao DAO
+}
+
+var _ outbox.Publisher = (*Enqueuer)(nil)
+
+// NewEnqueuer returns a new Enqueuer which enqueues deliveries with dao
+func NewEnqueuer(dao DAO) *Enqueuer {
+	return &Enqueuer{
+		dao: dao,
+	}
+}
+
+func (e *Enqueuer) Publish(ctx context.Context, event *outbox.Event) error {
+	_, err := e.dao.Enqueue(ctx, event, time.Now())
+	return err
+}
@@ -0,0 +1,43 @@
+package webhook
+
+import "transactor-server/pkg/db/ent"
+
+// MapEntWebhookSubscriptionToSubscription maps an ent.WebhookSubscription record to webhook.Subscription model
+func MapEntWebhookSubscriptionToSubscription(s *ent.WebhookSubscription) *Subscription {
+	if s == nil {
+		return nil
+	}
+
+	return &Subscription{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: s.EventTypes,
+		CreatedAt:  s.CreateTime,
+		UpdatedAt:  s.UpdateTime,
+	}
+}
+
+// MapEntWebhookDeliveryToDelivery maps an ent.WebhookDelivery record to webhook.Delivery model
+// the event type is only set when the event edge was loaded
+func MapEntWebhookDeliveryToDelivery(d *ent.WebhookDelivery) *Delivery {
+	if d == nil {
+		return nil
+	}
+
+	delivery := &Delivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreateTime,
+	}
+	if d.Edges.Event != nil {
+		delivery.EventType = d.Edges.Event.Type
+	}
+	return delivery
+}
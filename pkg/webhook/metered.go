@@ -0,0 +1,176 @@
+package webhook
+
+import (
+	"context"
+	"transactor-server/pkg/infra/log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// meteredSevice is a middleware/wrapper to the webhook.Service
+// it like name suggests adds metrics to each service call
+// on success it adds webhook_service_<method>_success and on failure webhook_service_<method>_failure
+type meteredSevice struct {
+	service Service
+
+	meter metric.Meter
+
+	createCounterSuccess         metric.Int64Counter
+	createCounterFailure         metric.Int64Counter
+	listCounterSuccess           metric.Int64Counter
+	listCounterFailure           metric.Int64Counter
+	getCounterSuccess            metric.Int64Counter
+	getCounterFailure            metric.Int64Counter
+	deleteCounterSuccess         metric.Int64Counter
+	deleteCounterFailure         metric.Int64Counter
+	listDeliveriesCounterSuccess metric.Int64Counter
+	listDeliveriesCounterFailure metric.Int64Counter
+	redeliverCounterSuccess      metric.Int64Counter
+	redeliverCounterFailure      metric.Int64Counter
+}
+
+var _ Service = (*meteredSevice)(nil)
+
+func NewMeteredService(service Service) Service {
+	meter := otel.GetMeterProvider().Meter("transactor-server")
+
+	createCounterSuccess, err := meter.Int64Counter("webhook_service_create_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	createCounterFailure, err := meter.Int64Counter("webhook_service_create_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listCounterSuccess, err := meter.Int64Counter("webhook_service_list_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listCounterFailure, err := meter.Int64Counter("webhook_service_list_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterSuccess, err := meter.Int64Counter("webhook_service_get_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	getCounterFailure, err := meter.Int64Counter("webhook_service_get_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	deleteCounterSuccess, err := meter.Int64Counter("webhook_service_delete_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	deleteCounterFailure, err := meter.Int64Counter("webhook_service_delete_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listDeliveriesCounterSuccess, err := meter.Int64Counter("webhook_service_list_deliveries_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	listDeliveriesCounterFailure, err := meter.Int64Counter("webhook_service_list_deliveries_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	redeliverCounterSuccess, err := meter.Int64Counter("webhook_service_redeliver_success")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+	redeliverCounterFailure, err := meter.Int64Counter("webhook_service_redeliver_failure")
+	if err != nil {
+		log.L.Fatal("", zap.Error(err))
+	}
+
+	return &meteredSevice{
+		service:                      service,
+		meter:                        meter,
+		createCounterSuccess:         createCounterSuccess,
+		createCounterFailure:         createCounterFailure,
+		listCounterSuccess:           listCounterSuccess,
+		listCounterFailure:           listCounterFailure,
+		getCounterSuccess:            getCounterSuccess,
+		getCounterFailure:            getCounterFailure,
+		deleteCounterSuccess:         deleteCounterSuccess,
+		deleteCounterFailure:         deleteCounterFailure,
+		listDeliveriesCounterSuccess: listDeliveriesCounterSuccess,
+		listDeliveriesCounterFailure: listDeliveriesCounterFailure,
+		redeliverCounterSuccess:      redeliverCounterSuccess,
+		redeliverCounterFailure:      redeliverCounterFailure,
+	}
+}
+
+func (m *meteredSevice) Create(ctx context.Context, req *CreateRequest) (resp *Subscription, err error) {
+	defer func() {
+		if err == nil {
+			m.createCounterSuccess.Add(ctx, 1)
+		} else {
+			m.createCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Create(ctx, req)
+	return
+}
+
+func (m *meteredSevice) List(ctx context.Context) (resp *ListResponse, err error) {
+	defer func() {
+		if err == nil {
+			m.listCounterSuccess.Add(ctx, 1)
+		} else {
+			m.listCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.List(ctx)
+	return
+}
+
+func (m *meteredSevice) Get(ctx context.Context, id int) (resp *Subscription, err error) {
+	defer func() {
+		if err == nil {
+			m.getCounterSuccess.Add(ctx, 1)
+		} else {
+			m.getCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Get(ctx, id)
+	return
+}
+
+func (m *meteredSevice) Delete(ctx context.Context, id int) (err error) {
+	defer func() {
+		if err == nil {
+			m.deleteCounterSuccess.Add(ctx, 1)
+		} else {
+			m.deleteCounterFailure.Add(ctx, 1)
+		}
+	}()
+	err = m.service.Delete(ctx, id)
+	return
+}
+
+func (m *meteredSevice) ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) (resp *ListDeliveriesResponse, err error) {
+	defer func() {
+		if err == nil {
+			m.listDeliveriesCounterSuccess.Add(ctx, 1)
+		} else {
+			m.listDeliveriesCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.ListDeliveries(ctx, req)
+	return
+}
+
+func (m *meteredSevice) Redeliver(ctx context.Context, id int) (resp *Delivery, err error) {
+	defer func() {
+		if err == nil {
+			m.redeliverCounterSuccess.Add(ctx, 1)
+		} else {
+			m.redeliverCounterFailure.Add(ctx, 1)
+		}
+	}()
+	resp, err = m.service.Redeliver(ctx, id)
+	return
+}
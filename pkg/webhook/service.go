@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"time"
+	"transactor-server/pkg/pkgerr"
+
+	"go.uber.org/zap"
+)
+
+// Service handles the main business logic for webhook related things
+//
+//go:generate go run -mod=mod github.com/vektra/mockery/v2 --name Service --output ../mocks --structname MockWebhookService  --filename webhook_service.go
+type Service interface {
+	// Create subscribes an url to the events of some types, events which happened before are not delivered
+	Create(context.Context, *CreateRequest) (*Subscription, error)
+	// List returns every subscription
+	List(context.Context) (*ListResponse, error)
+	// Get returns a subscription
+	Get(context.Context, int) (*Subscription, error)
+	// Delete removes a subscription, its deliveries which are pending are never sent
+	Delete(context.Context, int) error
+	// ListDeliveries returns the deliveries of a subscription, filtered on dead it is the dead letter list
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error)
+	// Redeliver sends a delivery again right away with all of its attempts, whatever its status is
+	Redeliver(context.Context, int) (*Delivery, error)
+}
+
+type service struct {
+	webhookDAO DAO
+
+	logger *zap.Logger
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(
+	webhookDAO DAO,
+
+	logger *zap.Logger,
+) Service {
+	return &service{
+		webhookDAO: webhookDAO,
+
+		logger: logger,
+	}
+}
+
+func (s *service) Create(ctx context.Context, req *CreateRequest) (*Subscription, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	dbSubscription, err := s.webhookDAO.Create(ctx, req)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntWebhookSubscriptionToSubscription(dbSubscription), nil
+}
+
+func (s *service) List(ctx context.Context) (*ListResponse, error) {
+	dbSubscriptions, err := s.webhookDAO.List(ctx)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	resp := &ListResponse{
+		Subscriptions: make([]*Subscription, 0, len(dbSubscriptions)),
+	}
+	for _, dbSubscription := range dbSubscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, MapEntWebhookSubscriptionToSubscription(dbSubscription))
+	}
+
+	return resp, nil
+}
+
+func (s *service) Get(ctx context.Context, id int) (*Subscription, error) {
+	dbSubscription, err := s.webhookDAO.Get(ctx, id)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	return MapEntWebhookSubscriptionToSubscription(dbSubscription), nil
+}
+
+func (s *service) Delete(ctx context.Context, id int) error {
+	err := s.webhookDAO.Delete(ctx, id)
+	if err != nil {
+		return pkgerr.WrapDAOError(err)
+	}
+
+	return nil
+}
+
+func (s *service) ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	// run validations, please the function to know more!
+	err := req.Validate()
+	if err != nil {
+		return nil, pkgerr.WrapStructValidationError(err)
+	}
+
+	// a subscription which does not exist is not found rather than having no deliveries
+	_, err = s.webhookDAO.Get(ctx, req.SubscriptionID)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	dbDeliveries, err := s.webhookDAO.ListDeliveries(ctx, req)
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	resp := &ListDeliveriesResponse{
+		Deliveries: make([]*Delivery, 0, len(dbDeliveries)),
+	}
+	for _, dbDelivery := range dbDeliveries {
+		resp.Deliveries = append(resp.Deliveries, MapEntWebhookDeliveryToDelivery(dbDelivery))
+	}
+
+	return resp, nil
+}
+
+func (s *service) Redeliver(ctx context.Context, id int) (*Delivery, error) {
+	dbDelivery, err := s.webhookDAO.Redeliver(ctx, id, time.Now())
+	if err != nil {
+		return nil, pkgerr.WrapDAOError(err)
+	}
+
+	s.logger.Info("webhook delivery redelivered", zap.Int("id", id), zap.Int("subscription_id", dbDelivery.SubscriptionID))
+
+	return MapEntWebhookDeliveryToDelivery(dbDelivery), nil
+}
@@ -0,0 +1,192 @@
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+	"transactor-server/pkg/db/ent"
+	"transactor-server/pkg/db/ent/webhookdelivery"
+	"transactor-server/pkg/mocks"
+	"transactor-server/pkg/pkgerr"
+	"transactor-server/pkg/webhook"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var dbSubscription = &ent.WebhookSubscription{
+	ID:         1,
+	URL:        "https://example.com/webhooks",
+	EventTypes: []string{"TransactionCreated"},
+	Secret:     "0123456789abcdef",
+	CreateTime: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+	UpdateTime: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+}
+
+var dbDelivery = &ent.WebhookDelivery{
+	ID:             3,
+	SubscriptionID: 1,
+	EventID:        42,
+	Status:         webhookdelivery.StatusDead,
+	Attempts:       8,
+	NextAttemptAt:  time.Date(2026, time.October, 18, 1, 0, 0, 0, time.UTC),
+	CreateTime:     time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+	Edges: ent.WebhookDeliveryEdges{
+		Event: &ent.OutboxEvent{ID: 42, Type: "TransactionCreated"},
+	},
+}
+
+func TestServiceCreate(t *testing.T) {
+	for name, req := range map[string]*webhook.CreateRequest{
+		"no url":             {Secret: "0123456789abcdef"},
+		"relative url":       {URL: "/webhooks", Secret: "0123456789abcdef"},
+		"not http":           {URL: "ftp://example.com/webhooks", Secret: "0123456789abcdef"},
+		"unknown event type": {URL: "https://example.com", EventTypes: []string{"AccountDeleted"}, Secret: "0123456789abcdef"},
+		"no secret":          {URL: "https://example.com"},
+		"short secret":       {URL: "https://example.com", Secret: "secret"},
+		"long secret":        {URL: "https://example.com", Secret: strings.Repeat("s", 129)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			service := webhook.NewService(mocks.NewMockWebhookDAO(t), zap.NewNop())
+
+			resp, err := service.Create(context.Background(), req)
+
+			require.Error(t, err)
+			require.Nil(t, resp)
+			validationErr, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok)
+			require.Equal(t, http.StatusBadRequest, validationErr.HttpStatusCode())
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		webhookDAO := mocks.NewMockWebhookDAO(t)
+		service := webhook.NewService(webhookDAO, zap.NewNop())
+
+		req := &webhook.CreateRequest{URL: "https://example.com/webhooks", EventTypes: []string{"TransactionCreated"}, Secret: "0123456789abcdef"}
+		webhookDAO.On("Create", mock.Anything, req).Return(dbSubscription, nil)
+
+		resp, err := service.Create(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, resp.ID)
+		require.Equal(t, "https://example.com/webhooks", resp.URL)
+		require.Equal(t, []string{"TransactionCreated"}, resp.EventTypes)
+	})
+}
+
+func TestServiceGet(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		webhookDAO := mocks.NewMockWebhookDAO(t)
+		service := webhook.NewService(webhookDAO, zap.NewNop())
+
+		webhookDAO.On("Get", mock.Anything, 2).Return(nil, &ent.NotFoundError{})
+
+		_, err := service.Get(context.Background(), 2)
+
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		webhookDAO := mocks.NewMockWebhookDAO(t)
+		service := webhook.NewService(webhookDAO, zap.NewNop())
+
+		webhookDAO.On("Get", mock.Anything, 1).Return(dbSubscription, nil)
+
+		resp, err := service.Get(context.Background(), 1)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, resp.ID)
+	})
+}
+
+func TestServiceListDeliveries(t *testing.T) {
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+		service := webhook.NewService(mocks.NewMockWebhookDAO(t), zap.NewNop())
+
+		for _, req := range []*webhook.ListDeliveriesRequest{
+			{SubscriptionID: 1, Status: "failed"},
+			{SubscriptionID: 1, Limit: 101},
+			{SubscriptionID: 0},
+		} {
+			_, err := service.ListDeliveries(context.Background(), req)
+			_, ok := err.(*pkgerr.ValidationError)
+			require.True(t, ok, req)
+		}
+	})
+
+	t.Run("subscription not found", func(t *testing.T) {
+		t.Parallel()
+		webhookDAO := mocks.NewMockWebhookDAO(t)
+		service := webhook.NewService(webhookDAO, zap.NewNop())
+
+		webhookDAO.On("Get", mock.Anything, 2).Return(nil, &ent.NotFoundError{})
+
+		_, err := service.ListDeliveries(context.Background(), &webhook.ListDeliveriesRequest{SubscriptionID: 2})
+
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusNotFound, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		webhookDAO := mocks.NewMockWebhookDAO(t)
+		service := webhook.NewService(webhookDAO, zap.NewNop())
+
+		req := &webhook.ListDeliveriesRequest{SubscriptionID: 1, Status: "dead"}
+		webhookDAO.On("Get", mock.Anything, 1).Return(dbSubscription, nil)
+		webhookDAO.On("ListDeliveries", mock.Anything, req).Return([]*ent.WebhookDelivery{dbDelivery}, nil)
+
+		resp, err := service.ListDeliveries(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 1)
+		require.Equal(t, "dead", resp.Deliveries[0].Status)
+		require.Equal(t, "TransactionCreated", resp.Deliveries[0].EventType)
+	})
+}
+
+func TestServiceRedeliver(t *testing.T) {
+	t.Run("dao error", func(t *testing.T) {
+		t.Parallel()
+		webhookDAO := mocks.NewMockWebhookDAO(t)
+		service := webhook.NewService(webhookDAO, zap.NewNop())
+
+		webhookDAO.On("Redeliver", mock.Anything, 3, mock.Anything).Return(nil, fmt.Errorf("connection reset"))
+
+		_, err := service.Redeliver(context.Background(), 3)
+
+		serviceErr, ok := err.(*pkgerr.ServiceError)
+		require.True(t, ok)
+		require.Equal(t, http.StatusInternalServerError, serviceErr.HttpStatusCode())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		webhookDAO := mocks.NewMockWebhookDAO(t)
+		service := webhook.NewService(webhookDAO, zap.NewNop())
+
+		pending := *dbDelivery
+		pending.Status = webhookdelivery.StatusPending
+		pending.Attempts = 0
+		webhookDAO.On("Redeliver", mock.Anything, 3, mock.Anything).Return(&pending, nil)
+
+		resp, err := service.Redeliver(context.Background(), 3)
+
+		require.NoError(t, err)
+		require.Equal(t, "pending", resp.Status)
+		require.Zero(t, resp.Attempts)
+	})
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// headers sent with every delivery along with X-Event-ID & X-Event-Type
+const (
+	// HeaderSignature has the time the delivery was signed at and its signature as t=<unix seconds>,v1=<hex HMAC-SHA256>
+	HeaderSignature = "X-Webhook-Signature"
+	// HeaderDeliveryID is the id of the delivery, it is the same on every attempt
+	HeaderDeliveryID = "X-Webhook-Delivery-ID"
+)
+
+// ErrInvalidSignature indicates a signature header which is malformed, too old or does not match the body
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Sign returns the signature header of body signed with secret at
+// the HMAC-SHA256 is of "<unix seconds>.<body>", so a captured delivery can not be replayed with another time
+func Sign(secret string, at time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + signature(secret, timestamp, body)
+}
+
+// Verify checks that header is a signature of body with secret which was made at most tolerance before now
+// it is what a subscriber does with every delivery, a tolerance of 0 accepts signatures of any age
+func Verify(secret string, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var timestamp, sig string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			sig = value
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || sig == "" {
+		return ErrInvalidSignature
+	}
+	if tolerance > 0 && now.Sub(time.Unix(unix, 0)) > tolerance {
+		return ErrInvalidSignature
+	}
+	// constant time so the signature can not be guessed byte by byte
+	if !hmac.Equal([]byte(sig), []byte(signature(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// signature returns the hex HMAC-SHA256 of "<timestamp>.<body>" with secret
+func signature(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
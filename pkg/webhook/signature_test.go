@@ -0,0 +1,34 @@
+package webhook_test
+
+import (
+	"testing"
+	"time"
+	"transactor-server/pkg/webhook"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignature(t *testing.T) {
+	t.Parallel()
+	at := time.Unix(1760745600, 0)
+	body := []byte(`{"id":1}`)
+
+	header := webhook.Sign("0123456789abcdef", at, body)
+	// the HMAC-SHA256 of "1760745600.{"id":1}", so subscribers can check it with any library
+	require.Equal(t, "t=1760745600,v1=46915a63f58a0b09562227dad728d9a663e7039f2aea8be8e92787e52033ec42", header)
+
+	require.NoError(t, webhook.Verify("0123456789abcdef", header, body, at.Add(time.Minute), 5*time.Minute))
+	require.NoError(t, webhook.Verify("0123456789abcdef", header, body, at.Add(time.Hour), 0))
+
+	for name, err := range map[string]error{
+		"other secret": webhook.Verify("fedcba9876543210", header, body, at, 0),
+		"other body":   webhook.Verify("0123456789abcdef", header, []byte(`{"id":2}`), at, 0),
+		"too old":      webhook.Verify("0123456789abcdef", header, body, at.Add(time.Hour), 5*time.Minute),
+		"other time":   webhook.Verify("0123456789abcdef", webhook.Sign("0123456789abcdef", at.Add(time.Second), body)[:13]+header[13:], body, at, 0),
+		"no signature": webhook.Verify("0123456789abcdef", "t=1760745600", body, at, 0),
+		"no timestamp": webhook.Verify("0123456789abcdef", header[13:], body, at, 0),
+		"empty":        webhook.Verify("0123456789abcdef", "", body, at, 0),
+	} {
+		require.ErrorIs(t, err, webhook.ErrInvalidSignature, name)
+	}
+}
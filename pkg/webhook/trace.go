@@ -0,0 +1,186 @@
+package webhook
+
+import (
+	"context"
+	"transactor-server/pkg/config"
+
+	zapotlp "github.com/SigNoz/zap_otlp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// tracedService is a middleware/wrapper to the webhook.Service
+// it like name suggests adds a span to the each of the methods
+// on error it ensures that span is marked with error
+// it also logs request and response and error if any
+// the secret of a create request is never logged, only its url & event types
+type tracedService struct {
+	service Service
+	logger  *zap.Logger
+}
+
+func NewTracedService(service Service, logger *zap.Logger) Service {
+	return &tracedService{
+		service: service,
+		logger:  logger,
+	}
+}
+
+var _ Service = (*tracedService)(nil)
+
+func (t *tracedService) Create(ctx context.Context, req *CreateRequest) (resp *Subscription, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "WebhookService.Create")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling WebhookService.Create", zapotlp.SpanCtx(ctx), zap.String("url", req.URL), zap.Strings("event_types", req.EventTypes))
+
+	resp, err = t.service.Create(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end WebhookService.Create with error", zapotlp.SpanCtx(ctx), zap.String("url", req.URL), zap.Strings("event_types", req.EventTypes), zap.Error(err))
+	} else {
+		t.logger.Info("end WebhookService.Create", zapotlp.SpanCtx(ctx), zap.String("url", req.URL), zap.Strings("event_types", req.EventTypes), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) List(ctx context.Context) (resp *ListResponse, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "WebhookService.List")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling WebhookService.List", zapotlp.SpanCtx(ctx))
+
+	resp, err = t.service.List(ctx)
+
+	if err != nil {
+		t.logger.Error("end WebhookService.List with error", zapotlp.SpanCtx(ctx), zap.Error(err))
+	} else {
+		t.logger.Info("end WebhookService.List", zapotlp.SpanCtx(ctx), zap.Int("subscriptions", len(resp.Subscriptions)))
+	}
+
+	return
+}
+
+func (t *tracedService) Get(ctx context.Context, id int) (resp *Subscription, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "WebhookService.Get")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling WebhookService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	resp, err = t.service.Get(ctx, id)
+
+	if err != nil {
+		t.logger.Error("end WebhookService.Get with error", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+	} else {
+		t.logger.Info("end WebhookService.Get", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+	}
+
+	return
+}
+
+func (t *tracedService) Delete(ctx context.Context, id int) (err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "WebhookService.Delete")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling WebhookService.Delete", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	err = t.service.Delete(ctx, id)
+
+	if err != nil {
+		t.logger.Error("end WebhookService.Delete with error", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+	} else {
+		t.logger.Info("end WebhookService.Delete", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+	}
+
+	return
+}
+
+func (t *tracedService) ListDeliveries(ctx context.Context, req *ListDeliveriesRequest) (resp *ListDeliveriesResponse, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "WebhookService.ListDeliveries")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling WebhookService.ListDeliveries", zapotlp.SpanCtx(ctx), zap.Any("req", req))
+
+	resp, err = t.service.ListDeliveries(ctx, req)
+
+	if err != nil {
+		t.logger.Error("end WebhookService.ListDeliveries with error", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Error(err))
+	} else {
+		t.logger.Info("end WebhookService.ListDeliveries", zapotlp.SpanCtx(ctx), zap.Any("req", req), zap.Int("deliveries", len(resp.Deliveries)))
+	}
+
+	return
+}
+
+func (t *tracedService) Redeliver(ctx context.Context, id int) (resp *Delivery, err error) {
+	// start span
+	ctx, span := otel.Tracer(config.AppName).Start(ctx, "WebhookService.Redeliver")
+	// end span before returning
+	defer span.End()
+	defer func() {
+		// incase of error set the span status to error
+		if err != nil {
+			span.SetStatus(codes.Error, "error")
+			span.RecordError(err)
+		}
+	}()
+
+	t.logger.Info("calling WebhookService.Redeliver", zapotlp.SpanCtx(ctx), zap.Int("id", id))
+
+	resp, err = t.service.Redeliver(ctx, id)
+
+	if err != nil {
+		t.logger.Error("end WebhookService.Redeliver with error", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Error(err))
+	} else {
+		t.logger.Info("end WebhookService.Redeliver", zapotlp.SpanCtx(ctx), zap.Int("id", id), zap.Any("resp", resp))
+	}
+
+	return
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+	"transactor-server/pkg/outbox"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/samber/lo"
+)
+
+const (
+	// MaxURLLength is the longest url a subscription can have, which is the size of its column
+	MaxURLLength = 2048
+	// MinSecretLength & MaxSecretLength bound the secret of a subscription, short secrets are easy to guess
+	MinSecretLength = 16
+	MaxSecretLength = 128
+	// MaxListLimit is the most deliveries which are listed at once
+	MaxListLimit = 100
+)
+
+// CreateRequest subscribes an url to the outbox events of some types
+type CreateRequest struct {
+	URL string `json:"url" example:"https://example.com/webhooks"`
+	// EventTypes are the event types which are delivered, every type when it is empty
+	EventTypes []string `json:"event_types" example:"TransactionCreated"`
+	// Secret signs every delivery, it is not returned by any api
+	Secret string `json:"secret" example:"a-long-random-secret"`
+}
+
+// Validate validates the CreateRequest to
+// have an absolute http or https url,
+// have only known event types
+// and have a secret of MinSecretLength to MaxSecretLength characters
+func (req CreateRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.URL, validation.Required, validation.Length(0, MaxURLLength), validation.By(validateURL)),
+		validation.Field(&req.EventTypes, validation.Each(validation.In(lo.ToAnySlice(outbox.EventTypes)...))),
+		validation.Field(&req.Secret, validation.Required, validation.Length(MinSecretLength, MaxSecretLength)),
+	)
+}
+
+// validateURL checks that value is an absolute http or https url
+func validateURL(value interface{}) error {
+	u, err := url.Parse(value.(string))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an absolute http or https url")
+	}
+	return nil
+}
+
+// ListDeliveriesRequest lists the deliveries of a subscription, newest first
+type ListDeliveriesRequest struct {
+	SubscriptionID int `query:"-"`
+	// Status only lists the deliveries with this status, dead lists the dead letters
+	Status string `query:"status"`
+	// Limit is MaxListLimit when it is not sent
+	Limit int `query:"limit"`
+}
+
+// Validate validates the ListDeliveriesRequest to
+// have a +ve subscription id,
+// have a known status if any
+// and ensure limit is not more than MaxListLimit
+func (req ListDeliveriesRequest) Validate() error {
+	return validation.ValidateStruct(&req,
+		validation.Field(&req.SubscriptionID, validation.Required, validation.Min(1)),
+		validation.Field(&req.Status, validation.In("pending", "delivered", "dead")),
+		validation.Field(&req.Limit, validation.Min(0), validation.Max(MaxListLimit)),
+	)
+}
+
+type Subscription struct {
+	ID         int       `json:"id"`
+	URL        string    `json:"url" example:"https://example.com/webhooks"`
+	EventTypes []string  `json:"event_types" example:"TransactionCreated"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ListResponse struct {
+	Subscriptions []*Subscription `json:"subscriptions"`
+}
+
+// Delivery is one event which is POSTed to one subscription
+type Delivery struct {
+	ID             int    `json:"id"`
+	SubscriptionID int    `json:"subscription_id"`
+	EventID        int    `json:"event_id"`
+	EventType      string `json:"event_type" example:"TransactionCreated"`
+	// Status is pending till the subscriber responds with a 2xx status, or dead when it failed max attempts times
+	Status string `json:"status" enums:"pending,delivered,dead"`
+	// Attempts is how many times sending failed since it was created or redelivered
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	ResponseStatus *int       `json:"response_status,omitempty"`
+	LastError      *string    `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type ListDeliveriesResponse struct {
+	Deliveries []*Delivery `json:"deliveries"`
+}